@@ -48,23 +48,27 @@ func HTTPServer(
 	enc := goahttp.ResponseEncoder
 	mux := goahttp.NewMuxer()
 	mux.Use(otelhttp.NewMiddleware("enduro/internal/api", otelhttp.WithTracerProvider(tp)))
+	authn := newAuthenticator(logger.WithName("auth"), config.Auth)
 
 	// Pipeline service.
 	pipelineEndpoints := pipeline.NewEndpoints(pipesvc)
 	pipelineErrorHandler := errorHandler(logger, "Pipeline error.")
 	pipelineServer := pipelinesvr.New(pipelineEndpoints, mux, dec, enc, pipelineErrorHandler, nil)
+	authn.pipeline(pipelineServer)
 	pipelinesvr.Mount(mux, pipelineServer)
 
 	// Batch service.
 	batchEndpoints := batch.NewEndpoints(batchsvc)
 	batchErrorHandler := errorHandler(logger, "Batch error.")
 	batchServer := batchsvr.New(batchEndpoints, mux, dec, enc, batchErrorHandler, nil)
+	authn.batch(batchServer)
 	batchsvr.Mount(mux, batchServer)
 
 	// Collection service.
 	collectionEndpoints := collection.NewEndpoints(colsvc.Goa())
 	collectionErrorHandler := errorHandler(logger, "Collection error.")
 	collectionServer := collectionsvr.New(collectionEndpoints, mux, dec, enc, collectionErrorHandler, nil)
+	authn.collection(collectionServer)
 	collectionServer.Monitor = middleware.WriteTimeout(0)(collectionServer.Monitor)
 	collectionServer.Download = middleware.WriteTimeout(0)(collectionServer.Download)
 	// TODO: Return 202 when Temporal accepts the update and expose completion
@@ -90,7 +94,7 @@ func HTTPServer(
 	handler = middleware.VersionHeader("X-Enduro-Version", config.AppVersion)(handler)
 	handler = securityHeadersMiddleware(config.ContentSecurityPolicy)(handler)
 	if config.Debug {
-		handler = goahttpmwr.Log(loggerAdapter(logger))(handler)
+		handler = logRequests(loggerAdapter(logger))(handler)
 		handler = debug(mux, os.Stdout)(handler)
 	}
 
@@ -0,0 +1,181 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+
+	"github.com/artefactual-labs/enduro/internal/api/auth"
+	batchsvr "github.com/artefactual-labs/enduro/internal/api/gen/http/batch/server"
+	collectionsvr "github.com/artefactual-labs/enduro/internal/api/gen/http/collection/server"
+	pipelinesvr "github.com/artefactual-labs/enduro/internal/api/gen/http/pipeline/server"
+	intcol "github.com/artefactual-labs/enduro/internal/collection"
+)
+
+// methodRoles lists the role required by each method of the API services.
+// Methods that are not listed require the admin role.
+var methodRoles = map[string]map[string]auth.Role{
+	"collection": {
+		"monitor":            auth.RoleViewer,
+		"list":               auth.RoleViewer,
+		"show":               auth.RoleViewer,
+		"delete":             auth.RoleOperator,
+		"cancel":             auth.RoleOperator,
+		"retry":              auth.RoleOperator,
+		"workflow":           auth.RoleViewer,
+		"status_history":     auth.RoleViewer,
+		"notifications":      auth.RoleViewer,
+		"rescan":             auth.RoleViewer,
+		"retention":          auth.RoleViewer,
+		"retention_postpone": auth.RoleOperator,
+		"retention_cancel":   auth.RoleOperator,
+		"set_legal_hold":     auth.RoleAdmin,
+		"clear_legal_hold":   auth.RoleAdmin,
+		"retention_migrate":  auth.RoleAdmin,
+		"download":           auth.RoleViewer,
+		"decide":             auth.RoleOperator,
+		"bulk":               auth.RoleOperator,
+		"bulk_status":        auth.RoleViewer,
+		"bulk_runs":          auth.RoleViewer,
+		"bulk_run":           auth.RoleViewer,
+	},
+	"batch": {
+		"submit": auth.RoleOperator,
+		"status": auth.RoleViewer,
+		"hints":  auth.RoleViewer,
+		"browse": auth.RoleOperator,
+	},
+	"pipeline": {
+		"list":       auth.RoleViewer,
+		"show":       auth.RoleViewer,
+		"route":      auth.RoleViewer,
+		"pause":      auth.RoleAdmin,
+		"drain":      auth.RoleAdmin,
+		"resume":     auth.RoleAdmin,
+		"processing": auth.RoleViewer,
+	},
+}
+
+func methodRole(service, method string) auth.Role {
+	if role, ok := methodRoles[service][method]; ok {
+		return role
+	}
+
+	return auth.RoleAdmin
+}
+
+// authenticator requires API requests to carry a bearer token granting the
+// role of the method they call. A nil authenticator lets every request through.
+type authenticator struct {
+	logger   logr.Logger
+	verifier *auth.Verifier
+}
+
+func newAuthenticator(logger logr.Logger, config auth.Config) *authenticator {
+	if !config.Enabled {
+		return nil
+	}
+
+	return &authenticator{
+		logger:   logger,
+		verifier: auth.NewVerifier(config, nil),
+	}
+}
+
+// require returns a middleware that authenticates the requests of the given
+// method. The authenticated user becomes the actor of the status transitions
+// caused by the request.
+func (a *authenticator) require(service, method string) func(http.Handler) http.Handler {
+	if a == nil {
+		return func(h http.Handler) http.Handler {
+			return h
+		}
+	}
+
+	role := methodRole(service, method)
+
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			p, err := a.verifier.Authenticate(r)
+			if err != nil {
+				a.logger.V(1).Info("Request not authenticated.", "service", service, "method", method, "error", err)
+				code := "invalid_token"
+				if errors.Is(err, auth.ErrMissingToken) {
+					code = ""
+				}
+				writeAuthError(w, r, http.StatusUnauthorized, code, "unauthorized", err.Error())
+				return
+			}
+			if !p.HasRole(role) {
+				a.logger.V(1).Info("Request not authorized.", "service", service, "method", method, "subject", p.Subject)
+				writeAuthError(w, r, http.StatusForbidden, "insufficient_scope", "forbidden", "the "+string(role)+" role is required")
+				return
+			}
+
+			ctx := auth.WithPrincipal(r.Context(), p)
+			ctx = intcol.WithActor(ctx, intcol.APIActor(p.Username))
+			h.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// writeAuthError writes the error with the body used by the other API errors
+// and the challenge of RFC 6750.
+func writeAuthError(w http.ResponseWriter, r *http.Request, status int, code, name, msg string) {
+	challenge := `Bearer realm="enduro"`
+	if code != "" {
+		challenge += `, error="` + code + `"`
+	}
+	w.Header().Set("WWW-Authenticate", challenge)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	resp := goahttp.NewErrorResponse(r.Context(), goa.PermanentError(name, "%s", msg))
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (a *authenticator) pipeline(s *pipelinesvr.Server) {
+	s.List = a.require("pipeline", "list")(s.List)
+	s.Show = a.require("pipeline", "show")(s.Show)
+	s.Route = a.require("pipeline", "route")(s.Route)
+	s.Pause = a.require("pipeline", "pause")(s.Pause)
+	s.Drain = a.require("pipeline", "drain")(s.Drain)
+	s.Resume = a.require("pipeline", "resume")(s.Resume)
+	s.Processing = a.require("pipeline", "processing")(s.Processing)
+}
+
+func (a *authenticator) batch(s *batchsvr.Server) {
+	s.Submit = a.require("batch", "submit")(s.Submit)
+	s.Status = a.require("batch", "status")(s.Status)
+	s.Hints = a.require("batch", "hints")(s.Hints)
+	s.Browse = a.require("batch", "browse")(s.Browse)
+}
+
+func (a *authenticator) collection(s *collectionsvr.Server) {
+	s.Monitor = a.require("collection", "monitor")(s.Monitor)
+	s.List = a.require("collection", "list")(s.List)
+	s.Show = a.require("collection", "show")(s.Show)
+	s.Delete = a.require("collection", "delete")(s.Delete)
+	s.Cancel = a.require("collection", "cancel")(s.Cancel)
+	s.Retry = a.require("collection", "retry")(s.Retry)
+	s.Workflow = a.require("collection", "workflow")(s.Workflow)
+	s.StatusHistory = a.require("collection", "status_history")(s.StatusHistory)
+	s.Notifications = a.require("collection", "notifications")(s.Notifications)
+	s.Rescan = a.require("collection", "rescan")(s.Rescan)
+	s.Retention = a.require("collection", "retention")(s.Retention)
+	s.RetentionPostpone = a.require("collection", "retention_postpone")(s.RetentionPostpone)
+	s.RetentionCancel = a.require("collection", "retention_cancel")(s.RetentionCancel)
+	s.SetLegalHold = a.require("collection", "set_legal_hold")(s.SetLegalHold)
+	s.ClearLegalHold = a.require("collection", "clear_legal_hold")(s.ClearLegalHold)
+	s.RetentionMigrate = a.require("collection", "retention_migrate")(s.RetentionMigrate)
+	s.Download = a.require("collection", "download")(s.Download)
+	s.Decide = a.require("collection", "decide")(s.Decide)
+	s.Bulk = a.require("collection", "bulk")(s.Bulk)
+	s.BulkStatus = a.require("collection", "bulk_status")(s.BulkStatus)
+	s.BulkRuns = a.require("collection", "bulk_runs")(s.BulkRuns)
+	s.BulkRun = a.require("collection", "bulk_run")(s.BulkRun)
+}
@@ -0,0 +1,82 @@
+package auth
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+const (
+	defaultRolesClaim    = "roles"
+	defaultUsernameClaim = "sub"
+)
+
+// Config configures the authentication of API requests with bearer tokens.
+type Config struct {
+	// Enabled requires a valid bearer token in every API request.
+	Enabled bool
+
+	// Issuer is the expected value of the "iss" claim. When JWKS is empty the
+	// key set is discovered from the OpenID configuration of the issuer.
+	Issuer string
+
+	// Audience is the expected value of the "aud" claim. It is required so
+	// tokens issued by the identity provider for other clients are rejected.
+	Audience string
+
+	// JWKS is the URL or the local path of the JSON Web Key Set used to verify
+	// token signatures.
+	JWKS string
+
+	// RolesClaim is the claim listing the roles of the user. Nested claims are
+	// separated by dots, e.g. "realm_access.roles".
+	RolesClaim string
+
+	// UsernameClaim is the claim identifying the user in the status history.
+	UsernameClaim string
+}
+
+func (c Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.JWKS == "" && c.Issuer == "" {
+		return errors.New("jwks or issuer is required")
+	}
+	if c.Audience == "" {
+		return errors.New("audience is required")
+	}
+	if c.JWKS == "" {
+		if _, err := url.ParseRequestURI(c.Issuer); err != nil {
+			return errors.New("issuer must be a URL when jwks is empty")
+		}
+	}
+
+	return nil
+}
+
+func (c Config) rolesClaim() string {
+	if c.RolesClaim == "" {
+		return defaultRolesClaim
+	}
+
+	return c.RolesClaim
+}
+
+func (c Config) usernameClaim() string {
+	if c.UsernameClaim == "" {
+		return defaultUsernameClaim
+	}
+
+	return c.UsernameClaim
+}
+
+// jwksURL returns the configured key set URL, or empty when the key set is a
+// local file or must be discovered.
+func (c Config) jwksURL() string {
+	if strings.HasPrefix(c.JWKS, "http://") || strings.HasPrefix(c.JWKS, "https://") {
+		return c.JWKS
+	}
+
+	return ""
+}
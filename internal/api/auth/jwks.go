@@ -0,0 +1,298 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// keySetTTL is how long a key set is used before it is loaded again.
+	keySetTTL = time.Hour
+
+	// keySetMinInterval limits how often an unknown key ID triggers a reload,
+	// so that tokens signed with unknown keys cannot flood the JWKS source.
+	keySetMinInterval = time.Minute
+
+	// maxKeySetSize limits the size of the documents read from the network.
+	maxKeySetSize = 1 << 20
+)
+
+type publicKey struct {
+	id  string
+	alg string
+	key crypto.PublicKey
+}
+
+// keySet caches the keys of a JSON Web Key Set loaded from a local file, a URL
+// or the OpenID configuration of the issuer.
+type keySet struct {
+	config Config
+	client *http.Client
+	now    func() time.Time
+
+	// group shares a reload among the lookups that need it.
+	group singleflight.Group
+
+	mu       sync.Mutex
+	keys     []publicKey
+	loadedAt time.Time
+	loadErr  error
+}
+
+func newKeySet(config Config, client *http.Client, now func() time.Time) *keySet {
+	return &keySet{config: config, client: client, now: now}
+}
+
+// lookup returns the keys that may have signed a token with the given key ID
+// and algorithm. The key set is reloaded when it expires or when the key ID is
+// unknown, e.g. after the identity provider rotated its keys.
+//
+// The key set is loaded without holding the lock, so tokens signed with known
+// keys are still verified while a token signed with an unknown key waits on a
+// slow source.
+func (s *keySet) lookup(ctx context.Context, kid, alg string) ([]publicKey, error) {
+	keys, stale, loadErr := s.match(kid, alg)
+	if stale {
+		// The load is shared with the other lookups waiting on it, so it must
+		// not be interrupted when this request is canceled.
+		_, _, _ = s.group.Do("load", func() (any, error) {
+			if _, stale, _ := s.match(kid, alg); stale {
+				s.reload(context.WithoutCancel(ctx))
+			}
+			return nil, nil
+		})
+		keys, _, loadErr = s.match(kid, alg)
+	}
+
+	if len(keys) == 0 {
+		if loadErr != nil {
+			return nil, loadErr
+		}
+		return nil, fmt.Errorf("no key found for key ID %q and algorithm %s", kid, alg)
+	}
+
+	return keys, nil
+}
+
+// match returns the cached keys for the key ID and algorithm, whether the key
+// set must be loaded again and the error of the last load.
+func (s *keySet) match(kid, alg string) ([]publicKey, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	since := s.now().Sub(s.loadedAt)
+	keys := matchKeys(s.keys, kid, alg)
+	stale := since >= keySetTTL || (len(keys) == 0 && since >= keySetMinInterval)
+
+	return keys, stale, s.loadErr
+}
+
+func (s *keySet) reload(ctx context.Context) {
+	loaded, err := s.load(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Keep using the previous keys if the source is unavailable.
+	if err == nil {
+		s.keys = loaded
+	}
+	s.loadedAt = s.now()
+	s.loadErr = err
+}
+
+func matchKeys(keys []publicKey, kid, alg string) []publicKey {
+	var matches []publicKey
+	for _, k := range keys {
+		if kid != "" && k.id != kid {
+			continue
+		}
+		if k.alg != "" && k.alg != alg {
+			continue
+		}
+		matches = append(matches, k)
+	}
+
+	return matches
+}
+
+func (s *keySet) load(ctx context.Context) ([]publicKey, error) {
+	var (
+		blob []byte
+		err  error
+	)
+	switch {
+	case s.config.jwksURL() != "":
+		blob, err = s.fetch(ctx, s.config.jwksURL())
+	case s.config.JWKS != "":
+		blob, err = os.ReadFile(s.config.JWKS)
+	default:
+		var jwksURL string
+		jwksURL, err = s.discover(ctx)
+		if err == nil {
+			blob, err = s.fetch(ctx, jwksURL)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error loading JWKS: %w", err)
+	}
+
+	keys, err := parseKeySet(blob)
+	if err != nil {
+		return nil, fmt.Errorf("error loading JWKS: %w", err)
+	}
+
+	return keys, nil
+}
+
+// discover returns the key set URL published in the OpenID configuration of
+// the issuer.
+func (s *keySet) discover(ctx context.Context) (string, error) {
+	blob, err := s.fetch(ctx, strings.TrimSuffix(s.config.Issuer, "/")+"/.well-known/openid-configuration")
+	if err != nil {
+		return "", err
+	}
+
+	var doc struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := json.Unmarshal(blob, &doc); err != nil {
+		return "", fmt.Errorf("error decoding OpenID configuration: %w", err)
+	}
+	if doc.JWKSURI == "" {
+		return "", errors.New("OpenID configuration does not include jwks_uri")
+	}
+
+	return doc.JWKSURI, nil
+}
+
+func (s *keySet) fetch(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status fetching %s: %s", url, resp.Status)
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, maxKeySetSize))
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// parseKeySet decodes the signature keys of a JSON Web Key Set. Keys of
+// unsupported types are ignored.
+func parseKeySet(blob []byte) ([]publicKey, error) {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(blob, &set); err != nil {
+		return nil, fmt.Errorf("error decoding key set: %w", err)
+	}
+
+	keys := make([]publicKey, 0, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q: %w", jwk.Kid, err)
+		}
+		if key == nil {
+			continue
+		}
+		keys = append(keys, publicKey{id: jwk.Kid, alg: jwk.Alg, key: key})
+	}
+
+	return keys, nil
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid modulus: %w", err)
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exponent: %w", err)
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, errors.New("exponent is too large")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		size := (curve.Params().BitSize + 7) / 8
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != size {
+			return nil, errors.New("invalid x coordinate")
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil || len(y) != size {
+			return nil, errors.New("invalid y coordinate")
+		}
+		point := append(append([]byte{4}, x...), y...)
+		return ecdsa.ParseUncompressedPublicKey(curve, point)
+	default:
+		return nil, nil
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, errors.New("empty value")
+	}
+
+	return new(big.Int).SetBytes(b), nil
+}
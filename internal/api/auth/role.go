@@ -0,0 +1,62 @@
+package auth
+
+import "context"
+
+// Role grants access to a group of API methods. Roles are hierarchical: an
+// operator can do everything a viewer can and an admin everything an operator
+// can.
+type Role string
+
+const (
+	RoleViewer   Role = "viewer"
+	RoleOperator Role = "operator"
+	RoleAdmin    Role = "admin"
+)
+
+func (r Role) level() int {
+	switch r {
+	case RoleViewer:
+		return 1
+	case RoleOperator:
+		return 2
+	case RoleAdmin:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// Principal is the user authenticated by a bearer token.
+type Principal struct {
+	Subject  string
+	Username string
+	Roles    []Role
+}
+
+// HasRole reports whether the principal has the given role or a role above it.
+func (p *Principal) HasRole(role Role) bool {
+	if p == nil || role.level() == 0 {
+		return false
+	}
+	for _, r := range p.Roles {
+		if r.level() >= role.level() {
+			return true
+		}
+	}
+
+	return false
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying the authenticated principal.
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the principal authenticated for the request, or
+// nil when the request was not authenticated.
+func PrincipalFromContext(ctx context.Context) *Principal {
+	p, _ := ctx.Value(principalKey{}).(*Principal)
+	return p
+}
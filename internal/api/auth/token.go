@@ -0,0 +1,315 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// leeway tolerates small clock differences with the identity provider.
+const leeway = time.Minute
+
+var (
+	// ErrMissingToken is returned when the request does not carry a bearer
+	// token.
+	ErrMissingToken = errors.New("missing bearer token")
+
+	// ErrInvalidToken is returned when the bearer token cannot be trusted.
+	ErrInvalidToken = errors.New("invalid bearer token")
+)
+
+// Verifier authenticates API requests with JSON Web Tokens signed by one of the
+// keys of a JSON Web Key Set.
+type Verifier struct {
+	config Config
+	keys   *keySet
+	now    func() time.Time
+}
+
+// NewVerifier returns a verifier of the tokens issued as configured. The key
+// set is loaded when the first token is verified.
+func NewVerifier(config Config, client *http.Client) *Verifier {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	return &Verifier{
+		config: config,
+		keys:   newKeySet(config, client, time.Now),
+		now:    time.Now,
+	}
+}
+
+// Authenticate verifies the bearer token of the request and returns the
+// principal it identifies.
+func (v *Verifier) Authenticate(r *http.Request) (*Principal, error) {
+	token := bearerToken(r)
+	if token == "" {
+		return nil, ErrMissingToken
+	}
+
+	return v.Verify(r.Context(), token)
+}
+
+// bearerToken returns the token of the Authorization header. Event streams can
+// also pass it in the access_token query parameter because browsers cannot set
+// headers on them.
+func bearerToken(r *http.Request) string {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if ok && strings.EqualFold(scheme, "Bearer") {
+		return strings.TrimSpace(token)
+	}
+	if r.Method == http.MethodGet && strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+		return r.URL.Query().Get("access_token")
+	}
+
+	return ""
+}
+
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+type claims struct {
+	Issuer    string      `json:"iss"`
+	Subject   string      `json:"sub"`
+	Audience  audience    `json:"aud"`
+	ExpiresAt json.Number `json:"exp"`
+	NotBefore json.Number `json:"nbf"`
+}
+
+// audience is a string or an array of strings.
+type audience []string
+
+func (a *audience) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*a = audience{s}
+		return nil
+	}
+
+	var l []string
+	if err := json.Unmarshal(b, &l); err != nil {
+		return err
+	}
+	*a = l
+
+	return nil
+}
+
+// Verify checks the signature and the claims of a compact serialized token.
+func (v *Verifier) Verify(ctx context.Context, token string) (*Principal, error) {
+	p, err := v.verify(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	return p, nil
+}
+
+func (v *Verifier) verify(ctx context.Context, token string) (*Principal, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return nil, fmt.Errorf("error decoding header: %v", err)
+	}
+	hash, ok := signatureHashes[h.Alg]
+	if !ok {
+		return nil, fmt.Errorf("unsupported algorithm %q", h.Alg)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errors.New("error decoding signature")
+	}
+
+	keys, err := v.keys.lookup(ctx, h.Kid, h.Alg)
+	if err != nil {
+		return nil, err
+	}
+	signed := parts[0] + "." + parts[1]
+	if !slices.ContainsFunc(keys, func(k publicKey) bool {
+		return verifySignature(k.key, h.Alg, hash, []byte(signed), sig)
+	}) {
+		return nil, errors.New("signature verification failed")
+	}
+
+	var c claims
+	if err := decodeSegment(parts[1], &c); err != nil {
+		return nil, fmt.Errorf("error decoding claims: %v", err)
+	}
+	if err := v.validate(c); err != nil {
+		return nil, err
+	}
+
+	var all map[string]any
+	if err := decodeSegment(parts[1], &all); err != nil {
+		return nil, fmt.Errorf("error decoding claims: %v", err)
+	}
+	username, _ := lookupClaim(all, v.config.usernameClaim()).(string)
+	if username == "" {
+		username = c.Subject
+	}
+
+	return &Principal{
+		Subject:  c.Subject,
+		Username: username,
+		Roles:    roles(lookupClaim(all, v.config.rolesClaim())),
+	}, nil
+}
+
+func (v *Verifier) validate(c claims) error {
+	now := v.now()
+
+	if c.ExpiresAt == "" {
+		return errors.New("missing exp claim")
+	}
+	exp, err := numericDate(c.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("invalid exp claim: %v", err)
+	}
+	if now.After(exp.Add(leeway)) {
+		return errors.New("token is expired")
+	}
+
+	if c.NotBefore != "" {
+		nbf, err := numericDate(c.NotBefore)
+		if err != nil {
+			return fmt.Errorf("invalid nbf claim: %v", err)
+		}
+		if now.Before(nbf.Add(-leeway)) {
+			return errors.New("token is not valid yet")
+		}
+	}
+
+	if v.config.Issuer != "" && c.Issuer != v.config.Issuer {
+		return fmt.Errorf("unexpected issuer %q", c.Issuer)
+	}
+	if !slices.Contains(c.Audience, v.config.Audience) {
+		return errors.New("token is not intended for this audience")
+	}
+
+	return nil
+}
+
+// ecdsaCurves are the curves of the keys used by the ECDSA algorithms.
+var ecdsaCurves = map[string]string{
+	"ES256": "P-256",
+	"ES384": "P-384",
+	"ES512": "P-521",
+}
+
+var signatureHashes = map[string]crypto.Hash{
+	"RS256": crypto.SHA256,
+	"RS384": crypto.SHA384,
+	"RS512": crypto.SHA512,
+	"PS256": crypto.SHA256,
+	"PS384": crypto.SHA384,
+	"PS512": crypto.SHA512,
+	"ES256": crypto.SHA256,
+	"ES384": crypto.SHA384,
+	"ES512": crypto.SHA512,
+}
+
+func verifySignature(key crypto.PublicKey, alg string, hash crypto.Hash, signed, sig []byte) bool {
+	h := hash.New()
+	h.Write(signed)
+	digest := h.Sum(nil)
+
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		switch alg[:2] {
+		case "RS":
+			return rsa.VerifyPKCS1v15(key, hash, digest, sig) == nil
+		case "PS":
+			return rsa.VerifyPSS(key, hash, digest, sig, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash}) == nil
+		}
+	case *ecdsa.PublicKey:
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if ecdsaCurves[alg] != key.Curve.Params().Name || len(sig) != 2*size {
+			return false
+		}
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		return ecdsa.Verify(key, digest, r, s)
+	}
+
+	return false
+}
+
+func decodeSegment(seg string, v any) error {
+	blob, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(blob))
+	dec.UseNumber()
+
+	return dec.Decode(v)
+}
+
+func numericDate(n json.Number) (time.Time, error) {
+	f, err := n.Float64()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(int64(f), 0), nil
+}
+
+// lookupClaim returns the value of a claim, following dots into nested
+// objects.
+func lookupClaim(claims map[string]any, name string) any {
+	var value any = claims
+	for part := range strings.SplitSeq(name, ".") {
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = obj[part]
+	}
+
+	return value
+}
+
+// roles returns the known roles listed by a claim, which can be an array or a
+// space-separated string.
+func roles(value any) []Role {
+	var names []string
+	switch value := value.(type) {
+	case string:
+		names = strings.Fields(value)
+	case []any:
+		for _, v := range value {
+			if s, ok := v.(string); ok {
+				names = append(names, s)
+			}
+		}
+	}
+
+	var res []Role
+	for _, name := range names {
+		if role := Role(name); role.level() > 0 {
+			res = append(res, role)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,434 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+var (
+	rsaKey    = mustRSAKey()
+	ecKey     = mustECKey(elliptic.P256())
+	ecP384Key = mustECKey(elliptic.P384())
+)
+
+func mustRSAKey() *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+func mustECKey(curve elliptic.Curve) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(curve, rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func keySetJSON(t *testing.T) []byte {
+	t.Helper()
+
+	ecPub, err := ecKey.PublicKey.Bytes()
+	assert.NilError(t, err)
+	ecP384Pub, err := ecP384Key.PublicKey.Bytes()
+	assert.NilError(t, err)
+	blob, err := json.Marshal(map[string]any{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA",
+				"kid": "rsa",
+				"use": "sig",
+				"alg": "RS256",
+				"n":   encode(rsaKey.N.Bytes()),
+				"e":   encode(big.NewInt(int64(rsaKey.E)).Bytes()),
+			},
+			{
+				"kty": "EC",
+				"kid": "ec",
+				"crv": "P-256",
+				"x":   encode(ecPub[1:33]),
+				"y":   encode(ecPub[33:]),
+			},
+			{
+				"kty": "EC",
+				"kid": "ec-p384",
+				"crv": "P-384",
+				"x":   encode(ecP384Pub[1:49]),
+				"y":   encode(ecP384Pub[49:]),
+			},
+			{
+				"kty": "oct",
+				"kid": "secret",
+				"k":   "c2VjcmV0",
+			},
+		},
+	})
+	assert.NilError(t, err)
+
+	return blob
+}
+
+func writeKeySet(t *testing.T) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	assert.NilError(t, os.WriteFile(path, keySetJSON(t), 0o600))
+
+	return path
+}
+
+func sign(t *testing.T, alg, kid string, claims map[string]any) string {
+	t.Helper()
+
+	header, err := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	assert.NilError(t, err)
+	payload, err := json.Marshal(claims)
+	assert.NilError(t, err)
+	signed := encode(header) + "." + encode(payload)
+
+	var sig []byte
+	switch alg {
+	case "RS256":
+		digest := crypto.SHA256.New()
+		digest.Write([]byte(signed))
+		sig, err = rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, digest.Sum(nil))
+		assert.NilError(t, err)
+	case "ES256":
+		key, size := ecKey, 32
+		if kid == "ec-p384" {
+			key, size = ecP384Key, 48
+		}
+		digest := crypto.SHA256.New()
+		digest.Write([]byte(signed))
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest.Sum(nil))
+		assert.NilError(t, err)
+		sig = append(r.FillBytes(make([]byte, size)), s.FillBytes(make([]byte, size))...)
+	}
+
+	return signed + "." + encode(sig)
+}
+
+func TestVerifier(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	jwks := writeKeySet(t)
+	validClaims := func() map[string]any {
+		return map[string]any{
+			"iss":   "https://id.example.org",
+			"aud":   []string{"enduro", "other"},
+			"sub":   "1f8e",
+			"exp":   now.Add(time.Hour).Unix(),
+			"nbf":   now.Add(-time.Minute).Unix(),
+			"roles": []string{"operator", "unknown"},
+		}
+	}
+
+	tests := map[string]struct {
+		config  Config
+		token   func(t *testing.T) string
+		want    *Principal
+		wantErr string
+	}{
+		"Verifies RS256 tokens": {
+			token: func(t *testing.T) string { return sign(t, "RS256", "rsa", validClaims()) },
+			want:  &Principal{Subject: "1f8e", Username: "1f8e", Roles: []Role{RoleOperator}},
+		},
+		"Verifies ES256 tokens without key ID": {
+			token: func(t *testing.T) string { return sign(t, "ES256", "", validClaims()) },
+			want:  &Principal{Subject: "1f8e", Username: "1f8e", Roles: []Role{RoleOperator}},
+		},
+		"Rejects ES256 tokens signed with keys of other curves": {
+			token:   func(t *testing.T) string { return sign(t, "ES256", "ec-p384", validClaims()) },
+			wantErr: "invalid bearer token: signature verification failed",
+		},
+		"Reads nested roles and the username claim": {
+			config: Config{RolesClaim: "realm_access.roles", UsernameClaim: "preferred_username"},
+			token: func(t *testing.T) string {
+				claims := validClaims()
+				claims["realm_access"] = map[string]any{"roles": []string{"admin"}}
+				claims["preferred_username"] = "alice"
+				return sign(t, "RS256", "rsa", claims)
+			},
+			want: &Principal{Subject: "1f8e", Username: "alice", Roles: []Role{RoleAdmin}},
+		},
+		"Reads space-separated roles": {
+			token: func(t *testing.T) string {
+				claims := validClaims()
+				claims["roles"] = "viewer operator"
+				return sign(t, "RS256", "rsa", claims)
+			},
+			want: &Principal{Subject: "1f8e", Username: "1f8e", Roles: []Role{RoleViewer, RoleOperator}},
+		},
+		"Rejects expired tokens": {
+			token: func(t *testing.T) string {
+				claims := validClaims()
+				claims["exp"] = now.Add(-2 * time.Minute).Unix()
+				return sign(t, "RS256", "rsa", claims)
+			},
+			wantErr: "invalid bearer token: token is expired",
+		},
+		"Accepts tokens expired within the leeway": {
+			token: func(t *testing.T) string {
+				claims := validClaims()
+				claims["exp"] = now.Add(-30 * time.Second).Unix()
+				return sign(t, "RS256", "rsa", claims)
+			},
+			want: &Principal{Subject: "1f8e", Username: "1f8e", Roles: []Role{RoleOperator}},
+		},
+		"Rejects tokens without expiration": {
+			token: func(t *testing.T) string {
+				claims := validClaims()
+				delete(claims, "exp")
+				return sign(t, "RS256", "rsa", claims)
+			},
+			wantErr: "invalid bearer token: missing exp claim",
+		},
+		"Rejects tokens not valid yet": {
+			token: func(t *testing.T) string {
+				claims := validClaims()
+				claims["nbf"] = now.Add(time.Hour).Unix()
+				return sign(t, "RS256", "rsa", claims)
+			},
+			wantErr: "invalid bearer token: token is not valid yet",
+		},
+		"Rejects tokens of other issuers": {
+			token: func(t *testing.T) string {
+				claims := validClaims()
+				claims["iss"] = "https://evil.example.org"
+				return sign(t, "RS256", "rsa", claims)
+			},
+			wantErr: `invalid bearer token: unexpected issuer "https://evil.example.org"`,
+		},
+		"Rejects tokens without audience": {
+			token: func(t *testing.T) string {
+				claims := validClaims()
+				delete(claims, "aud")
+				return sign(t, "RS256", "rsa", claims)
+			},
+			wantErr: "invalid bearer token: token is not intended for this audience",
+		},
+		"Rejects tokens for other audiences": {
+			token: func(t *testing.T) string {
+				claims := validClaims()
+				claims["aud"] = "other"
+				return sign(t, "RS256", "rsa", claims)
+			},
+			wantErr: "invalid bearer token: token is not intended for this audience",
+		},
+		"Rejects tampered tokens": {
+			token: func(t *testing.T) string {
+				token := sign(t, "RS256", "rsa", validClaims())
+				claims := validClaims()
+				claims["roles"] = []string{"admin"}
+				payload, _ := json.Marshal(claims)
+				parts := strings.Split(token, ".")
+				return parts[0] + "." + encode(payload) + "." + parts[2]
+			},
+			wantErr: "invalid bearer token: signature verification failed",
+		},
+		"Rejects unsigned tokens": {
+			token: func(t *testing.T) string {
+				header, _ := json.Marshal(map[string]string{"alg": "none"})
+				payload, _ := json.Marshal(validClaims())
+				return encode(header) + "." + encode(payload) + "."
+			},
+			wantErr: `invalid bearer token: unsupported algorithm "none"`,
+		},
+		"Rejects unknown keys": {
+			token:   func(t *testing.T) string { return sign(t, "RS256", "rotated", validClaims()) },
+			wantErr: `invalid bearer token: no key found for key ID "rotated" and algorithm RS256`,
+		},
+		"Rejects malformed tokens": {
+			token:   func(t *testing.T) string { return "abc" },
+			wantErr: "invalid bearer token: malformed token",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config := tc.config
+			config.Enabled = true
+			config.Issuer = "https://id.example.org"
+			config.Audience = "enduro"
+			config.JWKS = jwks
+			v := NewVerifier(config, nil)
+			v.now = func() time.Time { return now }
+			v.keys.now = v.now
+
+			p, err := v.Verify(context.Background(), tc.token(t))
+			if tc.wantErr != "" {
+				assert.Error(t, err, tc.wantErr)
+				assert.Assert(t, errors.Is(err, ErrInvalidToken))
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, p, tc.want)
+		})
+	}
+}
+
+func TestVerifierDiscovery(t *testing.T) {
+	t.Parallel()
+
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			_ = json.NewEncoder(w).Encode(map[string]string{"issuer": srv.URL, "jwks_uri": srv.URL + "/certs"})
+		case "/certs":
+			_, _ = w.Write(keySetJSON(t))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	v := NewVerifier(Config{Enabled: true, Issuer: srv.URL, Audience: "enduro"}, srv.Client())
+	token := sign(t, "RS256", "rsa", map[string]any{
+		"iss":   srv.URL,
+		"aud":   "enduro",
+		"sub":   "1f8e",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"roles": []string{"viewer"},
+	})
+
+	p, err := v.Verify(context.Background(), token)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, p, &Principal{Subject: "1f8e", Username: "1f8e", Roles: []Role{RoleViewer}})
+}
+
+func TestKeySetReload(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	assert.NilError(t, os.WriteFile(path, []byte(`{"keys": []}`), 0o600))
+
+	now := time.Now()
+	v := NewVerifier(Config{Enabled: true, JWKS: path, Audience: "enduro"}, nil)
+	v.keys.now = func() time.Time { return now }
+	token := sign(t, "RS256", "rsa", map[string]any{"aud": "enduro", "exp": now.Add(time.Hour).Unix()})
+
+	_, err := v.Verify(context.Background(), token)
+	assert.ErrorContains(t, err, "no key found")
+
+	// Keys added to the set are only loaded once the minimum interval passed.
+	assert.NilError(t, os.WriteFile(path, keySetJSON(t), 0o600))
+	_, err = v.Verify(context.Background(), token)
+	assert.ErrorContains(t, err, "no key found")
+
+	now = now.Add(keySetMinInterval)
+	_, err = v.Verify(context.Background(), token)
+	assert.NilError(t, err)
+}
+
+func TestKeySetLoadsWithoutBlockingKnownKeys(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	fetching := make(chan struct{})
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The first load answers right away, the reload waits to be released.
+		if requests.Add(1) > 1 {
+			close(fetching)
+			<-release
+		}
+		_, _ = w.Write(keySetJSON(t))
+	}))
+	t.Cleanup(srv.Close)
+
+	now := time.Now()
+	v := NewVerifier(Config{Enabled: true, JWKS: srv.URL, Audience: "enduro"}, srv.Client())
+	v.keys.now = func() time.Time { return now }
+	claims := map[string]any{"aud": "enduro", "exp": now.Add(time.Hour).Unix()}
+	known := sign(t, "RS256", "rsa", claims)
+	unknown := sign(t, "RS256", "rotated", claims)
+
+	_, err := v.Verify(context.Background(), known)
+	assert.NilError(t, err)
+
+	// Tokens signed with an unknown key reload the key set once.
+	now = now.Add(keySetMinInterval)
+	var wg sync.WaitGroup
+	for range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := v.Verify(context.Background(), unknown)
+			assert.ErrorContains(t, err, "no key found")
+		}()
+	}
+
+	// Tokens signed with known keys are verified during the reload.
+	<-fetching
+	_, err = v.Verify(context.Background(), known)
+	assert.NilError(t, err)
+
+	close(release)
+	wg.Wait()
+	assert.Equal(t, requests.Load(), int32(2))
+}
+
+func TestBearerToken(t *testing.T) {
+	t.Parallel()
+
+	req := httptest.NewRequest(http.MethodGet, "/collection?access_token=query", nil)
+	req.Header.Set("Authorization", "bearer header")
+	assert.Equal(t, bearerToken(req), "header")
+
+	req = httptest.NewRequest(http.MethodGet, "/collection?access_token=query", nil)
+	assert.Equal(t, bearerToken(req), "")
+
+	req = httptest.NewRequest(http.MethodGet, "/collection/monitor?access_token=query", nil)
+	req.Header.Set("Accept", "text/event-stream")
+	assert.Equal(t, bearerToken(req), "query")
+}
+
+func TestConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	assert.NilError(t, Config{}.Validate())
+	assert.NilError(t, Config{Enabled: true, JWKS: "/etc/enduro/jwks.json", Audience: "enduro"}.Validate())
+	assert.NilError(t, Config{Enabled: true, Issuer: "https://id.example.org", Audience: "enduro"}.Validate())
+	assert.Error(t, Config{Enabled: true}.Validate(), "jwks or issuer is required")
+	assert.Error(t, Config{Enabled: true, Issuer: "https://id.example.org"}.Validate(), "audience is required")
+	assert.Error(t, Config{Enabled: true, Issuer: "enduro", Audience: "enduro"}.Validate(), "issuer must be a URL when jwks is empty")
+}
+
+func TestPrincipalHasRole(t *testing.T) {
+	t.Parallel()
+
+	operator := &Principal{Roles: []Role{RoleOperator}}
+	assert.Assert(t, operator.HasRole(RoleViewer))
+	assert.Assert(t, operator.HasRole(RoleOperator))
+	assert.Assert(t, !operator.HasRole(RoleAdmin))
+	assert.Assert(t, !(&Principal{}).HasRole(RoleViewer))
+	assert.Assert(t, !(*Principal)(nil).HasRole(RoleViewer))
+}
@@ -0,0 +1,184 @@
+package api
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/enduro/internal/api/auth"
+	"github.com/artefactual-labs/enduro/internal/api/gen/batch"
+	"github.com/artefactual-labs/enduro/internal/api/gen/collection"
+	"github.com/artefactual-labs/enduro/internal/api/gen/pipeline"
+	intcol "github.com/artefactual-labs/enduro/internal/collection"
+)
+
+func TestMethodRoles(t *testing.T) {
+	t.Parallel()
+
+	services := map[string][]string{
+		collection.ServiceName: collection.MethodNames[:],
+		batch.ServiceName:      batch.MethodNames[:],
+		pipeline.ServiceName:   pipeline.MethodNames[:],
+	}
+	for service, methods := range services {
+		for _, method := range methods {
+			_, ok := methodRoles[service][method]
+			assert.Assert(t, ok, "missing role of method %s.%s", service, method)
+		}
+	}
+
+	assert.Equal(t, methodRole("collection", "delete"), auth.RoleOperator)
+	assert.Equal(t, methodRole("collection", "bulk"), auth.RoleOperator)
+	assert.Equal(t, methodRole("batch", "submit"), auth.RoleOperator)
+	assert.Equal(t, methodRole("collection", "unknown"), auth.RoleAdmin)
+}
+
+func TestAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NilError(t, err)
+	jwks, err := json.Marshal(map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "test",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	})
+	assert.NilError(t, err)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	assert.NilError(t, os.WriteFile(path, jwks, 0o600))
+
+	token := func(roles ...string) string {
+		header, _ := json.Marshal(map[string]string{"alg": "RS256", "kid": "test"})
+		payload, _ := json.Marshal(map[string]any{
+			"iss":                "https://id.example.org",
+			"aud":                "enduro",
+			"sub":                "1f8e",
+			"preferred_username": "alice",
+			"exp":                time.Now().Add(time.Hour).Unix(),
+			"roles":              roles,
+		})
+		signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+		digest := crypto.SHA256.New()
+		digest.Write([]byte(signed))
+		sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest.Sum(nil))
+		assert.NilError(t, err)
+		return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+	}
+
+	authn := newAuthenticator(logr.Discard(), auth.Config{
+		Enabled:       true,
+		Issuer:        "https://id.example.org",
+		Audience:      "enduro",
+		JWKS:          path,
+		RolesClaim:    "roles",
+		UsernameClaim: "preferred_username",
+	})
+
+	tests := map[string]struct {
+		method        string
+		authorization string
+		wantStatus    int
+		wantChallenge string
+		wantActor     string
+	}{
+		"Rejects requests without token": {
+			method:        "delete",
+			wantStatus:    http.StatusUnauthorized,
+			wantChallenge: `Bearer realm="enduro"`,
+		},
+		"Rejects invalid tokens": {
+			method:        "delete",
+			authorization: "Bearer abc",
+			wantStatus:    http.StatusUnauthorized,
+			wantChallenge: `Bearer realm="enduro", error="invalid_token"`,
+		},
+		"Rejects users without the role of the method": {
+			method:        "delete",
+			authorization: "Bearer " + token("viewer"),
+			wantStatus:    http.StatusForbidden,
+			wantChallenge: `Bearer realm="enduro", error="insufficient_scope"`,
+		},
+		"Rejects users without roles": {
+			method:        "list",
+			authorization: "Bearer " + token(),
+			wantStatus:    http.StatusForbidden,
+			wantChallenge: `Bearer realm="enduro", error="insufficient_scope"`,
+		},
+		"Allows viewers to read": {
+			method:        "list",
+			authorization: "Bearer " + token("viewer"),
+			wantStatus:    http.StatusNoContent,
+			wantActor:     "api:alice",
+		},
+		"Allows operators to delete": {
+			method:        "delete",
+			authorization: "Bearer " + token("operator"),
+			wantStatus:    http.StatusNoContent,
+			wantActor:     "api:alice",
+		},
+		"Allows admins to delete": {
+			method:        "delete",
+			authorization: "Bearer " + token("admin"),
+			wantStatus:    http.StatusNoContent,
+			wantActor:     "api:alice",
+		},
+		"Rejects operators placing legal holds": {
+			method:        "set_legal_hold",
+			authorization: "Bearer " + token("operator"),
+			wantStatus:    http.StatusForbidden,
+			wantChallenge: `Bearer realm="enduro", error="insufficient_scope"`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var actor string
+			h := authn.require("collection", tc.method)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				actor = intcol.ActorFromContext(r.Context())
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			req := httptest.NewRequest(http.MethodGet, "http://example.com/collection", nil)
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+			rec := httptest.NewRecorder()
+
+			h.ServeHTTP(rec, req)
+
+			assert.Equal(t, rec.Code, tc.wantStatus)
+			assert.Equal(t, rec.Header().Get("WWW-Authenticate"), tc.wantChallenge)
+			assert.Equal(t, actor, tc.wantActor)
+		})
+	}
+}
+
+func TestAuthenticatorDisabled(t *testing.T) {
+	t.Parallel()
+
+	authn := newAuthenticator(logr.Discard(), auth.Config{})
+	h := authn.require("collection", "delete")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	req := httptest.NewRequest(http.MethodDelete, "http://example.com/collection/1", nil)
+	rec := httptest.NewRecorder()
+
+	h.ServeHTTP(rec, req)
+
+	assert.Equal(t, rec.Code, http.StatusNoContent)
+}
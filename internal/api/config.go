@@ -1,6 +1,10 @@
 package api
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/artefactual-labs/enduro/internal/api/auth"
+)
 
 type Config struct {
 	Listen                string
@@ -8,6 +12,7 @@ type Config struct {
 	AppVersion            string
 	AllowedOrigins        []string
 	ContentSecurityPolicy string
+	Auth                  auth.Config
 }
 
 func (c Config) Validate() error {
@@ -15,6 +20,9 @@ func (c Config) Validate() error {
 	if err != nil {
 		return fmt.Errorf("invalid API allowed origin: %w", err)
 	}
+	if err := c.Auth.Validate(); err != nil {
+		return fmt.Errorf("invalid API auth configuration: %w", err)
+	}
 
 	return nil
 }
@@ -9,7 +9,7 @@
 // issue where response bodies of type `application/x-7z-compressed` should not
 // be printed, this copy of the middleware was created and modified accordingly,
 // as the original debug middleware in the Goa library could not be directly
-// altered.
+// altered. The bearer tokens of the requests are not printed either.
 
 package api
 
@@ -43,7 +43,7 @@ func debug(mux goahttp.Muxer, w io.Writer) func(http.Handler) http.Handler {
 			}
 
 			// Request URL
-			fmt.Fprintf(buf, "> [%s] %s %s", reqID, r.Method, r.URL.String())
+			fmt.Fprintf(buf, "> [%s] %s %s", reqID, r.Method, redactURL(r.URL).String())
 
 			// Request Headers
 			keys := make([]string, len(r.Header))
@@ -54,7 +54,7 @@ func debug(mux goahttp.Muxer, w io.Writer) func(http.Handler) http.Handler {
 			}
 			sort.Strings(keys)
 			for _, k := range keys {
-				fmt.Fprintf(buf, "\n> [%s] %s: %s", reqID, k, strings.Join(r.Header[k], ", "))
+				fmt.Fprintf(buf, "\n> [%s] %s: %s", reqID, k, redactHeader(k, r.Header[k]))
 			}
 
 			// Request parameters
@@ -35,6 +35,10 @@ var _ = Service("collection", func() {
 			Attribute("status", String, func() {
 				EnumCollectionStatus()
 			})
+			Attribute("decision_activity", String, "Activity that failed in pending collections")
+			Attribute("failure_category", String, "Category of the Archivematica failure", func() {
+				EnumFailureCategory()
+			})
 			Attribute("cursor", String, "Pagination cursor")
 		})
 		Result(PaginatedCollectionOf(StoredCollection))
@@ -50,6 +54,8 @@ var _ = Service("collection", func() {
 				Param("earliest_created_time")
 				Param("latest_created_time")
 				Param("status")
+				Param("decision_activity")
+				Param("failure_category")
 				Param("cursor")
 			})
 		})
@@ -75,10 +81,12 @@ var _ = Service("collection", func() {
 			Required("id")
 		})
 		Error("not_found", CollectionNotFound, "Collection not found")
+		Error("legal_hold")
 		HTTP(func() {
 			DELETE("/{id}")
 			Response(StatusNoContent)
 			Response("not_found", StatusNotFound)
+			Response("legal_hold", StatusConflict)
 		})
 	})
 	Method("cancel", func() {
@@ -140,6 +148,117 @@ var _ = Service("collection", func() {
 			Response("not_found", StatusNotFound)
 		})
 	})
+	Method("notifications", func() {
+		Description("Retrieve the webhook notification delivery log for a collection")
+		Payload(func() {
+			Attribute("id", UInt, "Identifier of collection to look up")
+			Required("id")
+		})
+		Result(CollectionOf(NotificationDelivery))
+		Error("not_found", CollectionNotFound, "Collection not found")
+		HTTP(func() {
+			GET("/{id}/notifications")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+		})
+	})
+	Method("rescan", func() {
+		Description("List the blobs of a watcher that were never dispatched and are not known collections, without dispatching them")
+		Payload(func() {
+			Attribute("watcher", String, "Name of the watcher")
+			Required("watcher")
+		})
+		Result(CollectionOf(RescanObject))
+		Error("not_valid")
+		HTTP(func() {
+			GET("/rescan")
+			Param("watcher")
+			Response(StatusOK)
+			Response("not_valid", StatusBadRequest)
+		})
+	})
+	Method("retention", func() {
+		Description("List the scheduled deletions of the originals")
+		Payload(func() {
+			Attribute("status", String, "Status of the deletions", func() {
+				Enum("pending", "done", "failed", "canceled")
+			})
+		})
+		Result(CollectionOf(RetentionDeletion))
+		HTTP(func() {
+			GET("/retention")
+			Param("status")
+			Response(StatusOK)
+		})
+	})
+	Method("retention_postpone", func() {
+		Description("Change the due date of the pending deletion of the original of a collection")
+		Payload(func() {
+			Attribute("id", UInt, "Identifier of collection")
+			Attribute("due_at", String, "New due datetime", func() {
+				Format(FormatDateTime)
+			})
+			Required("id", "due_at")
+		})
+		Error("not_found", CollectionNotFound, "Collection not found")
+		HTTP(func() {
+			POST("/{id}/retention/postpone")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+		})
+	})
+	Method("retention_cancel", func() {
+		Description("Cancel the pending deletion of the original of a collection")
+		Payload(func() {
+			Attribute("id", UInt, "Identifier of collection")
+			Required("id")
+		})
+		Error("not_found", CollectionNotFound, "Collection not found")
+		HTTP(func() {
+			DELETE("/{id}/retention")
+			Response(StatusNoContent)
+			Response("not_found", StatusNotFound)
+		})
+	})
+	Method("set_legal_hold", func() {
+		Description("Place a collection under legal hold")
+		Payload(func() {
+			Attribute("id", UInt, "Identifier of collection")
+			Attribute("reason", String, "Reason of the legal hold")
+			Required("id", "reason")
+		})
+		Error("not_found", CollectionNotFound, "Collection not found")
+		HTTP(func() {
+			POST("/{id}/legal-hold")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+		})
+	})
+	Method("clear_legal_hold", func() {
+		Description("Release a collection from legal hold")
+		Payload(func() {
+			Attribute("id", UInt, "Identifier of collection")
+			Attribute("reason", String, "Reason of the release")
+			Required("id", "reason")
+		})
+		Error("not_found", CollectionNotFound, "Collection not found")
+		HTTP(func() {
+			DELETE("/{id}/legal-hold")
+			Params(func() {
+				Param("reason")
+			})
+			Response(StatusNoContent)
+			Response("not_found", StatusNotFound)
+		})
+	})
+	Method("retention_migrate", func() {
+		Description("Hand the retention timers of running processing workflows over to the retention scheduler")
+		Result(RetentionMigrateResult)
+		HTTP(func() {
+			POST("/retention/migrate")
+			Response(StatusOK)
+		})
+	})
 	Method("download", func() {
 		Description("Download collection by ID")
 		Payload(func() {
@@ -168,7 +287,9 @@ var _ = Service("collection", func() {
 		Description("Make decision for a pending collection by ID")
 		Payload(func() {
 			Attribute("id", UInt, "Identifier of collection to look up")
-			Attribute("option", String, "Decision option to proceed with")
+			Attribute("option", String, "Decision option to proceed with", func() {
+				EnumDecisionOption()
+			})
 			Required("id", "option")
 		})
 		Error("not_found", CollectionNotFound, "Collection not found")
@@ -184,10 +305,10 @@ var _ = Service("collection", func() {
 		})
 	})
 	Method("bulk", func() {
-		Description("Bulk operations (retry, cancel...).")
+		Description("Bulk operations (retry, cancel, decide...).")
 		Payload(func() {
 			Attribute("operation", String, func() {
-				Enum("retry", "cancel", "abandon")
+				Enum("retry", "cancel", "abandon", "decide")
 			})
 			Attribute("status", String, func() {
 				EnumCollectionStatus()
@@ -195,6 +316,25 @@ var _ = Service("collection", func() {
 			Attribute("size", UInt, func() {
 				Default(100)
 			})
+			Attribute("option", String, "Decision option of the decide operation", func() {
+				EnumDecisionOption()
+			})
+			Attribute("name", String, "Prefix of the name of the collections")
+			Attribute("original_id", String)
+			AttributeUUID("transfer_id", "Identifier of Archivematica tranfser")
+			AttributeUUID("aip_id", "Identifier of Archivematica AIP")
+			AttributeUUID("pipeline_id", "Identifier of Archivematica pipeline")
+			Attribute("earliest_created_time", String, func() {
+				Format(FormatDateTime)
+			})
+			Attribute("latest_created_time", String, func() {
+				Format(FormatDateTime)
+			})
+			Attribute("decision_activity", String, "Activity that failed in pending collections")
+			Attribute("ids", ArrayOf(UInt), "Identifiers of the collections, the filters are ignored when given")
+			Attribute("dry_run", Boolean, "Count the affected collections without running the operation", func() {
+				Default(false)
+			})
 			Required("operation", "status")
 		})
 		Result(BulkResult)
@@ -208,19 +348,49 @@ var _ = Service("collection", func() {
 		})
 	})
 	Method("bulk_status", func() {
-		Description("Retrieve status of current bulk operation.")
+		Description("Retrieve status of the most recent bulk operation.")
 		Result(BulkStatusResult)
 		HTTP(func() {
 			GET("/bulk")
 			Response(StatusOK)
 		})
 	})
+	Method("bulk_runs", func() {
+		Description("List the most recent bulk operations")
+		Result(ArrayOf(BulkRun))
+		HTTP(func() {
+			GET("/bulk/runs")
+			Response(StatusOK)
+		})
+	})
+	Method("bulk_run", func() {
+		Description("Show bulk operation by ID")
+		Payload(func() {
+			Attribute("id", UInt, "Identifier of the bulk operation")
+			Required("id")
+		})
+		Result(BulkRun)
+		Error("not_found", BulkRunNotFound, "Bulk operation not found")
+		HTTP(func() {
+			GET("/bulk/runs/{id}")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+		})
+	})
 })
 
 var EnumCollectionStatus = func() {
 	Enum("new", "in progress", "done", "error", "unknown", "queued", "pending", "abandoned")
 }
 
+var EnumFailureCategory = func() {
+	Enum("failed", "rejected", "unexpected_state", "api_error", "unreachable")
+}
+
+var EnumDecisionOption = func() {
+	Enum("RETRY", "RETRY_ONCE", "ABANDON", "SKIP")
+}
+
 var Collection = Type("Collection", func() {
 	Description("Collection describes a collection to be stored.")
 	Attribute("name", String, "Name of the collection")
@@ -306,6 +476,17 @@ var DetailedStoredCollection = ResultType("application/vnd.enduro.detailed-store
 			Format(FormatDateTime)
 		})
 		Attribute("reconciliation_error", String, "Last storage reconciliation error")
+		Attribute("legal_hold", Boolean, "Whether the collection is under legal hold")
+		Attribute("legal_hold_reason", String, "Reason of the legal hold")
+		Attribute("legal_hold_actor", String, "Person or system that placed the legal hold")
+		Attribute("legal_hold_at", String, "Datetime when the legal hold was placed", func() {
+			Format(FormatDateTime)
+		})
+		Attribute("decision", PendingDecision, "Failure awaiting an operator decision")
+		Attribute("validation", CollectionOf(ValidationResult), "Results of the transfer validators")
+		Attribute("pipeline_selection", String, "Explanation of the pipeline chosen by the scheduler")
+		Attribute("progress", Progress, "Latest progress reported by Archivematica")
+		Attribute("failure", Failure, "Reason why Archivematica could not process the collection")
 	})
 	View("default", func() {
 		Attribute("id")
@@ -324,8 +505,70 @@ var DetailedStoredCollection = ResultType("application/vnd.enduro.detailed-store
 		Attribute("reconciliation_status")
 		Attribute("reconciliation_checked_at")
 		Attribute("reconciliation_error")
+		Attribute("legal_hold")
+		Attribute("legal_hold_reason")
+		Attribute("legal_hold_actor")
+		Attribute("legal_hold_at")
+		Attribute("decision")
+		Attribute("validation")
+		Attribute("pipeline_selection")
+		Attribute("progress")
+		Attribute("failure")
 	})
-	Required("id", "status", "created_at")
+	Required("id", "status", "created_at", "legal_hold")
+})
+
+var PendingDecision = Type("EnduroCollectionPendingDecision", func() {
+	Description("PendingDecision describes the failure that a collection is waiting on an operator to decide about.")
+	Attribute("activity", String, "Name of the failed activity")
+	Attribute("error", String, "Error returned by the failed activity")
+	Attribute("options", ArrayOf(String, func() {
+		EnumDecisionOption()
+	}), "Decision options accepted by the workflow")
+	Required("activity", "error", "options")
+})
+
+var Progress = Type("EnduroCollectionProgress", func() {
+	Description("Progress describes the Archivematica jobs run for the transfer or the SIP of a collection.")
+	Attribute("stage", String, "Processing stage", func() {
+		Enum("transfer", "ingest")
+	})
+	Attribute("microservice", String, "Microservice of the current job")
+	Attribute("job", String, "Name of the current job")
+	Attribute("jobs_completed", UInt, "Number of jobs completed")
+	Attribute("jobs_total", UInt, "Number of jobs run so far")
+	Attribute("percent", UInt, "Percentage of the jobs run so far that completed")
+	Attribute("failed_jobs", ArrayOf(FailedJob), "Jobs that failed")
+	Attribute("updated_at", String, "Datetime of the snapshot", func() {
+		Format(FormatDateTime)
+	})
+	Required("stage", "jobs_completed", "jobs_total", "percent", "updated_at")
+})
+
+var FailedJob = Type("EnduroCollectionFailedJob", func() {
+	Attribute("id", String, "Identifier of the job")
+	Attribute("name", String, "Name of the job")
+	Attribute("microservice", String, "Microservice of the job")
+	Required("id", "name", "microservice")
+})
+
+var Failure = Type("EnduroCollectionFailure", func() {
+	Description("Failure describes why Archivematica could not process the transfer or the SIP of a collection.")
+	Attribute("category", String, "Category of the failure", func() {
+		EnumFailureCategory()
+	})
+	Attribute("stage", String, "Processing stage", func() {
+		Enum("transfer", "ingest")
+	})
+	Attribute("unit_id", String, "Identifier of the Archivematica transfer or SIP")
+	Attribute("microservice", String, "Microservice of the failed job")
+	Attribute("job", String, "Name of the failed job")
+	Attribute("stderr", String, "Excerpt of the standard error of the failed job")
+	Attribute("errors", ArrayOf(String), "Chain of errors seen by Enduro, outermost first")
+	Attribute("occurred_at", String, "Datetime of the failure", func() {
+		Format(FormatDateTime)
+	})
+	Required("category", "stage", "unit_id", "errors", "occurred_at")
 })
 
 var MonitorUpdate = Type("EnduroMonitorUpdate", func() {
@@ -335,6 +578,7 @@ var MonitorUpdate = Type("EnduroMonitorUpdate", func() {
 	Attribute("id", UInt, "Identifier of collection")
 	Attribute("type", String, "Type of the event")
 	Attribute("item", StoredCollection, "Collection")
+	Attribute("progress", Progress, "Progress of the collection, in collection:progress events")
 	Required("timestamp", "id", "type")
 })
 
@@ -388,11 +632,95 @@ var StatusTransition = ResultType("application/vnd.enduro.collection-status-tran
 			Format(FormatDateTime)
 		})
 		Attribute("is_run_start", Boolean, "Whether the transition starts a fully recorded workflow run")
-		Attribute("reason", String, "Machine-readable reason for the transition")
+		Attribute("reason", String, "Machine-readable reason for the transition, e.g. workflow_retried or deadline_exceeded")
+		Attribute("actor", String, "Person or system that caused the transition: system, api or api:<user>, bulk:<run> or watcher:<name>")
+		Attribute("detail", String, "Free-form detail provided with the transition")
 	})
 	Required("id", "workflow_id", "run_id", "status", "occurred_at", "is_run_start")
 })
 
+var NotificationDelivery = ResultType("application/vnd.enduro.collection-notification-delivery", func() {
+	Description("NotificationDelivery describes the delivery of a collection event to a webhook.")
+	Attributes(func() {
+		Attribute("id", UInt64, "Identifier of the delivery")
+		Attribute("webhook", String, "Name of the webhook")
+		Attribute("event", String, "Name of the event", func() {
+			Enum("queued", "in_progress", "done", "error", "pending", "abandoned", "decision_reminder")
+		})
+		Attribute("status", String, "Status of the delivery", func() {
+			Enum("pending", "delivered", "failed")
+		})
+		Attribute("attempts", Int, "Number of delivery attempts")
+		Attribute("response_code", Int, "HTTP status code of the last response")
+		Attribute("error", String, "Error of the last attempt")
+		Attribute("created_at", String, "Creation datetime", func() {
+			Format(FormatDateTime)
+		})
+		Attribute("updated_at", String, "Datetime of the last update", func() {
+			Format(FormatDateTime)
+		})
+	})
+	Required("id", "webhook", "event", "status", "attempts", "created_at", "updated_at")
+})
+
+var RescanObject = ResultType("application/vnd.enduro.collection-rescan-object", func() {
+	Description("RescanObject describes a blob found by a watcher rescan.")
+	Attributes(func() {
+		Attribute("watcher", String, "Name of the watcher")
+		Attribute("key", String, "Key of the blob")
+		Attribute("bucket", String, "Name of the bucket, when known")
+		Attribute("is_dir", Boolean, "Whether the blob is a directory")
+	})
+	Required("watcher", "key", "is_dir")
+})
+
+var RetentionDeletion = ResultType("application/vnd.enduro.collection-retention-deletion", func() {
+	Description("RetentionDeletion describes the scheduled deletion of the original of a collection.")
+	Attributes(func() {
+		Attribute("collection_id", UInt, "Identifier of the collection")
+		Attribute("watcher", String, "Name of the watcher")
+		Attribute("key", String, "Key of the original")
+		Attribute("status", String, "Status of the deletion", func() {
+			Enum("pending", "done", "failed", "canceled")
+		})
+		Attribute("due_at", String, "Due datetime", func() {
+			Format(FormatDateTime)
+		})
+		Attribute("attempts", Int, "Number of deletion attempts")
+		Attribute("error", String, "Error of the last attempt")
+		Attribute("created_at", String, "Creation datetime", func() {
+			Format(FormatDateTime)
+		})
+		Attribute("updated_at", String, "Datetime of the last update", func() {
+			Format(FormatDateTime)
+		})
+	})
+	Required("collection_id", "watcher", "key", "status", "due_at", "attempts", "created_at", "updated_at")
+})
+
+var RetentionMigrateResult = Type("RetentionMigrateResult", func() {
+	Attribute("signaled", UInt, "Number of running processing workflows signaled")
+	Required("signaled")
+})
+
+var ValidationResult = ResultType("application/vnd.enduro.collection-validation-result", func() {
+	Description("ValidationResult describes the outcome of a transfer validator.")
+	Attributes(func() {
+		Attribute("validator", String, "Name of the validator")
+		Attribute("severity", String, "Severity of the validator", func() {
+			Enum("fail", "warn")
+		})
+		Attribute("status", String, "Outcome of the validator", func() {
+			Enum("passed", "failed", "warned")
+		})
+		Attribute("message", String, "Description of the problems found")
+		Attribute("created_at", String, "Validation datetime", func() {
+			Format(FormatDateTime)
+		})
+	})
+	Required("validator", "severity", "status", "created_at")
+})
+
 var CollectionNotFound = Type("CollectionNotfound", func() {
 	Description("Collection not found.")
 	Attribute("message", String, "Message of error", func() {
@@ -403,9 +731,10 @@ var CollectionNotFound = Type("CollectionNotfound", func() {
 })
 
 var BulkResult = Type("BulkResult", func() {
+	Attribute("id", UInt, "Identifier of the bulk operation")
 	Attribute("workflow_id", String)
 	Attribute("run_id", String)
-	Required("workflow_id", "run_id")
+	Attribute("count", UInt, "Number of collections affected by the operation (dry run)")
 })
 
 var BulkStatusResult = Type("BulkStatusResult", func() {
@@ -419,5 +748,51 @@ var BulkStatusResult = Type("BulkStatusResult", func() {
 	Attribute("status", String)
 	Attribute("workflow_id", String)
 	Attribute("run_id", String)
+	Attribute("outcomes", ArrayOf(BulkOutcome), "Outcome of the operation on every collection")
 	Required("running")
 })
+
+var BulkOutcome = Type("BulkOutcome", func() {
+	Description("BulkOutcome describes the outcome of a bulk operation on a collection.")
+	Attribute("id", UInt, "Identifier of the collection")
+	Attribute("outcome", String, func() {
+		Enum("succeeded", "skipped", "failed")
+	})
+	Attribute("error", String, "Why the operation failed or skipped the collection")
+	Required("id", "outcome")
+})
+
+var BulkRun = Type("EnduroBulkRun", func() {
+	Description("EnduroBulkRun describes a bulk operation.")
+	Attribute("id", UInt, "Identifier of the bulk operation")
+	Attribute("workflow_id", String)
+	Attribute("run_id", String)
+	Attribute("operation", String, func() {
+		Enum("retry", "cancel", "abandon", "decide")
+	})
+	Attribute("status", String, "Status of the affected collections", func() {
+		EnumCollectionStatus()
+	})
+	Attribute("option", String, "Decision option of the decide operation")
+	Attribute("state", String, func() {
+		Enum("running", "completed", "failed")
+	})
+	Attribute("error", String, "Error that stopped the operation")
+	Attribute("created_at", String, func() {
+		Format(FormatDateTime)
+	})
+	Attribute("completed_at", String, func() {
+		Format(FormatDateTime)
+	})
+	Attribute("outcomes", ArrayOf(BulkOutcome), "Outcome of the operation on every collection, only included when showing a single operation")
+	Required("id", "workflow_id", "run_id", "operation", "status", "state", "created_at")
+})
+
+var BulkRunNotFound = Type("BulkRunNotFound", func() {
+	Description("Bulk operation not found.")
+	Attribute("message", String, "Message of error", func() {
+		Meta("struct:error:name")
+	})
+	Attribute("id", UInt, "Identifier of missing bulk operation")
+	Required("message", "id")
+})
@@ -26,7 +26,7 @@ var _ = API("enduro", func() {
 	})
 	cors.Origin("*", func() {
 		cors.Methods("GET", "HEAD", "POST", "PUT", "DELETE", "OPTIONS")
-		cors.Headers("Content-Type")
+		cors.Headers("Content-Type", "Authorization")
 		cors.Expose("X-Enduro-Version")
 	})
 })
@@ -39,6 +39,73 @@ var _ = Service("pipeline", func() {
 			Response("not_found", StatusNotFound)
 		})
 	})
+	Method("route", func() {
+		Description("Explain which routing rule selects the pipeline of a transfer")
+		Payload(func() {
+			Attribute("key", String, "Key (name) of the transfer")
+			Attribute("watcher", String, "Name of the watcher")
+			Attribute("transfer_type", String, "Archivematica transfer type", func() {
+				Default("standard")
+			})
+			Attribute("size", Int64, "Size of the transfer in bytes", func() {
+				Minimum(0)
+			})
+			Required("key")
+		})
+		Result(PipelineRoute)
+		HTTP(func() {
+			GET("/route")
+			Response(StatusOK)
+			Params(func() {
+				Param("key")
+				Param("watcher")
+				Param("transfer_type")
+				Param("size")
+			})
+		})
+	})
+	Method("pause", func() {
+		Description("Hold new transfers of a pipeline until it is resumed")
+		Payload(func() {
+			AttributeUUID("id", "Identifier of pipeline")
+			Required("id")
+		})
+		Result(StoredPipeline)
+		Error("not_found", PipelineNotFound, "Pipeline not found")
+		HTTP(func() {
+			POST("/{id}/pause")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+		})
+	})
+	Method("drain", func() {
+		Description("Send new transfers of a pipeline to other pipelines until it is resumed")
+		Payload(func() {
+			AttributeUUID("id", "Identifier of pipeline")
+			Required("id")
+		})
+		Result(StoredPipeline)
+		Error("not_found", PipelineNotFound, "Pipeline not found")
+		HTTP(func() {
+			POST("/{id}/drain")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+		})
+	})
+	Method("resume", func() {
+		Description("Resume sending new transfers to a paused or draining pipeline")
+		Payload(func() {
+			AttributeUUID("id", "Identifier of pipeline")
+			Required("id")
+		})
+		Result(StoredPipeline)
+		Error("not_found", PipelineNotFound, "Pipeline not found")
+		HTTP(func() {
+			POST("/{id}/resume")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+		})
+	})
 	Method("processing", func() {
 		Description("List all processing configurations of a pipeline given its ID")
 		Payload(func() {
@@ -61,6 +128,9 @@ var Pipeline = Type("Pipeline", func() {
 	Attribute("name", String, "Name of the pipeline")
 	Attribute("capacity", Int64, "Maximum concurrent transfers")
 	Attribute("current", Int64, "Current transfers")
+	Attribute("state", String, "Maintenance state", func() {
+		Enum("running", "paused", "draining")
+	})
 	Required("name")
 })
 
@@ -73,6 +143,8 @@ var StoredPipeline = ResultType("application/vnd.enduro.stored-pipeline", func()
 		Attribute("capacity")
 		Attribute("current")
 		Attribute("status")
+		Attribute("state")
+		Attribute("holders", ArrayOf(PipelineLease), "Workflows holding a slot of the pipeline, only included by show")
 	})
 	View("default", func() {
 		Attribute("id")
@@ -80,10 +152,42 @@ var StoredPipeline = ResultType("application/vnd.enduro.stored-pipeline", func()
 		Attribute("capacity")
 		Attribute("current")
 		Attribute("status")
+		Attribute("state")
+		Attribute("holders")
 	})
 	Required("name")
 })
 
+var PipelineLease = Type("EnduroPipelineLease", func() {
+	Description("EnduroPipelineLease describes a slot of a pipeline held by a workflow.")
+	Attribute("holder", String, "Identifier of the workflow holding the slot")
+	Attribute("worker", String, "Enduro instance that acquired the slot")
+	Attribute("acquired_at", String, "Datetime when the slot was acquired", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("expires_at", String, "Datetime when the lease expires unless it is renewed", func() {
+		Format(FormatDateTime)
+	})
+	Required("holder", "worker", "acquired_at", "expires_at")
+})
+
+var PipelineRoute = Type("EnduroPipelineRoute", func() {
+	Description("EnduroPipelineRoute describes the result of the routing rules.")
+	Attribute("rule", String, "Name of the matching rule, not included when no rule matches")
+	Attribute("pipelines", ArrayOf(String), "Candidate pipelines of the matching rule")
+	Attribute("processing_config", String, "Processing configuration of the matching rule")
+	Attribute("evaluations", ArrayOf(PipelineRuleEvaluation), "Result of every rule in order")
+	Required("pipelines", "evaluations")
+})
+
+var PipelineRuleEvaluation = Type("EnduroPipelineRuleEvaluation", func() {
+	Description("EnduroPipelineRuleEvaluation describes the result of a routing rule.")
+	Attribute("rule", String, "Name of the rule")
+	Attribute("matched", Boolean)
+	Attribute("reason", String, "Condition that was not met")
+	Required("rule", "matched")
+})
+
 var PipelineNotFound = Type("PipelineNotFound", func() {
 	Description("Pipeline not found.")
 	Attribute("message", String, "Message of error", func() {
@@ -17,35 +17,55 @@ import (
 
 // Client is the "collection" service client.
 type Client struct {
-	MonitorEndpoint       goa.Endpoint
-	ListEndpoint          goa.Endpoint
-	ShowEndpoint          goa.Endpoint
-	DeleteEndpoint        goa.Endpoint
-	CancelEndpoint        goa.Endpoint
-	RetryEndpoint         goa.Endpoint
-	WorkflowEndpoint      goa.Endpoint
-	StatusHistoryEndpoint goa.Endpoint
-	DownloadEndpoint      goa.Endpoint
-	DecideEndpoint        goa.Endpoint
-	BulkEndpoint          goa.Endpoint
-	BulkStatusEndpoint    goa.Endpoint
+	MonitorEndpoint           goa.Endpoint
+	ListEndpoint              goa.Endpoint
+	ShowEndpoint              goa.Endpoint
+	DeleteEndpoint            goa.Endpoint
+	CancelEndpoint            goa.Endpoint
+	RetryEndpoint             goa.Endpoint
+	WorkflowEndpoint          goa.Endpoint
+	StatusHistoryEndpoint     goa.Endpoint
+	NotificationsEndpoint     goa.Endpoint
+	RescanEndpoint            goa.Endpoint
+	RetentionEndpoint         goa.Endpoint
+	RetentionPostponeEndpoint goa.Endpoint
+	RetentionCancelEndpoint   goa.Endpoint
+	SetLegalHoldEndpoint      goa.Endpoint
+	ClearLegalHoldEndpoint    goa.Endpoint
+	RetentionMigrateEndpoint  goa.Endpoint
+	DownloadEndpoint          goa.Endpoint
+	DecideEndpoint            goa.Endpoint
+	BulkEndpoint              goa.Endpoint
+	BulkStatusEndpoint        goa.Endpoint
+	BulkRunsEndpoint          goa.Endpoint
+	BulkRunEndpoint           goa.Endpoint
 }
 
 // NewClient initializes a "collection" service client given the endpoints.
-func NewClient(monitor, list, show, delete_, cancel, retry, workflow, statusHistory, download, decide, bulk, bulkStatus goa.Endpoint) *Client {
+func NewClient(monitor, list, show, delete_, cancel, retry, workflow, statusHistory, notifications, rescan, retention, retentionPostpone, retentionCancel, setLegalHold, clearLegalHold, retentionMigrate, download, decide, bulk, bulkStatus, bulkRuns, bulkRun goa.Endpoint) *Client {
 	return &Client{
-		MonitorEndpoint:       monitor,
-		ListEndpoint:          list,
-		ShowEndpoint:          show,
-		DeleteEndpoint:        delete_,
-		CancelEndpoint:        cancel,
-		RetryEndpoint:         retry,
-		WorkflowEndpoint:      workflow,
-		StatusHistoryEndpoint: statusHistory,
-		DownloadEndpoint:      download,
-		DecideEndpoint:        decide,
-		BulkEndpoint:          bulk,
-		BulkStatusEndpoint:    bulkStatus,
+		MonitorEndpoint:           monitor,
+		ListEndpoint:              list,
+		ShowEndpoint:              show,
+		DeleteEndpoint:            delete_,
+		CancelEndpoint:            cancel,
+		RetryEndpoint:             retry,
+		WorkflowEndpoint:          workflow,
+		StatusHistoryEndpoint:     statusHistory,
+		NotificationsEndpoint:     notifications,
+		RescanEndpoint:            rescan,
+		RetentionEndpoint:         retention,
+		RetentionPostponeEndpoint: retentionPostpone,
+		RetentionCancelEndpoint:   retentionCancel,
+		SetLegalHoldEndpoint:      setLegalHold,
+		ClearLegalHoldEndpoint:    clearLegalHold,
+		RetentionMigrateEndpoint:  retentionMigrate,
+		DownloadEndpoint:          download,
+		DecideEndpoint:            decide,
+		BulkEndpoint:              bulk,
+		BulkStatusEndpoint:        bulkStatus,
+		BulkRunsEndpoint:          bulkRuns,
+		BulkRunEndpoint:           bulkRun,
 	}
 }
 
@@ -85,6 +105,7 @@ func (c *Client) Show(ctx context.Context, p *ShowPayload) (res *EnduroDetailedS
 // Delete calls the "delete" endpoint of the "collection" service.
 // Delete may return the following errors:
 //   - "not_found" (type *CollectionNotfound): Collection not found
+//   - "legal_hold" (type *goa.ServiceError)
 //   - error: internal error
 func (c *Client) Delete(ctx context.Context, p *DeletePayload) (err error) {
 	_, err = c.DeleteEndpoint(ctx, p)
@@ -142,6 +163,92 @@ func (c *Client) StatusHistory(ctx context.Context, p *StatusHistoryPayload) (re
 	return ires.(*EnduroCollectionStatusHistory), nil
 }
 
+// Notifications calls the "notifications" endpoint of the "collection" service.
+// Notifications may return the following errors:
+//   - "not_found" (type *CollectionNotfound): Collection not found
+//   - error: internal error
+func (c *Client) Notifications(ctx context.Context, p *NotificationsPayload) (res EnduroCollectionNotificationDeliveryCollection, err error) {
+	var ires any
+	ires, err = c.NotificationsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(EnduroCollectionNotificationDeliveryCollection), nil
+}
+
+// Rescan calls the "rescan" endpoint of the "collection" service.
+// Rescan may return the following errors:
+//   - "not_valid" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) Rescan(ctx context.Context, p *RescanPayload) (res EnduroCollectionRescanObjectCollection, err error) {
+	var ires any
+	ires, err = c.RescanEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(EnduroCollectionRescanObjectCollection), nil
+}
+
+// Retention calls the "retention" endpoint of the "collection" service.
+func (c *Client) Retention(ctx context.Context, p *RetentionPayload) (res EnduroCollectionRetentionDeletionCollection, err error) {
+	var ires any
+	ires, err = c.RetentionEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(EnduroCollectionRetentionDeletionCollection), nil
+}
+
+// RetentionPostpone calls the "retention_postpone" endpoint of the
+// "collection" service.
+// RetentionPostpone may return the following errors:
+//   - "not_found" (type *CollectionNotfound): Collection not found
+//   - error: internal error
+func (c *Client) RetentionPostpone(ctx context.Context, p *RetentionPostponePayload) (err error) {
+	_, err = c.RetentionPostponeEndpoint(ctx, p)
+	return
+}
+
+// RetentionCancel calls the "retention_cancel" endpoint of the "collection"
+// service.
+// RetentionCancel may return the following errors:
+//   - "not_found" (type *CollectionNotfound): Collection not found
+//   - error: internal error
+func (c *Client) RetentionCancel(ctx context.Context, p *RetentionCancelPayload) (err error) {
+	_, err = c.RetentionCancelEndpoint(ctx, p)
+	return
+}
+
+// SetLegalHold calls the "set_legal_hold" endpoint of the "collection" service.
+// SetLegalHold may return the following errors:
+//   - "not_found" (type *CollectionNotfound): Collection not found
+//   - error: internal error
+func (c *Client) SetLegalHold(ctx context.Context, p *SetLegalHoldPayload) (err error) {
+	_, err = c.SetLegalHoldEndpoint(ctx, p)
+	return
+}
+
+// ClearLegalHold calls the "clear_legal_hold" endpoint of the "collection"
+// service.
+// ClearLegalHold may return the following errors:
+//   - "not_found" (type *CollectionNotfound): Collection not found
+//   - error: internal error
+func (c *Client) ClearLegalHold(ctx context.Context, p *ClearLegalHoldPayload) (err error) {
+	_, err = c.ClearLegalHoldEndpoint(ctx, p)
+	return
+}
+
+// RetentionMigrate calls the "retention_migrate" endpoint of the "collection"
+// service.
+func (c *Client) RetentionMigrate(ctx context.Context) (res *RetentionMigrateResult, err error) {
+	var ires any
+	ires, err = c.RetentionMigrateEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.(*RetentionMigrateResult), nil
+}
+
 // Download calls the "download" endpoint of the "collection" service.
 // Download may return the following errors:
 //   - "not_found" (type *CollectionNotfound): Collection not found
@@ -189,3 +296,26 @@ func (c *Client) BulkStatus(ctx context.Context) (res *BulkStatusResult, err err
 	}
 	return ires.(*BulkStatusResult), nil
 }
+
+// BulkRuns calls the "bulk_runs" endpoint of the "collection" service.
+func (c *Client) BulkRuns(ctx context.Context) (res []*EnduroBulkRun, err error) {
+	var ires any
+	ires, err = c.BulkRunsEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.([]*EnduroBulkRun), nil
+}
+
+// BulkRun calls the "bulk_run" endpoint of the "collection" service.
+// BulkRun may return the following errors:
+//   - "not_found" (type *BulkRunNotFound): Bulk operation not found
+//   - error: internal error
+func (c *Client) BulkRun(ctx context.Context, p *BulkRunPayload) (res *EnduroBulkRun, err error) {
+	var ires any
+	ires, err = c.BulkRunEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*EnduroBulkRun), nil
+}
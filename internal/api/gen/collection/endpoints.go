@@ -17,18 +17,28 @@ import (
 
 // Endpoints wraps the "collection" service endpoints.
 type Endpoints struct {
-	Monitor       goa.Endpoint
-	List          goa.Endpoint
-	Show          goa.Endpoint
-	Delete        goa.Endpoint
-	Cancel        goa.Endpoint
-	Retry         goa.Endpoint
-	Workflow      goa.Endpoint
-	StatusHistory goa.Endpoint
-	Download      goa.Endpoint
-	Decide        goa.Endpoint
-	Bulk          goa.Endpoint
-	BulkStatus    goa.Endpoint
+	Monitor           goa.Endpoint
+	List              goa.Endpoint
+	Show              goa.Endpoint
+	Delete            goa.Endpoint
+	Cancel            goa.Endpoint
+	Retry             goa.Endpoint
+	Workflow          goa.Endpoint
+	StatusHistory     goa.Endpoint
+	Notifications     goa.Endpoint
+	Rescan            goa.Endpoint
+	Retention         goa.Endpoint
+	RetentionPostpone goa.Endpoint
+	RetentionCancel   goa.Endpoint
+	SetLegalHold      goa.Endpoint
+	ClearLegalHold    goa.Endpoint
+	RetentionMigrate  goa.Endpoint
+	Download          goa.Endpoint
+	Decide            goa.Endpoint
+	Bulk              goa.Endpoint
+	BulkStatus        goa.Endpoint
+	BulkRuns          goa.Endpoint
+	BulkRun           goa.Endpoint
 }
 
 // MonitorEndpointInput holds both the payload and the server stream of the
@@ -50,18 +60,28 @@ type DownloadResponseData struct {
 // NewEndpoints wraps the methods of the "collection" service with endpoints.
 func NewEndpoints(s Service) *Endpoints {
 	return &Endpoints{
-		Monitor:       NewMonitorEndpoint(s),
-		List:          NewListEndpoint(s),
-		Show:          NewShowEndpoint(s),
-		Delete:        NewDeleteEndpoint(s),
-		Cancel:        NewCancelEndpoint(s),
-		Retry:         NewRetryEndpoint(s),
-		Workflow:      NewWorkflowEndpoint(s),
-		StatusHistory: NewStatusHistoryEndpoint(s),
-		Download:      NewDownloadEndpoint(s),
-		Decide:        NewDecideEndpoint(s),
-		Bulk:          NewBulkEndpoint(s),
-		BulkStatus:    NewBulkStatusEndpoint(s),
+		Monitor:           NewMonitorEndpoint(s),
+		List:              NewListEndpoint(s),
+		Show:              NewShowEndpoint(s),
+		Delete:            NewDeleteEndpoint(s),
+		Cancel:            NewCancelEndpoint(s),
+		Retry:             NewRetryEndpoint(s),
+		Workflow:          NewWorkflowEndpoint(s),
+		StatusHistory:     NewStatusHistoryEndpoint(s),
+		Notifications:     NewNotificationsEndpoint(s),
+		Rescan:            NewRescanEndpoint(s),
+		Retention:         NewRetentionEndpoint(s),
+		RetentionPostpone: NewRetentionPostponeEndpoint(s),
+		RetentionCancel:   NewRetentionCancelEndpoint(s),
+		SetLegalHold:      NewSetLegalHoldEndpoint(s),
+		ClearLegalHold:    NewClearLegalHoldEndpoint(s),
+		RetentionMigrate:  NewRetentionMigrateEndpoint(s),
+		Download:          NewDownloadEndpoint(s),
+		Decide:            NewDecideEndpoint(s),
+		Bulk:              NewBulkEndpoint(s),
+		BulkStatus:        NewBulkStatusEndpoint(s),
+		BulkRuns:          NewBulkRunsEndpoint(s),
+		BulkRun:           NewBulkRunEndpoint(s),
 	}
 }
 
@@ -75,10 +95,20 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.Retry = m(e.Retry)
 	e.Workflow = m(e.Workflow)
 	e.StatusHistory = m(e.StatusHistory)
+	e.Notifications = m(e.Notifications)
+	e.Rescan = m(e.Rescan)
+	e.Retention = m(e.Retention)
+	e.RetentionPostpone = m(e.RetentionPostpone)
+	e.RetentionCancel = m(e.RetentionCancel)
+	e.SetLegalHold = m(e.SetLegalHold)
+	e.ClearLegalHold = m(e.ClearLegalHold)
+	e.RetentionMigrate = m(e.RetentionMigrate)
 	e.Download = m(e.Download)
 	e.Decide = m(e.Decide)
 	e.Bulk = m(e.Bulk)
 	e.BulkStatus = m(e.BulkStatus)
+	e.BulkRuns = m(e.BulkRuns)
+	e.BulkRun = m(e.BulkRun)
 }
 
 // NewMonitorEndpoint returns an endpoint function that calls the method
@@ -168,6 +198,92 @@ func NewStatusHistoryEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewNotificationsEndpoint returns an endpoint function that calls the method
+// "notifications" of service "collection".
+func NewNotificationsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*NotificationsPayload)
+		res, err := s.Notifications(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedEnduroCollectionNotificationDeliveryCollection(res, "default")
+		return vres, nil
+	}
+}
+
+// NewRescanEndpoint returns an endpoint function that calls the method
+// "rescan" of service "collection".
+func NewRescanEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RescanPayload)
+		res, err := s.Rescan(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedEnduroCollectionRescanObjectCollection(res, "default")
+		return vres, nil
+	}
+}
+
+// NewRetentionEndpoint returns an endpoint function that calls the method
+// "retention" of service "collection".
+func NewRetentionEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RetentionPayload)
+		res, err := s.Retention(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedEnduroCollectionRetentionDeletionCollection(res, "default")
+		return vres, nil
+	}
+}
+
+// NewRetentionPostponeEndpoint returns an endpoint function that calls the
+// method "retention_postpone" of service "collection".
+func NewRetentionPostponeEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RetentionPostponePayload)
+		return nil, s.RetentionPostpone(ctx, p)
+	}
+}
+
+// NewRetentionCancelEndpoint returns an endpoint function that calls the
+// method "retention_cancel" of service "collection".
+func NewRetentionCancelEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RetentionCancelPayload)
+		return nil, s.RetentionCancel(ctx, p)
+	}
+}
+
+// NewSetLegalHoldEndpoint returns an endpoint function that calls the method
+// "set_legal_hold" of service "collection".
+func NewSetLegalHoldEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SetLegalHoldPayload)
+		return nil, s.SetLegalHold(ctx, p)
+	}
+}
+
+// NewClearLegalHoldEndpoint returns an endpoint function that calls the method
+// "clear_legal_hold" of service "collection".
+func NewClearLegalHoldEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ClearLegalHoldPayload)
+		return nil, s.ClearLegalHold(ctx, p)
+	}
+}
+
+// NewRetentionMigrateEndpoint returns an endpoint function that calls the
+// method "retention_migrate" of service "collection".
+func NewRetentionMigrateEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		return s.RetentionMigrate(ctx)
+	}
+}
+
 // NewDownloadEndpoint returns an endpoint function that calls the method
 // "download" of service "collection".
 func NewDownloadEndpoint(s Service) goa.Endpoint {
@@ -206,3 +322,20 @@ func NewBulkStatusEndpoint(s Service) goa.Endpoint {
 		return s.BulkStatus(ctx)
 	}
 }
+
+// NewBulkRunsEndpoint returns an endpoint function that calls the method
+// "bulk_runs" of service "collection".
+func NewBulkRunsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		return s.BulkRuns(ctx)
+	}
+}
+
+// NewBulkRunEndpoint returns an endpoint function that calls the method
+// "bulk_run" of service "collection".
+func NewBulkRunEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*BulkRunPayload)
+		return s.BulkRun(ctx, p)
+	}
+}
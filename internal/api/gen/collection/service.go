@@ -34,6 +34,24 @@ type Service interface {
 	Workflow(context.Context, *WorkflowPayload) (res *EnduroCollectionWorkflowStatus, err error)
 	// Retrieve the recorded status transition history for a collection
 	StatusHistory(context.Context, *StatusHistoryPayload) (res *EnduroCollectionStatusHistory, err error)
+	// Retrieve the webhook notification delivery log for a collection
+	Notifications(context.Context, *NotificationsPayload) (res EnduroCollectionNotificationDeliveryCollection, err error)
+	// List the blobs of a watcher that were never dispatched and are not known
+	// collections, without dispatching them
+	Rescan(context.Context, *RescanPayload) (res EnduroCollectionRescanObjectCollection, err error)
+	// List the scheduled deletions of the originals
+	Retention(context.Context, *RetentionPayload) (res EnduroCollectionRetentionDeletionCollection, err error)
+	// Change the due date of the pending deletion of the original of a collection
+	RetentionPostpone(context.Context, *RetentionPostponePayload) (err error)
+	// Cancel the pending deletion of the original of a collection
+	RetentionCancel(context.Context, *RetentionCancelPayload) (err error)
+	// Place a collection under legal hold
+	SetLegalHold(context.Context, *SetLegalHoldPayload) (err error)
+	// Release a collection from legal hold
+	ClearLegalHold(context.Context, *ClearLegalHoldPayload) (err error)
+	// Hand the retention timers of running processing workflows over to the
+	// retention scheduler
+	RetentionMigrate(context.Context) (res *RetentionMigrateResult, err error)
 	// Download collection by ID
 
 	// If body implements [io.WriterTo], that implementation will be used instead.
@@ -42,10 +60,14 @@ type Service interface {
 	Download(context.Context, *DownloadPayload) (res *DownloadResult, body io.ReadCloser, err error)
 	// Make decision for a pending collection by ID
 	Decide(context.Context, *DecidePayload) (err error)
-	// Bulk operations (retry, cancel...).
+	// Bulk operations (retry, cancel, decide...).
 	Bulk(context.Context, *BulkPayload) (res *BulkResult, err error)
-	// Retrieve status of current bulk operation.
+	// Retrieve status of the most recent bulk operation.
 	BulkStatus(context.Context) (res *BulkStatusResult, err error)
+	// List the most recent bulk operations
+	BulkRuns(context.Context) (res []*EnduroBulkRun, err error)
+	// Show bulk operation by ID
+	BulkRun(context.Context, *BulkRunPayload) (res *EnduroBulkRun, err error)
 }
 
 // APIName is the name of the API as defined in the design.
@@ -62,7 +84,7 @@ const ServiceName = "collection"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [12]string{"monitor", "list", "show", "delete", "cancel", "retry", "workflow", "status_history", "download", "decide", "bulk", "bulk_status"}
+var MethodNames = [22]string{"monitor", "list", "show", "delete", "cancel", "retry", "workflow", "status_history", "notifications", "rescan", "retention", "retention_postpone", "retention_cancel", "set_legal_hold", "clear_legal_hold", "retention_migrate", "download", "decide", "bulk", "bulk_status", "bulk_runs", "bulk_run"}
 
 // MonitorServerStream allows streaming instances of *EnduroMonitorUpdate to
 // the client.
@@ -85,17 +107,63 @@ type MonitorClientStream interface {
 	RecvWithContext(context.Context) (*EnduroMonitorUpdate, error)
 }
 
+// BulkOutcome describes the outcome of a bulk operation on a collection.
+type BulkOutcome struct {
+	// Identifier of the collection
+	ID      uint
+	Outcome string
+	// Why the operation failed or skipped the collection
+	Error *string
+}
+
 // BulkPayload is the payload type of the collection service bulk method.
 type BulkPayload struct {
 	Operation string
 	Status    string
 	Size      uint
+	// Decision option of the decide operation
+	Option *string
+	// Prefix of the name of the collections
+	Name       *string
+	OriginalID *string
+	// Identifier of Archivematica tranfser
+	TransferID *string
+	// Identifier of Archivematica AIP
+	AipID *string
+	// Identifier of Archivematica pipeline
+	PipelineID          *string
+	EarliestCreatedTime *string
+	LatestCreatedTime   *string
+	// Activity that failed in pending collections
+	DecisionActivity *string
+	// Identifiers of the collections, the filters are ignored when given
+	Ids []uint
+	// Count the affected collections without running the operation
+	DryRun bool
 }
 
 // BulkResult is the result type of the collection service bulk method.
 type BulkResult struct {
-	WorkflowID string
-	RunID      string
+	// Identifier of the bulk operation
+	ID         *uint
+	WorkflowID *string
+	RunID      *string
+	// Number of collections affected by the operation (dry run)
+	Count *uint
+}
+
+// Bulk operation not found.
+type BulkRunNotFound struct {
+	// Message of error
+	Message string
+	// Identifier of missing bulk operation
+	ID uint
+}
+
+// BulkRunPayload is the payload type of the collection service bulk_run method.
+type BulkRunPayload struct {
+	// Identifier of the bulk operation
+	ID uint
 }
 
 // BulkStatusResult is the result type of the collection service bulk_status
@@ -107,6 +175,8 @@ type BulkStatusResult struct {
 	Status     *string
 	WorkflowID *string
 	RunID      *string
+	// Outcome of the operation on every collection
+	Outcomes []*BulkOutcome
 }
 
 // CancelPayload is the payload type of the collection service cancel method.
@@ -115,6 +185,15 @@ type CancelPayload struct {
 	ID uint
 }
 
+// ClearLegalHoldPayload is the payload type of the collection service
+// clear_legal_hold method.
+type ClearLegalHoldPayload struct {
+	// Identifier of collection
+	ID uint
+	// Reason of the release
+	Reason string
+}
+
 // Collection not found.
 type CollectionNotfound struct {
 	// Message of error
@@ -151,6 +230,159 @@ type DownloadResult struct {
 	ContentDisposition string
 }
 
+// EnduroBulkRun is the result type of the collection service bulk_run method.
+type EnduroBulkRun struct {
+	// Identifier of the bulk operation
+	ID         uint
+	WorkflowID string
+	RunID      string
+	Operation  string
+	// Status of the affected collections
+	Status string
+	// Decision option of the decide operation
+	Option *string
+	State  string
+	// Error that stopped the operation
+	Error       *string
+	CreatedAt   string
+	CompletedAt *string
+	// Outcome of the operation on every collection, only included when showing a
+	// single operation
+	Outcomes []*BulkOutcome
+}
+
+type EnduroCollectionFailedJob struct {
+	// Identifier of the job
+	ID string
+	// Name of the job
+	Name string
+	// Microservice of the job
+	Microservice string
+}
+
+// Failure describes why Archivematica could not process the transfer or the
+// SIP of a collection.
+type EnduroCollectionFailure struct {
+	// Category of the failure
+	Category string
+	// Processing stage
+	Stage string
+	// Identifier of the Archivematica transfer or SIP
+	UnitID string
+	// Microservice of the failed job
+	Microservice *string
+	// Name of the failed job
+	Job *string
+	// Excerpt of the standard error of the failed job
+	Stderr *string
+	// Chain of errors seen by Enduro, outermost first
+	Errors []string
+	// Datetime of the failure
+	OccurredAt string
+}
+
+// NotificationDelivery describes the delivery of a collection event to a
+// webhook.
+type EnduroCollectionNotificationDelivery struct {
+	// Identifier of the delivery
+	ID uint64
+	// Name of the webhook
+	Webhook string
+	// Name of the event
+	Event string
+	// Status of the delivery
+	Status string
+	// Number of delivery attempts
+	Attempts int
+	// HTTP status code of the last response
+	ResponseCode *int
+	// Error of the last attempt
+	Error *string
+	// Creation datetime
+	CreatedAt string
+	// Datetime of the last update
+	UpdatedAt string
+}
+
+// EnduroCollectionNotificationDeliveryCollection is the result type of the
+// collection service notifications method.
+type EnduroCollectionNotificationDeliveryCollection []*EnduroCollectionNotificationDelivery
+
+// PendingDecision describes the failure that a collection is waiting on an
+// operator to decide about.
+type EnduroCollectionPendingDecision struct {
+	// Name of the failed activity
+	Activity string
+	// Error returned by the failed activity
+	Error string
+	// Decision options accepted by the workflow
+	Options []string
+}
+
+// Progress describes the Archivematica jobs run for the transfer or the SIP of
+// a collection.
+type EnduroCollectionProgress struct {
+	// Processing stage
+	Stage string
+	// Microservice of the current job
+	Microservice *string
+	// Name of the current job
+	Job *string
+	// Number of jobs completed
+	JobsCompleted uint
+	// Number of jobs run so far
+	JobsTotal uint
+	// Percentage of the jobs run so far that completed
+	Percent uint
+	// Jobs that failed
+	FailedJobs []*EnduroCollectionFailedJob
+	// Datetime of the snapshot
+	UpdatedAt string
+}
+
+// RescanObject describes a blob found by a watcher rescan.
+type EnduroCollectionRescanObject struct {
+	// Name of the watcher
+	Watcher string
+	// Key of the blob
+	Key string
+	// Name of the bucket, when known
+	Bucket *string
+	// Whether the blob is a directory
+	IsDir bool
+}
+
+// EnduroCollectionRescanObjectCollection is the result type of the collection
+// service rescan method.
+type EnduroCollectionRescanObjectCollection []*EnduroCollectionRescanObject
+
+// RetentionDeletion describes the scheduled deletion of the original of a
+// collection.
+type EnduroCollectionRetentionDeletion struct {
+	// Identifier of the collection
+	CollectionID uint
+	// Name of the watcher
+	Watcher string
+	// Key of the original
+	Key string
+	// Status of the deletion
+	Status string
+	// Due datetime
+	DueAt string
+	// Number of deletion attempts
+	Attempts int
+	// Error of the last attempt
+	Error *string
+	// Creation datetime
+	CreatedAt string
+	// Datetime of the last update
+	UpdatedAt string
+}
+
+// EnduroCollectionRetentionDeletionCollection is the result type of the
+// collection service retention method.
+type EnduroCollectionRetentionDeletionCollection []*EnduroCollectionRetentionDeletion
+
 // EnduroCollectionStatusHistory is the result type of the collection service
 // status_history method.
 type EnduroCollectionStatusHistory struct {
@@ -175,12 +407,34 @@ type EnduroCollectionStatusTransition struct {
 	OccurredAt string
 	// Whether the transition starts a fully recorded workflow run
 	IsRunStart bool
-	// Machine-readable reason for the transition
+	// Machine-readable reason for the transition, e.g. workflow_retried or
+	// deadline_exceeded
 	Reason *string
+	// Person or system that caused the transition: system, api or api:<user>,
+	// bulk:<run> or watcher:<name>
+	Actor *string
+	// Free-form detail provided with the transition
+	Detail *string
 }
 
 type EnduroCollectionStatusTransitionCollection []*EnduroCollectionStatusTransition
 
+// ValidationResult describes the outcome of a transfer validator.
+type EnduroCollectionValidationResult struct {
+	// Name of the validator
+	Validator string
+	// Severity of the validator
+	Severity string
+	// Outcome of the validator
+	Status string
+	// Description of the problems found
+	Message *string
+	// Validation datetime
+	CreatedAt string
+}
+
+type EnduroCollectionValidationResultCollection []*EnduroCollectionValidationResult
+
 // WorkflowHistoryEvent describes a history event in Temporal.
 type EnduroCollectionWorkflowHistory struct {
 	// Identifier of collection
@@ -235,6 +489,24 @@ type EnduroDetailedStoredCollection struct {
 	ReconciliationCheckedAt *string
 	// Last storage reconciliation error
 	ReconciliationError *string
+	// Whether the collection is under legal hold
+	LegalHold bool
+	// Reason of the legal hold
+	LegalHoldReason *string
+	// Person or system that placed the legal hold
+	LegalHoldActor *string
+	// Datetime when the legal hold was placed
+	LegalHoldAt *string
+	// Failure awaiting an operator decision
+	Decision *EnduroCollectionPendingDecision
+	// Results of the transfer validators
+	Validation EnduroCollectionValidationResultCollection
+	// Explanation of the pipeline chosen by the scheduler
+	PipelineSelection *string
+	// Latest progress reported by Archivematica
+	Progress *EnduroCollectionProgress
+	// Reason why Archivematica could not process the collection
+	Failure *EnduroCollectionFailure
 }
 
 // EnduroMonitorUpdate is the result type of the collection service monitor
@@ -247,6 +519,8 @@ type EnduroMonitorUpdate struct {
 	Type string
 	// Collection
 	Item *EnduroStoredCollection
+	// Progress of the collection, in collection:progress events
+	Progress *EnduroCollectionProgress
 }
 
 // StoredCollection describes a collection retrieved by the service.
@@ -292,6 +566,10 @@ type ListPayload struct {
 	EarliestCreatedTime *string
 	LatestCreatedTime   *string
 	Status              *string
+	// Activity that failed in pending collections
+	DecisionActivity *string
+	// Category of the Archivematica failure
+	FailureCategory *string
 	// Pagination cursor
 	Cursor *string
 }
@@ -302,6 +580,49 @@ type ListResult struct {
 	NextCursor *string
 }
 
+// NotificationsPayload is the payload type of the collection service
+// notifications method.
+type NotificationsPayload struct {
+	// Identifier of collection to look up
+	ID uint
+}
+
+// RescanPayload is the payload type of the collection service rescan method.
+type RescanPayload struct {
+	// Name of the watcher
+	Watcher string
+}
+
+// RetentionCancelPayload is the payload type of the collection service
+// retention_cancel method.
+type RetentionCancelPayload struct {
+	// Identifier of collection
+	ID uint
+}
+
+// RetentionMigrateResult is the result type of the collection service
+// retention_migrate method.
+type RetentionMigrateResult struct {
+	// Number of running processing workflows signaled
+	Signaled uint
+}
+
+// RetentionPayload is the payload type of the collection service retention
+// method.
+type RetentionPayload struct {
+	// Status of the deletions
+	Status *string
+}
+
+// RetentionPostponePayload is the payload type of the collection service
+// retention_postpone method.
+type RetentionPostponePayload struct {
+	// Identifier of collection
+	ID uint
+	// New due datetime
+	DueAt string
+}
+
 // RetryPayload is the payload type of the collection service retry method.
 type RetryPayload struct {
 	// Identifier of collection to retry
@@ -314,6 +635,15 @@ type RetryResult struct {
 	Mode string
 }
 
+// SetLegalHoldPayload is the payload type of the collection service
+// set_legal_hold method.
+type SetLegalHoldPayload struct {
+	// Identifier of collection
+	ID uint
+	// Reason of the legal hold
+	Reason string
+}
+
 // ShowPayload is the payload type of the collection service show method.
 type ShowPayload struct {
 	// Identifier of collection to show
@@ -334,6 +664,23 @@ type WorkflowPayload struct {
 	ID uint
 }
 
+// Error returns an error description.
+func (e *BulkRunNotFound) Error() string {
+	return "Bulk operation not found."
+}
+
+// ErrorName returns the error name.
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *BulkRunNotFound) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns the error name.
+func (e *BulkRunNotFound) GoaErrorName() string {
+	return e.Message
+}
+
 // Error returns an error description.
 func (e *CollectionNotfound) Error() string {
 	return "Collection not found."
@@ -351,6 +698,11 @@ func (e *CollectionNotfound) GoaErrorName() string {
 	return e.Message
 }
 
+// MakeLegalHold builds a goa.ServiceError from an error.
+func MakeLegalHold(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "legal_hold", false, false, false)
+}
+
 // MakeNotRunning builds a goa.ServiceError from an error.
 func MakeNotRunning(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "not_running", false, false, false)
@@ -411,6 +763,51 @@ func NewViewedEnduroCollectionStatusHistory(res *EnduroCollectionStatusHistory,
 	return &collectionviews.EnduroCollectionStatusHistory{Projected: p, View: "default"}
 }
 
+// NewEnduroCollectionNotificationDeliveryCollection initializes result type
+// EnduroCollectionNotificationDeliveryCollection from viewed result type
+// EnduroCollectionNotificationDeliveryCollection.
+func NewEnduroCollectionNotificationDeliveryCollection(vres collectionviews.EnduroCollectionNotificationDeliveryCollection) EnduroCollectionNotificationDeliveryCollection {
+	return newEnduroCollectionNotificationDeliveryCollection(vres.Projected)
+}
+
+// NewViewedEnduroCollectionNotificationDeliveryCollection initializes viewed
+// result type EnduroCollectionNotificationDeliveryCollection from result type
+// EnduroCollectionNotificationDeliveryCollection using the given view.
+func NewViewedEnduroCollectionNotificationDeliveryCollection(res EnduroCollectionNotificationDeliveryCollection, view string) collectionviews.EnduroCollectionNotificationDeliveryCollection {
+	p := newEnduroCollectionNotificationDeliveryCollectionView(res)
+	return collectionviews.EnduroCollectionNotificationDeliveryCollection{Projected: p, View: "default"}
+}
+
+// NewEnduroCollectionRescanObjectCollection initializes result type
+// EnduroCollectionRescanObjectCollection from viewed result type
+// EnduroCollectionRescanObjectCollection.
+func NewEnduroCollectionRescanObjectCollection(vres collectionviews.EnduroCollectionRescanObjectCollection) EnduroCollectionRescanObjectCollection {
+	return newEnduroCollectionRescanObjectCollection(vres.Projected)
+}
+
+// NewViewedEnduroCollectionRescanObjectCollection initializes viewed result
+// type EnduroCollectionRescanObjectCollection from result type
+// EnduroCollectionRescanObjectCollection using the given view.
+func NewViewedEnduroCollectionRescanObjectCollection(res EnduroCollectionRescanObjectCollection, view string) collectionviews.EnduroCollectionRescanObjectCollection {
+	p := newEnduroCollectionRescanObjectCollectionView(res)
+	return collectionviews.EnduroCollectionRescanObjectCollection{Projected: p, View: "default"}
+}
+
+// NewEnduroCollectionRetentionDeletionCollection initializes result type
+// EnduroCollectionRetentionDeletionCollection from viewed result type
+// EnduroCollectionRetentionDeletionCollection.
+func NewEnduroCollectionRetentionDeletionCollection(vres collectionviews.EnduroCollectionRetentionDeletionCollection) EnduroCollectionRetentionDeletionCollection {
+	return newEnduroCollectionRetentionDeletionCollection(vres.Projected)
+}
+
+// NewViewedEnduroCollectionRetentionDeletionCollection initializes viewed
+// result type EnduroCollectionRetentionDeletionCollection from result type
+// EnduroCollectionRetentionDeletionCollection using the given view.
+func NewViewedEnduroCollectionRetentionDeletionCollection(res EnduroCollectionRetentionDeletionCollection, view string) collectionviews.EnduroCollectionRetentionDeletionCollection {
+	p := newEnduroCollectionRetentionDeletionCollectionView(res)
+	return collectionviews.EnduroCollectionRetentionDeletionCollection{Projected: p, View: "default"}
+}
+
 // newEnduroStoredCollection converts projected type EnduroStoredCollection to
 // service type EnduroStoredCollection.
 func newEnduroStoredCollection(vres *collectionviews.EnduroStoredCollectionView) *EnduroStoredCollection {
@@ -500,6 +897,10 @@ func newEnduroDetailedStoredCollection(vres *collectionviews.EnduroDetailedStore
 		ReconciliationStatus:    vres.ReconciliationStatus,
 		ReconciliationCheckedAt: vres.ReconciliationCheckedAt,
 		ReconciliationError:     vres.ReconciliationError,
+		LegalHoldReason:         vres.LegalHoldReason,
+		LegalHoldActor:          vres.LegalHoldActor,
+		LegalHoldAt:             vres.LegalHoldAt,
+		PipelineSelection:       vres.PipelineSelection,
 	}
 	if vres.ID != nil {
 		res.ID = *vres.ID
@@ -510,9 +911,24 @@ func newEnduroDetailedStoredCollection(vres *collectionviews.EnduroDetailedStore
 	if vres.CreatedAt != nil {
 		res.CreatedAt = *vres.CreatedAt
 	}
+	if vres.LegalHold != nil {
+		res.LegalHold = *vres.LegalHold
+	}
 	if vres.Status == nil {
 		res.Status = "new"
 	}
+	if vres.Decision != nil {
+		res.Decision = transformCollectionviewsEnduroCollectionPendingDecisionViewToEnduroCollectionPendingDecision(vres.Decision)
+	}
+	if vres.Progress != nil {
+		res.Progress = transformCollectionviewsEnduroCollectionProgressViewToEnduroCollectionProgress(vres.Progress)
+	}
+	if vres.Failure != nil {
+		res.Failure = transformCollectionviewsEnduroCollectionFailureViewToEnduroCollectionFailure(vres.Failure)
+	}
+	if vres.Validation != nil {
+		res.Validation = newEnduroCollectionValidationResultCollection(vres.Validation)
+	}
 	return res
 }
 
@@ -537,6 +953,81 @@ func newEnduroDetailedStoredCollectionView(res *EnduroDetailedStoredCollection)
 		ReconciliationStatus:    res.ReconciliationStatus,
 		ReconciliationCheckedAt: res.ReconciliationCheckedAt,
 		ReconciliationError:     res.ReconciliationError,
+		LegalHold:               &res.LegalHold,
+		LegalHoldReason:         res.LegalHoldReason,
+		LegalHoldActor:          res.LegalHoldActor,
+		LegalHoldAt:             res.LegalHoldAt,
+		PipelineSelection:       res.PipelineSelection,
+	}
+	if res.Decision != nil {
+		vres.Decision = transformEnduroCollectionPendingDecisionToCollectionviewsEnduroCollectionPendingDecisionView(res.Decision)
+	}
+	if res.Progress != nil {
+		vres.Progress = transformEnduroCollectionProgressToCollectionviewsEnduroCollectionProgressView(res.Progress)
+	}
+	if res.Failure != nil {
+		vres.Failure = transformEnduroCollectionFailureToCollectionviewsEnduroCollectionFailureView(res.Failure)
+	}
+	if res.Validation != nil {
+		vres.Validation = newEnduroCollectionValidationResultCollectionView(res.Validation)
+	}
+	return vres
+}
+
+// newEnduroCollectionValidationResultCollection converts projected type
+// EnduroCollectionValidationResultCollection to service type
+// EnduroCollectionValidationResultCollection.
+func newEnduroCollectionValidationResultCollection(vres collectionviews.EnduroCollectionValidationResultCollectionView) EnduroCollectionValidationResultCollection {
+	res := make(EnduroCollectionValidationResultCollection, len(vres))
+	for i, n := range vres {
+		res[i] = newEnduroCollectionValidationResult(n)
+	}
+	return res
+}
+
+// newEnduroCollectionValidationResultCollectionView projects result type
+// EnduroCollectionValidationResultCollection to projected type
+// EnduroCollectionValidationResultCollectionView using the "default" view.
+func newEnduroCollectionValidationResultCollectionView(res EnduroCollectionValidationResultCollection) collectionviews.EnduroCollectionValidationResultCollectionView {
+	vres := make(collectionviews.EnduroCollectionValidationResultCollectionView, len(res))
+	for i, n := range res {
+		vres[i] = newEnduroCollectionValidationResultView(n)
+	}
+	return vres
+}
+
+// newEnduroCollectionValidationResult converts projected type
+// EnduroCollectionValidationResult to service type
+// EnduroCollectionValidationResult.
+func newEnduroCollectionValidationResult(vres *collectionviews.EnduroCollectionValidationResultView) *EnduroCollectionValidationResult {
+	res := &EnduroCollectionValidationResult{
+		Message: vres.Message,
+	}
+	if vres.Validator != nil {
+		res.Validator = *vres.Validator
+	}
+	if vres.Severity != nil {
+		res.Severity = *vres.Severity
+	}
+	if vres.Status != nil {
+		res.Status = *vres.Status
+	}
+	if vres.CreatedAt != nil {
+		res.CreatedAt = *vres.CreatedAt
+	}
+	return res
+}
+
+// newEnduroCollectionValidationResultView projects result type
+// EnduroCollectionValidationResult to projected type
+// EnduroCollectionValidationResultView using the "default" view.
+func newEnduroCollectionValidationResultView(res *EnduroCollectionValidationResult) *collectionviews.EnduroCollectionValidationResultView {
+	vres := &collectionviews.EnduroCollectionValidationResultView{
+		Validator: &res.Validator,
+		Severity:  &res.Severity,
+		Status:    &res.Status,
+		Message:   res.Message,
+		CreatedAt: &res.CreatedAt,
 	}
 	return vres
 }
@@ -668,6 +1159,8 @@ func newEnduroCollectionStatusTransition(vres *collectionviews.EnduroCollectionS
 	res := &EnduroCollectionStatusTransition{
 		PreviousStatus: vres.PreviousStatus,
 		Reason:         vres.Reason,
+		Actor:          vres.Actor,
+		Detail:         vres.Detail,
 	}
 	if vres.ID != nil {
 		res.ID = *vres.ID
@@ -703,6 +1196,401 @@ func newEnduroCollectionStatusTransitionView(res *EnduroCollectionStatusTransiti
 		OccurredAt:     &res.OccurredAt,
 		IsRunStart:     &res.IsRunStart,
 		Reason:         res.Reason,
+		Actor:          res.Actor,
+		Detail:         res.Detail,
+	}
+	return vres
+}
+
+// newEnduroCollectionNotificationDeliveryCollection converts projected type
+// EnduroCollectionNotificationDeliveryCollection to service type
+// EnduroCollectionNotificationDeliveryCollection.
+func newEnduroCollectionNotificationDeliveryCollection(vres collectionviews.EnduroCollectionNotificationDeliveryCollectionView) EnduroCollectionNotificationDeliveryCollection {
+	res := make(EnduroCollectionNotificationDeliveryCollection, len(vres))
+	for i, n := range vres {
+		res[i] = newEnduroCollectionNotificationDelivery(n)
+	}
+	return res
+}
+
+// newEnduroCollectionNotificationDeliveryCollectionView projects result type
+// EnduroCollectionNotificationDeliveryCollection to projected type
+// EnduroCollectionNotificationDeliveryCollectionView using the "default" view.
+func newEnduroCollectionNotificationDeliveryCollectionView(res EnduroCollectionNotificationDeliveryCollection) collectionviews.EnduroCollectionNotificationDeliveryCollectionView {
+	vres := make(collectionviews.EnduroCollectionNotificationDeliveryCollectionView, len(res))
+	for i, n := range res {
+		vres[i] = newEnduroCollectionNotificationDeliveryView(n)
+	}
+	return vres
+}
+
+// newEnduroCollectionNotificationDelivery converts projected type
+// EnduroCollectionNotificationDelivery to service type
+// EnduroCollectionNotificationDelivery.
+func newEnduroCollectionNotificationDelivery(vres *collectionviews.EnduroCollectionNotificationDeliveryView) *EnduroCollectionNotificationDelivery {
+	res := &EnduroCollectionNotificationDelivery{
+		ResponseCode: vres.ResponseCode,
+		Error:        vres.Error,
+	}
+	if vres.ID != nil {
+		res.ID = *vres.ID
+	}
+	if vres.Webhook != nil {
+		res.Webhook = *vres.Webhook
+	}
+	if vres.Event != nil {
+		res.Event = *vres.Event
+	}
+	if vres.Status != nil {
+		res.Status = *vres.Status
+	}
+	if vres.Attempts != nil {
+		res.Attempts = *vres.Attempts
+	}
+	if vres.CreatedAt != nil {
+		res.CreatedAt = *vres.CreatedAt
+	}
+	if vres.UpdatedAt != nil {
+		res.UpdatedAt = *vres.UpdatedAt
+	}
+	return res
+}
+
+// newEnduroCollectionNotificationDeliveryView projects result type
+// EnduroCollectionNotificationDelivery to projected type
+// EnduroCollectionNotificationDeliveryView using the "default" view.
+func newEnduroCollectionNotificationDeliveryView(res *EnduroCollectionNotificationDelivery) *collectionviews.EnduroCollectionNotificationDeliveryView {
+	vres := &collectionviews.EnduroCollectionNotificationDeliveryView{
+		ID:           &res.ID,
+		Webhook:      &res.Webhook,
+		Event:        &res.Event,
+		Status:       &res.Status,
+		Attempts:     &res.Attempts,
+		ResponseCode: res.ResponseCode,
+		Error:        res.Error,
+		CreatedAt:    &res.CreatedAt,
+		UpdatedAt:    &res.UpdatedAt,
 	}
 	return vres
 }
+
+// newEnduroCollectionRescanObjectCollection converts projected type
+// EnduroCollectionRescanObjectCollection to service type
+// EnduroCollectionRescanObjectCollection.
+func newEnduroCollectionRescanObjectCollection(vres collectionviews.EnduroCollectionRescanObjectCollectionView) EnduroCollectionRescanObjectCollection {
+	res := make(EnduroCollectionRescanObjectCollection, len(vres))
+	for i, n := range vres {
+		res[i] = newEnduroCollectionRescanObject(n)
+	}
+	return res
+}
+
+// newEnduroCollectionRescanObjectCollectionView projects result type
+// EnduroCollectionRescanObjectCollection to projected type
+// EnduroCollectionRescanObjectCollectionView using the "default" view.
+func newEnduroCollectionRescanObjectCollectionView(res EnduroCollectionRescanObjectCollection) collectionviews.EnduroCollectionRescanObjectCollectionView {
+	vres := make(collectionviews.EnduroCollectionRescanObjectCollectionView, len(res))
+	for i, n := range res {
+		vres[i] = newEnduroCollectionRescanObjectView(n)
+	}
+	return vres
+}
+
+// newEnduroCollectionRescanObject converts projected type
+// EnduroCollectionRescanObject to service type EnduroCollectionRescanObject.
+func newEnduroCollectionRescanObject(vres *collectionviews.EnduroCollectionRescanObjectView) *EnduroCollectionRescanObject {
+	res := &EnduroCollectionRescanObject{
+		Bucket: vres.Bucket,
+	}
+	if vres.Watcher != nil {
+		res.Watcher = *vres.Watcher
+	}
+	if vres.Key != nil {
+		res.Key = *vres.Key
+	}
+	if vres.IsDir != nil {
+		res.IsDir = *vres.IsDir
+	}
+	return res
+}
+
+// newEnduroCollectionRescanObjectView projects result type
+// EnduroCollectionRescanObject to projected type
+// EnduroCollectionRescanObjectView using the "default" view.
+func newEnduroCollectionRescanObjectView(res *EnduroCollectionRescanObject) *collectionviews.EnduroCollectionRescanObjectView {
+	vres := &collectionviews.EnduroCollectionRescanObjectView{
+		Watcher: &res.Watcher,
+		Key:     &res.Key,
+		Bucket:  res.Bucket,
+		IsDir:   &res.IsDir,
+	}
+	return vres
+}
+
+// newEnduroCollectionRetentionDeletionCollection converts projected type
+// EnduroCollectionRetentionDeletionCollection to service type
+// EnduroCollectionRetentionDeletionCollection.
+func newEnduroCollectionRetentionDeletionCollection(vres collectionviews.EnduroCollectionRetentionDeletionCollectionView) EnduroCollectionRetentionDeletionCollection {
+	res := make(EnduroCollectionRetentionDeletionCollection, len(vres))
+	for i, n := range vres {
+		res[i] = newEnduroCollectionRetentionDeletion(n)
+	}
+	return res
+}
+
+// newEnduroCollectionRetentionDeletionCollectionView projects result type
+// EnduroCollectionRetentionDeletionCollection to projected type
+// EnduroCollectionRetentionDeletionCollectionView using the "default" view.
+func newEnduroCollectionRetentionDeletionCollectionView(res EnduroCollectionRetentionDeletionCollection) collectionviews.EnduroCollectionRetentionDeletionCollectionView {
+	vres := make(collectionviews.EnduroCollectionRetentionDeletionCollectionView, len(res))
+	for i, n := range res {
+		vres[i] = newEnduroCollectionRetentionDeletionView(n)
+	}
+	return vres
+}
+
+// newEnduroCollectionRetentionDeletion converts projected type
+// EnduroCollectionRetentionDeletion to service type
+// EnduroCollectionRetentionDeletion.
+func newEnduroCollectionRetentionDeletion(vres *collectionviews.EnduroCollectionRetentionDeletionView) *EnduroCollectionRetentionDeletion {
+	res := &EnduroCollectionRetentionDeletion{
+		Error: vres.Error,
+	}
+	if vres.CollectionID != nil {
+		res.CollectionID = *vres.CollectionID
+	}
+	if vres.Watcher != nil {
+		res.Watcher = *vres.Watcher
+	}
+	if vres.Key != nil {
+		res.Key = *vres.Key
+	}
+	if vres.Status != nil {
+		res.Status = *vres.Status
+	}
+	if vres.DueAt != nil {
+		res.DueAt = *vres.DueAt
+	}
+	if vres.Attempts != nil {
+		res.Attempts = *vres.Attempts
+	}
+	if vres.CreatedAt != nil {
+		res.CreatedAt = *vres.CreatedAt
+	}
+	if vres.UpdatedAt != nil {
+		res.UpdatedAt = *vres.UpdatedAt
+	}
+	return res
+}
+
+// newEnduroCollectionRetentionDeletionView projects result type
+// EnduroCollectionRetentionDeletion to projected type
+// EnduroCollectionRetentionDeletionView using the "default" view.
+func newEnduroCollectionRetentionDeletionView(res *EnduroCollectionRetentionDeletion) *collectionviews.EnduroCollectionRetentionDeletionView {
+	vres := &collectionviews.EnduroCollectionRetentionDeletionView{
+		CollectionID: &res.CollectionID,
+		Watcher:      &res.Watcher,
+		Key:          &res.Key,
+		Status:       &res.Status,
+		DueAt:        &res.DueAt,
+		Attempts:     &res.Attempts,
+		Error:        res.Error,
+		CreatedAt:    &res.CreatedAt,
+		UpdatedAt:    &res.UpdatedAt,
+	}
+	return vres
+}
+
+// transformCollectionviewsEnduroCollectionPendingDecisionViewToEnduroCollectionPendingDecision
+// builds a value of type *EnduroCollectionPendingDecision from a value of type
+// *collectionviews.EnduroCollectionPendingDecisionView.
+func transformCollectionviewsEnduroCollectionPendingDecisionViewToEnduroCollectionPendingDecision(v *collectionviews.EnduroCollectionPendingDecisionView) *EnduroCollectionPendingDecision {
+	if v == nil {
+		return nil
+	}
+	res := &EnduroCollectionPendingDecision{
+		Activity: *v.Activity,
+		Error:    *v.Error,
+	}
+	if v.Options != nil {
+		res.Options = make([]string, len(v.Options))
+		for i, val := range v.Options {
+			res.Options[i] = val
+		}
+	} else {
+		res.Options = []string{}
+	}
+
+	return res
+}
+
+// transformCollectionviewsEnduroCollectionProgressViewToEnduroCollectionProgress
+// builds a value of type *EnduroCollectionProgress from a value of type
+// *collectionviews.EnduroCollectionProgressView.
+func transformCollectionviewsEnduroCollectionProgressViewToEnduroCollectionProgress(v *collectionviews.EnduroCollectionProgressView) *EnduroCollectionProgress {
+	if v == nil {
+		return nil
+	}
+	res := &EnduroCollectionProgress{
+		Stage:         *v.Stage,
+		Microservice:  v.Microservice,
+		Job:           v.Job,
+		JobsCompleted: *v.JobsCompleted,
+		JobsTotal:     *v.JobsTotal,
+		Percent:       *v.Percent,
+		UpdatedAt:     *v.UpdatedAt,
+	}
+	if v.FailedJobs != nil {
+		res.FailedJobs = make([]*EnduroCollectionFailedJob, len(v.FailedJobs))
+		for i, val := range v.FailedJobs {
+			if val == nil {
+				res.FailedJobs[i] = nil
+				continue
+			}
+			res.FailedJobs[i] = transformCollectionviewsEnduroCollectionFailedJobViewToEnduroCollectionFailedJob(val)
+		}
+	}
+
+	return res
+}
+
+// transformCollectionviewsEnduroCollectionFailedJobViewToEnduroCollectionFailedJob
+// builds a value of type *EnduroCollectionFailedJob from a value of type
+// *collectionviews.EnduroCollectionFailedJobView.
+func transformCollectionviewsEnduroCollectionFailedJobViewToEnduroCollectionFailedJob(v *collectionviews.EnduroCollectionFailedJobView) *EnduroCollectionFailedJob {
+	if v == nil {
+		return nil
+	}
+	res := &EnduroCollectionFailedJob{
+		ID:           *v.ID,
+		Name:         *v.Name,
+		Microservice: *v.Microservice,
+	}
+
+	return res
+}
+
+// transformCollectionviewsEnduroCollectionFailureViewToEnduroCollectionFailure
+// builds a value of type *EnduroCollectionFailure from a value of type
+// *collectionviews.EnduroCollectionFailureView.
+func transformCollectionviewsEnduroCollectionFailureViewToEnduroCollectionFailure(v *collectionviews.EnduroCollectionFailureView) *EnduroCollectionFailure {
+	if v == nil {
+		return nil
+	}
+	res := &EnduroCollectionFailure{
+		Category:     *v.Category,
+		Stage:        *v.Stage,
+		UnitID:       *v.UnitID,
+		Microservice: v.Microservice,
+		Job:          v.Job,
+		Stderr:       v.Stderr,
+		OccurredAt:   *v.OccurredAt,
+	}
+	if v.Errors != nil {
+		res.Errors = make([]string, len(v.Errors))
+		for i, val := range v.Errors {
+			res.Errors[i] = val
+		}
+	} else {
+		res.Errors = []string{}
+	}
+
+	return res
+}
+
+// transformEnduroCollectionPendingDecisionToCollectionviewsEnduroCollectionPendingDecisionView
+// builds a value of type *collectionviews.EnduroCollectionPendingDecisionView
+// from a value of type *EnduroCollectionPendingDecision.
+func transformEnduroCollectionPendingDecisionToCollectionviewsEnduroCollectionPendingDecisionView(v *EnduroCollectionPendingDecision) *collectionviews.EnduroCollectionPendingDecisionView {
+	if v == nil {
+		return nil
+	}
+	res := &collectionviews.EnduroCollectionPendingDecisionView{
+		Activity: &v.Activity,
+		Error:    &v.Error,
+	}
+	if v.Options != nil {
+		res.Options = make([]string, len(v.Options))
+		for i, val := range v.Options {
+			res.Options[i] = val
+		}
+	} else {
+		res.Options = []string{}
+	}
+
+	return res
+}
+
+// transformEnduroCollectionProgressToCollectionviewsEnduroCollectionProgressView
+// builds a value of type *collectionviews.EnduroCollectionProgressView from a
+// value of type *EnduroCollectionProgress.
+func transformEnduroCollectionProgressToCollectionviewsEnduroCollectionProgressView(v *EnduroCollectionProgress) *collectionviews.EnduroCollectionProgressView {
+	if v == nil {
+		return nil
+	}
+	res := &collectionviews.EnduroCollectionProgressView{
+		Stage:         &v.Stage,
+		Microservice:  v.Microservice,
+		Job:           v.Job,
+		JobsCompleted: &v.JobsCompleted,
+		JobsTotal:     &v.JobsTotal,
+		Percent:       &v.Percent,
+		UpdatedAt:     &v.UpdatedAt,
+	}
+	if v.FailedJobs != nil {
+		res.FailedJobs = make([]*collectionviews.EnduroCollectionFailedJobView, len(v.FailedJobs))
+		for i, val := range v.FailedJobs {
+			if val == nil {
+				res.FailedJobs[i] = nil
+				continue
+			}
+			res.FailedJobs[i] = transformEnduroCollectionFailedJobToCollectionviewsEnduroCollectionFailedJobView(val)
+		}
+	}
+
+	return res
+}
+
+// transformEnduroCollectionFailedJobToCollectionviewsEnduroCollectionFailedJobView
+// builds a value of type *collectionviews.EnduroCollectionFailedJobView from a
+// value of type *EnduroCollectionFailedJob.
+func transformEnduroCollectionFailedJobToCollectionviewsEnduroCollectionFailedJobView(v *EnduroCollectionFailedJob) *collectionviews.EnduroCollectionFailedJobView {
+	if v == nil {
+		return nil
+	}
+	res := &collectionviews.EnduroCollectionFailedJobView{
+		ID:           &v.ID,
+		Name:         &v.Name,
+		Microservice: &v.Microservice,
+	}
+
+	return res
+}
+
+// transformEnduroCollectionFailureToCollectionviewsEnduroCollectionFailureView
+// builds a value of type *collectionviews.EnduroCollectionFailureView from a
+// value of type *EnduroCollectionFailure.
+func transformEnduroCollectionFailureToCollectionviewsEnduroCollectionFailureView(v *EnduroCollectionFailure) *collectionviews.EnduroCollectionFailureView {
+	if v == nil {
+		return nil
+	}
+	res := &collectionviews.EnduroCollectionFailureView{
+		Category:     &v.Category,
+		Stage:        &v.Stage,
+		UnitID:       &v.UnitID,
+		Microservice: v.Microservice,
+		Job:          v.Job,
+		Stderr:       v.Stderr,
+		OccurredAt:   &v.OccurredAt,
+	}
+	if v.Errors != nil {
+		res.Errors = make([]string, len(v.Errors))
+		for i, val := range v.Errors {
+			res.Errors[i] = val
+		}
+	} else {
+		res.Errors = []string{}
+	}
+
+	return res
+}
@@ -39,6 +39,33 @@ type EnduroCollectionStatusHistory struct {
 	View string
 }
 
+// EnduroCollectionNotificationDeliveryCollection is the viewed result type
+// that is projected based on a view.
+type EnduroCollectionNotificationDeliveryCollection struct {
+	// Type to project
+	Projected EnduroCollectionNotificationDeliveryCollectionView
+	// View to render
+	View string
+}
+
+// EnduroCollectionRescanObjectCollection is the viewed result type that is
+// projected based on a view.
+type EnduroCollectionRescanObjectCollection struct {
+	// Type to project
+	Projected EnduroCollectionRescanObjectCollectionView
+	// View to render
+	View string
+}
+
+// EnduroCollectionRetentionDeletionCollection is the viewed result type that
+// is projected based on a view.
+type EnduroCollectionRetentionDeletionCollection struct {
+	// Type to project
+	Projected EnduroCollectionRetentionDeletionCollectionView
+	// View to render
+	View string
+}
+
 // EnduroMonitorUpdateView is a type that runs validations on a projected type.
 type EnduroMonitorUpdateView struct {
 	Timestamp *string
@@ -48,6 +75,8 @@ type EnduroMonitorUpdateView struct {
 	Type *string
 	// Collection
 	Item *EnduroStoredCollectionView
+	// Progress of the collection, in collection:progress events
+	Progress *EnduroCollectionProgressView
 }
 
 // EnduroStoredCollectionView is a type that runs validations on a projected
@@ -79,6 +108,38 @@ type EnduroStoredCollectionView struct {
 	CompletedAt *string
 }
 
+// EnduroCollectionProgressView is a type that runs validations on a projected
+// type.
+type EnduroCollectionProgressView struct {
+	// Processing stage
+	Stage *string
+	// Microservice of the current job
+	Microservice *string
+	// Name of the current job
+	Job *string
+	// Number of jobs completed
+	JobsCompleted *uint
+	// Number of jobs run so far
+	JobsTotal *uint
+	// Percentage of the jobs run so far that completed
+	Percent *uint
+	// Jobs that failed
+	FailedJobs []*EnduroCollectionFailedJobView
+	// Datetime of the snapshot
+	UpdatedAt *string
+}
+
+// EnduroCollectionFailedJobView is a type that runs validations on a projected
+// type.
+type EnduroCollectionFailedJobView struct {
+	// Identifier of the job
+	ID *string
+	// Name of the job
+	Name *string
+	// Microservice of the job
+	Microservice *string
+}
+
 // EnduroStoredCollectionCollectionView is a type that runs validations on a
 // projected type.
 type EnduroStoredCollectionCollectionView []*EnduroStoredCollectionView
@@ -118,6 +179,75 @@ type EnduroDetailedStoredCollectionView struct {
 	ReconciliationCheckedAt *string
 	// Last storage reconciliation error
 	ReconciliationError *string
+	// Whether the collection is under legal hold
+	LegalHold *bool
+	// Reason of the legal hold
+	LegalHoldReason *string
+	// Person or system that placed the legal hold
+	LegalHoldActor *string
+	// Datetime when the legal hold was placed
+	LegalHoldAt *string
+	// Failure awaiting an operator decision
+	Decision *EnduroCollectionPendingDecisionView
+	// Results of the transfer validators
+	Validation EnduroCollectionValidationResultCollectionView
+	// Explanation of the pipeline chosen by the scheduler
+	PipelineSelection *string
+	// Latest progress reported by Archivematica
+	Progress *EnduroCollectionProgressView
+	// Reason why Archivematica could not process the collection
+	Failure *EnduroCollectionFailureView
+}
+
+// EnduroCollectionPendingDecisionView is a type that runs validations on a
+// projected type.
+type EnduroCollectionPendingDecisionView struct {
+	// Name of the failed activity
+	Activity *string
+	// Error returned by the failed activity
+	Error *string
+	// Decision options accepted by the workflow
+	Options []string
+}
+
+// EnduroCollectionValidationResultCollectionView is a type that runs
+// validations on a projected type.
+type EnduroCollectionValidationResultCollectionView []*EnduroCollectionValidationResultView
+
+// EnduroCollectionValidationResultView is a type that runs validations on a
+// projected type.
+type EnduroCollectionValidationResultView struct {
+	// Name of the validator
+	Validator *string
+	// Severity of the validator
+	Severity *string
+	// Outcome of the validator
+	Status *string
+	// Description of the problems found
+	Message *string
+	// Validation datetime
+	CreatedAt *string
+}
+
+// EnduroCollectionFailureView is a type that runs validations on a projected
+// type.
+type EnduroCollectionFailureView struct {
+	// Category of the failure
+	Category *string
+	// Processing stage
+	Stage *string
+	// Identifier of the Archivematica transfer or SIP
+	UnitID *string
+	// Microservice of the failed job
+	Microservice *string
+	// Name of the failed job
+	Job *string
+	// Excerpt of the standard error of the failed job
+	Stderr *string
+	// Chain of errors seen by Enduro, outermost first
+	Errors []string
+	// Datetime of the failure
+	OccurredAt *string
 }
 
 // EnduroCollectionWorkflowStatusView is a type that runs validations on a
@@ -171,8 +301,85 @@ type EnduroCollectionStatusTransitionView struct {
 	OccurredAt *string
 	// Whether the transition starts a fully recorded workflow run
 	IsRunStart *bool
-	// Machine-readable reason for the transition
+	// Machine-readable reason for the transition, e.g. workflow_retried or
+	// deadline_exceeded
 	Reason *string
+	// Person or system that caused the transition: system, api or api:<user>,
+	// bulk:<run> or watcher:<name>
+	Actor *string
+	// Free-form detail provided with the transition
+	Detail *string
+}
+
+// EnduroCollectionNotificationDeliveryCollectionView is a type that runs
+// validations on a projected type.
+type EnduroCollectionNotificationDeliveryCollectionView []*EnduroCollectionNotificationDeliveryView
+
+// EnduroCollectionNotificationDeliveryView is a type that runs validations on
+// a projected type.
+type EnduroCollectionNotificationDeliveryView struct {
+	// Identifier of the delivery
+	ID *uint64
+	// Name of the webhook
+	Webhook *string
+	// Name of the event
+	Event *string
+	// Status of the delivery
+	Status *string
+	// Number of delivery attempts
+	Attempts *int
+	// HTTP status code of the last response
+	ResponseCode *int
+	// Error of the last attempt
+	Error *string
+	// Creation datetime
+	CreatedAt *string
+	// Datetime of the last update
+	UpdatedAt *string
+}
+
+// EnduroCollectionRescanObjectCollectionView is a type that runs validations
+// on a projected type.
+type EnduroCollectionRescanObjectCollectionView []*EnduroCollectionRescanObjectView
+
+// EnduroCollectionRescanObjectView is a type that runs validations on a
+// projected type.
+type EnduroCollectionRescanObjectView struct {
+	// Name of the watcher
+	Watcher *string
+	// Key of the blob
+	Key *string
+	// Name of the bucket, when known
+	Bucket *string
+	// Whether the blob is a directory
+	IsDir *bool
+}
+
+// EnduroCollectionRetentionDeletionCollectionView is a type that runs
+// validations on a projected type.
+type EnduroCollectionRetentionDeletionCollectionView []*EnduroCollectionRetentionDeletionView
+
+// EnduroCollectionRetentionDeletionView is a type that runs validations on a
+// projected type.
+type EnduroCollectionRetentionDeletionView struct {
+	// Identifier of the collection
+	CollectionID *uint
+	// Name of the watcher
+	Watcher *string
+	// Key of the original
+	Key *string
+	// Status of the deletion
+	Status *string
+	// Due datetime
+	DueAt *string
+	// Number of deletion attempts
+	Attempts *int
+	// Error of the last attempt
+	Error *string
+	// Creation datetime
+	CreatedAt *string
+	// Datetime of the last update
+	UpdatedAt *string
 }
 
 var (
@@ -196,6 +403,15 @@ var (
 			"reconciliation_status",
 			"reconciliation_checked_at",
 			"reconciliation_error",
+			"legal_hold",
+			"legal_hold_reason",
+			"legal_hold_actor",
+			"legal_hold_at",
+			"decision",
+			"validation",
+			"pipeline_selection",
+			"progress",
+			"failure",
 		},
 	}
 	// EnduroCollectionWorkflowStatusMap is a map indexing the attribute names of
@@ -214,6 +430,47 @@ var (
 			"transitions",
 		},
 	}
+	// EnduroCollectionNotificationDeliveryCollectionMap is a map indexing the
+	// attribute names of EnduroCollectionNotificationDeliveryCollection by view
+	// name.
+	EnduroCollectionNotificationDeliveryCollectionMap = map[string][]string{
+		"default": {
+			"id",
+			"webhook",
+			"event",
+			"status",
+			"attempts",
+			"response_code",
+			"error",
+			"created_at",
+			"updated_at",
+		},
+	}
+	// EnduroCollectionRescanObjectCollectionMap is a map indexing the attribute
+	// names of EnduroCollectionRescanObjectCollection by view name.
+	EnduroCollectionRescanObjectCollectionMap = map[string][]string{
+		"default": {
+			"watcher",
+			"key",
+			"bucket",
+			"is_dir",
+		},
+	}
+	// EnduroCollectionRetentionDeletionCollectionMap is a map indexing the
+	// attribute names of EnduroCollectionRetentionDeletionCollection by view name.
+	EnduroCollectionRetentionDeletionCollectionMap = map[string][]string{
+		"default": {
+			"collection_id",
+			"watcher",
+			"key",
+			"status",
+			"due_at",
+			"attempts",
+			"error",
+			"created_at",
+			"updated_at",
+		},
+	}
 	// EnduroStoredCollectionMap is a map indexing the attribute names of
 	// EnduroStoredCollection by view name.
 	EnduroStoredCollectionMap = map[string][]string{
@@ -250,6 +507,28 @@ var (
 			"completed_at",
 		},
 	}
+	// EnduroCollectionValidationResultCollectionMap is a map indexing the
+	// attribute names of EnduroCollectionValidationResultCollection by view name.
+	EnduroCollectionValidationResultCollectionMap = map[string][]string{
+		"default": {
+			"validator",
+			"severity",
+			"status",
+			"message",
+			"created_at",
+		},
+	}
+	// EnduroCollectionValidationResultMap is a map indexing the attribute names of
+	// EnduroCollectionValidationResult by view name.
+	EnduroCollectionValidationResultMap = map[string][]string{
+		"default": {
+			"validator",
+			"severity",
+			"status",
+			"message",
+			"created_at",
+		},
+	}
 	// EnduroCollectionWorkflowHistoryCollectionMap is a map indexing the attribute
 	// names of EnduroCollectionWorkflowHistoryCollection by view name.
 	EnduroCollectionWorkflowHistoryCollectionMap = map[string][]string{
@@ -280,6 +559,8 @@ var (
 			"occurred_at",
 			"is_run_start",
 			"reason",
+			"actor",
+			"detail",
 		},
 	}
 	// EnduroCollectionStatusTransitionMap is a map indexing the attribute names of
@@ -294,6 +575,48 @@ var (
 			"occurred_at",
 			"is_run_start",
 			"reason",
+			"actor",
+			"detail",
+		},
+	}
+	// EnduroCollectionNotificationDeliveryMap is a map indexing the attribute
+	// names of EnduroCollectionNotificationDelivery by view name.
+	EnduroCollectionNotificationDeliveryMap = map[string][]string{
+		"default": {
+			"id",
+			"webhook",
+			"event",
+			"status",
+			"attempts",
+			"response_code",
+			"error",
+			"created_at",
+			"updated_at",
+		},
+	}
+	// EnduroCollectionRescanObjectMap is a map indexing the attribute names of
+	// EnduroCollectionRescanObject by view name.
+	EnduroCollectionRescanObjectMap = map[string][]string{
+		"default": {
+			"watcher",
+			"key",
+			"bucket",
+			"is_dir",
+		},
+	}
+	// EnduroCollectionRetentionDeletionMap is a map indexing the attribute names
+	// of EnduroCollectionRetentionDeletion by view name.
+	EnduroCollectionRetentionDeletionMap = map[string][]string{
+		"default": {
+			"collection_id",
+			"watcher",
+			"key",
+			"status",
+			"due_at",
+			"attempts",
+			"error",
+			"created_at",
+			"updated_at",
 		},
 	}
 )
@@ -334,6 +657,44 @@ func ValidateEnduroCollectionStatusHistory(result *EnduroCollectionStatusHistory
 	return
 }
 
+// ValidateEnduroCollectionNotificationDeliveryCollection runs the validations
+// defined on the viewed result type
+// EnduroCollectionNotificationDeliveryCollection.
+func ValidateEnduroCollectionNotificationDeliveryCollection(result EnduroCollectionNotificationDeliveryCollection) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateEnduroCollectionNotificationDeliveryCollectionView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateEnduroCollectionRescanObjectCollection runs the validations defined
+// on the viewed result type EnduroCollectionRescanObjectCollection.
+func ValidateEnduroCollectionRescanObjectCollection(result EnduroCollectionRescanObjectCollection) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateEnduroCollectionRescanObjectCollectionView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateEnduroCollectionRetentionDeletionCollection runs the validations
+// defined on the viewed result type
+// EnduroCollectionRetentionDeletionCollection.
+func ValidateEnduroCollectionRetentionDeletionCollection(result EnduroCollectionRetentionDeletionCollection) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateEnduroCollectionRetentionDeletionCollectionView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
 // ValidateEnduroMonitorUpdateView runs the validations defined on
 // EnduroMonitorUpdateView.
 func ValidateEnduroMonitorUpdateView(result *EnduroMonitorUpdateView) (err error) {
@@ -354,6 +715,11 @@ func ValidateEnduroMonitorUpdateView(result *EnduroMonitorUpdateView) (err error
 			err = goa.MergeErrors(err, err2)
 		}
 	}
+	if result.Progress != nil {
+		if err2 := ValidateEnduroCollectionProgressView(result.Progress); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	return
 }
 
@@ -401,6 +767,57 @@ func ValidateEnduroStoredCollectionView(result *EnduroStoredCollectionView) (err
 	return
 }
 
+// ValidateEnduroCollectionProgressView runs the validations defined on
+// EnduroCollectionProgressView.
+func ValidateEnduroCollectionProgressView(result *EnduroCollectionProgressView) (err error) {
+	if result.Stage == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("stage", "result"))
+	}
+	if result.JobsCompleted == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("jobs_completed", "result"))
+	}
+	if result.JobsTotal == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("jobs_total", "result"))
+	}
+	if result.Percent == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("percent", "result"))
+	}
+	if result.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "result"))
+	}
+	if result.Stage != nil {
+		if !(*result.Stage == "transfer" || *result.Stage == "ingest") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.stage", *result.Stage, []any{"transfer", "ingest"}))
+		}
+	}
+	for _, e := range result.FailedJobs {
+		if e != nil {
+			if err2 := ValidateEnduroCollectionFailedJobView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if result.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.updated_at", *result.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateEnduroCollectionFailedJobView runs the validations defined on
+// EnduroCollectionFailedJobView.
+func ValidateEnduroCollectionFailedJobView(result *EnduroCollectionFailedJobView) (err error) {
+	if result.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "result"))
+	}
+	if result.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "result"))
+	}
+	if result.Microservice == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("microservice", "result"))
+	}
+	return
+}
+
 // ValidateEnduroStoredCollectionCollectionView runs the validations defined on
 // EnduroStoredCollectionCollectionView using the "default" view.
 func ValidateEnduroStoredCollectionCollectionView(result EnduroStoredCollectionCollectionView) (err error) {
@@ -424,6 +841,9 @@ func ValidateEnduroDetailedStoredCollectionView(result *EnduroDetailedStoredColl
 	if result.CreatedAt == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "result"))
 	}
+	if result.LegalHold == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("legal_hold", "result"))
+	}
 	if result.Status != nil {
 		if !(*result.Status == "new" || *result.Status == "in progress" || *result.Status == "done" || *result.Status == "error" || *result.Status == "unknown" || *result.Status == "queued" || *result.Status == "pending" || *result.Status == "abandoned") {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.status", *result.Status, []any{"new", "in progress", "done", "error", "unknown", "queued", "pending", "abandoned"}))
@@ -464,6 +884,126 @@ func ValidateEnduroDetailedStoredCollectionView(result *EnduroDetailedStoredColl
 	if result.ReconciliationCheckedAt != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("result.reconciliation_checked_at", *result.ReconciliationCheckedAt, goa.FormatDateTime))
 	}
+	if result.LegalHoldAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.legal_hold_at", *result.LegalHoldAt, goa.FormatDateTime))
+	}
+	if result.Decision != nil {
+		if err2 := ValidateEnduroCollectionPendingDecisionView(result.Decision); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if result.Progress != nil {
+		if err2 := ValidateEnduroCollectionProgressView(result.Progress); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if result.Failure != nil {
+		if err2 := ValidateEnduroCollectionFailureView(result.Failure); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if result.Validation != nil {
+		if err2 := ValidateEnduroCollectionValidationResultCollectionView(result.Validation); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateEnduroCollectionPendingDecisionView runs the validations defined on
+// EnduroCollectionPendingDecisionView.
+func ValidateEnduroCollectionPendingDecisionView(result *EnduroCollectionPendingDecisionView) (err error) {
+	if result.Activity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("activity", "result"))
+	}
+	if result.Error == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("error", "result"))
+	}
+	if result.Options == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("options", "result"))
+	}
+	for _, e := range result.Options {
+		if !(e == "RETRY" || e == "RETRY_ONCE" || e == "ABANDON" || e == "SKIP") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.options[*]", e, []any{"RETRY", "RETRY_ONCE", "ABANDON", "SKIP"}))
+		}
+	}
+	return
+}
+
+// ValidateEnduroCollectionValidationResultCollectionView runs the validations
+// defined on EnduroCollectionValidationResultCollectionView using the
+// "default" view.
+func ValidateEnduroCollectionValidationResultCollectionView(result EnduroCollectionValidationResultCollectionView) (err error) {
+	for _, item := range result {
+		if err2 := ValidateEnduroCollectionValidationResultView(item); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateEnduroCollectionValidationResultView runs the validations defined on
+// EnduroCollectionValidationResultView using the "default" view.
+func ValidateEnduroCollectionValidationResultView(result *EnduroCollectionValidationResultView) (err error) {
+	if result.Validator == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("validator", "result"))
+	}
+	if result.Severity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("severity", "result"))
+	}
+	if result.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "result"))
+	}
+	if result.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "result"))
+	}
+	if result.Severity != nil {
+		if !(*result.Severity == "fail" || *result.Severity == "warn") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.severity", *result.Severity, []any{"fail", "warn"}))
+		}
+	}
+	if result.Status != nil {
+		if !(*result.Status == "passed" || *result.Status == "failed" || *result.Status == "warned") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.status", *result.Status, []any{"passed", "failed", "warned"}))
+		}
+	}
+	if result.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.created_at", *result.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateEnduroCollectionFailureView runs the validations defined on
+// EnduroCollectionFailureView.
+func ValidateEnduroCollectionFailureView(result *EnduroCollectionFailureView) (err error) {
+	if result.Category == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("category", "result"))
+	}
+	if result.Stage == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("stage", "result"))
+	}
+	if result.UnitID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("unit_id", "result"))
+	}
+	if result.Errors == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("errors", "result"))
+	}
+	if result.OccurredAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("occurred_at", "result"))
+	}
+	if result.Category != nil {
+		if !(*result.Category == "failed" || *result.Category == "rejected" || *result.Category == "unexpected_state" || *result.Category == "api_error" || *result.Category == "unreachable") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.category", *result.Category, []any{"failed", "rejected", "unexpected_state", "api_error", "unreachable"}))
+		}
+	}
+	if result.Stage != nil {
+		if !(*result.Stage == "transfer" || *result.Stage == "ingest") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.stage", *result.Stage, []any{"transfer", "ingest"}))
+		}
+	}
+	if result.OccurredAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.occurred_at", *result.OccurredAt, goa.FormatDateTime))
+	}
 	return
 }
 
@@ -565,3 +1105,141 @@ func ValidateEnduroCollectionStatusTransitionView(result *EnduroCollectionStatus
 	}
 	return
 }
+
+// ValidateEnduroCollectionNotificationDeliveryCollectionView runs the
+// validations defined on EnduroCollectionNotificationDeliveryCollectionView
+// using the "default" view.
+func ValidateEnduroCollectionNotificationDeliveryCollectionView(result EnduroCollectionNotificationDeliveryCollectionView) (err error) {
+	for _, item := range result {
+		if err2 := ValidateEnduroCollectionNotificationDeliveryView(item); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateEnduroCollectionNotificationDeliveryView runs the validations
+// defined on EnduroCollectionNotificationDeliveryView using the "default" view.
+func ValidateEnduroCollectionNotificationDeliveryView(result *EnduroCollectionNotificationDeliveryView) (err error) {
+	if result.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "result"))
+	}
+	if result.Webhook == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("webhook", "result"))
+	}
+	if result.Event == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event", "result"))
+	}
+	if result.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "result"))
+	}
+	if result.Attempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "result"))
+	}
+	if result.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "result"))
+	}
+	if result.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "result"))
+	}
+	if result.Event != nil {
+		if !(*result.Event == "queued" || *result.Event == "in_progress" || *result.Event == "done" || *result.Event == "error" || *result.Event == "pending" || *result.Event == "abandoned" || *result.Event == "decision_reminder") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.event", *result.Event, []any{"queued", "in_progress", "done", "error", "pending", "abandoned", "decision_reminder"}))
+		}
+	}
+	if result.Status != nil {
+		if !(*result.Status == "pending" || *result.Status == "delivered" || *result.Status == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.status", *result.Status, []any{"pending", "delivered", "failed"}))
+		}
+	}
+	if result.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.created_at", *result.CreatedAt, goa.FormatDateTime))
+	}
+	if result.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.updated_at", *result.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateEnduroCollectionRescanObjectCollectionView runs the validations
+// defined on EnduroCollectionRescanObjectCollectionView using the "default"
+// view.
+func ValidateEnduroCollectionRescanObjectCollectionView(result EnduroCollectionRescanObjectCollectionView) (err error) {
+	for _, item := range result {
+		if err2 := ValidateEnduroCollectionRescanObjectView(item); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateEnduroCollectionRescanObjectView runs the validations defined on
+// EnduroCollectionRescanObjectView using the "default" view.
+func ValidateEnduroCollectionRescanObjectView(result *EnduroCollectionRescanObjectView) (err error) {
+	if result.Watcher == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("watcher", "result"))
+	}
+	if result.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "result"))
+	}
+	if result.IsDir == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("is_dir", "result"))
+	}
+	return
+}
+
+// ValidateEnduroCollectionRetentionDeletionCollectionView runs the validations
+// defined on EnduroCollectionRetentionDeletionCollectionView using the
+// "default" view.
+func ValidateEnduroCollectionRetentionDeletionCollectionView(result EnduroCollectionRetentionDeletionCollectionView) (err error) {
+	for _, item := range result {
+		if err2 := ValidateEnduroCollectionRetentionDeletionView(item); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateEnduroCollectionRetentionDeletionView runs the validations defined
+// on EnduroCollectionRetentionDeletionView using the "default" view.
+func ValidateEnduroCollectionRetentionDeletionView(result *EnduroCollectionRetentionDeletionView) (err error) {
+	if result.CollectionID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("collection_id", "result"))
+	}
+	if result.Watcher == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("watcher", "result"))
+	}
+	if result.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "result"))
+	}
+	if result.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "result"))
+	}
+	if result.DueAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("due_at", "result"))
+	}
+	if result.Attempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "result"))
+	}
+	if result.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "result"))
+	}
+	if result.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "result"))
+	}
+	if result.Status != nil {
+		if !(*result.Status == "pending" || *result.Status == "done" || *result.Status == "failed" || *result.Status == "canceled") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.status", *result.Status, []any{"pending", "done", "failed", "canceled"}))
+		}
+	}
+	if result.DueAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.due_at", *result.DueAt, goa.FormatDateTime))
+	}
+	if result.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.created_at", *result.CreatedAt, goa.FormatDateTime))
+	}
+	if result.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.updated_at", *result.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
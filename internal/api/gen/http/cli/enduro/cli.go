@@ -26,9 +26,9 @@ import (
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() []string {
 	return []string{
-		"pipeline (list|show|processing)",
+		"pipeline (list|show|route|pause|drain|resume|processing)",
 		"batch (submit|status|hints|browse)",
-		"collection (monitor|list|show|delete|cancel|retry|workflow|status-history|download|decide|bulk|bulk-status)",
+		"collection (monitor|list|show|delete|cancel|retry|workflow|status-history|notifications|rescan|retention|retention-postpone|retention-cancel|set-legal-hold|clear-legal-hold|retention-migrate|download|decide|bulk|bulk-status|bulk-runs|bulk-run)",
 	}
 }
 
@@ -59,6 +59,21 @@ func ParseEndpoint(
 		pipelineShowFlags  = flag.NewFlagSet("show", flag.ExitOnError)
 		pipelineShowIDFlag = pipelineShowFlags.String("id", "REQUIRED", "Identifier of pipeline to show")
 
+		pipelineRouteFlags            = flag.NewFlagSet("route", flag.ExitOnError)
+		pipelineRouteKeyFlag          = pipelineRouteFlags.String("key", "REQUIRED", "")
+		pipelineRouteWatcherFlag      = pipelineRouteFlags.String("watcher", "", "")
+		pipelineRouteTransferTypeFlag = pipelineRouteFlags.String("transfer-type", "standard", "")
+		pipelineRouteSizeFlag         = pipelineRouteFlags.String("size", "", "")
+
+		pipelinePauseFlags  = flag.NewFlagSet("pause", flag.ExitOnError)
+		pipelinePauseIDFlag = pipelinePauseFlags.String("id", "REQUIRED", "Identifier of pipeline")
+
+		pipelineDrainFlags  = flag.NewFlagSet("drain", flag.ExitOnError)
+		pipelineDrainIDFlag = pipelineDrainFlags.String("id", "REQUIRED", "Identifier of pipeline")
+
+		pipelineResumeFlags  = flag.NewFlagSet("resume", flag.ExitOnError)
+		pipelineResumeIDFlag = pipelineResumeFlags.String("id", "REQUIRED", "Identifier of pipeline")
+
 		pipelineProcessingFlags  = flag.NewFlagSet("processing", flag.ExitOnError)
 		pipelineProcessingIDFlag = pipelineProcessingFlags.String("id", "REQUIRED", "Identifier of pipeline")
 
@@ -87,6 +102,8 @@ func ParseEndpoint(
 		collectionListEarliestCreatedTimeFlag = collectionListFlags.String("earliest-created-time", "", "")
 		collectionListLatestCreatedTimeFlag   = collectionListFlags.String("latest-created-time", "", "")
 		collectionListStatusFlag              = collectionListFlags.String("status", "", "")
+		collectionListDecisionActivityFlag    = collectionListFlags.String("decision-activity", "", "")
+		collectionListFailureCategoryFlag     = collectionListFlags.String("failure-category", "", "")
 		collectionListCursorFlag              = collectionListFlags.String("cursor", "", "")
 
 		collectionShowFlags  = flag.NewFlagSet("show", flag.ExitOnError)
@@ -107,6 +124,32 @@ func ParseEndpoint(
 		collectionStatusHistoryFlags  = flag.NewFlagSet("status-history", flag.ExitOnError)
 		collectionStatusHistoryIDFlag = collectionStatusHistoryFlags.String("id", "REQUIRED", "Identifier of collection to look up")
 
+		collectionNotificationsFlags  = flag.NewFlagSet("notifications", flag.ExitOnError)
+		collectionNotificationsIDFlag = collectionNotificationsFlags.String("id", "REQUIRED", "Identifier of collection to look up")
+
+		collectionRescanFlags       = flag.NewFlagSet("rescan", flag.ExitOnError)
+		collectionRescanWatcherFlag = collectionRescanFlags.String("watcher", "REQUIRED", "")
+
+		collectionRetentionFlags      = flag.NewFlagSet("retention", flag.ExitOnError)
+		collectionRetentionStatusFlag = collectionRetentionFlags.String("status", "", "")
+
+		collectionRetentionPostponeFlags    = flag.NewFlagSet("retention-postpone", flag.ExitOnError)
+		collectionRetentionPostponeBodyFlag = collectionRetentionPostponeFlags.String("body", "REQUIRED", "")
+		collectionRetentionPostponeIDFlag   = collectionRetentionPostponeFlags.String("id", "REQUIRED", "Identifier of collection")
+
+		collectionRetentionCancelFlags  = flag.NewFlagSet("retention-cancel", flag.ExitOnError)
+		collectionRetentionCancelIDFlag = collectionRetentionCancelFlags.String("id", "REQUIRED", "Identifier of collection")
+
+		collectionSetLegalHoldFlags    = flag.NewFlagSet("set-legal-hold", flag.ExitOnError)
+		collectionSetLegalHoldBodyFlag = collectionSetLegalHoldFlags.String("body", "REQUIRED", "")
+		collectionSetLegalHoldIDFlag   = collectionSetLegalHoldFlags.String("id", "REQUIRED", "Identifier of collection")
+
+		collectionClearLegalHoldFlags      = flag.NewFlagSet("clear-legal-hold", flag.ExitOnError)
+		collectionClearLegalHoldIDFlag     = collectionClearLegalHoldFlags.String("id", "REQUIRED", "Identifier of collection")
+		collectionClearLegalHoldReasonFlag = collectionClearLegalHoldFlags.String("reason", "REQUIRED", "")
+
+		collectionRetentionMigrateFlags = flag.NewFlagSet("retention-migrate", flag.ExitOnError)
+
 		collectionDownloadFlags  = flag.NewFlagSet("download", flag.ExitOnError)
 		collectionDownloadIDFlag = collectionDownloadFlags.String("id", "REQUIRED", "Identifier of collection to look up")
 
@@ -118,10 +161,19 @@ func ParseEndpoint(
 		collectionBulkBodyFlag = collectionBulkFlags.String("body", "REQUIRED", "")
 
 		collectionBulkStatusFlags = flag.NewFlagSet("bulk-status", flag.ExitOnError)
+
+		collectionBulkRunsFlags = flag.NewFlagSet("bulk-runs", flag.ExitOnError)
+
+		collectionBulkRunFlags  = flag.NewFlagSet("bulk-run", flag.ExitOnError)
+		collectionBulkRunIDFlag = collectionBulkRunFlags.String("id", "REQUIRED", "Identifier of the bulk operation")
 	)
 	pipelineFlags.Usage = pipelineUsage
 	pipelineListFlags.Usage = pipelineListUsage
 	pipelineShowFlags.Usage = pipelineShowUsage
+	pipelineRouteFlags.Usage = pipelineRouteUsage
+	pipelinePauseFlags.Usage = pipelinePauseUsage
+	pipelineDrainFlags.Usage = pipelineDrainUsage
+	pipelineResumeFlags.Usage = pipelineResumeUsage
 	pipelineProcessingFlags.Usage = pipelineProcessingUsage
 
 	batchFlags.Usage = batchUsage
@@ -139,10 +191,20 @@ func ParseEndpoint(
 	collectionRetryFlags.Usage = collectionRetryUsage
 	collectionWorkflowFlags.Usage = collectionWorkflowUsage
 	collectionStatusHistoryFlags.Usage = collectionStatusHistoryUsage
+	collectionNotificationsFlags.Usage = collectionNotificationsUsage
+	collectionRescanFlags.Usage = collectionRescanUsage
+	collectionRetentionFlags.Usage = collectionRetentionUsage
+	collectionRetentionPostponeFlags.Usage = collectionRetentionPostponeUsage
+	collectionRetentionCancelFlags.Usage = collectionRetentionCancelUsage
+	collectionSetLegalHoldFlags.Usage = collectionSetLegalHoldUsage
+	collectionClearLegalHoldFlags.Usage = collectionClearLegalHoldUsage
+	collectionRetentionMigrateFlags.Usage = collectionRetentionMigrateUsage
 	collectionDownloadFlags.Usage = collectionDownloadUsage
 	collectionDecideFlags.Usage = collectionDecideUsage
 	collectionBulkFlags.Usage = collectionBulkUsage
 	collectionBulkStatusFlags.Usage = collectionBulkStatusUsage
+	collectionBulkRunsFlags.Usage = collectionBulkRunsUsage
+	collectionBulkRunFlags.Usage = collectionBulkRunUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -188,6 +250,18 @@ func ParseEndpoint(
 			case "show":
 				epf = pipelineShowFlags
 
+			case "route":
+				epf = pipelineRouteFlags
+
+			case "pause":
+				epf = pipelinePauseFlags
+
+			case "drain":
+				epf = pipelineDrainFlags
+
+			case "resume":
+				epf = pipelineResumeFlags
+
 			case "processing":
 				epf = pipelineProcessingFlags
 
@@ -235,6 +309,30 @@ func ParseEndpoint(
 			case "status-history":
 				epf = collectionStatusHistoryFlags
 
+			case "notifications":
+				epf = collectionNotificationsFlags
+
+			case "rescan":
+				epf = collectionRescanFlags
+
+			case "retention":
+				epf = collectionRetentionFlags
+
+			case "retention-postpone":
+				epf = collectionRetentionPostponeFlags
+
+			case "retention-cancel":
+				epf = collectionRetentionCancelFlags
+
+			case "set-legal-hold":
+				epf = collectionSetLegalHoldFlags
+
+			case "clear-legal-hold":
+				epf = collectionClearLegalHoldFlags
+
+			case "retention-migrate":
+				epf = collectionRetentionMigrateFlags
+
 			case "download":
 				epf = collectionDownloadFlags
 
@@ -247,6 +345,12 @@ func ParseEndpoint(
 			case "bulk-status":
 				epf = collectionBulkStatusFlags
 
+			case "bulk-runs":
+				epf = collectionBulkRunsFlags
+
+			case "bulk-run":
+				epf = collectionBulkRunFlags
+
 			}
 
 		}
@@ -278,6 +382,18 @@ func ParseEndpoint(
 			case "show":
 				endpoint = c.Show()
 				data, err = pipelinec.BuildShowPayload(*pipelineShowIDFlag)
+			case "route":
+				endpoint = c.Route()
+				data, err = pipelinec.BuildRoutePayload(*pipelineRouteKeyFlag, *pipelineRouteWatcherFlag, *pipelineRouteTransferTypeFlag, *pipelineRouteSizeFlag)
+			case "pause":
+				endpoint = c.Pause()
+				data, err = pipelinec.BuildPausePayload(*pipelinePauseIDFlag)
+			case "drain":
+				endpoint = c.Drain()
+				data, err = pipelinec.BuildDrainPayload(*pipelineDrainIDFlag)
+			case "resume":
+				endpoint = c.Resume()
+				data, err = pipelinec.BuildResumePayload(*pipelineResumeIDFlag)
 			case "processing":
 				endpoint = c.Processing()
 				data, err = pipelinec.BuildProcessingPayload(*pipelineProcessingIDFlag)
@@ -303,7 +419,7 @@ func ParseEndpoint(
 				endpoint = c.Monitor()
 			case "list":
 				endpoint = c.List()
-				data, err = collectionc.BuildListPayload(*collectionListNameFlag, *collectionListOriginalIDFlag, *collectionListTransferIDFlag, *collectionListAipIDFlag, *collectionListPipelineIDFlag, *collectionListEarliestCreatedTimeFlag, *collectionListLatestCreatedTimeFlag, *collectionListStatusFlag, *collectionListCursorFlag)
+				data, err = collectionc.BuildListPayload(*collectionListNameFlag, *collectionListOriginalIDFlag, *collectionListTransferIDFlag, *collectionListAipIDFlag, *collectionListPipelineIDFlag, *collectionListEarliestCreatedTimeFlag, *collectionListLatestCreatedTimeFlag, *collectionListStatusFlag, *collectionListDecisionActivityFlag, *collectionListFailureCategoryFlag, *collectionListCursorFlag)
 			case "show":
 				endpoint = c.Show()
 				data, err = collectionc.BuildShowPayload(*collectionShowIDFlag)
@@ -322,6 +438,29 @@ func ParseEndpoint(
 			case "status-history":
 				endpoint = c.StatusHistory()
 				data, err = collectionc.BuildStatusHistoryPayload(*collectionStatusHistoryIDFlag)
+			case "notifications":
+				endpoint = c.Notifications()
+				data, err = collectionc.BuildNotificationsPayload(*collectionNotificationsIDFlag)
+			case "rescan":
+				endpoint = c.Rescan()
+				data, err = collectionc.BuildRescanPayload(*collectionRescanWatcherFlag)
+			case "retention":
+				endpoint = c.Retention()
+				data, err = collectionc.BuildRetentionPayload(*collectionRetentionStatusFlag)
+			case "retention-postpone":
+				endpoint = c.RetentionPostpone()
+				data, err = collectionc.BuildRetentionPostponePayload(*collectionRetentionPostponeBodyFlag, *collectionRetentionPostponeIDFlag)
+			case "retention-cancel":
+				endpoint = c.RetentionCancel()
+				data, err = collectionc.BuildRetentionCancelPayload(*collectionRetentionCancelIDFlag)
+			case "set-legal-hold":
+				endpoint = c.SetLegalHold()
+				data, err = collectionc.BuildSetLegalHoldPayload(*collectionSetLegalHoldBodyFlag, *collectionSetLegalHoldIDFlag)
+			case "clear-legal-hold":
+				endpoint = c.ClearLegalHold()
+				data, err = collectionc.BuildClearLegalHoldPayload(*collectionClearLegalHoldIDFlag, *collectionClearLegalHoldReasonFlag)
+			case "retention-migrate":
+				endpoint = c.RetentionMigrate()
 			case "download":
 				endpoint = c.Download()
 				data, err = collectionc.BuildDownloadPayload(*collectionDownloadIDFlag)
@@ -333,6 +472,11 @@ func ParseEndpoint(
 				data, err = collectionc.BuildBulkPayload(*collectionBulkBodyFlag)
 			case "bulk-status":
 				endpoint = c.BulkStatus()
+			case "bulk-runs":
+				endpoint = c.BulkRuns()
+			case "bulk-run":
+				endpoint = c.BulkRun()
+				data, err = collectionc.BuildBulkRunPayload(*collectionBulkRunIDFlag)
 			}
 		}
 	}
@@ -350,6 +494,10 @@ func pipelineUsage() {
 	fmt.Fprintln(os.Stderr, "COMMAND:")
 	fmt.Fprintln(os.Stderr, `    list: List all known pipelines`)
 	fmt.Fprintln(os.Stderr, `    show: Show pipeline by ID`)
+	fmt.Fprintln(os.Stderr, `    route: Explain which routing rule selects the pipeline of a transfer`)
+	fmt.Fprintln(os.Stderr, `    pause: Hold new transfers of a pipeline until it is resumed`)
+	fmt.Fprintln(os.Stderr, `    drain: Send new transfers of a pipeline to other pipelines until it is resumed`)
+	fmt.Fprintln(os.Stderr, `    resume: Resume sending new transfers to a paused or draining pipeline`)
 	fmt.Fprintln(os.Stderr, `    processing: List all processing configurations of a pipeline given its ID`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
@@ -393,6 +541,84 @@ func pipelineShowUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "pipeline show --id \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\"")
 }
 
+func pipelineRouteUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] pipeline route", os.Args[0])
+	fmt.Fprint(os.Stderr, " -key STRING")
+	fmt.Fprint(os.Stderr, " -watcher STRING")
+	fmt.Fprint(os.Stderr, " -transfer-type STRING")
+	fmt.Fprint(os.Stderr, " -size INT64")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Explain which routing rule selects the pipeline of a transfer`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -key STRING: `)
+	fmt.Fprintln(os.Stderr, `    -watcher STRING: `)
+	fmt.Fprintln(os.Stderr, `    -transfer-type STRING: `)
+	fmt.Fprintln(os.Stderr, `    -size INT64: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "pipeline route --key \"abc123\" --watcher \"abc123\" --transfer-type \"abc123\" --size 1")
+}
+
+func pipelinePauseUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] pipeline pause", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Hold new transfers of a pipeline until it is resumed`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: Identifier of pipeline`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "pipeline pause --id \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\"")
+}
+
+func pipelineDrainUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] pipeline drain", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Send new transfers of a pipeline to other pipelines until it is resumed`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: Identifier of pipeline`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "pipeline drain --id \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\"")
+}
+
+func pipelineResumeUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] pipeline resume", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Resume sending new transfers to a paused or draining pipeline`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: Identifier of pipeline`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "pipeline resume --id \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\"")
+}
+
 func pipelineProcessingUsage() {
 	// Header with flags
 	fmt.Fprintf(os.Stderr, "%s [flags] pipeline processing", os.Args[0])
@@ -506,10 +732,20 @@ func collectionUsage() {
 	fmt.Fprintln(os.Stderr, `    retry: Retry collection processing by ID`)
 	fmt.Fprintln(os.Stderr, `    workflow: Retrieve workflow status by ID`)
 	fmt.Fprintln(os.Stderr, `    status-history: Retrieve the recorded status transition history for a collection`)
+	fmt.Fprintln(os.Stderr, `    notifications: Retrieve the webhook notification delivery log for a collection`)
+	fmt.Fprintln(os.Stderr, `    rescan: List the blobs of a watcher that were never dispatched and are not known collections, without dispatching them`)
+	fmt.Fprintln(os.Stderr, `    retention: List the scheduled deletions of the originals`)
+	fmt.Fprintln(os.Stderr, `    retention-postpone: Change the due date of the pending deletion of the original of a collection`)
+	fmt.Fprintln(os.Stderr, `    retention-cancel: Cancel the pending deletion of the original of a collection`)
+	fmt.Fprintln(os.Stderr, `    set-legal-hold: Place a collection under legal hold`)
+	fmt.Fprintln(os.Stderr, `    clear-legal-hold: Release a collection from legal hold`)
+	fmt.Fprintln(os.Stderr, `    retention-migrate: Hand the retention timers of running processing workflows over to the retention scheduler`)
 	fmt.Fprintln(os.Stderr, `    download: Download collection by ID`)
 	fmt.Fprintln(os.Stderr, `    decide: Make decision for a pending collection by ID`)
-	fmt.Fprintln(os.Stderr, `    bulk: Bulk operations (retry, cancel...).`)
-	fmt.Fprintln(os.Stderr, `    bulk-status: Retrieve status of current bulk operation.`)
+	fmt.Fprintln(os.Stderr, `    bulk: Bulk operations (retry, cancel, decide...).`)
+	fmt.Fprintln(os.Stderr, `    bulk-status: Retrieve status of the most recent bulk operation.`)
+	fmt.Fprintln(os.Stderr, `    bulk-runs: List the most recent bulk operations`)
+	fmt.Fprintln(os.Stderr, `    bulk-run: Show bulk operation by ID`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
 	fmt.Fprintf(os.Stderr, "    %s collection COMMAND --help\n", os.Args[0])
@@ -541,6 +777,8 @@ func collectionListUsage() {
 	fmt.Fprint(os.Stderr, " -earliest-created-time STRING")
 	fmt.Fprint(os.Stderr, " -latest-created-time STRING")
 	fmt.Fprint(os.Stderr, " -status STRING")
+	fmt.Fprint(os.Stderr, " -decision-activity STRING")
+	fmt.Fprint(os.Stderr, " -failure-category STRING")
 	fmt.Fprint(os.Stderr, " -cursor STRING")
 	fmt.Fprintln(os.Stderr)
 
@@ -557,11 +795,13 @@ func collectionListUsage() {
 	fmt.Fprintln(os.Stderr, `    -earliest-created-time STRING: `)
 	fmt.Fprintln(os.Stderr, `    -latest-created-time STRING: `)
 	fmt.Fprintln(os.Stderr, `    -status STRING: `)
+	fmt.Fprintln(os.Stderr, `    -decision-activity STRING: `)
+	fmt.Fprintln(os.Stderr, `    -failure-category STRING: `)
 	fmt.Fprintln(os.Stderr, `    -cursor STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "collection list --name \"abc123\" --original-id \"abc123\" --transfer-id \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\" --aip-id \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\" --pipeline-id \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\" --earliest-created-time \"e1d563b0-1474-4155-beed-f2d3a12e1529\" --latest-created-time \"e1d563b0-1474-4155-beed-f2d3a12e1529\" --status \"in progress\" --cursor \"abc123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "collection list --name \"abc123\" --original-id \"abc123\" --transfer-id \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\" --aip-id \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\" --pipeline-id \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\" --earliest-created-time \"e1d563b0-1474-4155-beed-f2d3a12e1529\" --latest-created-time \"e1d563b0-1474-4155-beed-f2d3a12e1529\" --status \"in progress\" --decision-activity \"abc123\" --failure-category \"rejected\" --cursor \"abc123\"")
 }
 
 func collectionShowUsage() {
@@ -672,6 +912,154 @@ func collectionStatusHistoryUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "collection status-history --id 1")
 }
 
+func collectionNotificationsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] collection notifications", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id UINT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Retrieve the webhook notification delivery log for a collection`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id UINT: Identifier of collection to look up`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "collection notifications --id 1")
+}
+
+func collectionRescanUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] collection rescan", os.Args[0])
+	fmt.Fprint(os.Stderr, " -watcher STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the blobs of a watcher that were never dispatched and are not known collections, without dispatching them`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -watcher STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "collection rescan --watcher \"abc123\"")
+}
+
+func collectionRetentionUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] collection retention", os.Args[0])
+	fmt.Fprint(os.Stderr, " -status STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the scheduled deletions of the originals`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -status STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "collection retention --status \"done\"")
+}
+
+func collectionRetentionPostponeUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] collection retention-postpone", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -id UINT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Change the due date of the pending deletion of the original of a collection`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -id UINT: Identifier of collection`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "collection retention-postpone --body '{\n      \"due_at\": \"1970-01-01T00:00:01Z\"\n   }' --id 1")
+}
+
+func collectionRetentionCancelUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] collection retention-cancel", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id UINT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Cancel the pending deletion of the original of a collection`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id UINT: Identifier of collection`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "collection retention-cancel --id 1")
+}
+
+func collectionSetLegalHoldUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] collection set-legal-hold", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -id UINT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Place a collection under legal hold`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -id UINT: Identifier of collection`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "collection set-legal-hold --body '{\n      \"reason\": \"abc123\"\n   }' --id 1")
+}
+
+func collectionClearLegalHoldUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] collection clear-legal-hold", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id UINT")
+	fmt.Fprint(os.Stderr, " -reason STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Release a collection from legal hold`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id UINT: Identifier of collection`)
+	fmt.Fprintln(os.Stderr, `    -reason STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "collection clear-legal-hold --id 1 --reason \"abc123\"")
+}
+
+func collectionRetentionMigrateUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] collection retention-migrate", os.Args[0])
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Hand the retention timers of running processing workflows over to the retention scheduler`)
+
+	// Flags list
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "collection retention-migrate")
+}
+
 func collectionDownloadUsage() {
 	// Header with flags
 	fmt.Fprintf(os.Stderr, "%s [flags] collection download", os.Args[0])
@@ -707,7 +1095,7 @@ func collectionDecideUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "collection decide --body '{\n      \"option\": \"abc123\"\n   }' --id 1")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "collection decide --body '{\n      \"option\": \"RETRY_ONCE\"\n   }' --id 1")
 }
 
 func collectionBulkUsage() {
@@ -718,14 +1106,14 @@ func collectionBulkUsage() {
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Bulk operations (retry, cancel...).`)
+	fmt.Fprintln(os.Stderr, `Bulk operations (retry, cancel, decide...).`)
 
 	// Flags list
 	fmt.Fprintln(os.Stderr, `    -body JSON: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "collection bulk --body '{\n      \"operation\": \"cancel\",\n      \"size\": 1,\n      \"status\": \"in progress\"\n   }'")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "collection bulk --body '{\n      \"aip_id\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\",\n      \"decision_activity\": \"abc123\",\n      \"dry_run\": false,\n      \"earliest_created_time\": \"1970-01-01T00:00:01Z\",\n      \"ids\": [\n         1\n      ],\n      \"latest_created_time\": \"1970-01-01T00:00:01Z\",\n      \"name\": \"abc123\",\n      \"operation\": \"cancel\",\n      \"option\": \"RETRY_ONCE\",\n      \"original_id\": \"abc123\",\n      \"pipeline_id\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\",\n      \"size\": 1,\n      \"status\": \"in progress\",\n      \"transfer_id\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\"\n   }'")
 }
 
 func collectionBulkStatusUsage() {
@@ -735,7 +1123,7 @@ func collectionBulkStatusUsage() {
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Retrieve status of current bulk operation.`)
+	fmt.Fprintln(os.Stderr, `Retrieve status of the most recent bulk operation.`)
 
 	// Flags list
 
@@ -743,3 +1131,37 @@ func collectionBulkStatusUsage() {
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "collection bulk-status")
 }
+
+func collectionBulkRunsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] collection bulk-runs", os.Args[0])
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the most recent bulk operations`)
+
+	// Flags list
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "collection bulk-runs")
+}
+
+func collectionBulkRunUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] collection bulk-run", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id UINT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Show bulk operation by ID`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id UINT: Identifier of the bulk operation`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "collection bulk-run --id 1")
+}
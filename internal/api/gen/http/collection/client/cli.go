@@ -19,7 +19,7 @@ import (
 
 // BuildListPayload builds the payload for the collection list endpoint from
 // CLI flags.
-func BuildListPayload(collectionListName string, collectionListOriginalID string, collectionListTransferID string, collectionListAipID string, collectionListPipelineID string, collectionListEarliestCreatedTime string, collectionListLatestCreatedTime string, collectionListStatus string, collectionListCursor string) (*collection.ListPayload, error) {
+func BuildListPayload(collectionListName string, collectionListOriginalID string, collectionListTransferID string, collectionListAipID string, collectionListPipelineID string, collectionListEarliestCreatedTime string, collectionListLatestCreatedTime string, collectionListStatus string, collectionListDecisionActivity string, collectionListFailureCategory string, collectionListCursor string) (*collection.ListPayload, error) {
 	var err error
 	var name *string
 	{
@@ -95,6 +95,24 @@ func BuildListPayload(collectionListName string, collectionListOriginalID string
 			}
 		}
 	}
+	var decisionActivity *string
+	{
+		if collectionListDecisionActivity != "" {
+			decisionActivity = &collectionListDecisionActivity
+		}
+	}
+	var failureCategory *string
+	{
+		if collectionListFailureCategory != "" {
+			failureCategory = &collectionListFailureCategory
+			if !(*failureCategory == "failed" || *failureCategory == "rejected" || *failureCategory == "unexpected_state" || *failureCategory == "api_error" || *failureCategory == "unreachable") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("failure_category", *failureCategory, []any{"failed", "rejected", "unexpected_state", "api_error", "unreachable"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 	var cursor *string
 	{
 		if collectionListCursor != "" {
@@ -110,6 +128,8 @@ func BuildListPayload(collectionListName string, collectionListOriginalID string
 	v.EarliestCreatedTime = earliestCreatedTime
 	v.LatestCreatedTime = latestCreatedTime
 	v.Status = status
+	v.DecisionActivity = decisionActivity
+	v.FailureCategory = failureCategory
 	v.Cursor = cursor
 
 	return v, nil
@@ -229,6 +249,163 @@ func BuildStatusHistoryPayload(collectionStatusHistoryID string) (*collection.St
 	return v, nil
 }
 
+// BuildNotificationsPayload builds the payload for the collection
+// notifications endpoint from CLI flags.
+func BuildNotificationsPayload(collectionNotificationsID string) (*collection.NotificationsPayload, error) {
+	var err error
+	var id uint
+	{
+		var v uint64
+		v, err = strconv.ParseUint(collectionNotificationsID, 10, strconv.IntSize)
+		id = uint(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be UINT")
+		}
+	}
+	v := &collection.NotificationsPayload{}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildRescanPayload builds the payload for the collection rescan endpoint
+// from CLI flags.
+func BuildRescanPayload(collectionRescanWatcher string) (*collection.RescanPayload, error) {
+	var watcher string
+	{
+		watcher = collectionRescanWatcher
+	}
+	v := &collection.RescanPayload{}
+	v.Watcher = watcher
+
+	return v, nil
+}
+
+// BuildRetentionPayload builds the payload for the collection retention
+// endpoint from CLI flags.
+func BuildRetentionPayload(collectionRetentionStatus string) (*collection.RetentionPayload, error) {
+	var err error
+	var status *string
+	{
+		if collectionRetentionStatus != "" {
+			status = &collectionRetentionStatus
+			if !(*status == "pending" || *status == "done" || *status == "failed" || *status == "canceled") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", *status, []any{"pending", "done", "failed", "canceled"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &collection.RetentionPayload{}
+	v.Status = status
+
+	return v, nil
+}
+
+// BuildRetentionPostponePayload builds the payload for the collection
+// retention_postpone endpoint from CLI flags.
+func BuildRetentionPostponePayload(collectionRetentionPostponeBody string, collectionRetentionPostponeID string) (*collection.RetentionPostponePayload, error) {
+	var err error
+	var body RetentionPostponeRequestBody
+	{
+		err = json.Unmarshal([]byte(collectionRetentionPostponeBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"due_at\": \"1970-01-01T00:00:01Z\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.due_at", body.DueAt, goa.FormatDateTime))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id uint
+	{
+		var v uint64
+		v, err = strconv.ParseUint(collectionRetentionPostponeID, 10, strconv.IntSize)
+		id = uint(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be UINT")
+		}
+	}
+	v := &collection.RetentionPostponePayload{
+		DueAt: body.DueAt,
+	}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildRetentionCancelPayload builds the payload for the collection
+// retention_cancel endpoint from CLI flags.
+func BuildRetentionCancelPayload(collectionRetentionCancelID string) (*collection.RetentionCancelPayload, error) {
+	var err error
+	var id uint
+	{
+		var v uint64
+		v, err = strconv.ParseUint(collectionRetentionCancelID, 10, strconv.IntSize)
+		id = uint(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be UINT")
+		}
+	}
+	v := &collection.RetentionCancelPayload{}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildSetLegalHoldPayload builds the payload for the collection
+// set_legal_hold endpoint from CLI flags.
+func BuildSetLegalHoldPayload(collectionSetLegalHoldBody string, collectionSetLegalHoldID string) (*collection.SetLegalHoldPayload, error) {
+	var err error
+	var body SetLegalHoldRequestBody
+	{
+		err = json.Unmarshal([]byte(collectionSetLegalHoldBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"reason\": \"abc123\"\n   }'")
+		}
+	}
+	var id uint
+	{
+		var v uint64
+		v, err = strconv.ParseUint(collectionSetLegalHoldID, 10, strconv.IntSize)
+		id = uint(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be UINT")
+		}
+	}
+	v := &collection.SetLegalHoldPayload{
+		Reason: body.Reason,
+	}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildClearLegalHoldPayload builds the payload for the collection
+// clear_legal_hold endpoint from CLI flags.
+func BuildClearLegalHoldPayload(collectionClearLegalHoldID string, collectionClearLegalHoldReason string) (*collection.ClearLegalHoldPayload, error) {
+	var err error
+	var id uint
+	{
+		var v uint64
+		v, err = strconv.ParseUint(collectionClearLegalHoldID, 10, strconv.IntSize)
+		id = uint(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be UINT")
+		}
+	}
+	var reason string
+	{
+		reason = collectionClearLegalHoldReason
+	}
+	v := &collection.ClearLegalHoldPayload{}
+	v.ID = id
+	v.Reason = reason
+
+	return v, nil
+}
+
 // BuildDownloadPayload builds the payload for the collection download endpoint
 // from CLI flags.
 func BuildDownloadPayload(collectionDownloadID string) (*collection.DownloadPayload, error) {
@@ -259,7 +436,7 @@ func BuildDecidePayload(collectionDecideBody string, collectionDecideID string)
 	{
 		err = json.Unmarshal([]byte(collectionDecideBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"option\": \"abc123\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"option\": \"RETRY_ONCE\"\n   }'")
 		}
 	}
 	var id uint
@@ -288,22 +465,52 @@ func BuildBulkPayload(collectionBulkBody string) (*collection.BulkPayload, error
 	{
 		err = json.Unmarshal([]byte(collectionBulkBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"operation\": \"cancel\",\n      \"size\": 1,\n      \"status\": \"in progress\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"aip_id\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\",\n      \"decision_activity\": \"abc123\",\n      \"dry_run\": false,\n      \"earliest_created_time\": \"1970-01-01T00:00:01Z\",\n      \"ids\": [\n         1\n      ],\n      \"latest_created_time\": \"1970-01-01T00:00:01Z\",\n      \"name\": \"abc123\",\n      \"operation\": \"cancel\",\n      \"option\": \"RETRY_ONCE\",\n      \"original_id\": \"abc123\",\n      \"pipeline_id\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\",\n      \"size\": 1,\n      \"status\": \"in progress\",\n      \"transfer_id\": \"d1845cb6-a5ea-474a-9ab8-26f9bcd919f5\"\n   }'")
 		}
-		if !(body.Operation == "retry" || body.Operation == "cancel" || body.Operation == "abandon") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.operation", body.Operation, []any{"retry", "cancel", "abandon"}))
+		if !(body.Operation == "retry" || body.Operation == "cancel" || body.Operation == "abandon" || body.Operation == "decide") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.operation", body.Operation, []any{"retry", "cancel", "abandon", "decide"}))
 		}
 		if !(body.Status == "new" || body.Status == "in progress" || body.Status == "done" || body.Status == "error" || body.Status == "unknown" || body.Status == "queued" || body.Status == "pending" || body.Status == "abandoned") {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", body.Status, []any{"new", "in progress", "done", "error", "unknown", "queued", "pending", "abandoned"}))
 		}
+		if body.Option != nil {
+			if !(*body.Option == "RETRY" || *body.Option == "RETRY_ONCE" || *body.Option == "ABANDON" || *body.Option == "SKIP") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.option", *body.Option, []any{"RETRY", "RETRY_ONCE", "ABANDON", "SKIP"}))
+			}
+		}
+		if body.TransferID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.transfer_id", *body.TransferID, goa.FormatUUID))
+		}
+		if body.AipID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.aip_id", *body.AipID, goa.FormatUUID))
+		}
+		if body.PipelineID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.pipeline_id", *body.PipelineID, goa.FormatUUID))
+		}
+		if body.EarliestCreatedTime != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.earliest_created_time", *body.EarliestCreatedTime, goa.FormatDateTime))
+		}
+		if body.LatestCreatedTime != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.latest_created_time", *body.LatestCreatedTime, goa.FormatDateTime))
+		}
 		if err != nil {
 			return nil, err
 		}
 	}
 	v := &collection.BulkPayload{
-		Operation: body.Operation,
-		Status:    body.Status,
-		Size:      body.Size,
+		Operation:           body.Operation,
+		Status:              body.Status,
+		Size:                body.Size,
+		Option:              body.Option,
+		Name:                body.Name,
+		OriginalID:          body.OriginalID,
+		TransferID:          body.TransferID,
+		AipID:               body.AipID,
+		PipelineID:          body.PipelineID,
+		EarliestCreatedTime: body.EarliestCreatedTime,
+		LatestCreatedTime:   body.LatestCreatedTime,
+		DecisionActivity:    body.DecisionActivity,
+		DryRun:              body.DryRun,
 	}
 	{
 		var zero uint
@@ -311,6 +518,37 @@ func BuildBulkPayload(collectionBulkBody string) (*collection.BulkPayload, error
 			v.Size = 100
 		}
 	}
+	if body.Ids != nil {
+		v.Ids = make([]uint, len(body.Ids))
+		for i, val := range body.Ids {
+			v.Ids[i] = val
+		}
+	}
+	{
+		var zero bool
+		if v.DryRun == zero {
+			v.DryRun = false
+		}
+	}
+
+	return v, nil
+}
+
+// BuildBulkRunPayload builds the payload for the collection bulk_run endpoint
+// from CLI flags.
+func BuildBulkRunPayload(collectionBulkRunID string) (*collection.BulkRunPayload, error) {
+	var err error
+	var id uint
+	{
+		var v uint64
+		v, err = strconv.ParseUint(collectionBulkRunID, 10, strconv.IntSize)
+		id = uint(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be UINT")
+		}
+	}
+	v := &collection.BulkRunPayload{}
+	v.ID = id
 
 	return v, nil
 }
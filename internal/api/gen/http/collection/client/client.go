@@ -48,6 +48,37 @@ type Client struct {
 	// status_history endpoint.
 	StatusHistoryDoer goahttp.Doer
 
+	// Notifications Doer is the HTTP client used to make requests to the
+	// notifications endpoint.
+	NotificationsDoer goahttp.Doer
+
+	// Rescan Doer is the HTTP client used to make requests to the rescan endpoint.
+	RescanDoer goahttp.Doer
+
+	// Retention Doer is the HTTP client used to make requests to the retention
+	// endpoint.
+	RetentionDoer goahttp.Doer
+
+	// RetentionPostpone Doer is the HTTP client used to make requests to the
+	// retention_postpone endpoint.
+	RetentionPostponeDoer goahttp.Doer
+
+	// RetentionCancel Doer is the HTTP client used to make requests to the
+	// retention_cancel endpoint.
+	RetentionCancelDoer goahttp.Doer
+
+	// SetLegalHold Doer is the HTTP client used to make requests to the
+	// set_legal_hold endpoint.
+	SetLegalHoldDoer goahttp.Doer
+
+	// ClearLegalHold Doer is the HTTP client used to make requests to the
+	// clear_legal_hold endpoint.
+	ClearLegalHoldDoer goahttp.Doer
+
+	// RetentionMigrate Doer is the HTTP client used to make requests to the
+	// retention_migrate endpoint.
+	RetentionMigrateDoer goahttp.Doer
+
 	// Download Doer is the HTTP client used to make requests to the download
 	// endpoint.
 	DownloadDoer goahttp.Doer
@@ -62,6 +93,13 @@ type Client struct {
 	// endpoint.
 	BulkStatusDoer goahttp.Doer
 
+	// BulkRuns Doer is the HTTP client used to make requests to the bulk_runs
+	// endpoint.
+	BulkRunsDoer goahttp.Doer
+
+	// BulkRun Doer is the HTTP client used to make requests to the bulk_run
+	// endpoint.
+	BulkRunDoer goahttp.Doer
 	// CORS Doer is the HTTP client used to make requests to the  endpoint.
 	CORSDoer goahttp.Doer
 
@@ -85,24 +123,33 @@ func NewClient(
 	restoreBody bool,
 ) *Client {
 	return &Client{
-		MonitorDoer:         doer,
-		ListDoer:            doer,
-		ShowDoer:            doer,
-		DeleteDoer:          doer,
-		CancelDoer:          doer,
-		RetryDoer:           doer,
-		WorkflowDoer:        doer,
-		StatusHistoryDoer:   doer,
-		DownloadDoer:        doer,
-		DecideDoer:          doer,
-		BulkDoer:            doer,
-		BulkStatusDoer:      doer,
-		CORSDoer:            doer,
-		RestoreResponseBody: restoreBody,
-		scheme:              scheme,
-		host:                host,
-		decoder:             dec,
-		encoder:             enc,
+		MonitorDoer:           doer,
+		ListDoer:              doer,
+		ShowDoer:              doer,
+		DeleteDoer:            doer,
+		CancelDoer:            doer,
+		RetryDoer:             doer,
+		WorkflowDoer:          doer,
+		StatusHistoryDoer:     doer,
+		NotificationsDoer:     doer,
+		RescanDoer:            doer,
+		RetentionDoer:         doer,
+		RetentionPostponeDoer: doer,
+		RetentionCancelDoer:   doer,
+		SetLegalHoldDoer:      doer,
+		ClearLegalHoldDoer:    doer,
+		RetentionMigrateDoer:  doer,
+		DownloadDoer:          doer,
+		DecideDoer:            doer,
+		BulkDoer:              doer,
+		BulkStatusDoer:        doer,
+		BulkRunsDoer:          doer,
+		BulkRunDoer:           doer,
+		RestoreResponseBody:   restoreBody,
+		scheme:                scheme,
+		host:                  host,
+		decoder:               dec,
+		encoder:               enc,
 	}
 }
 
@@ -276,6 +323,183 @@ func (c *Client) StatusHistory() goa.Endpoint {
 	}
 }
 
+// Notifications returns an endpoint that makes HTTP requests to the collection
+// service notifications server.
+func (c *Client) Notifications() goa.Endpoint {
+	var (
+		decodeResponse = DecodeNotificationsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildNotificationsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.NotificationsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("collection", "notifications", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Rescan returns an endpoint that makes HTTP requests to the collection
+// service rescan server.
+func (c *Client) Rescan() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRescanRequest(c.encoder)
+		decodeResponse = DecodeRescanResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRescanRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RescanDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("collection", "rescan", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Retention returns an endpoint that makes HTTP requests to the collection
+// service retention server.
+func (c *Client) Retention() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRetentionRequest(c.encoder)
+		decodeResponse = DecodeRetentionResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRetentionRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RetentionDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("collection", "retention", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RetentionPostpone returns an endpoint that makes HTTP requests to the
+// collection service retention_postpone server.
+func (c *Client) RetentionPostpone() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRetentionPostponeRequest(c.encoder)
+		decodeResponse = DecodeRetentionPostponeResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRetentionPostponeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RetentionPostponeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("collection", "retention_postpone", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RetentionCancel returns an endpoint that makes HTTP requests to the
+// collection service retention_cancel server.
+func (c *Client) RetentionCancel() goa.Endpoint {
+	var (
+		decodeResponse = DecodeRetentionCancelResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRetentionCancelRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RetentionCancelDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("collection", "retention_cancel", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// SetLegalHold returns an endpoint that makes HTTP requests to the collection
+// service set_legal_hold server.
+func (c *Client) SetLegalHold() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSetLegalHoldRequest(c.encoder)
+		decodeResponse = DecodeSetLegalHoldResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSetLegalHoldRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SetLegalHoldDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("collection", "set_legal_hold", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ClearLegalHold returns an endpoint that makes HTTP requests to the
+// collection service clear_legal_hold server.
+func (c *Client) ClearLegalHold() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeClearLegalHoldRequest(c.encoder)
+		decodeResponse = DecodeClearLegalHoldResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildClearLegalHoldRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ClearLegalHoldDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("collection", "clear_legal_hold", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RetentionMigrate returns an endpoint that makes HTTP requests to the
+// collection service retention_migrate server.
+func (c *Client) RetentionMigrate() goa.Endpoint {
+	var (
+		decodeResponse = DecodeRetentionMigrateResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRetentionMigrateRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RetentionMigrateDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("collection", "retention_migrate", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // Download returns an endpoint that makes HTTP requests to the collection
 // service download server.
 func (c *Client) Download() goa.Endpoint {
@@ -366,3 +590,41 @@ func (c *Client) BulkStatus() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// BulkRuns returns an endpoint that makes HTTP requests to the collection
+// service bulk_runs server.
+func (c *Client) BulkRuns() goa.Endpoint {
+	var (
+		decodeResponse = DecodeBulkRunsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildBulkRunsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.BulkRunsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("collection", "bulk_runs", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// BulkRun returns an endpoint that makes HTTP requests to the collection
+// service bulk_run server.
+func (c *Client) BulkRun() goa.Endpoint {
+	var (
+		decodeResponse = DecodeBulkRunResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildBulkRunRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.BulkRunDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("collection", "bulk_run", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -125,6 +125,12 @@ func EncodeListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.R
 		if p.Status != nil {
 			values.Add("status", *p.Status)
 		}
+		if p.DecisionActivity != nil {
+			values.Add("decision_activity", *p.DecisionActivity)
+		}
+		if p.FailureCategory != nil {
+			values.Add("failure_category", *p.FailureCategory)
+		}
 		if p.Cursor != nil {
 			values.Add("cursor", *p.Cursor)
 		}
@@ -287,6 +293,7 @@ func (c *Client) BuildDeleteRequest(ctx context.Context, v any) (*http.Request,
 // should be restored after having been read.
 // DecodeDeleteResponse may return the following errors:
 //   - "not_found" (type *collection.CollectionNotfound): http.StatusNotFound
+//   - "legal_hold" (type *goa.ServiceError): http.StatusConflict
 //   - error: internal error
 func DecodeDeleteResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
@@ -319,6 +326,20 @@ func DecodeDeleteResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 				return nil, goahttp.ErrValidationError("collection", "delete", err)
 			}
 			return nil, NewDeleteNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body DeleteLegalHoldResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("collection", "delete", err)
+			}
+			err = ValidateDeleteLegalHoldResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("collection", "delete", err)
+			}
+			return nil, NewDeleteLegalHold(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("collection", "delete", resp.StatusCode, string(body))
@@ -675,23 +696,23 @@ func DecodeStatusHistoryResponse(decoder func(*http.Response) goahttp.Decoder, r
 	}
 }
 
-// BuildDownloadRequest instantiates a HTTP request object with method and path
-// set to call the "collection" service "download" endpoint
-func (c *Client) BuildDownloadRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildNotificationsRequest instantiates a HTTP request object with method and
+// path set to call the "collection" service "notifications" endpoint
+func (c *Client) BuildNotificationsRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		id uint
 	)
 	{
-		p, ok := v.(*collection.DownloadPayload)
+		p, ok := v.(*collection.NotificationsPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("collection", "download", "*collection.DownloadPayload", v)
+			return nil, goahttp.ErrInvalidType("collection", "notifications", "*collection.NotificationsPayload", v)
 		}
 		id = p.ID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DownloadCollectionPath(id)}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: NotificationsCollectionPath(id)}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("collection", "download", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("collection", "notifications", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -700,13 +721,13 @@ func (c *Client) BuildDownloadRequest(ctx context.Context, v any) (*http.Request
 	return req, nil
 }
 
-// DecodeDownloadResponse returns a decoder for responses returned by the
-// collection download endpoint. restoreBody controls whether the response body
-// should be restored after having been read.
-// DecodeDownloadResponse may return the following errors:
+// DecodeNotificationsResponse returns a decoder for responses returned by the
+// collection notifications endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeNotificationsResponse may return the following errors:
 //   - "not_found" (type *collection.CollectionNotfound): http.StatusNotFound
 //   - error: internal error
-func DecodeDownloadResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeNotificationsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -717,79 +738,55 @@ func DecodeDownloadResponse(decoder func(*http.Response) goahttp.Decoder, restor
 			defer func() {
 				resp.Body = io.NopCloser(bytes.NewBuffer(b))
 			}()
+		} else {
+			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				contentType        string
-				contentLength      int64
-				contentDisposition string
-				err                error
+				body EnduroCollectionNotificationDeliveryResponseCollection
+				err  error
 			)
-			contentTypeRaw := resp.Header.Get("Content-Type")
-			if contentTypeRaw == "" {
-				err = goa.MergeErrors(err, goa.MissingFieldError("content_type", "header"))
-			}
-			contentType = contentTypeRaw
-			{
-				contentLengthRaw := resp.Header.Get("Content-Length")
-				if contentLengthRaw == "" {
-					return nil, goahttp.ErrValidationError("collection", "download", goa.MissingFieldError("content_length", "header"))
-				}
-				v, err2 := strconv.ParseInt(contentLengthRaw, 10, 64)
-				if err2 != nil {
-					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("content_length", contentLengthRaw, "integer"))
-				}
-				contentLength = v
-			}
-			contentDispositionRaw := resp.Header.Get("Content-Disposition")
-			if contentDispositionRaw == "" {
-				err = goa.MergeErrors(err, goa.MissingFieldError("content_disposition", "header"))
-			}
-			contentDisposition = contentDispositionRaw
+			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("collection", "download", err)
+				return nil, goahttp.ErrDecodingError("collection", "notifications", err)
 			}
-			res := NewDownloadResultOK(contentType, contentLength, contentDisposition)
+			p := NewNotificationsEnduroCollectionNotificationDeliveryCollectionOK(body)
+			view := "default"
+			vres := collectionviews.EnduroCollectionNotificationDeliveryCollection{Projected: p, View: view}
+			if err = collectionviews.ValidateEnduroCollectionNotificationDeliveryCollection(vres); err != nil {
+				return nil, goahttp.ErrValidationError("collection", "notifications", err)
+			}
+			res := collection.NewEnduroCollectionNotificationDeliveryCollection(vres)
 			return res, nil
 		case http.StatusNotFound:
 			var (
-				body DownloadNotFoundResponseBody
+				body NotificationsNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("collection", "download", err)
+				return nil, goahttp.ErrDecodingError("collection", "notifications", err)
 			}
-			err = ValidateDownloadNotFoundResponseBody(&body)
+			err = ValidateNotificationsNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("collection", "download", err)
+				return nil, goahttp.ErrValidationError("collection", "notifications", err)
 			}
-			return nil, NewDownloadNotFound(&body)
+			return nil, NewNotificationsNotFound(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("collection", "download", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("collection", "notifications", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildDecideRequest instantiates a HTTP request object with method and path
-// set to call the "collection" service "decide" endpoint
-func (c *Client) BuildDecideRequest(ctx context.Context, v any) (*http.Request, error) {
-	var (
-		id uint
-	)
-	{
-		p, ok := v.(*collection.DecidePayload)
-		if !ok {
-			return nil, goahttp.ErrInvalidType("collection", "decide", "*collection.DecidePayload", v)
-		}
-		id = p.ID
-	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DecideCollectionPath(id)}
-	req, err := http.NewRequest("POST", u.String(), nil)
+// BuildRescanRequest instantiates a HTTP request object with method and path
+// set to call the "collection" service "rescan" endpoint
+func (c *Client) BuildRescanRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RescanCollectionPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("collection", "decide", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("collection", "rescan", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -798,30 +795,28 @@ func (c *Client) BuildDecideRequest(ctx context.Context, v any) (*http.Request,
 	return req, nil
 }
 
-// EncodeDecideRequest returns an encoder for requests sent to the collection
-// decide server.
-func EncodeDecideRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeRescanRequest returns an encoder for requests sent to the collection
+// rescan server.
+func EncodeRescanRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*collection.DecidePayload)
+		p, ok := v.(*collection.RescanPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("collection", "decide", "*collection.DecidePayload", v)
-		}
-		body := p
-		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("collection", "decide", err)
+			return goahttp.ErrInvalidType("collection", "rescan", "*collection.RescanPayload", v)
 		}
+		values := req.URL.Query()
+		values.Add("watcher", p.Watcher)
+		req.URL.RawQuery = values.Encode()
 		return nil
 	}
 }
 
-// DecodeDecideResponse returns a decoder for responses returned by the
-// collection decide endpoint. restoreBody controls whether the response body
+// DecodeRescanResponse returns a decoder for responses returned by the
+// collection rescan endpoint. restoreBody controls whether the response body
 // should be restored after having been read.
-// DecodeDecideResponse may return the following errors:
-//   - "not_found" (type *collection.CollectionNotfound): http.StatusNotFound
+// DecodeRescanResponse may return the following errors:
 //   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
 //   - error: internal error
-func DecodeDecideResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeRescanResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -837,49 +832,50 @@ func DecodeDecideResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 		}
 		switch resp.StatusCode {
 		case http.StatusOK:
-			return nil, nil
-		case http.StatusNotFound:
 			var (
-				body DecideNotFoundResponseBody
+				body EnduroCollectionRescanObjectResponseCollection
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("collection", "decide", err)
+				return nil, goahttp.ErrDecodingError("collection", "rescan", err)
 			}
-			err = ValidateDecideNotFoundResponseBody(&body)
-			if err != nil {
-				return nil, goahttp.ErrValidationError("collection", "decide", err)
+			p := NewRescanEnduroCollectionRescanObjectCollectionOK(body)
+			view := "default"
+			vres := collectionviews.EnduroCollectionRescanObjectCollection{Projected: p, View: view}
+			if err = collectionviews.ValidateEnduroCollectionRescanObjectCollection(vres); err != nil {
+				return nil, goahttp.ErrValidationError("collection", "rescan", err)
 			}
-			return nil, NewDecideNotFound(&body)
+			res := collection.NewEnduroCollectionRescanObjectCollection(vres)
+			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body DecideNotValidResponseBody
+				body RescanNotValidResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("collection", "decide", err)
+				return nil, goahttp.ErrDecodingError("collection", "rescan", err)
 			}
-			err = ValidateDecideNotValidResponseBody(&body)
+			err = ValidateRescanNotValidResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("collection", "decide", err)
+				return nil, goahttp.ErrValidationError("collection", "rescan", err)
 			}
-			return nil, NewDecideNotValid(&body)
+			return nil, NewRescanNotValid(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("collection", "decide", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("collection", "rescan", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildBulkRequest instantiates a HTTP request object with method and path set
-// to call the "collection" service "bulk" endpoint
-func (c *Client) BuildBulkRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: BulkCollectionPath()}
-	req, err := http.NewRequest("POST", u.String(), nil)
+// BuildRetentionRequest instantiates a HTTP request object with method and
+// path set to call the "collection" service "retention" endpoint
+func (c *Client) BuildRetentionRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RetentionCollectionPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("collection", "bulk", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("collection", "retention", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -888,30 +884,27 @@ func (c *Client) BuildBulkRequest(ctx context.Context, v any) (*http.Request, er
 	return req, nil
 }
 
-// EncodeBulkRequest returns an encoder for requests sent to the collection
-// bulk server.
-func EncodeBulkRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeRetentionRequest returns an encoder for requests sent to the
+// collection retention server.
+func EncodeRetentionRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*collection.BulkPayload)
+		p, ok := v.(*collection.RetentionPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("collection", "bulk", "*collection.BulkPayload", v)
+			return goahttp.ErrInvalidType("collection", "retention", "*collection.RetentionPayload", v)
 		}
-		body := NewBulkRequestBody(p)
-		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("collection", "bulk", err)
+		values := req.URL.Query()
+		if p.Status != nil {
+			values.Add("status", *p.Status)
 		}
+		req.URL.RawQuery = values.Encode()
 		return nil
 	}
 }
 
-// DecodeBulkResponse returns a decoder for responses returned by the
-// collection bulk endpoint. restoreBody controls whether the response body
-// should be restored after having been read.
-// DecodeBulkResponse may return the following errors:
-//   - "not_available" (type *goa.ServiceError): http.StatusConflict
-//   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
-//   - error: internal error
-func DecodeBulkResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+// DecodeRetentionResponse returns a decoder for responses returned by the
+// collection retention endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeRetentionResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -926,63 +919,132 @@ func DecodeBulkResponse(decoder func(*http.Response) goahttp.Decoder, restoreBod
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusAccepted:
+		case http.StatusOK:
 			var (
-				body BulkResponseBody
+				body EnduroCollectionRetentionDeletionResponseCollection
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("collection", "bulk", err)
+				return nil, goahttp.ErrDecodingError("collection", "retention", err)
 			}
-			err = ValidateBulkResponseBody(&body)
-			if err != nil {
-				return nil, goahttp.ErrValidationError("collection", "bulk", err)
+			p := NewRetentionEnduroCollectionRetentionDeletionCollectionOK(body)
+			view := "default"
+			vres := collectionviews.EnduroCollectionRetentionDeletionCollection{Projected: p, View: view}
+			if err = collectionviews.ValidateEnduroCollectionRetentionDeletionCollection(vres); err != nil {
+				return nil, goahttp.ErrValidationError("collection", "retention", err)
 			}
-			res := NewBulkResultAccepted(&body)
+			res := collection.NewEnduroCollectionRetentionDeletionCollection(vres)
 			return res, nil
-		case http.StatusConflict:
-			var (
-				body BulkNotAvailableResponseBody
-				err  error
-			)
-			err = decoder(resp).Decode(&body)
-			if err != nil {
-				return nil, goahttp.ErrDecodingError("collection", "bulk", err)
-			}
-			err = ValidateBulkNotAvailableResponseBody(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("collection", "retention", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRetentionPostponeRequest instantiates a HTTP request object with method
+// and path set to call the "collection" service "retention_postpone" endpoint
+func (c *Client) BuildRetentionPostponeRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id uint
+	)
+	{
+		p, ok := v.(*collection.RetentionPostponePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("collection", "retention_postpone", "*collection.RetentionPostponePayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RetentionPostponeCollectionPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("collection", "retention_postpone", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRetentionPostponeRequest returns an encoder for requests sent to the
+// collection retention_postpone server.
+func EncodeRetentionPostponeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*collection.RetentionPostponePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("collection", "retention_postpone", "*collection.RetentionPostponePayload", v)
+		}
+		body := NewRetentionPostponeRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("collection", "retention_postpone", err)
+		}
+		return nil
+	}
+}
+
+// DecodeRetentionPostponeResponse returns a decoder for responses returned by
+// the collection retention_postpone endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeRetentionPostponeResponse may return the following errors:
+//   - "not_found" (type *collection.CollectionNotfound): http.StatusNotFound
+//   - error: internal error
+func DecodeRetentionPostponeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("collection", "bulk", err)
+				return nil, err
 			}
-			return nil, NewBulkNotAvailable(&body)
-		case http.StatusBadRequest:
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		case http.StatusNotFound:
 			var (
-				body BulkNotValidResponseBody
+				body RetentionPostponeNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("collection", "bulk", err)
+				return nil, goahttp.ErrDecodingError("collection", "retention_postpone", err)
 			}
-			err = ValidateBulkNotValidResponseBody(&body)
+			err = ValidateRetentionPostponeNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("collection", "bulk", err)
+				return nil, goahttp.ErrValidationError("collection", "retention_postpone", err)
 			}
-			return nil, NewBulkNotValid(&body)
+			return nil, NewRetentionPostponeNotFound(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("collection", "bulk", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("collection", "retention_postpone", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildBulkStatusRequest instantiates a HTTP request object with method and
-// path set to call the "collection" service "bulk_status" endpoint
-func (c *Client) BuildBulkStatusRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: BulkStatusCollectionPath()}
-	req, err := http.NewRequest("GET", u.String(), nil)
+// BuildRetentionCancelRequest instantiates a HTTP request object with method
+// and path set to call the "collection" service "retention_cancel" endpoint
+func (c *Client) BuildRetentionCancelRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id uint
+	)
+	{
+		p, ok := v.(*collection.RetentionCancelPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("collection", "retention_cancel", "*collection.RetentionCancelPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RetentionCancelCollectionPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("collection", "bulk_status", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("collection", "retention_cancel", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -991,10 +1053,13 @@ func (c *Client) BuildBulkStatusRequest(ctx context.Context, v any) (*http.Reque
 	return req, nil
 }
 
-// DecodeBulkStatusResponse returns a decoder for responses returned by the
-// collection bulk_status endpoint. restoreBody controls whether the response
-// body should be restored after having been read.
-func DecodeBulkStatusResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+// DecodeRetentionCancelResponse returns a decoder for responses returned by
+// the collection retention_cancel endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeRetentionCancelResponse may return the following errors:
+//   - "not_found" (type *collection.CollectionNotfound): http.StatusNotFound
+//   - error: internal error
+func DecodeRetentionCancelResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1009,31 +1074,751 @@ func DecodeBulkStatusResponse(decoder func(*http.Response) goahttp.Decoder, rest
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusOK:
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusNotFound:
 			var (
-				body BulkStatusResponseBody
+				body RetentionCancelNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("collection", "bulk_status", err)
+				return nil, goahttp.ErrDecodingError("collection", "retention_cancel", err)
 			}
-			err = ValidateBulkStatusResponseBody(&body)
+			err = ValidateRetentionCancelNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("collection", "bulk_status", err)
+				return nil, goahttp.ErrValidationError("collection", "retention_cancel", err)
 			}
-			res := NewBulkStatusResultOK(&body)
-			return res, nil
+			return nil, NewRetentionCancelNotFound(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("collection", "bulk_status", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("collection", "retention_cancel", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// unmarshalEnduroStoredCollectionResponseBodyToCollectionEnduroStoredCollection
-// builds a value of type *collection.EnduroStoredCollection from a value of
-// type *EnduroStoredCollectionResponseBody.
+// BuildSetLegalHoldRequest instantiates a HTTP request object with method and
+// path set to call the "collection" service "set_legal_hold" endpoint
+func (c *Client) BuildSetLegalHoldRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id uint
+	)
+	{
+		p, ok := v.(*collection.SetLegalHoldPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("collection", "set_legal_hold", "*collection.SetLegalHoldPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SetLegalHoldCollectionPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("collection", "set_legal_hold", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSetLegalHoldRequest returns an encoder for requests sent to the
+// collection set_legal_hold server.
+func EncodeSetLegalHoldRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*collection.SetLegalHoldPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("collection", "set_legal_hold", "*collection.SetLegalHoldPayload", v)
+		}
+		body := NewSetLegalHoldRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("collection", "set_legal_hold", err)
+		}
+		return nil
+	}
+}
+
+// DecodeSetLegalHoldResponse returns a decoder for responses returned by the
+// collection set_legal_hold endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeSetLegalHoldResponse may return the following errors:
+//   - "not_found" (type *collection.CollectionNotfound): http.StatusNotFound
+//   - error: internal error
+func DecodeSetLegalHoldResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		case http.StatusNotFound:
+			var (
+				body SetLegalHoldNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("collection", "set_legal_hold", err)
+			}
+			err = ValidateSetLegalHoldNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("collection", "set_legal_hold", err)
+			}
+			return nil, NewSetLegalHoldNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("collection", "set_legal_hold", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildClearLegalHoldRequest instantiates a HTTP request object with method
+// and path set to call the "collection" service "clear_legal_hold" endpoint
+func (c *Client) BuildClearLegalHoldRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id uint
+	)
+	{
+		p, ok := v.(*collection.ClearLegalHoldPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("collection", "clear_legal_hold", "*collection.ClearLegalHoldPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ClearLegalHoldCollectionPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("collection", "clear_legal_hold", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeClearLegalHoldRequest returns an encoder for requests sent to the
+// collection clear_legal_hold server.
+func EncodeClearLegalHoldRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*collection.ClearLegalHoldPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("collection", "clear_legal_hold", "*collection.ClearLegalHoldPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("reason", p.Reason)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeClearLegalHoldResponse returns a decoder for responses returned by the
+// collection clear_legal_hold endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeClearLegalHoldResponse may return the following errors:
+//   - "not_found" (type *collection.CollectionNotfound): http.StatusNotFound
+//   - error: internal error
+func DecodeClearLegalHoldResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusNotFound:
+			var (
+				body ClearLegalHoldNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("collection", "clear_legal_hold", err)
+			}
+			err = ValidateClearLegalHoldNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("collection", "clear_legal_hold", err)
+			}
+			return nil, NewClearLegalHoldNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("collection", "clear_legal_hold", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRetentionMigrateRequest instantiates a HTTP request object with method
+// and path set to call the "collection" service "retention_migrate" endpoint
+func (c *Client) BuildRetentionMigrateRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RetentionMigrateCollectionPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("collection", "retention_migrate", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeRetentionMigrateResponse returns a decoder for responses returned by
+// the collection retention_migrate endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+func DecodeRetentionMigrateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RetentionMigrateResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("collection", "retention_migrate", err)
+			}
+			err = ValidateRetentionMigrateResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("collection", "retention_migrate", err)
+			}
+			res := NewRetentionMigrateResultOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("collection", "retention_migrate", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDownloadRequest instantiates a HTTP request object with method and path
+// set to call the "collection" service "download" endpoint
+func (c *Client) BuildDownloadRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id uint
+	)
+	{
+		p, ok := v.(*collection.DownloadPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("collection", "download", "*collection.DownloadPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DownloadCollectionPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("collection", "download", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeDownloadResponse returns a decoder for responses returned by the
+// collection download endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeDownloadResponse may return the following errors:
+//   - "not_found" (type *collection.CollectionNotfound): http.StatusNotFound
+//   - error: internal error
+func DecodeDownloadResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				contentType        string
+				contentLength      int64
+				contentDisposition string
+				err                error
+			)
+			contentTypeRaw := resp.Header.Get("Content-Type")
+			if contentTypeRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("content_type", "header"))
+			}
+			contentType = contentTypeRaw
+			{
+				contentLengthRaw := resp.Header.Get("Content-Length")
+				if contentLengthRaw == "" {
+					return nil, goahttp.ErrValidationError("collection", "download", goa.MissingFieldError("content_length", "header"))
+				}
+				v, err2 := strconv.ParseInt(contentLengthRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("content_length", contentLengthRaw, "integer"))
+				}
+				contentLength = v
+			}
+			contentDispositionRaw := resp.Header.Get("Content-Disposition")
+			if contentDispositionRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("content_disposition", "header"))
+			}
+			contentDisposition = contentDispositionRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("collection", "download", err)
+			}
+			res := NewDownloadResultOK(contentType, contentLength, contentDisposition)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body DownloadNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("collection", "download", err)
+			}
+			err = ValidateDownloadNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("collection", "download", err)
+			}
+			return nil, NewDownloadNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("collection", "download", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDecideRequest instantiates a HTTP request object with method and path
+// set to call the "collection" service "decide" endpoint
+func (c *Client) BuildDecideRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id uint
+	)
+	{
+		p, ok := v.(*collection.DecidePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("collection", "decide", "*collection.DecidePayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DecideCollectionPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("collection", "decide", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDecideRequest returns an encoder for requests sent to the collection
+// decide server.
+func EncodeDecideRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*collection.DecidePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("collection", "decide", "*collection.DecidePayload", v)
+		}
+		body := p
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("collection", "decide", err)
+		}
+		return nil
+	}
+}
+
+// DecodeDecideResponse returns a decoder for responses returned by the
+// collection decide endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeDecideResponse may return the following errors:
+//   - "not_found" (type *collection.CollectionNotfound): http.StatusNotFound
+//   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
+//   - error: internal error
+func DecodeDecideResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		case http.StatusNotFound:
+			var (
+				body DecideNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("collection", "decide", err)
+			}
+			err = ValidateDecideNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("collection", "decide", err)
+			}
+			return nil, NewDecideNotFound(&body)
+		case http.StatusBadRequest:
+			var (
+				body DecideNotValidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("collection", "decide", err)
+			}
+			err = ValidateDecideNotValidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("collection", "decide", err)
+			}
+			return nil, NewDecideNotValid(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("collection", "decide", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildBulkRequest instantiates a HTTP request object with method and path set
+// to call the "collection" service "bulk" endpoint
+func (c *Client) BuildBulkRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: BulkCollectionPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("collection", "bulk", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeBulkRequest returns an encoder for requests sent to the collection
+// bulk server.
+func EncodeBulkRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*collection.BulkPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("collection", "bulk", "*collection.BulkPayload", v)
+		}
+		body := NewBulkRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("collection", "bulk", err)
+		}
+		return nil
+	}
+}
+
+// DecodeBulkResponse returns a decoder for responses returned by the
+// collection bulk endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeBulkResponse may return the following errors:
+//   - "not_available" (type *goa.ServiceError): http.StatusConflict
+//   - "not_valid" (type *goa.ServiceError): http.StatusBadRequest
+//   - error: internal error
+func DecodeBulkResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusAccepted:
+			var (
+				body BulkResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("collection", "bulk", err)
+			}
+			res := NewBulkResultAccepted(&body)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body BulkNotAvailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("collection", "bulk", err)
+			}
+			err = ValidateBulkNotAvailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("collection", "bulk", err)
+			}
+			return nil, NewBulkNotAvailable(&body)
+		case http.StatusBadRequest:
+			var (
+				body BulkNotValidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("collection", "bulk", err)
+			}
+			err = ValidateBulkNotValidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("collection", "bulk", err)
+			}
+			return nil, NewBulkNotValid(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("collection", "bulk", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildBulkStatusRequest instantiates a HTTP request object with method and
+// path set to call the "collection" service "bulk_status" endpoint
+func (c *Client) BuildBulkStatusRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: BulkStatusCollectionPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("collection", "bulk_status", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeBulkStatusResponse returns a decoder for responses returned by the
+// collection bulk_status endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeBulkStatusResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body BulkStatusResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("collection", "bulk_status", err)
+			}
+			err = ValidateBulkStatusResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("collection", "bulk_status", err)
+			}
+			res := NewBulkStatusResultOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("collection", "bulk_status", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildBulkRunsRequest instantiates a HTTP request object with method and path
+// set to call the "collection" service "bulk_runs" endpoint
+func (c *Client) BuildBulkRunsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: BulkRunsCollectionPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("collection", "bulk_runs", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeBulkRunsResponse returns a decoder for responses returned by the
+// collection bulk_runs endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeBulkRunsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body []*EnduroBulkRunResponse
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("collection", "bulk_runs", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateEnduroBulkRunResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("collection", "bulk_runs", err)
+			}
+			res := NewBulkRunsEnduroBulkRunOK(body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("collection", "bulk_runs", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildBulkRunRequest instantiates a HTTP request object with method and path
+// set to call the "collection" service "bulk_run" endpoint
+func (c *Client) BuildBulkRunRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id uint
+	)
+	{
+		p, ok := v.(*collection.BulkRunPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("collection", "bulk_run", "*collection.BulkRunPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: BulkRunCollectionPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("collection", "bulk_run", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeBulkRunResponse returns a decoder for responses returned by the
+// collection bulk_run endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeBulkRunResponse may return the following errors:
+//   - "not_found" (type *collection.BulkRunNotFound): http.StatusNotFound
+//   - error: internal error
+func DecodeBulkRunResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body BulkRunResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("collection", "bulk_run", err)
+			}
+			err = ValidateBulkRunResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("collection", "bulk_run", err)
+			}
+			res := NewBulkRunEnduroBulkRunOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body BulkRunNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("collection", "bulk_run", err)
+			}
+			err = ValidateBulkRunNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("collection", "bulk_run", err)
+			}
+			return nil, NewBulkRunNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("collection", "bulk_run", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalEnduroStoredCollectionResponseBodyToCollectionEnduroStoredCollection
+// builds a value of type *collection.EnduroStoredCollection from a value of
+// type *EnduroStoredCollectionResponseBody.
 func unmarshalEnduroStoredCollectionResponseBodyToCollectionEnduroStoredCollection(v *EnduroStoredCollectionResponseBody) *collection.EnduroStoredCollection {
 	if v == nil {
 		return nil
@@ -1056,6 +1841,159 @@ func unmarshalEnduroStoredCollectionResponseBodyToCollectionEnduroStoredCollecti
 	return res
 }
 
+// unmarshalEnduroCollectionProgressResponseBodyToCollectionEnduroCollectionProgress
+// builds a value of type *collection.EnduroCollectionProgress from a value of
+// type *EnduroCollectionProgressResponseBody.
+func unmarshalEnduroCollectionProgressResponseBodyToCollectionEnduroCollectionProgress(v *EnduroCollectionProgressResponseBody) *collection.EnduroCollectionProgress {
+	if v == nil {
+		return nil
+	}
+	res := &collection.EnduroCollectionProgress{
+		Stage:         *v.Stage,
+		Microservice:  v.Microservice,
+		Job:           v.Job,
+		JobsCompleted: *v.JobsCompleted,
+		JobsTotal:     *v.JobsTotal,
+		Percent:       *v.Percent,
+		UpdatedAt:     *v.UpdatedAt,
+	}
+	if v.FailedJobs != nil {
+		res.FailedJobs = make([]*collection.EnduroCollectionFailedJob, len(v.FailedJobs))
+		for i, val := range v.FailedJobs {
+			if val == nil {
+				res.FailedJobs[i] = nil
+				continue
+			}
+			res.FailedJobs[i] = unmarshalEnduroCollectionFailedJobResponseBodyToCollectionEnduroCollectionFailedJob(val)
+		}
+	}
+
+	return res
+}
+
+// unmarshalEnduroCollectionFailedJobResponseBodyToCollectionEnduroCollectionFailedJob
+// builds a value of type *collection.EnduroCollectionFailedJob from a value of
+// type *EnduroCollectionFailedJobResponseBody.
+func unmarshalEnduroCollectionFailedJobResponseBodyToCollectionEnduroCollectionFailedJob(v *EnduroCollectionFailedJobResponseBody) *collection.EnduroCollectionFailedJob {
+	if v == nil {
+		return nil
+	}
+	res := &collection.EnduroCollectionFailedJob{
+		ID:           *v.ID,
+		Name:         *v.Name,
+		Microservice: *v.Microservice,
+	}
+
+	return res
+}
+
+// unmarshalEnduroCollectionPendingDecisionResponseBodyToCollectionviewsEnduroCollectionPendingDecisionView
+// builds a value of type *collectionviews.EnduroCollectionPendingDecisionView
+// from a value of type *EnduroCollectionPendingDecisionResponseBody.
+func unmarshalEnduroCollectionPendingDecisionResponseBodyToCollectionviewsEnduroCollectionPendingDecisionView(v *EnduroCollectionPendingDecisionResponseBody) *collectionviews.EnduroCollectionPendingDecisionView {
+	if v == nil {
+		return nil
+	}
+	res := &collectionviews.EnduroCollectionPendingDecisionView{
+		Activity: v.Activity,
+		Error:    v.Error,
+	}
+	res.Options = make([]string, len(v.Options))
+	for i, val := range v.Options {
+		res.Options[i] = val
+	}
+
+	return res
+}
+
+// unmarshalEnduroCollectionValidationResultResponseBodyToCollectionviewsEnduroCollectionValidationResultView
+// builds a value of type *collectionviews.EnduroCollectionValidationResultView
+// from a value of type *EnduroCollectionValidationResultResponseBody.
+func unmarshalEnduroCollectionValidationResultResponseBodyToCollectionviewsEnduroCollectionValidationResultView(v *EnduroCollectionValidationResultResponseBody) *collectionviews.EnduroCollectionValidationResultView {
+	if v == nil {
+		return nil
+	}
+	res := &collectionviews.EnduroCollectionValidationResultView{
+		Validator: v.Validator,
+		Severity:  v.Severity,
+		Status:    v.Status,
+		Message:   v.Message,
+		CreatedAt: v.CreatedAt,
+	}
+
+	return res
+}
+
+// unmarshalEnduroCollectionProgressResponseBodyToCollectionviewsEnduroCollectionProgressView
+// builds a value of type *collectionviews.EnduroCollectionProgressView from a
+// value of type *EnduroCollectionProgressResponseBody.
+func unmarshalEnduroCollectionProgressResponseBodyToCollectionviewsEnduroCollectionProgressView(v *EnduroCollectionProgressResponseBody) *collectionviews.EnduroCollectionProgressView {
+	if v == nil {
+		return nil
+	}
+	res := &collectionviews.EnduroCollectionProgressView{
+		Stage:         v.Stage,
+		Microservice:  v.Microservice,
+		Job:           v.Job,
+		JobsCompleted: v.JobsCompleted,
+		JobsTotal:     v.JobsTotal,
+		Percent:       v.Percent,
+		UpdatedAt:     v.UpdatedAt,
+	}
+	if v.FailedJobs != nil {
+		res.FailedJobs = make([]*collectionviews.EnduroCollectionFailedJobView, len(v.FailedJobs))
+		for i, val := range v.FailedJobs {
+			if val == nil {
+				res.FailedJobs[i] = nil
+				continue
+			}
+			res.FailedJobs[i] = unmarshalEnduroCollectionFailedJobResponseBodyToCollectionviewsEnduroCollectionFailedJobView(val)
+		}
+	}
+
+	return res
+}
+
+// unmarshalEnduroCollectionFailedJobResponseBodyToCollectionviewsEnduroCollectionFailedJobView
+// builds a value of type *collectionviews.EnduroCollectionFailedJobView from a
+// value of type *EnduroCollectionFailedJobResponseBody.
+func unmarshalEnduroCollectionFailedJobResponseBodyToCollectionviewsEnduroCollectionFailedJobView(v *EnduroCollectionFailedJobResponseBody) *collectionviews.EnduroCollectionFailedJobView {
+	if v == nil {
+		return nil
+	}
+	res := &collectionviews.EnduroCollectionFailedJobView{
+		ID:           v.ID,
+		Name:         v.Name,
+		Microservice: v.Microservice,
+	}
+
+	return res
+}
+
+// unmarshalEnduroCollectionFailureResponseBodyToCollectionviewsEnduroCollectionFailureView
+// builds a value of type *collectionviews.EnduroCollectionFailureView from a
+// value of type *EnduroCollectionFailureResponseBody.
+func unmarshalEnduroCollectionFailureResponseBodyToCollectionviewsEnduroCollectionFailureView(v *EnduroCollectionFailureResponseBody) *collectionviews.EnduroCollectionFailureView {
+	if v == nil {
+		return nil
+	}
+	res := &collectionviews.EnduroCollectionFailureView{
+		Category:     v.Category,
+		Stage:        v.Stage,
+		UnitID:       v.UnitID,
+		Microservice: v.Microservice,
+		Job:          v.Job,
+		Stderr:       v.Stderr,
+		OccurredAt:   v.OccurredAt,
+	}
+	res.Errors = make([]string, len(v.Errors))
+	for i, val := range v.Errors {
+		res.Errors[i] = val
+	}
+
+	return res
+}
+
 // unmarshalEnduroCollectionWorkflowHistoryResponseBodyToCollectionviewsEnduroCollectionWorkflowHistoryView
 // builds a value of type *collectionviews.EnduroCollectionWorkflowHistoryView
 // from a value of type *EnduroCollectionWorkflowHistoryResponseBody.
@@ -1085,6 +2023,121 @@ func unmarshalEnduroCollectionStatusTransitionResponseBodyToCollectionviewsEndur
 		OccurredAt:     v.OccurredAt,
 		IsRunStart:     v.IsRunStart,
 		Reason:         v.Reason,
+		Actor:          v.Actor,
+		Detail:         v.Detail,
+	}
+
+	return res
+}
+
+// unmarshalEnduroCollectionNotificationDeliveryResponseToCollectionviewsEnduroCollectionNotificationDeliveryView
+// builds a value of type
+// *collectionviews.EnduroCollectionNotificationDeliveryView from a value of
+// type *EnduroCollectionNotificationDeliveryResponse.
+func unmarshalEnduroCollectionNotificationDeliveryResponseToCollectionviewsEnduroCollectionNotificationDeliveryView(v *EnduroCollectionNotificationDeliveryResponse) *collectionviews.EnduroCollectionNotificationDeliveryView {
+	res := &collectionviews.EnduroCollectionNotificationDeliveryView{
+		ID:           v.ID,
+		Webhook:      v.Webhook,
+		Event:        v.Event,
+		Status:       v.Status,
+		Attempts:     v.Attempts,
+		ResponseCode: v.ResponseCode,
+		Error:        v.Error,
+		CreatedAt:    v.CreatedAt,
+		UpdatedAt:    v.UpdatedAt,
+	}
+
+	return res
+}
+
+// unmarshalEnduroCollectionRescanObjectResponseToCollectionviewsEnduroCollectionRescanObjectView
+// builds a value of type *collectionviews.EnduroCollectionRescanObjectView
+// from a value of type *EnduroCollectionRescanObjectResponse.
+func unmarshalEnduroCollectionRescanObjectResponseToCollectionviewsEnduroCollectionRescanObjectView(v *EnduroCollectionRescanObjectResponse) *collectionviews.EnduroCollectionRescanObjectView {
+	res := &collectionviews.EnduroCollectionRescanObjectView{
+		Watcher: v.Watcher,
+		Key:     v.Key,
+		Bucket:  v.Bucket,
+		IsDir:   v.IsDir,
+	}
+
+	return res
+}
+
+// unmarshalEnduroCollectionRetentionDeletionResponseToCollectionviewsEnduroCollectionRetentionDeletionView
+// builds a value of type
+// *collectionviews.EnduroCollectionRetentionDeletionView from a value of type
+// *EnduroCollectionRetentionDeletionResponse.
+func unmarshalEnduroCollectionRetentionDeletionResponseToCollectionviewsEnduroCollectionRetentionDeletionView(v *EnduroCollectionRetentionDeletionResponse) *collectionviews.EnduroCollectionRetentionDeletionView {
+	res := &collectionviews.EnduroCollectionRetentionDeletionView{
+		CollectionID: v.CollectionID,
+		Watcher:      v.Watcher,
+		Key:          v.Key,
+		Status:       v.Status,
+		DueAt:        v.DueAt,
+		Attempts:     v.Attempts,
+		Error:        v.Error,
+		CreatedAt:    v.CreatedAt,
+		UpdatedAt:    v.UpdatedAt,
+	}
+
+	return res
+}
+
+// unmarshalBulkOutcomeResponseBodyToCollectionBulkOutcome builds a value of
+// type *collection.BulkOutcome from a value of type *BulkOutcomeResponseBody.
+func unmarshalBulkOutcomeResponseBodyToCollectionBulkOutcome(v *BulkOutcomeResponseBody) *collection.BulkOutcome {
+	if v == nil {
+		return nil
+	}
+	res := &collection.BulkOutcome{
+		ID:      *v.ID,
+		Outcome: *v.Outcome,
+		Error:   v.Error,
+	}
+
+	return res
+}
+
+// unmarshalEnduroBulkRunResponseToCollectionEnduroBulkRun builds a value of
+// type *collection.EnduroBulkRun from a value of type *EnduroBulkRunResponse.
+func unmarshalEnduroBulkRunResponseToCollectionEnduroBulkRun(v *EnduroBulkRunResponse) *collection.EnduroBulkRun {
+	res := &collection.EnduroBulkRun{
+		ID:          *v.ID,
+		WorkflowID:  *v.WorkflowID,
+		RunID:       *v.RunID,
+		Operation:   *v.Operation,
+		Status:      *v.Status,
+		Option:      v.Option,
+		State:       *v.State,
+		Error:       v.Error,
+		CreatedAt:   *v.CreatedAt,
+		CompletedAt: v.CompletedAt,
+	}
+	if v.Outcomes != nil {
+		res.Outcomes = make([]*collection.BulkOutcome, len(v.Outcomes))
+		for i, val := range v.Outcomes {
+			if val == nil {
+				res.Outcomes[i] = nil
+				continue
+			}
+			res.Outcomes[i] = unmarshalBulkOutcomeResponseToCollectionBulkOutcome(val)
+		}
+	}
+
+	return res
+}
+
+// unmarshalBulkOutcomeResponseToCollectionBulkOutcome builds a value of type
+// *collection.BulkOutcome from a value of type *BulkOutcomeResponse.
+func unmarshalBulkOutcomeResponseToCollectionBulkOutcome(v *BulkOutcomeResponse) *collection.BulkOutcome {
+	if v == nil {
+		return nil
+	}
+	res := &collection.BulkOutcome{
+		ID:      *v.ID,
+		Outcome: *v.Outcome,
+		Error:   v.Error,
 	}
 
 	return res
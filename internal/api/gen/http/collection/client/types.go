@@ -14,12 +14,45 @@ import (
 	goa "goa.design/goa/v3/pkg"
 )
 
+// RetentionPostponeRequestBody is the type of the "collection" service
+// "retention_postpone" endpoint HTTP request body.
+type RetentionPostponeRequestBody struct {
+	// New due datetime
+	DueAt string `form:"due_at" json:"due_at" xml:"due_at"`
+}
+
+// SetLegalHoldRequestBody is the type of the "collection" service
+// "set_legal_hold" endpoint HTTP request body.
+type SetLegalHoldRequestBody struct {
+	// Reason of the legal hold
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+}
+
 // BulkRequestBody is the type of the "collection" service "bulk" endpoint HTTP
 // request body.
 type BulkRequestBody struct {
 	Operation string `form:"operation" json:"operation" xml:"operation"`
 	Status    string `form:"status" json:"status" xml:"status"`
 	Size      uint   `form:"size" json:"size" xml:"size"`
+	// Decision option of the decide operation
+	Option *string `form:"option,omitempty" json:"option,omitempty" xml:"option,omitempty"`
+	// Prefix of the name of the collections
+	Name       *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	OriginalID *string `form:"original_id,omitempty" json:"original_id,omitempty" xml:"original_id,omitempty"`
+	// Identifier of Archivematica tranfser
+	TransferID *string `form:"transfer_id,omitempty" json:"transfer_id,omitempty" xml:"transfer_id,omitempty"`
+	// Identifier of Archivematica AIP
+	AipID *string `form:"aip_id,omitempty" json:"aip_id,omitempty" xml:"aip_id,omitempty"`
+	// Identifier of Archivematica pipeline
+	PipelineID          *string `form:"pipeline_id,omitempty" json:"pipeline_id,omitempty" xml:"pipeline_id,omitempty"`
+	EarliestCreatedTime *string `form:"earliest_created_time,omitempty" json:"earliest_created_time,omitempty" xml:"earliest_created_time,omitempty"`
+	LatestCreatedTime   *string `form:"latest_created_time,omitempty" json:"latest_created_time,omitempty" xml:"latest_created_time,omitempty"`
+	// Activity that failed in pending collections
+	DecisionActivity *string `form:"decision_activity,omitempty" json:"decision_activity,omitempty" xml:"decision_activity,omitempty"`
+	// Identifiers of the collections, the filters are ignored when given
+	Ids []uint `form:"ids,omitempty" json:"ids,omitempty" xml:"ids,omitempty"`
+	// Count the affected collections without running the operation
+	DryRun bool `form:"dry_run" json:"dry_run" xml:"dry_run"`
 }
 
 // MonitorResponseBody is the type of the "collection" service "monitor"
@@ -32,6 +65,8 @@ type MonitorResponseBody struct {
 	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
 	// Collection
 	Item *EnduroStoredCollectionResponseBody `form:"item,omitempty" json:"item,omitempty" xml:"item,omitempty"`
+	// Progress of the collection, in collection:progress events
+	Progress *EnduroCollectionProgressResponseBody `form:"progress,omitempty" json:"progress,omitempty" xml:"progress,omitempty"`
 }
 
 // ListResponseBody is the type of the "collection" service "list" endpoint
@@ -76,6 +111,24 @@ type ShowResponseBody struct {
 	ReconciliationCheckedAt *string `form:"reconciliation_checked_at,omitempty" json:"reconciliation_checked_at,omitempty" xml:"reconciliation_checked_at,omitempty"`
 	// Last storage reconciliation error
 	ReconciliationError *string `form:"reconciliation_error,omitempty" json:"reconciliation_error,omitempty" xml:"reconciliation_error,omitempty"`
+	// Whether the collection is under legal hold
+	LegalHold *bool `form:"legal_hold,omitempty" json:"legal_hold,omitempty" xml:"legal_hold,omitempty"`
+	// Reason of the legal hold
+	LegalHoldReason *string `form:"legal_hold_reason,omitempty" json:"legal_hold_reason,omitempty" xml:"legal_hold_reason,omitempty"`
+	// Person or system that placed the legal hold
+	LegalHoldActor *string `form:"legal_hold_actor,omitempty" json:"legal_hold_actor,omitempty" xml:"legal_hold_actor,omitempty"`
+	// Datetime when the legal hold was placed
+	LegalHoldAt *string `form:"legal_hold_at,omitempty" json:"legal_hold_at,omitempty" xml:"legal_hold_at,omitempty"`
+	// Failure awaiting an operator decision
+	Decision *EnduroCollectionPendingDecisionResponseBody `form:"decision,omitempty" json:"decision,omitempty" xml:"decision,omitempty"`
+	// Results of the transfer validators
+	Validation EnduroCollectionValidationResultResponseBodyCollection `form:"validation,omitempty" json:"validation,omitempty" xml:"validation,omitempty"`
+	// Explanation of the pipeline chosen by the scheduler
+	PipelineSelection *string `form:"pipeline_selection,omitempty" json:"pipeline_selection,omitempty" xml:"pipeline_selection,omitempty"`
+	// Latest progress reported by Archivematica
+	Progress *EnduroCollectionProgressResponseBody `form:"progress,omitempty" json:"progress,omitempty" xml:"progress,omitempty"`
+	// Reason why Archivematica could not process the collection
+	Failure *EnduroCollectionFailureResponseBody `form:"failure,omitempty" json:"failure,omitempty" xml:"failure,omitempty"`
 }
 
 // RetryResponseBody is the type of the "collection" service "retry" endpoint
@@ -100,11 +153,34 @@ type StatusHistoryResponseBody struct {
 	Transitions  EnduroCollectionStatusTransitionResponseBodyCollection `form:"transitions,omitempty" json:"transitions,omitempty" xml:"transitions,omitempty"`
 }
 
+// EnduroCollectionNotificationDeliveryResponseCollection is the type of the
+// "collection" service "notifications" endpoint HTTP response body.
+type EnduroCollectionNotificationDeliveryResponseCollection []*EnduroCollectionNotificationDeliveryResponse
+
+// EnduroCollectionRescanObjectResponseCollection is the type of the
+// "collection" service "rescan" endpoint HTTP response body.
+type EnduroCollectionRescanObjectResponseCollection []*EnduroCollectionRescanObjectResponse
+
+// EnduroCollectionRetentionDeletionResponseCollection is the type of the
+// "collection" service "retention" endpoint HTTP response body.
+type EnduroCollectionRetentionDeletionResponseCollection []*EnduroCollectionRetentionDeletionResponse
+
+// RetentionMigrateResponseBody is the type of the "collection" service
+// "retention_migrate" endpoint HTTP response body.
+type RetentionMigrateResponseBody struct {
+	// Number of running processing workflows signaled
+	Signaled *uint `form:"signaled,omitempty" json:"signaled,omitempty" xml:"signaled,omitempty"`
+}
+
 // BulkResponseBody is the type of the "collection" service "bulk" endpoint
 // HTTP response body.
 type BulkResponseBody struct {
+	// Identifier of the bulk operation
+	ID         *uint   `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
 	WorkflowID *string `form:"workflow_id,omitempty" json:"workflow_id,omitempty" xml:"workflow_id,omitempty"`
 	RunID      *string `form:"run_id,omitempty" json:"run_id,omitempty" xml:"run_id,omitempty"`
+	// Number of collections affected by the operation (dry run)
+	Count *uint `form:"count,omitempty" json:"count,omitempty" xml:"count,omitempty"`
 }
 
 // BulkStatusResponseBody is the type of the "collection" service "bulk_status"
@@ -116,6 +192,30 @@ type BulkStatusResponseBody struct {
 	Status     *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
 	WorkflowID *string `form:"workflow_id,omitempty" json:"workflow_id,omitempty" xml:"workflow_id,omitempty"`
 	RunID      *string `form:"run_id,omitempty" json:"run_id,omitempty" xml:"run_id,omitempty"`
+	// Outcome of the operation on every collection
+	Outcomes []*BulkOutcomeResponseBody `form:"outcomes,omitempty" json:"outcomes,omitempty" xml:"outcomes,omitempty"`
+}
+
+// BulkRunResponseBody is the type of the "collection" service "bulk_run"
+// endpoint HTTP response body.
+type BulkRunResponseBody struct {
+	// Identifier of the bulk operation
+	ID         *uint   `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	WorkflowID *string `form:"workflow_id,omitempty" json:"workflow_id,omitempty" xml:"workflow_id,omitempty"`
+	RunID      *string `form:"run_id,omitempty" json:"run_id,omitempty" xml:"run_id,omitempty"`
+	Operation  *string `form:"operation,omitempty" json:"operation,omitempty" xml:"operation,omitempty"`
+	// Status of the affected collections
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Decision option of the decide operation
+	Option *string `form:"option,omitempty" json:"option,omitempty" xml:"option,omitempty"`
+	State  *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// Error that stopped the operation
+	Error       *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	CreatedAt   *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	CompletedAt *string `form:"completed_at,omitempty" json:"completed_at,omitempty" xml:"completed_at,omitempty"`
+	// Outcome of the operation on every collection, only included when showing a
+	// single operation
+	Outcomes []*BulkOutcomeResponseBody `form:"outcomes,omitempty" json:"outcomes,omitempty" xml:"outcomes,omitempty"`
 }
 
 // ShowNotFoundResponseBody is the type of the "collection" service "show"
@@ -136,6 +236,24 @@ type DeleteNotFoundResponseBody struct {
 	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
 }
 
+// DeleteLegalHoldResponseBody is the type of the "collection" service "delete"
+// endpoint HTTP response body for the "legal_hold" error.
+type DeleteLegalHoldResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // CancelNotFoundResponseBody is the type of the "collection" service "cancel"
 // endpoint HTTP response body for the "not_found" error.
 type CancelNotFoundResponseBody struct {
@@ -208,6 +326,70 @@ type StatusHistoryNotFoundResponseBody struct {
 	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
 }
 
+// NotificationsNotFoundResponseBody is the type of the "collection" service
+// "notifications" endpoint HTTP response body for the "not_found" error.
+type NotificationsNotFoundResponseBody struct {
+	// Message of error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Identifier of missing collection
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
+// RescanNotValidResponseBody is the type of the "collection" service "rescan"
+// endpoint HTTP response body for the "not_valid" error.
+type RescanNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RetentionPostponeNotFoundResponseBody is the type of the "collection"
+// service "retention_postpone" endpoint HTTP response body for the "not_found"
+// error.
+type RetentionPostponeNotFoundResponseBody struct {
+	// Message of error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Identifier of missing collection
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
+// RetentionCancelNotFoundResponseBody is the type of the "collection" service
+// "retention_cancel" endpoint HTTP response body for the "not_found" error.
+type RetentionCancelNotFoundResponseBody struct {
+	// Message of error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Identifier of missing collection
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
+// SetLegalHoldNotFoundResponseBody is the type of the "collection" service
+// "set_legal_hold" endpoint HTTP response body for the "not_found" error.
+type SetLegalHoldNotFoundResponseBody struct {
+	// Message of error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Identifier of missing collection
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
+// ClearLegalHoldNotFoundResponseBody is the type of the "collection" service
+// "clear_legal_hold" endpoint HTTP response body for the "not_found" error.
+type ClearLegalHoldNotFoundResponseBody struct {
+	// Message of error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Identifier of missing collection
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
 // DownloadNotFoundResponseBody is the type of the "collection" service
 // "download" endpoint HTTP response body for the "not_found" error.
 type DownloadNotFoundResponseBody struct {
@@ -280,6 +462,15 @@ type BulkNotValidResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// BulkRunNotFoundResponseBody is the type of the "collection" service
+// "bulk_run" endpoint HTTP response body for the "not_found" error.
+type BulkRunNotFoundResponseBody struct {
+	// Message of error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Identifier of missing bulk operation
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
 // EnduroStoredCollectionResponseBody is used to define fields on response body
 // types.
 type EnduroStoredCollectionResponseBody struct {
@@ -309,10 +500,93 @@ type EnduroStoredCollectionResponseBody struct {
 	CompletedAt *string `form:"completed_at,omitempty" json:"completed_at,omitempty" xml:"completed_at,omitempty"`
 }
 
+// EnduroCollectionProgressResponseBody is used to define fields on response
+// body types.
+type EnduroCollectionProgressResponseBody struct {
+	// Processing stage
+	Stage *string `form:"stage,omitempty" json:"stage,omitempty" xml:"stage,omitempty"`
+	// Microservice of the current job
+	Microservice *string `form:"microservice,omitempty" json:"microservice,omitempty" xml:"microservice,omitempty"`
+	// Name of the current job
+	Job *string `form:"job,omitempty" json:"job,omitempty" xml:"job,omitempty"`
+	// Number of jobs completed
+	JobsCompleted *uint `form:"jobs_completed,omitempty" json:"jobs_completed,omitempty" xml:"jobs_completed,omitempty"`
+	// Number of jobs run so far
+	JobsTotal *uint `form:"jobs_total,omitempty" json:"jobs_total,omitempty" xml:"jobs_total,omitempty"`
+	// Percentage of the jobs run so far that completed
+	Percent *uint `form:"percent,omitempty" json:"percent,omitempty" xml:"percent,omitempty"`
+	// Jobs that failed
+	FailedJobs []*EnduroCollectionFailedJobResponseBody `form:"failed_jobs,omitempty" json:"failed_jobs,omitempty" xml:"failed_jobs,omitempty"`
+	// Datetime of the snapshot
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// EnduroCollectionFailedJobResponseBody is used to define fields on response
+// body types.
+type EnduroCollectionFailedJobResponseBody struct {
+	// Identifier of the job
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the job
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Microservice of the job
+	Microservice *string `form:"microservice,omitempty" json:"microservice,omitempty" xml:"microservice,omitempty"`
+}
+
 // EnduroStoredCollectionCollectionResponseBody is used to define fields on
 // response body types.
 type EnduroStoredCollectionCollectionResponseBody []*EnduroStoredCollectionResponseBody
 
+// EnduroCollectionPendingDecisionResponseBody is used to define fields on
+// response body types.
+type EnduroCollectionPendingDecisionResponseBody struct {
+	// Name of the failed activity
+	Activity *string `form:"activity,omitempty" json:"activity,omitempty" xml:"activity,omitempty"`
+	// Error returned by the failed activity
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Decision options accepted by the workflow
+	Options []string `form:"options,omitempty" json:"options,omitempty" xml:"options,omitempty"`
+}
+
+// EnduroCollectionValidationResultResponseBodyCollection is used to define
+// fields on response body types.
+type EnduroCollectionValidationResultResponseBodyCollection []*EnduroCollectionValidationResultResponseBody
+
+// EnduroCollectionValidationResultResponseBody is used to define fields on
+// response body types.
+type EnduroCollectionValidationResultResponseBody struct {
+	// Name of the validator
+	Validator *string `form:"validator,omitempty" json:"validator,omitempty" xml:"validator,omitempty"`
+	// Severity of the validator
+	Severity *string `form:"severity,omitempty" json:"severity,omitempty" xml:"severity,omitempty"`
+	// Outcome of the validator
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Description of the problems found
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Validation datetime
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// EnduroCollectionFailureResponseBody is used to define fields on response
+// body types.
+type EnduroCollectionFailureResponseBody struct {
+	// Category of the failure
+	Category *string `form:"category,omitempty" json:"category,omitempty" xml:"category,omitempty"`
+	// Processing stage
+	Stage *string `form:"stage,omitempty" json:"stage,omitempty" xml:"stage,omitempty"`
+	// Identifier of the Archivematica transfer or SIP
+	UnitID *string `form:"unit_id,omitempty" json:"unit_id,omitempty" xml:"unit_id,omitempty"`
+	// Microservice of the failed job
+	Microservice *string `form:"microservice,omitempty" json:"microservice,omitempty" xml:"microservice,omitempty"`
+	// Name of the failed job
+	Job *string `form:"job,omitempty" json:"job,omitempty" xml:"job,omitempty"`
+	// Excerpt of the standard error of the failed job
+	Stderr *string `form:"stderr,omitempty" json:"stderr,omitempty" xml:"stderr,omitempty"`
+	// Chain of errors seen by Enduro, outermost first
+	Errors []string `form:"errors,omitempty" json:"errors,omitempty" xml:"errors,omitempty"`
+	// Datetime of the failure
+	OccurredAt *string `form:"occurred_at,omitempty" json:"occurred_at,omitempty" xml:"occurred_at,omitempty"`
+}
+
 // EnduroCollectionWorkflowHistoryResponseBodyCollection is used to define
 // fields on response body types.
 type EnduroCollectionWorkflowHistoryResponseBodyCollection []*EnduroCollectionWorkflowHistoryResponseBody
@@ -349,17 +623,149 @@ type EnduroCollectionStatusTransitionResponseBody struct {
 	OccurredAt *string `form:"occurred_at,omitempty" json:"occurred_at,omitempty" xml:"occurred_at,omitempty"`
 	// Whether the transition starts a fully recorded workflow run
 	IsRunStart *bool `form:"is_run_start,omitempty" json:"is_run_start,omitempty" xml:"is_run_start,omitempty"`
-	// Machine-readable reason for the transition
+	// Machine-readable reason for the transition, e.g. workflow_retried or
+	// deadline_exceeded
 	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Person or system that caused the transition: system, api or api:<user>,
+	// bulk:<run> or watcher:<name>
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty" xml:"actor,omitempty"`
+	// Free-form detail provided with the transition
+	Detail *string `form:"detail,omitempty" json:"detail,omitempty" xml:"detail,omitempty"`
+}
+
+// EnduroCollectionNotificationDeliveryResponse is used to define fields on
+// response body types.
+type EnduroCollectionNotificationDeliveryResponse struct {
+	// Identifier of the delivery
+	ID *uint64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the webhook
+	Webhook *string `form:"webhook,omitempty" json:"webhook,omitempty" xml:"webhook,omitempty"`
+	// Name of the event
+	Event *string `form:"event,omitempty" json:"event,omitempty" xml:"event,omitempty"`
+	// Status of the delivery
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Number of delivery attempts
+	Attempts *int `form:"attempts,omitempty" json:"attempts,omitempty" xml:"attempts,omitempty"`
+	// HTTP status code of the last response
+	ResponseCode *int `form:"response_code,omitempty" json:"response_code,omitempty" xml:"response_code,omitempty"`
+	// Error of the last attempt
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Creation datetime
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Datetime of the last update
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// EnduroCollectionRescanObjectResponse is used to define fields on response
+// body types.
+type EnduroCollectionRescanObjectResponse struct {
+	// Name of the watcher
+	Watcher *string `form:"watcher,omitempty" json:"watcher,omitempty" xml:"watcher,omitempty"`
+	// Key of the blob
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Name of the bucket, when known
+	Bucket *string `form:"bucket,omitempty" json:"bucket,omitempty" xml:"bucket,omitempty"`
+	// Whether the blob is a directory
+	IsDir *bool `form:"is_dir,omitempty" json:"is_dir,omitempty" xml:"is_dir,omitempty"`
+}
+
+// EnduroCollectionRetentionDeletionResponse is used to define fields on
+// response body types.
+type EnduroCollectionRetentionDeletionResponse struct {
+	// Identifier of the collection
+	CollectionID *uint `form:"collection_id,omitempty" json:"collection_id,omitempty" xml:"collection_id,omitempty"`
+	// Name of the watcher
+	Watcher *string `form:"watcher,omitempty" json:"watcher,omitempty" xml:"watcher,omitempty"`
+	// Key of the original
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Status of the deletion
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Due datetime
+	DueAt *string `form:"due_at,omitempty" json:"due_at,omitempty" xml:"due_at,omitempty"`
+	// Number of deletion attempts
+	Attempts *int `form:"attempts,omitempty" json:"attempts,omitempty" xml:"attempts,omitempty"`
+	// Error of the last attempt
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Creation datetime
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Datetime of the last update
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// BulkOutcomeResponseBody is used to define fields on response body types.
+type BulkOutcomeResponseBody struct {
+	// Identifier of the collection
+	ID      *uint   `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Outcome *string `form:"outcome,omitempty" json:"outcome,omitempty" xml:"outcome,omitempty"`
+	// Why the operation failed or skipped the collection
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// EnduroBulkRunResponse is used to define fields on response body types.
+type EnduroBulkRunResponse struct {
+	// Identifier of the bulk operation
+	ID         *uint   `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	WorkflowID *string `form:"workflow_id,omitempty" json:"workflow_id,omitempty" xml:"workflow_id,omitempty"`
+	RunID      *string `form:"run_id,omitempty" json:"run_id,omitempty" xml:"run_id,omitempty"`
+	Operation  *string `form:"operation,omitempty" json:"operation,omitempty" xml:"operation,omitempty"`
+	// Status of the affected collections
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Decision option of the decide operation
+	Option *string `form:"option,omitempty" json:"option,omitempty" xml:"option,omitempty"`
+	State  *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// Error that stopped the operation
+	Error       *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	CreatedAt   *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	CompletedAt *string `form:"completed_at,omitempty" json:"completed_at,omitempty" xml:"completed_at,omitempty"`
+	// Outcome of the operation on every collection, only included when showing a
+	// single operation
+	Outcomes []*BulkOutcomeResponse `form:"outcomes,omitempty" json:"outcomes,omitempty" xml:"outcomes,omitempty"`
+}
+
+// BulkOutcomeResponse is used to define fields on response body types.
+type BulkOutcomeResponse struct {
+	// Identifier of the collection
+	ID      *uint   `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Outcome *string `form:"outcome,omitempty" json:"outcome,omitempty" xml:"outcome,omitempty"`
+	// Why the operation failed or skipped the collection
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// NewRetentionPostponeRequestBody builds the HTTP request body from the
+// payload of the "retention_postpone" endpoint of the "collection" service.
+func NewRetentionPostponeRequestBody(p *collection.RetentionPostponePayload) *RetentionPostponeRequestBody {
+	body := &RetentionPostponeRequestBody{
+		DueAt: p.DueAt,
+	}
+	return body
+}
+
+// NewSetLegalHoldRequestBody builds the HTTP request body from the payload of
+// the "set_legal_hold" endpoint of the "collection" service.
+func NewSetLegalHoldRequestBody(p *collection.SetLegalHoldPayload) *SetLegalHoldRequestBody {
+	body := &SetLegalHoldRequestBody{
+		Reason: p.Reason,
+	}
+	return body
 }
 
 // NewBulkRequestBody builds the HTTP request body from the payload of the
 // "bulk" endpoint of the "collection" service.
 func NewBulkRequestBody(p *collection.BulkPayload) *BulkRequestBody {
 	body := &BulkRequestBody{
-		Operation: p.Operation,
-		Status:    p.Status,
-		Size:      p.Size,
+		Operation:           p.Operation,
+		Status:              p.Status,
+		Size:                p.Size,
+		Option:              p.Option,
+		Name:                p.Name,
+		OriginalID:          p.OriginalID,
+		TransferID:          p.TransferID,
+		AipID:               p.AipID,
+		PipelineID:          p.PipelineID,
+		EarliestCreatedTime: p.EarliestCreatedTime,
+		LatestCreatedTime:   p.LatestCreatedTime,
+		DecisionActivity:    p.DecisionActivity,
+		DryRun:              p.DryRun,
 	}
 	{
 		var zero uint
@@ -367,6 +773,18 @@ func NewBulkRequestBody(p *collection.BulkPayload) *BulkRequestBody {
 			body.Size = 100
 		}
 	}
+	if p.Ids != nil {
+		body.Ids = make([]uint, len(p.Ids))
+		for i, val := range p.Ids {
+			body.Ids[i] = val
+		}
+	}
+	{
+		var zero bool
+		if body.DryRun == zero {
+			body.DryRun = false
+		}
+	}
 	return body
 }
 
@@ -381,6 +799,9 @@ func NewMonitorEnduroMonitorUpdateOK(body *MonitorResponseBody) *collection.Endu
 	if body.Item != nil {
 		v.Item = unmarshalEnduroStoredCollectionResponseBodyToCollectionEnduroStoredCollection(body.Item)
 	}
+	if body.Progress != nil {
+		v.Progress = unmarshalEnduroCollectionProgressResponseBodyToCollectionEnduroCollectionProgress(body.Progress)
+	}
 
 	return v
 }
@@ -423,6 +844,30 @@ func NewShowEnduroDetailedStoredCollectionOK(body *ShowResponseBody) *collection
 		ReconciliationStatus:    body.ReconciliationStatus,
 		ReconciliationCheckedAt: body.ReconciliationCheckedAt,
 		ReconciliationError:     body.ReconciliationError,
+		LegalHold:               body.LegalHold,
+		LegalHoldReason:         body.LegalHoldReason,
+		LegalHoldActor:          body.LegalHoldActor,
+		LegalHoldAt:             body.LegalHoldAt,
+		PipelineSelection:       body.PipelineSelection,
+	}
+	if body.Decision != nil {
+		v.Decision = unmarshalEnduroCollectionPendingDecisionResponseBodyToCollectionviewsEnduroCollectionPendingDecisionView(body.Decision)
+	}
+	if body.Validation != nil {
+		v.Validation = make([]*collectionviews.EnduroCollectionValidationResultView, len(body.Validation))
+		for i, val := range body.Validation {
+			if val == nil {
+				v.Validation[i] = nil
+				continue
+			}
+			v.Validation[i] = unmarshalEnduroCollectionValidationResultResponseBodyToCollectionviewsEnduroCollectionValidationResultView(val)
+		}
+	}
+	if body.Progress != nil {
+		v.Progress = unmarshalEnduroCollectionProgressResponseBodyToCollectionviewsEnduroCollectionProgressView(body.Progress)
+	}
+	if body.Failure != nil {
+		v.Failure = unmarshalEnduroCollectionFailureResponseBodyToCollectionviewsEnduroCollectionFailureView(body.Failure)
 	}
 
 	return v
@@ -449,6 +894,21 @@ func NewDeleteNotFound(body *DeleteNotFoundResponseBody) *collection.CollectionN
 	return v
 }
 
+// NewDeleteLegalHold builds a collection service delete endpoint legal_hold
+// error.
+func NewDeleteLegalHold(body *DeleteLegalHoldResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
 // NewCancelNotFound builds a collection service cancel endpoint not_found
 // error.
 func NewCancelNotFound(body *CancelNotFoundResponseBody) *collection.CollectionNotfound {
@@ -570,6 +1030,132 @@ func NewStatusHistoryNotFound(body *StatusHistoryNotFoundResponseBody) *collecti
 	return v
 }
 
+// NewNotificationsEnduroCollectionNotificationDeliveryCollectionOK builds a
+// "collection" service "notifications" endpoint result from a HTTP "OK"
+// response.
+func NewNotificationsEnduroCollectionNotificationDeliveryCollectionOK(body EnduroCollectionNotificationDeliveryResponseCollection) collectionviews.EnduroCollectionNotificationDeliveryCollectionView {
+	v := make([]*collectionviews.EnduroCollectionNotificationDeliveryView, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalEnduroCollectionNotificationDeliveryResponseToCollectionviewsEnduroCollectionNotificationDeliveryView(val)
+	}
+
+	return v
+}
+
+// NewNotificationsNotFound builds a collection service notifications endpoint
+// not_found error.
+func NewNotificationsNotFound(body *NotificationsNotFoundResponseBody) *collection.CollectionNotfound {
+	v := &collection.CollectionNotfound{
+		Message: *body.Message,
+		ID:      *body.ID,
+	}
+
+	return v
+}
+
+// NewRescanEnduroCollectionRescanObjectCollectionOK builds a "collection"
+// service "rescan" endpoint result from a HTTP "OK" response.
+func NewRescanEnduroCollectionRescanObjectCollectionOK(body EnduroCollectionRescanObjectResponseCollection) collectionviews.EnduroCollectionRescanObjectCollectionView {
+	v := make([]*collectionviews.EnduroCollectionRescanObjectView, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalEnduroCollectionRescanObjectResponseToCollectionviewsEnduroCollectionRescanObjectView(val)
+	}
+
+	return v
+}
+
+// NewRescanNotValid builds a collection service rescan endpoint not_valid
+// error.
+func NewRescanNotValid(body *RescanNotValidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRetentionEnduroCollectionRetentionDeletionCollectionOK builds a
+// "collection" service "retention" endpoint result from a HTTP "OK" response.
+func NewRetentionEnduroCollectionRetentionDeletionCollectionOK(body EnduroCollectionRetentionDeletionResponseCollection) collectionviews.EnduroCollectionRetentionDeletionCollectionView {
+	v := make([]*collectionviews.EnduroCollectionRetentionDeletionView, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalEnduroCollectionRetentionDeletionResponseToCollectionviewsEnduroCollectionRetentionDeletionView(val)
+	}
+
+	return v
+}
+
+// NewRetentionPostponeNotFound builds a collection service retention_postpone
+// endpoint not_found error.
+func NewRetentionPostponeNotFound(body *RetentionPostponeNotFoundResponseBody) *collection.CollectionNotfound {
+	v := &collection.CollectionNotfound{
+		Message: *body.Message,
+		ID:      *body.ID,
+	}
+
+	return v
+}
+
+// NewRetentionCancelNotFound builds a collection service retention_cancel
+// endpoint not_found error.
+func NewRetentionCancelNotFound(body *RetentionCancelNotFoundResponseBody) *collection.CollectionNotfound {
+	v := &collection.CollectionNotfound{
+		Message: *body.Message,
+		ID:      *body.ID,
+	}
+
+	return v
+}
+
+// NewSetLegalHoldNotFound builds a collection service set_legal_hold endpoint
+// not_found error.
+func NewSetLegalHoldNotFound(body *SetLegalHoldNotFoundResponseBody) *collection.CollectionNotfound {
+	v := &collection.CollectionNotfound{
+		Message: *body.Message,
+		ID:      *body.ID,
+	}
+
+	return v
+}
+
+// NewClearLegalHoldNotFound builds a collection service clear_legal_hold
+// endpoint not_found error.
+func NewClearLegalHoldNotFound(body *ClearLegalHoldNotFoundResponseBody) *collection.CollectionNotfound {
+	v := &collection.CollectionNotfound{
+		Message: *body.Message,
+		ID:      *body.ID,
+	}
+
+	return v
+}
+
+// NewRetentionMigrateResultOK builds a "collection" service
+// "retention_migrate" endpoint result from a HTTP "OK" response.
+func NewRetentionMigrateResultOK(body *RetentionMigrateResponseBody) *collection.RetentionMigrateResult {
+	v := &collection.RetentionMigrateResult{
+		Signaled: *body.Signaled,
+	}
+
+	return v
+}
+
 // NewDownloadResultOK builds a "collection" service "download" endpoint result
 // from a HTTP "OK" response.
 func NewDownloadResultOK(contentType string, contentLength int64, contentDisposition string) *collection.DownloadResult {
@@ -622,8 +1208,10 @@ func NewDecideNotValid(body *DecideNotValidResponseBody) *goa.ServiceError {
 // from a HTTP "Accepted" response.
 func NewBulkResultAccepted(body *BulkResponseBody) *collection.BulkResult {
 	v := &collection.BulkResult{
-		WorkflowID: *body.WorkflowID,
-		RunID:      *body.RunID,
+		ID:         body.ID,
+		WorkflowID: body.WorkflowID,
+		RunID:      body.RunID,
+		Count:      body.Count,
 	}
 
 	return v
@@ -669,6 +1257,71 @@ func NewBulkStatusResultOK(body *BulkStatusResponseBody) *collection.BulkStatusR
 		WorkflowID: body.WorkflowID,
 		RunID:      body.RunID,
 	}
+	if body.Outcomes != nil {
+		v.Outcomes = make([]*collection.BulkOutcome, len(body.Outcomes))
+		for i, val := range body.Outcomes {
+			if val == nil {
+				v.Outcomes[i] = nil
+				continue
+			}
+			v.Outcomes[i] = unmarshalBulkOutcomeResponseBodyToCollectionBulkOutcome(val)
+		}
+	}
+
+	return v
+}
+
+// NewBulkRunsEnduroBulkRunOK builds a "collection" service "bulk_runs"
+// endpoint result from a HTTP "OK" response.
+func NewBulkRunsEnduroBulkRunOK(body []*EnduroBulkRunResponse) []*collection.EnduroBulkRun {
+	v := make([]*collection.EnduroBulkRun, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalEnduroBulkRunResponseToCollectionEnduroBulkRun(val)
+	}
+
+	return v
+}
+
+// NewBulkRunEnduroBulkRunOK builds a "collection" service "bulk_run" endpoint
+// result from a HTTP "OK" response.
+func NewBulkRunEnduroBulkRunOK(body *BulkRunResponseBody) *collection.EnduroBulkRun {
+	v := &collection.EnduroBulkRun{
+		ID:          *body.ID,
+		WorkflowID:  *body.WorkflowID,
+		RunID:       *body.RunID,
+		Operation:   *body.Operation,
+		Status:      *body.Status,
+		Option:      body.Option,
+		State:       *body.State,
+		Error:       body.Error,
+		CreatedAt:   *body.CreatedAt,
+		CompletedAt: body.CompletedAt,
+	}
+	if body.Outcomes != nil {
+		v.Outcomes = make([]*collection.BulkOutcome, len(body.Outcomes))
+		for i, val := range body.Outcomes {
+			if val == nil {
+				v.Outcomes[i] = nil
+				continue
+			}
+			v.Outcomes[i] = unmarshalBulkOutcomeResponseBodyToCollectionBulkOutcome(val)
+		}
+	}
+
+	return v
+}
+
+// NewBulkRunNotFound builds a collection service bulk_run endpoint not_found
+// error.
+func NewBulkRunNotFound(body *BulkRunNotFoundResponseBody) *collection.BulkRunNotFound {
+	v := &collection.BulkRunNotFound{
+		Message: *body.Message,
+		ID:      *body.ID,
+	}
 
 	return v
 }
@@ -693,6 +1346,11 @@ func ValidateMonitorResponseBody(body *MonitorResponseBody) (err error) {
 			err = goa.MergeErrors(err, err2)
 		}
 	}
+	if body.Progress != nil {
+		if err2 := ValidateEnduroCollectionProgressResponseBody(body.Progress); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	return
 }
 
@@ -722,13 +1380,11 @@ func ValidateRetryResponseBody(body *RetryResponseBody) (err error) {
 	return
 }
 
-// ValidateBulkResponseBody runs the validations defined on BulkResponseBody
-func ValidateBulkResponseBody(body *BulkResponseBody) (err error) {
-	if body.WorkflowID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("workflow_id", "body"))
-	}
-	if body.RunID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("run_id", "body"))
+// ValidateRetentionMigrateResponseBody runs the validations defined on
+// retention_migrate_response_body
+func ValidateRetentionMigrateResponseBody(body *RetentionMigrateResponseBody) (err error) {
+	if body.Signaled == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("signaled", "body"))
 	}
 	return
 }
@@ -745,6 +1401,68 @@ func ValidateBulkStatusResponseBody(body *BulkStatusResponseBody) (err error) {
 	if body.ClosedAt != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.closed_at", *body.ClosedAt, goa.FormatDateTime))
 	}
+	for _, e := range body.Outcomes {
+		if e != nil {
+			if err2 := ValidateBulkOutcomeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateBulkRunResponseBody runs the validations defined on
+// bulk_run_response_body
+func ValidateBulkRunResponseBody(body *BulkRunResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.WorkflowID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("workflow_id", "body"))
+	}
+	if body.RunID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("run_id", "body"))
+	}
+	if body.Operation == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("operation", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.State == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("state", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Operation != nil {
+		if !(*body.Operation == "retry" || *body.Operation == "cancel" || *body.Operation == "abandon" || *body.Operation == "decide") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.operation", *body.Operation, []any{"retry", "cancel", "abandon", "decide"}))
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "new" || *body.Status == "in progress" || *body.Status == "done" || *body.Status == "error" || *body.Status == "unknown" || *body.Status == "queued" || *body.Status == "pending" || *body.Status == "abandoned") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"new", "in progress", "done", "error", "unknown", "queued", "pending", "abandoned"}))
+		}
+	}
+	if body.State != nil {
+		if !(*body.State == "running" || *body.State == "completed" || *body.State == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.state", *body.State, []any{"running", "completed", "failed"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.CompletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.completed_at", *body.CompletedAt, goa.FormatDateTime))
+	}
+	for _, e := range body.Outcomes {
+		if e != nil {
+			if err2 := ValidateBulkOutcomeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
@@ -772,6 +1490,30 @@ func ValidateDeleteNotFoundResponseBody(body *DeleteNotFoundResponseBody) (err e
 	return
 }
 
+// ValidateDeleteLegalHoldResponseBody runs the validations defined on
+// delete_legal_hold_response_body
+func ValidateDeleteLegalHoldResponseBody(body *DeleteLegalHoldResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
 // ValidateCancelNotFoundResponseBody runs the validations defined on
 // cancel_not_found_response_body
 func ValidateCancelNotFoundResponseBody(body *CancelNotFoundResponseBody) (err error) {
@@ -868,6 +1610,90 @@ func ValidateStatusHistoryNotFoundResponseBody(body *StatusHistoryNotFoundRespon
 	return
 }
 
+// ValidateNotificationsNotFoundResponseBody runs the validations defined on
+// notifications_not_found_response_body
+func ValidateNotificationsNotFoundResponseBody(body *NotificationsNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	return
+}
+
+// ValidateRescanNotValidResponseBody runs the validations defined on
+// rescan_not_valid_response_body
+func ValidateRescanNotValidResponseBody(body *RescanNotValidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRetentionPostponeNotFoundResponseBody runs the validations defined
+// on retention_postpone_not_found_response_body
+func ValidateRetentionPostponeNotFoundResponseBody(body *RetentionPostponeNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	return
+}
+
+// ValidateRetentionCancelNotFoundResponseBody runs the validations defined on
+// retention_cancel_not_found_response_body
+func ValidateRetentionCancelNotFoundResponseBody(body *RetentionCancelNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	return
+}
+
+// ValidateSetLegalHoldNotFoundResponseBody runs the validations defined on
+// set_legal_hold_not_found_response_body
+func ValidateSetLegalHoldNotFoundResponseBody(body *SetLegalHoldNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	return
+}
+
+// ValidateClearLegalHoldNotFoundResponseBody runs the validations defined on
+// clear_legal_hold_not_found_response_body
+func ValidateClearLegalHoldNotFoundResponseBody(body *ClearLegalHoldNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	return
+}
+
 // ValidateDownloadNotFoundResponseBody runs the validations defined on
 // download_not_found_response_body
 func ValidateDownloadNotFoundResponseBody(body *DownloadNotFoundResponseBody) (err error) {
@@ -964,6 +1790,18 @@ func ValidateBulkNotValidResponseBody(body *BulkNotValidResponseBody) (err error
 	return
 }
 
+// ValidateBulkRunNotFoundResponseBody runs the validations defined on
+// bulk_run_not_found_response_body
+func ValidateBulkRunNotFoundResponseBody(body *BulkRunNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	return
+}
+
 // ValidateEnduroStoredCollectionResponseBody runs the validations defined on
 // EnduroStored-CollectionResponseBody
 func ValidateEnduroStoredCollectionResponseBody(body *EnduroStoredCollectionResponseBody) (err error) {
@@ -1008,6 +1846,57 @@ func ValidateEnduroStoredCollectionResponseBody(body *EnduroStoredCollectionResp
 	return
 }
 
+// ValidateEnduroCollectionProgressResponseBody runs the validations defined on
+// EnduroCollectionProgressResponseBody
+func ValidateEnduroCollectionProgressResponseBody(body *EnduroCollectionProgressResponseBody) (err error) {
+	if body.Stage == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("stage", "body"))
+	}
+	if body.JobsCompleted == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("jobs_completed", "body"))
+	}
+	if body.JobsTotal == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("jobs_total", "body"))
+	}
+	if body.Percent == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("percent", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.Stage != nil {
+		if !(*body.Stage == "transfer" || *body.Stage == "ingest") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.stage", *body.Stage, []any{"transfer", "ingest"}))
+		}
+	}
+	for _, e := range body.FailedJobs {
+		if e != nil {
+			if err2 := ValidateEnduroCollectionFailedJobResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateEnduroCollectionFailedJobResponseBody runs the validations defined
+// on EnduroCollectionFailedJobResponseBody
+func ValidateEnduroCollectionFailedJobResponseBody(body *EnduroCollectionFailedJobResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Microservice == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("microservice", "body"))
+	}
+	return
+}
+
 // ValidateEnduroStoredCollectionCollectionResponseBody runs the validations
 // defined on EnduroStored-CollectionCollectionResponseBody
 func ValidateEnduroStoredCollectionCollectionResponseBody(body EnduroStoredCollectionCollectionResponseBody) (err error) {
@@ -1021,6 +1910,105 @@ func ValidateEnduroStoredCollectionCollectionResponseBody(body EnduroStoredColle
 	return
 }
 
+// ValidateEnduroCollectionPendingDecisionResponseBody runs the validations
+// defined on EnduroCollectionPendingDecisionResponseBody
+func ValidateEnduroCollectionPendingDecisionResponseBody(body *EnduroCollectionPendingDecisionResponseBody) (err error) {
+	if body.Activity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("activity", "body"))
+	}
+	if body.Error == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("error", "body"))
+	}
+	if body.Options == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("options", "body"))
+	}
+	for _, e := range body.Options {
+		if !(e == "RETRY" || e == "RETRY_ONCE" || e == "ABANDON" || e == "SKIP") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.options[*]", e, []any{"RETRY", "RETRY_ONCE", "ABANDON", "SKIP"}))
+		}
+	}
+	return
+}
+
+// ValidateEnduroCollectionValidationResultResponseBodyCollection runs the
+// validations defined on
+// EnduroCollection-Validation-ResultResponseBodyCollection
+func ValidateEnduroCollectionValidationResultResponseBodyCollection(body EnduroCollectionValidationResultResponseBodyCollection) (err error) {
+	for _, e := range body {
+		if e != nil {
+			if err2 := ValidateEnduroCollectionValidationResultResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateEnduroCollectionValidationResultResponseBody runs the validations
+// defined on EnduroCollection-Validation-ResultResponseBody
+func ValidateEnduroCollectionValidationResultResponseBody(body *EnduroCollectionValidationResultResponseBody) (err error) {
+	if body.Validator == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("validator", "body"))
+	}
+	if body.Severity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("severity", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Severity != nil {
+		if !(*body.Severity == "fail" || *body.Severity == "warn") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.severity", *body.Severity, []any{"fail", "warn"}))
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "passed" || *body.Status == "failed" || *body.Status == "warned") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"passed", "failed", "warned"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateEnduroCollectionFailureResponseBody runs the validations defined on
+// EnduroCollectionFailureResponseBody
+func ValidateEnduroCollectionFailureResponseBody(body *EnduroCollectionFailureResponseBody) (err error) {
+	if body.Category == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("category", "body"))
+	}
+	if body.Stage == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("stage", "body"))
+	}
+	if body.UnitID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("unit_id", "body"))
+	}
+	if body.Errors == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("errors", "body"))
+	}
+	if body.OccurredAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("occurred_at", "body"))
+	}
+	if body.Category != nil {
+		if !(*body.Category == "failed" || *body.Category == "rejected" || *body.Category == "unexpected_state" || *body.Category == "api_error" || *body.Category == "unreachable") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.category", *body.Category, []any{"failed", "rejected", "unexpected_state", "api_error", "unreachable"}))
+		}
+	}
+	if body.Stage != nil {
+		if !(*body.Stage == "transfer" || *body.Stage == "ingest") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.stage", *body.Stage, []any{"transfer", "ingest"}))
+		}
+	}
+	if body.OccurredAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.occurred_at", *body.OccurredAt, goa.FormatDateTime))
+	}
+	return
+}
+
 // ValidateEnduroCollectionStatusTransitionResponseBodyCollection runs the
 // validations defined on
 // EnduroCollection-Status-TransitionResponseBodyCollection
@@ -1071,3 +2059,194 @@ func ValidateEnduroCollectionStatusTransitionResponseBody(body *EnduroCollection
 	}
 	return
 }
+
+// ValidateEnduroCollectionNotificationDeliveryResponse runs the validations
+// defined on EnduroCollection-Notification-DeliveryResponse
+func ValidateEnduroCollectionNotificationDeliveryResponse(body *EnduroCollectionNotificationDeliveryResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Webhook == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("webhook", "body"))
+	}
+	if body.Event == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Attempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.Event != nil {
+		if !(*body.Event == "queued" || *body.Event == "in_progress" || *body.Event == "done" || *body.Event == "error" || *body.Event == "pending" || *body.Event == "abandoned" || *body.Event == "decision_reminder") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.event", *body.Event, []any{"queued", "in_progress", "done", "error", "pending", "abandoned", "decision_reminder"}))
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "delivered" || *body.Status == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "delivered", "failed"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateEnduroCollectionRescanObjectResponse runs the validations defined on
+// EnduroCollection-Rescan-ObjectResponse
+func ValidateEnduroCollectionRescanObjectResponse(body *EnduroCollectionRescanObjectResponse) (err error) {
+	if body.Watcher == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("watcher", "body"))
+	}
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.IsDir == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("is_dir", "body"))
+	}
+	return
+}
+
+// ValidateEnduroCollectionRetentionDeletionResponse runs the validations
+// defined on EnduroCollection-Retention-DeletionResponse
+func ValidateEnduroCollectionRetentionDeletionResponse(body *EnduroCollectionRetentionDeletionResponse) (err error) {
+	if body.CollectionID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("collection_id", "body"))
+	}
+	if body.Watcher == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("watcher", "body"))
+	}
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.DueAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("due_at", "body"))
+	}
+	if body.Attempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "done" || *body.Status == "failed" || *body.Status == "canceled") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "done", "failed", "canceled"}))
+		}
+	}
+	if body.DueAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.due_at", *body.DueAt, goa.FormatDateTime))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateBulkOutcomeResponseBody runs the validations defined on
+// BulkOutcomeResponseBody
+func ValidateBulkOutcomeResponseBody(body *BulkOutcomeResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Outcome == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("outcome", "body"))
+	}
+	if body.Outcome != nil {
+		if !(*body.Outcome == "succeeded" || *body.Outcome == "skipped" || *body.Outcome == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.outcome", *body.Outcome, []any{"succeeded", "skipped", "failed"}))
+		}
+	}
+	return
+}
+
+// ValidateEnduroBulkRunResponse runs the validations defined on
+// EnduroBulkRunResponse
+func ValidateEnduroBulkRunResponse(body *EnduroBulkRunResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.WorkflowID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("workflow_id", "body"))
+	}
+	if body.RunID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("run_id", "body"))
+	}
+	if body.Operation == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("operation", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.State == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("state", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Operation != nil {
+		if !(*body.Operation == "retry" || *body.Operation == "cancel" || *body.Operation == "abandon" || *body.Operation == "decide") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.operation", *body.Operation, []any{"retry", "cancel", "abandon", "decide"}))
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "new" || *body.Status == "in progress" || *body.Status == "done" || *body.Status == "error" || *body.Status == "unknown" || *body.Status == "queued" || *body.Status == "pending" || *body.Status == "abandoned") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"new", "in progress", "done", "error", "unknown", "queued", "pending", "abandoned"}))
+		}
+	}
+	if body.State != nil {
+		if !(*body.State == "running" || *body.State == "completed" || *body.State == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.state", *body.State, []any{"running", "completed", "failed"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.CompletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.completed_at", *body.CompletedAt, goa.FormatDateTime))
+	}
+	for _, e := range body.Outcomes {
+		if e != nil {
+			if err2 := ValidateBulkOutcomeResponse(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateBulkOutcomeResponse runs the validations defined on
+// BulkOutcomeResponse
+func ValidateBulkOutcomeResponse(body *BulkOutcomeResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Outcome == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("outcome", "body"))
+	}
+	if body.Outcome != nil {
+		if !(*body.Outcome == "succeeded" || *body.Outcome == "skipped" || *body.Outcome == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.outcome", *body.Outcome, []any{"succeeded", "skipped", "failed"}))
+		}
+	}
+	return
+}
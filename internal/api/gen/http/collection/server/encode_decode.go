@@ -59,6 +59,8 @@ func DecodeListRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.De
 			earliestCreatedTime *string
 			latestCreatedTime   *string
 			status              *string
+			decisionActivity    *string
+			failureCategory     *string
 			cursor              *string
 			err                 error
 		)
@@ -115,6 +117,19 @@ func DecodeListRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.De
 				err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", *status, []any{"new", "in progress", "done", "error", "unknown", "queued", "pending", "abandoned"}))
 			}
 		}
+		decisionActivityRaw := qp.Get("decision_activity")
+		if decisionActivityRaw != "" {
+			decisionActivity = &decisionActivityRaw
+		}
+		failureCategoryRaw := qp.Get("failure_category")
+		if failureCategoryRaw != "" {
+			failureCategory = &failureCategoryRaw
+		}
+		if failureCategory != nil {
+			if !(*failureCategory == "failed" || *failureCategory == "rejected" || *failureCategory == "unexpected_state" || *failureCategory == "api_error" || *failureCategory == "unreachable") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("failure_category", *failureCategory, []any{"failed", "rejected", "unexpected_state", "api_error", "unreachable"}))
+			}
+		}
 		cursorRaw := qp.Get("cursor")
 		if cursorRaw != "" {
 			cursor = &cursorRaw
@@ -122,7 +137,7 @@ func DecodeListRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.De
 		if err != nil {
 			return payload, err
 		}
-		payload = NewListPayload(name, originalID, transferID, aipID, pipelineID, earliestCreatedTime, latestCreatedTime, status, cursor)
+		payload = NewListPayload(name, originalID, transferID, aipID, pipelineID, earliestCreatedTime, latestCreatedTime, status, decisionActivity, failureCategory, cursor)
 
 		return payload, nil
 	}
@@ -257,6 +272,19 @@ func EncodeDeleteError(encoder func(context.Context, http.ResponseWriter) goahtt
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
+		case "legal_hold":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteLegalHoldResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
 		}
@@ -562,28 +590,23 @@ func EncodeStatusHistoryError(encoder func(context.Context, http.ResponseWriter)
 	}
 }
 
-// EncodeDownloadResponse returns an encoder for responses returned by the
-// collection download endpoint.
-func EncodeDownloadResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeNotificationsResponse returns an encoder for responses returned by the
+// collection notifications endpoint.
+func EncodeNotificationsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*collection.DownloadResult)
-		w.Header().Set("Content-Type", res.ContentType)
-		{
-			val := res.ContentLength
-			contentLengths := strconv.FormatInt(val, 10)
-			w.Header().Set("Content-Length", contentLengths)
-		}
-		w.Header().Set("Content-Disposition", res.ContentDisposition)
+		res := v.(collectionviews.EnduroCollectionNotificationDeliveryCollection)
+		enc := encoder(ctx, w)
+		body := NewEnduroCollectionNotificationDeliveryResponseCollection(res.Projected)
 		w.WriteHeader(http.StatusOK)
-		return nil
+		return enc.Encode(body)
 	}
 }
 
-// DecodeDownloadRequest returns a decoder for requests sent to the collection
-// download endpoint.
-func DecodeDownloadRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*collection.DownloadPayload, error) {
-	return func(r *http.Request) (*collection.DownloadPayload, error) {
-		var payload *collection.DownloadPayload
+// DecodeNotificationsRequest returns a decoder for requests sent to the
+// collection notifications endpoint.
+func DecodeNotificationsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*collection.NotificationsPayload, error) {
+	return func(r *http.Request) (*collection.NotificationsPayload, error) {
+		var payload *collection.NotificationsPayload
 		var (
 			id  uint
 			err error
@@ -601,15 +624,15 @@ func DecodeDownloadRequest(mux goahttp.Muxer, decoder func(*http.Request) goahtt
 		if err != nil {
 			return payload, err
 		}
-		payload = NewDownloadPayload(id)
+		payload = NewNotificationsPayload(id)
 
 		return payload, nil
 	}
 }
 
-// EncodeDownloadError returns an encoder for errors returned by the download
-// collection endpoint.
-func EncodeDownloadError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeNotificationsError returns an encoder for errors returned by the
+// notifications collection endpoint.
+func EncodeNotificationsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -625,7 +648,7 @@ func EncodeDownloadError(encoder func(context.Context, http.ResponseWriter) goah
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDownloadNotFoundResponseBody(res)
+				body = NewNotificationsNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -636,26 +659,125 @@ func EncodeDownloadError(encoder func(context.Context, http.ResponseWriter) goah
 	}
 }
 
-// EncodeDecideResponse returns an encoder for responses returned by the
-// collection decide endpoint.
-func EncodeDecideResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeRescanResponse returns an encoder for responses returned by the
+// collection rescan endpoint.
+func EncodeRescanResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(collectionviews.EnduroCollectionRescanObjectCollection)
+		enc := encoder(ctx, w)
+		body := NewEnduroCollectionRescanObjectResponseCollection(res.Projected)
 		w.WriteHeader(http.StatusOK)
-		return nil
+		return enc.Encode(body)
 	}
 }
 
-// DecodeDecideRequest returns a decoder for requests sent to the collection
-// decide endpoint.
-func DecodeDecideRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*collection.DecidePayload, error) {
-	return func(r *http.Request) (*collection.DecidePayload, error) {
-		var payload *collection.DecidePayload
+// DecodeRescanRequest returns a decoder for requests sent to the collection
+// rescan endpoint.
+func DecodeRescanRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*collection.RescanPayload, error) {
+	return func(r *http.Request) (*collection.RescanPayload, error) {
+		var payload *collection.RescanPayload
 		var (
-			body struct {
-				// Decision option to proceed with
-				Option *string `form:"option" json:"option" xml:"option"`
+			watcher string
+			err     error
+		)
+		watcher = r.URL.Query().Get("watcher")
+		if watcher == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("watcher", "query string"))
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewRescanPayload(watcher)
+
+		return payload, nil
+	}
+}
+
+// EncodeRescanError returns an encoder for errors returned by the rescan
+// collection endpoint.
+func EncodeRescanError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_valid":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRescanNotValidResponseBody(res)
 			}
-			err error
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeRetentionResponse returns an encoder for responses returned by the
+// collection retention endpoint.
+func EncodeRetentionResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(collectionviews.EnduroCollectionRetentionDeletionCollection)
+		enc := encoder(ctx, w)
+		body := NewEnduroCollectionRetentionDeletionResponseCollection(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeRetentionRequest returns a decoder for requests sent to the collection
+// retention endpoint.
+func DecodeRetentionRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*collection.RetentionPayload, error) {
+	return func(r *http.Request) (*collection.RetentionPayload, error) {
+		var payload *collection.RetentionPayload
+		var (
+			status *string
+			err    error
+		)
+		statusRaw := r.URL.Query().Get("status")
+		if statusRaw != "" {
+			status = &statusRaw
+		}
+		if status != nil {
+			if !(*status == "pending" || *status == "done" || *status == "failed" || *status == "canceled") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", *status, []any{"pending", "done", "failed", "canceled"}))
+			}
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewRetentionPayload(status)
+
+		return payload, nil
+	}
+}
+
+// EncodeRetentionPostponeResponse returns an encoder for responses returned by
+// the collection retention_postpone endpoint.
+func EncodeRetentionPostponeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+}
+
+// DecodeRetentionPostponeRequest returns a decoder for requests sent to the
+// collection retention_postpone endpoint.
+func DecodeRetentionPostponeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*collection.RetentionPostponePayload, error) {
+	return func(r *http.Request) (*collection.RetentionPostponePayload, error) {
+		var payload *collection.RetentionPostponePayload
+		var (
+			body RetentionPostponeRequestBody
+			err  error
 		)
 		err = decoder(r).Decode(&body)
 		if err != nil {
@@ -668,6 +790,10 @@ func DecodeDecideRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.
 			}
 			return payload, goa.DecodePayloadError(err.Error())
 		}
+		err = ValidateRetentionPostponeRequestBody(&body)
+		if err != nil {
+			return payload, err
+		}
 
 		var (
 			id uint
@@ -685,15 +811,15 @@ func DecodeDecideRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.
 		if err != nil {
 			return payload, err
 		}
-		payload = NewDecidePayload(body, id)
+		payload = NewRetentionPostponePayload(&body, id)
 
 		return payload, nil
 	}
 }
 
-// EncodeDecideError returns an encoder for errors returned by the decide
-// collection endpoint.
-func EncodeDecideError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeRetentionPostponeError returns an encoder for errors returned by the
+// retention_postpone collection endpoint.
+func EncodeRetentionPostponeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -709,23 +835,76 @@ func EncodeDecideError(encoder func(context.Context, http.ResponseWriter) goahtt
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDecideNotFoundResponseBody(res)
+				body = NewRetentionPostponeNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
-		case "not_valid":
-			var res *goa.ServiceError
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeRetentionCancelResponse returns an encoder for responses returned by
+// the collection retention_cancel endpoint.
+func EncodeRetentionCancelResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+}
+
+// DecodeRetentionCancelRequest returns a decoder for requests sent to the
+// collection retention_cancel endpoint.
+func DecodeRetentionCancelRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*collection.RetentionCancelPayload, error) {
+	return func(r *http.Request) (*collection.RetentionCancelPayload, error) {
+		var payload *collection.RetentionCancelPayload
+		var (
+			id  uint
+			err error
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseUint(idRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "unsigned integer"))
+			}
+			id = uint(v)
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewRetentionCancelPayload(id)
+
+		return payload, nil
+	}
+}
+
+// EncodeRetentionCancelError returns an encoder for errors returned by the
+// retention_cancel collection endpoint.
+func EncodeRetentionCancelError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_found":
+			var res *collection.CollectionNotfound
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDecideNotValidResponseBody(res)
+				body = NewRetentionCancelNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusBadRequest)
+			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
@@ -733,25 +912,22 @@ func EncodeDecideError(encoder func(context.Context, http.ResponseWriter) goahtt
 	}
 }
 
-// EncodeBulkResponse returns an encoder for responses returned by the
-// collection bulk endpoint.
-func EncodeBulkResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeSetLegalHoldResponse returns an encoder for responses returned by the
+// collection set_legal_hold endpoint.
+func EncodeSetLegalHoldResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*collection.BulkResult)
-		enc := encoder(ctx, w)
-		body := NewBulkResponseBody(res)
-		w.WriteHeader(http.StatusAccepted)
-		return enc.Encode(body)
+		w.WriteHeader(http.StatusOK)
+		return nil
 	}
 }
 
-// DecodeBulkRequest returns a decoder for requests sent to the collection bulk
-// endpoint.
-func DecodeBulkRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*collection.BulkPayload, error) {
-	return func(r *http.Request) (*collection.BulkPayload, error) {
-		var payload *collection.BulkPayload
+// DecodeSetLegalHoldRequest returns a decoder for requests sent to the
+// collection set_legal_hold endpoint.
+func DecodeSetLegalHoldRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*collection.SetLegalHoldPayload, error) {
+	return func(r *http.Request) (*collection.SetLegalHoldPayload, error) {
+		var payload *collection.SetLegalHoldPayload
 		var (
-			body BulkRequestBody
+			body SetLegalHoldRequestBody
 			err  error
 		)
 		err = decoder(r).Decode(&body)
@@ -765,19 +941,36 @@ func DecodeBulkRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.De
 			}
 			return payload, goa.DecodePayloadError(err.Error())
 		}
-		err = ValidateBulkRequestBody(&body)
+		err = ValidateSetLegalHoldRequestBody(&body)
 		if err != nil {
 			return payload, err
 		}
-		payload = NewBulkPayload(&body)
+
+		var (
+			id uint
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseUint(idRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "unsigned integer"))
+			}
+			id = uint(v)
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewSetLegalHoldPayload(&body, id)
 
 		return payload, nil
 	}
 }
 
-// EncodeBulkError returns an encoder for errors returned by the bulk
-// collection endpoint.
-func EncodeBulkError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeSetLegalHoldError returns an encoder for errors returned by the
+// set_legal_hold collection endpoint.
+func EncodeSetLegalHoldError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -785,31 +978,18 @@ func EncodeBulkError(encoder func(context.Context, http.ResponseWriter) goahttp.
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
-		case "not_available":
-			var res *goa.ServiceError
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewBulkNotAvailableResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusConflict)
-			return enc.Encode(body)
-		case "not_valid":
-			var res *goa.ServiceError
+		case "not_found":
+			var res *collection.CollectionNotfound
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewBulkNotValidResponseBody(res)
+				body = NewSetLegalHoldNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusBadRequest)
+			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
@@ -817,30 +997,457 @@ func EncodeBulkError(encoder func(context.Context, http.ResponseWriter) goahttp.
 	}
 }
 
-// EncodeBulkStatusResponse returns an encoder for responses returned by the
-// collection bulk_status endpoint.
-func EncodeBulkStatusResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeClearLegalHoldResponse returns an encoder for responses returned by
+// the collection clear_legal_hold endpoint.
+func EncodeClearLegalHoldResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*collection.BulkStatusResult)
-		enc := encoder(ctx, w)
-		body := NewBulkStatusResponseBody(res)
-		w.WriteHeader(http.StatusOK)
-		return enc.Encode(body)
+		w.WriteHeader(http.StatusNoContent)
+		return nil
 	}
 }
 
-// marshalCollectionEnduroStoredCollectionToEnduroStoredCollectionResponseBody
-// builds a value of type *EnduroStoredCollectionResponseBody from a value of
-// type *collection.EnduroStoredCollection.
-func marshalCollectionEnduroStoredCollectionToEnduroStoredCollectionResponseBody(v *collection.EnduroStoredCollection) *EnduroStoredCollectionResponseBody {
-	if v == nil {
-		return nil
-	}
-	res := &EnduroStoredCollectionResponseBody{
-		ID:          v.ID,
-		Name:        v.Name,
-		Status:      v.Status,
-		WorkflowID:  v.WorkflowID,
+// DecodeClearLegalHoldRequest returns a decoder for requests sent to the
+// collection clear_legal_hold endpoint.
+func DecodeClearLegalHoldRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*collection.ClearLegalHoldPayload, error) {
+	return func(r *http.Request) (*collection.ClearLegalHoldPayload, error) {
+		var payload *collection.ClearLegalHoldPayload
+		var (
+			id     uint
+			reason string
+			err    error
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseUint(idRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "unsigned integer"))
+			}
+			id = uint(v)
+		}
+		reason = r.URL.Query().Get("reason")
+		if reason == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("reason", "query string"))
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewClearLegalHoldPayload(id, reason)
+
+		return payload, nil
+	}
+}
+
+// EncodeClearLegalHoldError returns an encoder for errors returned by the
+// clear_legal_hold collection endpoint.
+func EncodeClearLegalHoldError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_found":
+			var res *collection.CollectionNotfound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewClearLegalHoldNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeRetentionMigrateResponse returns an encoder for responses returned by
+// the collection retention_migrate endpoint.
+func EncodeRetentionMigrateResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*collection.RetentionMigrateResult)
+		enc := encoder(ctx, w)
+		body := NewRetentionMigrateResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// EncodeDownloadResponse returns an encoder for responses returned by the
+// collection download endpoint.
+func EncodeDownloadResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*collection.DownloadResult)
+		w.Header().Set("Content-Type", res.ContentType)
+		{
+			val := res.ContentLength
+			contentLengths := strconv.FormatInt(val, 10)
+			w.Header().Set("Content-Length", contentLengths)
+		}
+		w.Header().Set("Content-Disposition", res.ContentDisposition)
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+}
+
+// DecodeDownloadRequest returns a decoder for requests sent to the collection
+// download endpoint.
+func DecodeDownloadRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*collection.DownloadPayload, error) {
+	return func(r *http.Request) (*collection.DownloadPayload, error) {
+		var payload *collection.DownloadPayload
+		var (
+			id  uint
+			err error
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseUint(idRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "unsigned integer"))
+			}
+			id = uint(v)
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewDownloadPayload(id)
+
+		return payload, nil
+	}
+}
+
+// EncodeDownloadError returns an encoder for errors returned by the download
+// collection endpoint.
+func EncodeDownloadError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_found":
+			var res *collection.CollectionNotfound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDownloadNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeDecideResponse returns an encoder for responses returned by the
+// collection decide endpoint.
+func EncodeDecideResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+}
+
+// DecodeDecideRequest returns a decoder for requests sent to the collection
+// decide endpoint.
+func DecodeDecideRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*collection.DecidePayload, error) {
+	return func(r *http.Request) (*collection.DecidePayload, error) {
+		var payload *collection.DecidePayload
+		var (
+			body struct {
+				// Decision option to proceed with
+				Option *string `form:"option" json:"option" xml:"option"`
+			}
+			err error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return payload, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return payload, gerr
+			}
+			return payload, goa.DecodePayloadError(err.Error())
+		}
+		if body.Option != nil {
+			if !(*body.Option == "RETRY" || *body.Option == "RETRY_ONCE" || *body.Option == "ABANDON" || *body.Option == "SKIP") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.option", *body.Option, []any{"RETRY", "RETRY_ONCE", "ABANDON", "SKIP"}))
+			}
+		}
+		if err != nil {
+			return payload, err
+		}
+
+		var (
+			id uint
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseUint(idRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "unsigned integer"))
+			}
+			id = uint(v)
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewDecidePayload(body, id)
+
+		return payload, nil
+	}
+}
+
+// EncodeDecideError returns an encoder for errors returned by the decide
+// collection endpoint.
+func EncodeDecideError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_found":
+			var res *collection.CollectionNotfound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDecideNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "not_valid":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDecideNotValidResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeBulkResponse returns an encoder for responses returned by the
+// collection bulk endpoint.
+func EncodeBulkResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*collection.BulkResult)
+		enc := encoder(ctx, w)
+		body := NewBulkResponseBody(res)
+		w.WriteHeader(http.StatusAccepted)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeBulkRequest returns a decoder for requests sent to the collection bulk
+// endpoint.
+func DecodeBulkRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*collection.BulkPayload, error) {
+	return func(r *http.Request) (*collection.BulkPayload, error) {
+		var payload *collection.BulkPayload
+		var (
+			body BulkRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return payload, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return payload, gerr
+			}
+			return payload, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateBulkRequestBody(&body)
+		if err != nil {
+			return payload, err
+		}
+		payload = NewBulkPayload(&body)
+
+		return payload, nil
+	}
+}
+
+// EncodeBulkError returns an encoder for errors returned by the bulk
+// collection endpoint.
+func EncodeBulkError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_available":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewBulkNotAvailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "not_valid":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewBulkNotValidResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeBulkStatusResponse returns an encoder for responses returned by the
+// collection bulk_status endpoint.
+func EncodeBulkStatusResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*collection.BulkStatusResult)
+		enc := encoder(ctx, w)
+		body := NewBulkStatusResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// EncodeBulkRunsResponse returns an encoder for responses returned by the
+// collection bulk_runs endpoint.
+func EncodeBulkRunsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*collection.EnduroBulkRun)
+		enc := encoder(ctx, w)
+		body := NewBulkRunsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// EncodeBulkRunResponse returns an encoder for responses returned by the
+// collection bulk_run endpoint.
+func EncodeBulkRunResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*collection.EnduroBulkRun)
+		enc := encoder(ctx, w)
+		body := NewBulkRunResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeBulkRunRequest returns a decoder for requests sent to the collection
+// bulk_run endpoint.
+func DecodeBulkRunRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*collection.BulkRunPayload, error) {
+	return func(r *http.Request) (*collection.BulkRunPayload, error) {
+		var payload *collection.BulkRunPayload
+		var (
+			id  uint
+			err error
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseUint(idRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "unsigned integer"))
+			}
+			id = uint(v)
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewBulkRunPayload(id)
+
+		return payload, nil
+	}
+}
+
+// EncodeBulkRunError returns an encoder for errors returned by the bulk_run
+// collection endpoint.
+func EncodeBulkRunError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_found":
+			var res *collection.BulkRunNotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewBulkRunNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// marshalCollectionEnduroStoredCollectionToEnduroStoredCollectionResponseBody
+// builds a value of type *EnduroStoredCollectionResponseBody from a value of
+// type *collection.EnduroStoredCollection.
+func marshalCollectionEnduroStoredCollectionToEnduroStoredCollectionResponseBody(v *collection.EnduroStoredCollection) *EnduroStoredCollectionResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &EnduroStoredCollectionResponseBody{
+		ID:          v.ID,
+		Name:        v.Name,
+		Status:      v.Status,
+		WorkflowID:  v.WorkflowID,
 		RunID:       v.RunID,
 		TransferID:  v.TransferID,
 		AipID:       v.AipID,
@@ -854,6 +1461,167 @@ func marshalCollectionEnduroStoredCollectionToEnduroStoredCollectionResponseBody
 	return res
 }
 
+// marshalCollectionEnduroCollectionProgressToEnduroCollectionProgressResponseBody
+// builds a value of type *EnduroCollectionProgressResponseBody from a value of
+// type *collection.EnduroCollectionProgress.
+func marshalCollectionEnduroCollectionProgressToEnduroCollectionProgressResponseBody(v *collection.EnduroCollectionProgress) *EnduroCollectionProgressResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &EnduroCollectionProgressResponseBody{
+		Stage:         v.Stage,
+		Microservice:  v.Microservice,
+		Job:           v.Job,
+		JobsCompleted: v.JobsCompleted,
+		JobsTotal:     v.JobsTotal,
+		Percent:       v.Percent,
+		UpdatedAt:     v.UpdatedAt,
+	}
+	if v.FailedJobs != nil {
+		res.FailedJobs = make([]*EnduroCollectionFailedJobResponseBody, len(v.FailedJobs))
+		for i, val := range v.FailedJobs {
+			if val == nil {
+				res.FailedJobs[i] = nil
+				continue
+			}
+			res.FailedJobs[i] = marshalCollectionEnduroCollectionFailedJobToEnduroCollectionFailedJobResponseBody(val)
+		}
+	}
+
+	return res
+}
+
+// marshalCollectionEnduroCollectionFailedJobToEnduroCollectionFailedJobResponseBody
+// builds a value of type *EnduroCollectionFailedJobResponseBody from a value
+// of type *collection.EnduroCollectionFailedJob.
+func marshalCollectionEnduroCollectionFailedJobToEnduroCollectionFailedJobResponseBody(v *collection.EnduroCollectionFailedJob) *EnduroCollectionFailedJobResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &EnduroCollectionFailedJobResponseBody{
+		ID:           v.ID,
+		Name:         v.Name,
+		Microservice: v.Microservice,
+	}
+
+	return res
+}
+
+// marshalCollectionviewsEnduroCollectionPendingDecisionViewToEnduroCollectionPendingDecisionResponseBody
+// builds a value of type *EnduroCollectionPendingDecisionResponseBody from a
+// value of type *collectionviews.EnduroCollectionPendingDecisionView.
+func marshalCollectionviewsEnduroCollectionPendingDecisionViewToEnduroCollectionPendingDecisionResponseBody(v *collectionviews.EnduroCollectionPendingDecisionView) *EnduroCollectionPendingDecisionResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &EnduroCollectionPendingDecisionResponseBody{
+		Activity: *v.Activity,
+		Error:    *v.Error,
+	}
+	if v.Options != nil {
+		res.Options = make([]string, len(v.Options))
+		for i, val := range v.Options {
+			res.Options[i] = val
+		}
+	} else {
+		res.Options = []string{}
+	}
+
+	return res
+}
+
+// marshalCollectionviewsEnduroCollectionValidationResultViewToEnduroCollectionValidationResultResponseBody
+// builds a value of type *EnduroCollectionValidationResultResponseBody from a
+// value of type *collectionviews.EnduroCollectionValidationResultView.
+func marshalCollectionviewsEnduroCollectionValidationResultViewToEnduroCollectionValidationResultResponseBody(v *collectionviews.EnduroCollectionValidationResultView) *EnduroCollectionValidationResultResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &EnduroCollectionValidationResultResponseBody{
+		Validator: *v.Validator,
+		Severity:  *v.Severity,
+		Status:    *v.Status,
+		Message:   v.Message,
+		CreatedAt: *v.CreatedAt,
+	}
+
+	return res
+}
+
+// marshalCollectionviewsEnduroCollectionProgressViewToEnduroCollectionProgressResponseBody
+// builds a value of type *EnduroCollectionProgressResponseBody from a value of
+// type *collectionviews.EnduroCollectionProgressView.
+func marshalCollectionviewsEnduroCollectionProgressViewToEnduroCollectionProgressResponseBody(v *collectionviews.EnduroCollectionProgressView) *EnduroCollectionProgressResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &EnduroCollectionProgressResponseBody{
+		Stage:         *v.Stage,
+		Microservice:  v.Microservice,
+		Job:           v.Job,
+		JobsCompleted: *v.JobsCompleted,
+		JobsTotal:     *v.JobsTotal,
+		Percent:       *v.Percent,
+		UpdatedAt:     *v.UpdatedAt,
+	}
+	if v.FailedJobs != nil {
+		res.FailedJobs = make([]*EnduroCollectionFailedJobResponseBody, len(v.FailedJobs))
+		for i, val := range v.FailedJobs {
+			if val == nil {
+				res.FailedJobs[i] = nil
+				continue
+			}
+			res.FailedJobs[i] = marshalCollectionviewsEnduroCollectionFailedJobViewToEnduroCollectionFailedJobResponseBody(val)
+		}
+	}
+
+	return res
+}
+
+// marshalCollectionviewsEnduroCollectionFailedJobViewToEnduroCollectionFailedJobResponseBody
+// builds a value of type *EnduroCollectionFailedJobResponseBody from a value
+// of type *collectionviews.EnduroCollectionFailedJobView.
+func marshalCollectionviewsEnduroCollectionFailedJobViewToEnduroCollectionFailedJobResponseBody(v *collectionviews.EnduroCollectionFailedJobView) *EnduroCollectionFailedJobResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &EnduroCollectionFailedJobResponseBody{
+		ID:           *v.ID,
+		Name:         *v.Name,
+		Microservice: *v.Microservice,
+	}
+
+	return res
+}
+
+// marshalCollectionviewsEnduroCollectionFailureViewToEnduroCollectionFailureResponseBody
+// builds a value of type *EnduroCollectionFailureResponseBody from a value of
+// type *collectionviews.EnduroCollectionFailureView.
+func marshalCollectionviewsEnduroCollectionFailureViewToEnduroCollectionFailureResponseBody(v *collectionviews.EnduroCollectionFailureView) *EnduroCollectionFailureResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &EnduroCollectionFailureResponseBody{
+		Category:     *v.Category,
+		Stage:        *v.Stage,
+		UnitID:       *v.UnitID,
+		Microservice: v.Microservice,
+		Job:          v.Job,
+		Stderr:       v.Stderr,
+		OccurredAt:   *v.OccurredAt,
+	}
+	if v.Errors != nil {
+		res.Errors = make([]string, len(v.Errors))
+		for i, val := range v.Errors {
+			res.Errors[i] = val
+		}
+	} else {
+		res.Errors = []string{}
+	}
+
+	return res
+}
+
 // marshalCollectionviewsEnduroCollectionWorkflowHistoryViewToEnduroCollectionWorkflowHistoryResponseBody
 // builds a value of type *EnduroCollectionWorkflowHistoryResponseBody from a
 // value of type *collectionviews.EnduroCollectionWorkflowHistoryView.
@@ -883,6 +1651,119 @@ func marshalCollectionviewsEnduroCollectionStatusTransitionViewToEnduroCollectio
 		OccurredAt:     *v.OccurredAt,
 		IsRunStart:     *v.IsRunStart,
 		Reason:         v.Reason,
+		Actor:          v.Actor,
+		Detail:         v.Detail,
+	}
+
+	return res
+}
+
+// marshalCollectionviewsEnduroCollectionNotificationDeliveryViewToEnduroCollectionNotificationDeliveryResponse
+// builds a value of type *EnduroCollectionNotificationDeliveryResponse from a
+// value of type *collectionviews.EnduroCollectionNotificationDeliveryView.
+func marshalCollectionviewsEnduroCollectionNotificationDeliveryViewToEnduroCollectionNotificationDeliveryResponse(v *collectionviews.EnduroCollectionNotificationDeliveryView) *EnduroCollectionNotificationDeliveryResponse {
+	res := &EnduroCollectionNotificationDeliveryResponse{
+		ID:           *v.ID,
+		Webhook:      *v.Webhook,
+		Event:        *v.Event,
+		Status:       *v.Status,
+		Attempts:     *v.Attempts,
+		ResponseCode: v.ResponseCode,
+		Error:        v.Error,
+		CreatedAt:    *v.CreatedAt,
+		UpdatedAt:    *v.UpdatedAt,
+	}
+
+	return res
+}
+
+// marshalCollectionviewsEnduroCollectionRescanObjectViewToEnduroCollectionRescanObjectResponse
+// builds a value of type *EnduroCollectionRescanObjectResponse from a value of
+// type *collectionviews.EnduroCollectionRescanObjectView.
+func marshalCollectionviewsEnduroCollectionRescanObjectViewToEnduroCollectionRescanObjectResponse(v *collectionviews.EnduroCollectionRescanObjectView) *EnduroCollectionRescanObjectResponse {
+	res := &EnduroCollectionRescanObjectResponse{
+		Watcher: *v.Watcher,
+		Key:     *v.Key,
+		Bucket:  v.Bucket,
+		IsDir:   *v.IsDir,
+	}
+
+	return res
+}
+
+// marshalCollectionviewsEnduroCollectionRetentionDeletionViewToEnduroCollectionRetentionDeletionResponse
+// builds a value of type *EnduroCollectionRetentionDeletionResponse from a
+// value of type *collectionviews.EnduroCollectionRetentionDeletionView.
+func marshalCollectionviewsEnduroCollectionRetentionDeletionViewToEnduroCollectionRetentionDeletionResponse(v *collectionviews.EnduroCollectionRetentionDeletionView) *EnduroCollectionRetentionDeletionResponse {
+	res := &EnduroCollectionRetentionDeletionResponse{
+		CollectionID: *v.CollectionID,
+		Watcher:      *v.Watcher,
+		Key:          *v.Key,
+		Status:       *v.Status,
+		DueAt:        *v.DueAt,
+		Attempts:     *v.Attempts,
+		Error:        v.Error,
+		CreatedAt:    *v.CreatedAt,
+		UpdatedAt:    *v.UpdatedAt,
+	}
+
+	return res
+}
+
+// marshalCollectionBulkOutcomeToBulkOutcomeResponseBody builds a value of type
+// *BulkOutcomeResponseBody from a value of type *collection.BulkOutcome.
+func marshalCollectionBulkOutcomeToBulkOutcomeResponseBody(v *collection.BulkOutcome) *BulkOutcomeResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &BulkOutcomeResponseBody{
+		ID:      v.ID,
+		Outcome: v.Outcome,
+		Error:   v.Error,
+	}
+
+	return res
+}
+
+// marshalCollectionEnduroBulkRunToEnduroBulkRunResponse builds a value of type
+// *EnduroBulkRunResponse from a value of type *collection.EnduroBulkRun.
+func marshalCollectionEnduroBulkRunToEnduroBulkRunResponse(v *collection.EnduroBulkRun) *EnduroBulkRunResponse {
+	res := &EnduroBulkRunResponse{
+		ID:          v.ID,
+		WorkflowID:  v.WorkflowID,
+		RunID:       v.RunID,
+		Operation:   v.Operation,
+		Status:      v.Status,
+		Option:      v.Option,
+		State:       v.State,
+		Error:       v.Error,
+		CreatedAt:   v.CreatedAt,
+		CompletedAt: v.CompletedAt,
+	}
+	if v.Outcomes != nil {
+		res.Outcomes = make([]*BulkOutcomeResponse, len(v.Outcomes))
+		for i, val := range v.Outcomes {
+			if val == nil {
+				res.Outcomes[i] = nil
+				continue
+			}
+			res.Outcomes[i] = marshalCollectionBulkOutcomeToBulkOutcomeResponse(val)
+		}
+	}
+
+	return res
+}
+
+// marshalCollectionBulkOutcomeToBulkOutcomeResponse builds a value of type
+// *BulkOutcomeResponse from a value of type *collection.BulkOutcome.
+func marshalCollectionBulkOutcomeToBulkOutcomeResponse(v *collection.BulkOutcome) *BulkOutcomeResponse {
+	if v == nil {
+		return nil
+	}
+	res := &BulkOutcomeResponse{
+		ID:      v.ID,
+		Outcome: v.Outcome,
+		Error:   v.Error,
 	}
 
 	return res
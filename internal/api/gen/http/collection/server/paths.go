@@ -52,6 +52,46 @@ func StatusHistoryCollectionPath(id uint) string {
 	return fmt.Sprintf("/collection/%v/status-history", id)
 }
 
+// NotificationsCollectionPath returns the URL path to the collection service notifications HTTP endpoint.
+func NotificationsCollectionPath(id uint) string {
+	return fmt.Sprintf("/collection/%v/notifications", id)
+}
+
+// RescanCollectionPath returns the URL path to the collection service rescan HTTP endpoint.
+func RescanCollectionPath() string {
+	return "/collection/rescan"
+}
+
+// RetentionCollectionPath returns the URL path to the collection service retention HTTP endpoint.
+func RetentionCollectionPath() string {
+	return "/collection/retention"
+}
+
+// RetentionPostponeCollectionPath returns the URL path to the collection service retention_postpone HTTP endpoint.
+func RetentionPostponeCollectionPath(id uint) string {
+	return fmt.Sprintf("/collection/%v/retention/postpone", id)
+}
+
+// RetentionCancelCollectionPath returns the URL path to the collection service retention_cancel HTTP endpoint.
+func RetentionCancelCollectionPath(id uint) string {
+	return fmt.Sprintf("/collection/%v/retention", id)
+}
+
+// SetLegalHoldCollectionPath returns the URL path to the collection service set_legal_hold HTTP endpoint.
+func SetLegalHoldCollectionPath(id uint) string {
+	return fmt.Sprintf("/collection/%v/legal-hold", id)
+}
+
+// ClearLegalHoldCollectionPath returns the URL path to the collection service clear_legal_hold HTTP endpoint.
+func ClearLegalHoldCollectionPath(id uint) string {
+	return fmt.Sprintf("/collection/%v/legal-hold", id)
+}
+
+// RetentionMigrateCollectionPath returns the URL path to the collection service retention_migrate HTTP endpoint.
+func RetentionMigrateCollectionPath() string {
+	return "/collection/retention/migrate"
+}
+
 // DownloadCollectionPath returns the URL path to the collection service download HTTP endpoint.
 func DownloadCollectionPath(id uint) string {
 	return fmt.Sprintf("/collection/%v/download", id)
@@ -71,3 +111,13 @@ func BulkCollectionPath() string {
 func BulkStatusCollectionPath() string {
 	return "/collection/bulk"
 }
+
+// BulkRunsCollectionPath returns the URL path to the collection service bulk_runs HTTP endpoint.
+func BulkRunsCollectionPath() string {
+	return "/collection/bulk/runs"
+}
+
+// BulkRunCollectionPath returns the URL path to the collection service bulk_run HTTP endpoint.
+func BulkRunCollectionPath(id uint) string {
+	return fmt.Sprintf("/collection/bulk/runs/%v", id)
+}
@@ -22,20 +22,30 @@ import (
 
 // Server lists the collection service endpoint HTTP handlers.
 type Server struct {
-	Mounts        []*MountPoint
-	Monitor       http.Handler
-	List          http.Handler
-	Show          http.Handler
-	Delete        http.Handler
-	Cancel        http.Handler
-	Retry         http.Handler
-	Workflow      http.Handler
-	StatusHistory http.Handler
-	Download      http.Handler
-	Decide        http.Handler
-	Bulk          http.Handler
-	BulkStatus    http.Handler
-	CORS          http.Handler
+	Mounts            []*MountPoint
+	Monitor           http.Handler
+	List              http.Handler
+	Show              http.Handler
+	Delete            http.Handler
+	Cancel            http.Handler
+	Retry             http.Handler
+	Workflow          http.Handler
+	StatusHistory     http.Handler
+	Notifications     http.Handler
+	Rescan            http.Handler
+	Retention         http.Handler
+	RetentionPostpone http.Handler
+	RetentionCancel   http.Handler
+	SetLegalHold      http.Handler
+	ClearLegalHold    http.Handler
+	RetentionMigrate  http.Handler
+	Download          http.Handler
+	Decide            http.Handler
+	Bulk              http.Handler
+	BulkStatus        http.Handler
+	BulkRuns          http.Handler
+	BulkRun           http.Handler
+	CORS              http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -73,10 +83,20 @@ func New(
 			{"Retry", "POST", "/collection/{id}/retry"},
 			{"Workflow", "GET", "/collection/{id}/workflow"},
 			{"StatusHistory", "GET", "/collection/{id}/status-history"},
+			{"Notifications", "GET", "/collection/{id}/notifications"},
+			{"Rescan", "GET", "/collection/rescan"},
+			{"Retention", "GET", "/collection/retention"},
+			{"RetentionPostpone", "POST", "/collection/{id}/retention/postpone"},
+			{"RetentionCancel", "DELETE", "/collection/{id}/retention"},
+			{"SetLegalHold", "POST", "/collection/{id}/legal-hold"},
+			{"ClearLegalHold", "DELETE", "/collection/{id}/legal-hold"},
+			{"RetentionMigrate", "POST", "/collection/retention/migrate"},
 			{"Download", "GET", "/collection/{id}/download"},
 			{"Decide", "POST", "/collection/{id}/decision"},
 			{"Bulk", "POST", "/collection/bulk"},
 			{"BulkStatus", "GET", "/collection/bulk"},
+			{"BulkRuns", "GET", "/collection/bulk/runs"},
+			{"BulkRun", "GET", "/collection/bulk/runs/{id}"},
 			{"CORS", "OPTIONS", "/collection/monitor"},
 			{"CORS", "OPTIONS", "/collection"},
 			{"CORS", "OPTIONS", "/collection/{id}"},
@@ -84,23 +104,42 @@ func New(
 			{"CORS", "OPTIONS", "/collection/{id}/retry"},
 			{"CORS", "OPTIONS", "/collection/{id}/workflow"},
 			{"CORS", "OPTIONS", "/collection/{id}/status-history"},
+			{"CORS", "OPTIONS", "/collection/{id}/notifications"},
+			{"CORS", "OPTIONS", "/collection/rescan"},
+			{"CORS", "OPTIONS", "/collection/retention"},
+			{"CORS", "OPTIONS", "/collection/{id}/retention/postpone"},
+			{"CORS", "OPTIONS", "/collection/{id}/retention"},
+			{"CORS", "OPTIONS", "/collection/{id}/legal-hold"},
+			{"CORS", "OPTIONS", "/collection/retention/migrate"},
 			{"CORS", "OPTIONS", "/collection/{id}/download"},
 			{"CORS", "OPTIONS", "/collection/{id}/decision"},
 			{"CORS", "OPTIONS", "/collection/bulk"},
+			{"CORS", "OPTIONS", "/collection/bulk/runs"},
+			{"CORS", "OPTIONS", "/collection/bulk/runs/{id}"},
 		},
-		Monitor:       NewMonitorHandler(e.Monitor, mux, decoder, encoder, errhandler, formatter),
-		List:          NewListHandler(e.List, mux, decoder, encoder, errhandler, formatter),
-		Show:          NewShowHandler(e.Show, mux, decoder, encoder, errhandler, formatter),
-		Delete:        NewDeleteHandler(e.Delete, mux, decoder, encoder, errhandler, formatter),
-		Cancel:        NewCancelHandler(e.Cancel, mux, decoder, encoder, errhandler, formatter),
-		Retry:         NewRetryHandler(e.Retry, mux, decoder, encoder, errhandler, formatter),
-		Workflow:      NewWorkflowHandler(e.Workflow, mux, decoder, encoder, errhandler, formatter),
-		StatusHistory: NewStatusHistoryHandler(e.StatusHistory, mux, decoder, encoder, errhandler, formatter),
-		Download:      NewDownloadHandler(e.Download, mux, decoder, encoder, errhandler, formatter),
-		Decide:        NewDecideHandler(e.Decide, mux, decoder, encoder, errhandler, formatter),
-		Bulk:          NewBulkHandler(e.Bulk, mux, decoder, encoder, errhandler, formatter),
-		BulkStatus:    NewBulkStatusHandler(e.BulkStatus, mux, decoder, encoder, errhandler, formatter),
-		CORS:          NewCORSHandler(),
+		Monitor:           NewMonitorHandler(e.Monitor, mux, decoder, encoder, errhandler, formatter),
+		List:              NewListHandler(e.List, mux, decoder, encoder, errhandler, formatter),
+		Show:              NewShowHandler(e.Show, mux, decoder, encoder, errhandler, formatter),
+		Delete:            NewDeleteHandler(e.Delete, mux, decoder, encoder, errhandler, formatter),
+		Cancel:            NewCancelHandler(e.Cancel, mux, decoder, encoder, errhandler, formatter),
+		Retry:             NewRetryHandler(e.Retry, mux, decoder, encoder, errhandler, formatter),
+		Workflow:          NewWorkflowHandler(e.Workflow, mux, decoder, encoder, errhandler, formatter),
+		StatusHistory:     NewStatusHistoryHandler(e.StatusHistory, mux, decoder, encoder, errhandler, formatter),
+		Notifications:     NewNotificationsHandler(e.Notifications, mux, decoder, encoder, errhandler, formatter),
+		Rescan:            NewRescanHandler(e.Rescan, mux, decoder, encoder, errhandler, formatter),
+		Retention:         NewRetentionHandler(e.Retention, mux, decoder, encoder, errhandler, formatter),
+		RetentionPostpone: NewRetentionPostponeHandler(e.RetentionPostpone, mux, decoder, encoder, errhandler, formatter),
+		RetentionCancel:   NewRetentionCancelHandler(e.RetentionCancel, mux, decoder, encoder, errhandler, formatter),
+		SetLegalHold:      NewSetLegalHoldHandler(e.SetLegalHold, mux, decoder, encoder, errhandler, formatter),
+		ClearLegalHold:    NewClearLegalHoldHandler(e.ClearLegalHold, mux, decoder, encoder, errhandler, formatter),
+		RetentionMigrate:  NewRetentionMigrateHandler(e.RetentionMigrate, mux, decoder, encoder, errhandler, formatter),
+		Download:          NewDownloadHandler(e.Download, mux, decoder, encoder, errhandler, formatter),
+		Decide:            NewDecideHandler(e.Decide, mux, decoder, encoder, errhandler, formatter),
+		Bulk:              NewBulkHandler(e.Bulk, mux, decoder, encoder, errhandler, formatter),
+		BulkStatus:        NewBulkStatusHandler(e.BulkStatus, mux, decoder, encoder, errhandler, formatter),
+		BulkRuns:          NewBulkRunsHandler(e.BulkRuns, mux, decoder, encoder, errhandler, formatter),
+		BulkRun:           NewBulkRunHandler(e.BulkRun, mux, decoder, encoder, errhandler, formatter),
+		CORS:              NewCORSHandler(),
 	}
 }
 
@@ -117,10 +156,20 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.Retry = m(s.Retry)
 	s.Workflow = m(s.Workflow)
 	s.StatusHistory = m(s.StatusHistory)
+	s.Notifications = m(s.Notifications)
+	s.Rescan = m(s.Rescan)
+	s.Retention = m(s.Retention)
+	s.RetentionPostpone = m(s.RetentionPostpone)
+	s.RetentionCancel = m(s.RetentionCancel)
+	s.SetLegalHold = m(s.SetLegalHold)
+	s.ClearLegalHold = m(s.ClearLegalHold)
+	s.RetentionMigrate = m(s.RetentionMigrate)
 	s.Download = m(s.Download)
 	s.Decide = m(s.Decide)
 	s.Bulk = m(s.Bulk)
 	s.BulkStatus = m(s.BulkStatus)
+	s.BulkRuns = m(s.BulkRuns)
+	s.BulkRun = m(s.BulkRun)
 	s.CORS = m(s.CORS)
 }
 
@@ -137,10 +186,20 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountRetryHandler(mux, h.Retry)
 	MountWorkflowHandler(mux, h.Workflow)
 	MountStatusHistoryHandler(mux, h.StatusHistory)
+	MountNotificationsHandler(mux, h.Notifications)
+	MountRescanHandler(mux, h.Rescan)
+	MountRetentionHandler(mux, h.Retention)
+	MountRetentionPostponeHandler(mux, h.RetentionPostpone)
+	MountRetentionCancelHandler(mux, h.RetentionCancel)
+	MountSetLegalHoldHandler(mux, h.SetLegalHold)
+	MountClearLegalHoldHandler(mux, h.ClearLegalHold)
+	MountRetentionMigrateHandler(mux, h.RetentionMigrate)
 	MountDownloadHandler(mux, h.Download)
 	MountDecideHandler(mux, h.Decide)
 	MountBulkHandler(mux, h.Bulk)
 	MountBulkStatusHandler(mux, h.BulkStatus)
+	MountBulkRunsHandler(mux, h.BulkRuns)
+	MountBulkRunHandler(mux, h.BulkRun)
 	MountCORSHandler(mux, h.CORS)
 }
 
@@ -566,6 +625,423 @@ func NewStatusHistoryHandler(
 	})
 }
 
+// MountNotificationsHandler configures the mux to serve the "collection"
+// service "notifications" endpoint.
+func MountNotificationsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/collection/{id}/notifications", f)
+}
+
+// NewNotificationsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "collection" service "notifications" endpoint.
+func NewNotificationsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeNotificationsRequest(mux, decoder)
+		encodeResponse = EncodeNotificationsResponse(encoder)
+		encodeError    = EncodeNotificationsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "notifications")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "collection")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRescanHandler configures the mux to serve the "collection" service
+// "rescan" endpoint.
+func MountRescanHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/collection/rescan", f)
+}
+
+// NewRescanHandler creates a HTTP handler which loads the HTTP request and
+// calls the "collection" service "rescan" endpoint.
+func NewRescanHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRescanRequest(mux, decoder)
+		encodeResponse = EncodeRescanResponse(encoder)
+		encodeError    = EncodeRescanError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "rescan")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "collection")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRetentionHandler configures the mux to serve the "collection" service
+// "retention" endpoint.
+func MountRetentionHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/collection/retention", f)
+}
+
+// NewRetentionHandler creates a HTTP handler which loads the HTTP request and
+// calls the "collection" service "retention" endpoint.
+func NewRetentionHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRetentionRequest(mux, decoder)
+		encodeResponse = EncodeRetentionResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "retention")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "collection")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRetentionPostponeHandler configures the mux to serve the "collection"
+// service "retention_postpone" endpoint.
+func MountRetentionPostponeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/collection/{id}/retention/postpone", f)
+}
+
+// NewRetentionPostponeHandler creates a HTTP handler which loads the HTTP
+// request and calls the "collection" service "retention_postpone" endpoint.
+func NewRetentionPostponeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRetentionPostponeRequest(mux, decoder)
+		encodeResponse = EncodeRetentionPostponeResponse(encoder)
+		encodeError    = EncodeRetentionPostponeError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "retention_postpone")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "collection")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRetentionCancelHandler configures the mux to serve the "collection"
+// service "retention_cancel" endpoint.
+func MountRetentionCancelHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/collection/{id}/retention", f)
+}
+
+// NewRetentionCancelHandler creates a HTTP handler which loads the HTTP
+// request and calls the "collection" service "retention_cancel" endpoint.
+func NewRetentionCancelHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRetentionCancelRequest(mux, decoder)
+		encodeResponse = EncodeRetentionCancelResponse(encoder)
+		encodeError    = EncodeRetentionCancelError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "retention_cancel")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "collection")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountSetLegalHoldHandler configures the mux to serve the "collection"
+// service "set_legal_hold" endpoint.
+func MountSetLegalHoldHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/collection/{id}/legal-hold", f)
+}
+
+// NewSetLegalHoldHandler creates a HTTP handler which loads the HTTP request
+// and calls the "collection" service "set_legal_hold" endpoint.
+func NewSetLegalHoldHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSetLegalHoldRequest(mux, decoder)
+		encodeResponse = EncodeSetLegalHoldResponse(encoder)
+		encodeError    = EncodeSetLegalHoldError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "set_legal_hold")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "collection")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountClearLegalHoldHandler configures the mux to serve the "collection"
+// service "clear_legal_hold" endpoint.
+func MountClearLegalHoldHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/collection/{id}/legal-hold", f)
+}
+
+// NewClearLegalHoldHandler creates a HTTP handler which loads the HTTP request
+// and calls the "collection" service "clear_legal_hold" endpoint.
+func NewClearLegalHoldHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeClearLegalHoldRequest(mux, decoder)
+		encodeResponse = EncodeClearLegalHoldResponse(encoder)
+		encodeError    = EncodeClearLegalHoldError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "clear_legal_hold")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "collection")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRetentionMigrateHandler configures the mux to serve the "collection"
+// service "retention_migrate" endpoint.
+func MountRetentionMigrateHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/collection/retention/migrate", f)
+}
+
+// NewRetentionMigrateHandler creates a HTTP handler which loads the HTTP
+// request and calls the "collection" service "retention_migrate" endpoint.
+func NewRetentionMigrateHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		encodeResponse = EncodeRetentionMigrateResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "retention_migrate")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "collection")
+		var err error
+		res, err := endpoint(ctx, nil)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountDownloadHandler configures the mux to serve the "collection" service
 // "download" endpoint.
 func MountDownloadHandler(mux goahttp.Muxer, h http.Handler) {
@@ -806,6 +1282,105 @@ func NewBulkStatusHandler(
 	})
 }
 
+// MountBulkRunsHandler configures the mux to serve the "collection" service
+// "bulk_runs" endpoint.
+func MountBulkRunsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/collection/bulk/runs", f)
+}
+
+// NewBulkRunsHandler creates a HTTP handler which loads the HTTP request and
+// calls the "collection" service "bulk_runs" endpoint.
+func NewBulkRunsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		encodeResponse = EncodeBulkRunsResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "bulk_runs")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "collection")
+		var err error
+		res, err := endpoint(ctx, nil)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountBulkRunHandler configures the mux to serve the "collection" service
+// "bulk_run" endpoint.
+func MountBulkRunHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/collection/bulk/runs/{id}", f)
+}
+
+// NewBulkRunHandler creates a HTTP handler which loads the HTTP request and
+// calls the "collection" service "bulk_run" endpoint.
+func NewBulkRunHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeBulkRunRequest(mux, decoder)
+		encodeResponse = EncodeBulkRunResponse(encoder)
+		encodeError    = EncodeBulkRunError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "bulk_run")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "collection")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountCORSHandler configures the mux to serve the CORS endpoints for the
 // service collection.
 func MountCORSHandler(mux goahttp.Muxer, h http.Handler) {
@@ -817,9 +1392,18 @@ func MountCORSHandler(mux goahttp.Muxer, h http.Handler) {
 	mux.Handle("OPTIONS", "/collection/{id}/retry", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/collection/{id}/workflow", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/collection/{id}/status-history", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/collection/{id}/notifications", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/collection/rescan", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/collection/retention", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/collection/{id}/retention/postpone", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/collection/{id}/retention", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/collection/{id}/legal-hold", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/collection/retention/migrate", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/collection/{id}/download", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/collection/{id}/decision", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/collection/bulk", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/collection/bulk/runs", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/collection/bulk/runs/{id}", h.ServeHTTP)
 }
 
 // NewCORSHandler creates a HTTP handler which returns a simple 204 response.
@@ -846,7 +1430,7 @@ func HandleCollectionOrigin(h http.Handler) http.Handler {
 			if acrm := r.Header.Get("Access-Control-Request-Method"); acrm != "" {
 				// We are handling a preflight request
 				w.Header().Set("Access-Control-Allow-Methods", "GET, HEAD, POST, PUT, DELETE, OPTIONS")
-				w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+				w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
 				w.WriteHeader(204)
 				return
 			}
@@ -14,12 +14,45 @@ import (
 	goa "goa.design/goa/v3/pkg"
 )
 
+// RetentionPostponeRequestBody is the type of the "collection" service
+// "retention_postpone" endpoint HTTP request body.
+type RetentionPostponeRequestBody struct {
+	// New due datetime
+	DueAt *string `form:"due_at,omitempty" json:"due_at,omitempty" xml:"due_at,omitempty"`
+}
+
+// SetLegalHoldRequestBody is the type of the "collection" service
+// "set_legal_hold" endpoint HTTP request body.
+type SetLegalHoldRequestBody struct {
+	// Reason of the legal hold
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
 // BulkRequestBody is the type of the "collection" service "bulk" endpoint HTTP
 // request body.
 type BulkRequestBody struct {
 	Operation *string `form:"operation,omitempty" json:"operation,omitempty" xml:"operation,omitempty"`
 	Status    *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
 	Size      *uint   `form:"size,omitempty" json:"size,omitempty" xml:"size,omitempty"`
+	// Decision option of the decide operation
+	Option *string `form:"option,omitempty" json:"option,omitempty" xml:"option,omitempty"`
+	// Prefix of the name of the collections
+	Name       *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	OriginalID *string `form:"original_id,omitempty" json:"original_id,omitempty" xml:"original_id,omitempty"`
+	// Identifier of Archivematica tranfser
+	TransferID *string `form:"transfer_id,omitempty" json:"transfer_id,omitempty" xml:"transfer_id,omitempty"`
+	// Identifier of Archivematica AIP
+	AipID *string `form:"aip_id,omitempty" json:"aip_id,omitempty" xml:"aip_id,omitempty"`
+	// Identifier of Archivematica pipeline
+	PipelineID          *string `form:"pipeline_id,omitempty" json:"pipeline_id,omitempty" xml:"pipeline_id,omitempty"`
+	EarliestCreatedTime *string `form:"earliest_created_time,omitempty" json:"earliest_created_time,omitempty" xml:"earliest_created_time,omitempty"`
+	LatestCreatedTime   *string `form:"latest_created_time,omitempty" json:"latest_created_time,omitempty" xml:"latest_created_time,omitempty"`
+	// Activity that failed in pending collections
+	DecisionActivity *string `form:"decision_activity,omitempty" json:"decision_activity,omitempty" xml:"decision_activity,omitempty"`
+	// Identifiers of the collections, the filters are ignored when given
+	Ids []uint `form:"ids,omitempty" json:"ids,omitempty" xml:"ids,omitempty"`
+	// Count the affected collections without running the operation
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty" xml:"dry_run,omitempty"`
 }
 
 // MonitorResponseBody is the type of the "collection" service "monitor"
@@ -32,6 +65,8 @@ type MonitorResponseBody struct {
 	Type string `form:"type" json:"type" xml:"type"`
 	// Collection
 	Item *EnduroStoredCollectionResponseBody `form:"item,omitempty" json:"item,omitempty" xml:"item,omitempty"`
+	// Progress of the collection, in collection:progress events
+	Progress *EnduroCollectionProgressResponseBody `form:"progress,omitempty" json:"progress,omitempty" xml:"progress,omitempty"`
 }
 
 // ListResponseBody is the type of the "collection" service "list" endpoint
@@ -76,6 +111,24 @@ type ShowResponseBody struct {
 	ReconciliationCheckedAt *string `form:"reconciliation_checked_at,omitempty" json:"reconciliation_checked_at,omitempty" xml:"reconciliation_checked_at,omitempty"`
 	// Last storage reconciliation error
 	ReconciliationError *string `form:"reconciliation_error,omitempty" json:"reconciliation_error,omitempty" xml:"reconciliation_error,omitempty"`
+	// Whether the collection is under legal hold
+	LegalHold bool `form:"legal_hold" json:"legal_hold" xml:"legal_hold"`
+	// Reason of the legal hold
+	LegalHoldReason *string `form:"legal_hold_reason,omitempty" json:"legal_hold_reason,omitempty" xml:"legal_hold_reason,omitempty"`
+	// Person or system that placed the legal hold
+	LegalHoldActor *string `form:"legal_hold_actor,omitempty" json:"legal_hold_actor,omitempty" xml:"legal_hold_actor,omitempty"`
+	// Datetime when the legal hold was placed
+	LegalHoldAt *string `form:"legal_hold_at,omitempty" json:"legal_hold_at,omitempty" xml:"legal_hold_at,omitempty"`
+	// Failure awaiting an operator decision
+	Decision *EnduroCollectionPendingDecisionResponseBody `form:"decision,omitempty" json:"decision,omitempty" xml:"decision,omitempty"`
+	// Results of the transfer validators
+	Validation EnduroCollectionValidationResultResponseBodyCollection `form:"validation,omitempty" json:"validation,omitempty" xml:"validation,omitempty"`
+	// Explanation of the pipeline chosen by the scheduler
+	PipelineSelection *string `form:"pipeline_selection,omitempty" json:"pipeline_selection,omitempty" xml:"pipeline_selection,omitempty"`
+	// Latest progress reported by Archivematica
+	Progress *EnduroCollectionProgressResponseBody `form:"progress,omitempty" json:"progress,omitempty" xml:"progress,omitempty"`
+	// Reason why Archivematica could not process the collection
+	Failure *EnduroCollectionFailureResponseBody `form:"failure,omitempty" json:"failure,omitempty" xml:"failure,omitempty"`
 }
 
 // RetryResponseBody is the type of the "collection" service "retry" endpoint
@@ -100,11 +153,34 @@ type StatusHistoryResponseBody struct {
 	Transitions  EnduroCollectionStatusTransitionResponseBodyCollection `form:"transitions" json:"transitions" xml:"transitions"`
 }
 
+// EnduroCollectionNotificationDeliveryResponseCollection is the type of the
+// "collection" service "notifications" endpoint HTTP response body.
+type EnduroCollectionNotificationDeliveryResponseCollection []*EnduroCollectionNotificationDeliveryResponse
+
+// EnduroCollectionRescanObjectResponseCollection is the type of the
+// "collection" service "rescan" endpoint HTTP response body.
+type EnduroCollectionRescanObjectResponseCollection []*EnduroCollectionRescanObjectResponse
+
+// EnduroCollectionRetentionDeletionResponseCollection is the type of the
+// "collection" service "retention" endpoint HTTP response body.
+type EnduroCollectionRetentionDeletionResponseCollection []*EnduroCollectionRetentionDeletionResponse
+
+// RetentionMigrateResponseBody is the type of the "collection" service
+// "retention_migrate" endpoint HTTP response body.
+type RetentionMigrateResponseBody struct {
+	// Number of running processing workflows signaled
+	Signaled uint `form:"signaled" json:"signaled" xml:"signaled"`
+}
+
 // BulkResponseBody is the type of the "collection" service "bulk" endpoint
 // HTTP response body.
 type BulkResponseBody struct {
-	WorkflowID string `form:"workflow_id" json:"workflow_id" xml:"workflow_id"`
-	RunID      string `form:"run_id" json:"run_id" xml:"run_id"`
+	// Identifier of the bulk operation
+	ID         *uint   `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	WorkflowID *string `form:"workflow_id,omitempty" json:"workflow_id,omitempty" xml:"workflow_id,omitempty"`
+	RunID      *string `form:"run_id,omitempty" json:"run_id,omitempty" xml:"run_id,omitempty"`
+	// Number of collections affected by the operation (dry run)
+	Count *uint `form:"count,omitempty" json:"count,omitempty" xml:"count,omitempty"`
 }
 
 // BulkStatusResponseBody is the type of the "collection" service "bulk_status"
@@ -116,6 +192,34 @@ type BulkStatusResponseBody struct {
 	Status     *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
 	WorkflowID *string `form:"workflow_id,omitempty" json:"workflow_id,omitempty" xml:"workflow_id,omitempty"`
 	RunID      *string `form:"run_id,omitempty" json:"run_id,omitempty" xml:"run_id,omitempty"`
+	// Outcome of the operation on every collection
+	Outcomes []*BulkOutcomeResponseBody `form:"outcomes,omitempty" json:"outcomes,omitempty" xml:"outcomes,omitempty"`
+}
+
+// BulkRunsResponseBody is the type of the "collection" service "bulk_runs"
+// endpoint HTTP response body.
+type BulkRunsResponseBody []*EnduroBulkRunResponse
+
+// BulkRunResponseBody is the type of the "collection" service "bulk_run"
+// endpoint HTTP response body.
+type BulkRunResponseBody struct {
+	// Identifier of the bulk operation
+	ID         uint   `form:"id" json:"id" xml:"id"`
+	WorkflowID string `form:"workflow_id" json:"workflow_id" xml:"workflow_id"`
+	RunID      string `form:"run_id" json:"run_id" xml:"run_id"`
+	Operation  string `form:"operation" json:"operation" xml:"operation"`
+	// Status of the affected collections
+	Status string `form:"status" json:"status" xml:"status"`
+	// Decision option of the decide operation
+	Option *string `form:"option,omitempty" json:"option,omitempty" xml:"option,omitempty"`
+	State  string  `form:"state" json:"state" xml:"state"`
+	// Error that stopped the operation
+	Error       *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	CreatedAt   string  `form:"created_at" json:"created_at" xml:"created_at"`
+	CompletedAt *string `form:"completed_at,omitempty" json:"completed_at,omitempty" xml:"completed_at,omitempty"`
+	// Outcome of the operation on every collection, only included when showing a
+	// single operation
+	Outcomes []*BulkOutcomeResponseBody `form:"outcomes,omitempty" json:"outcomes,omitempty" xml:"outcomes,omitempty"`
 }
 
 // ShowNotFoundResponseBody is the type of the "collection" service "show"
@@ -136,6 +240,24 @@ type DeleteNotFoundResponseBody struct {
 	ID uint `form:"id" json:"id" xml:"id"`
 }
 
+// DeleteLegalHoldResponseBody is the type of the "collection" service "delete"
+// endpoint HTTP response body for the "legal_hold" error.
+type DeleteLegalHoldResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // CancelNotFoundResponseBody is the type of the "collection" service "cancel"
 // endpoint HTTP response body for the "not_found" error.
 type CancelNotFoundResponseBody struct {
@@ -208,6 +330,70 @@ type StatusHistoryNotFoundResponseBody struct {
 	ID uint `form:"id" json:"id" xml:"id"`
 }
 
+// NotificationsNotFoundResponseBody is the type of the "collection" service
+// "notifications" endpoint HTTP response body for the "not_found" error.
+type NotificationsNotFoundResponseBody struct {
+	// Message of error
+	Message string `form:"message" json:"message" xml:"message"`
+	// Identifier of missing collection
+	ID uint `form:"id" json:"id" xml:"id"`
+}
+
+// RescanNotValidResponseBody is the type of the "collection" service "rescan"
+// endpoint HTTP response body for the "not_valid" error.
+type RescanNotValidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RetentionPostponeNotFoundResponseBody is the type of the "collection"
+// service "retention_postpone" endpoint HTTP response body for the "not_found"
+// error.
+type RetentionPostponeNotFoundResponseBody struct {
+	// Message of error
+	Message string `form:"message" json:"message" xml:"message"`
+	// Identifier of missing collection
+	ID uint `form:"id" json:"id" xml:"id"`
+}
+
+// RetentionCancelNotFoundResponseBody is the type of the "collection" service
+// "retention_cancel" endpoint HTTP response body for the "not_found" error.
+type RetentionCancelNotFoundResponseBody struct {
+	// Message of error
+	Message string `form:"message" json:"message" xml:"message"`
+	// Identifier of missing collection
+	ID uint `form:"id" json:"id" xml:"id"`
+}
+
+// SetLegalHoldNotFoundResponseBody is the type of the "collection" service
+// "set_legal_hold" endpoint HTTP response body for the "not_found" error.
+type SetLegalHoldNotFoundResponseBody struct {
+	// Message of error
+	Message string `form:"message" json:"message" xml:"message"`
+	// Identifier of missing collection
+	ID uint `form:"id" json:"id" xml:"id"`
+}
+
+// ClearLegalHoldNotFoundResponseBody is the type of the "collection" service
+// "clear_legal_hold" endpoint HTTP response body for the "not_found" error.
+type ClearLegalHoldNotFoundResponseBody struct {
+	// Message of error
+	Message string `form:"message" json:"message" xml:"message"`
+	// Identifier of missing collection
+	ID uint `form:"id" json:"id" xml:"id"`
+}
+
 // DownloadNotFoundResponseBody is the type of the "collection" service
 // "download" endpoint HTTP response body for the "not_found" error.
 type DownloadNotFoundResponseBody struct {
@@ -280,6 +466,15 @@ type BulkNotValidResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// BulkRunNotFoundResponseBody is the type of the "collection" service
+// "bulk_run" endpoint HTTP response body for the "not_found" error.
+type BulkRunNotFoundResponseBody struct {
+	// Message of error
+	Message string `form:"message" json:"message" xml:"message"`
+	// Identifier of missing bulk operation
+	ID uint `form:"id" json:"id" xml:"id"`
+}
+
 // EnduroStoredCollectionResponseBody is used to define fields on response body
 // types.
 type EnduroStoredCollectionResponseBody struct {
@@ -309,10 +504,93 @@ type EnduroStoredCollectionResponseBody struct {
 	CompletedAt *string `form:"completed_at,omitempty" json:"completed_at,omitempty" xml:"completed_at,omitempty"`
 }
 
+// EnduroCollectionProgressResponseBody is used to define fields on response
+// body types.
+type EnduroCollectionProgressResponseBody struct {
+	// Processing stage
+	Stage string `form:"stage" json:"stage" xml:"stage"`
+	// Microservice of the current job
+	Microservice *string `form:"microservice,omitempty" json:"microservice,omitempty" xml:"microservice,omitempty"`
+	// Name of the current job
+	Job *string `form:"job,omitempty" json:"job,omitempty" xml:"job,omitempty"`
+	// Number of jobs completed
+	JobsCompleted uint `form:"jobs_completed" json:"jobs_completed" xml:"jobs_completed"`
+	// Number of jobs run so far
+	JobsTotal uint `form:"jobs_total" json:"jobs_total" xml:"jobs_total"`
+	// Percentage of the jobs run so far that completed
+	Percent uint `form:"percent" json:"percent" xml:"percent"`
+	// Jobs that failed
+	FailedJobs []*EnduroCollectionFailedJobResponseBody `form:"failed_jobs,omitempty" json:"failed_jobs,omitempty" xml:"failed_jobs,omitempty"`
+	// Datetime of the snapshot
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
+// EnduroCollectionFailedJobResponseBody is used to define fields on response
+// body types.
+type EnduroCollectionFailedJobResponseBody struct {
+	// Identifier of the job
+	ID string `form:"id" json:"id" xml:"id"`
+	// Name of the job
+	Name string `form:"name" json:"name" xml:"name"`
+	// Microservice of the job
+	Microservice string `form:"microservice" json:"microservice" xml:"microservice"`
+}
+
 // EnduroStoredCollectionCollectionResponseBody is used to define fields on
 // response body types.
 type EnduroStoredCollectionCollectionResponseBody []*EnduroStoredCollectionResponseBody
 
+// EnduroCollectionPendingDecisionResponseBody is used to define fields on
+// response body types.
+type EnduroCollectionPendingDecisionResponseBody struct {
+	// Name of the failed activity
+	Activity string `form:"activity" json:"activity" xml:"activity"`
+	// Error returned by the failed activity
+	Error string `form:"error" json:"error" xml:"error"`
+	// Decision options accepted by the workflow
+	Options []string `form:"options" json:"options" xml:"options"`
+}
+
+// EnduroCollectionValidationResultResponseBodyCollection is used to define
+// fields on response body types.
+type EnduroCollectionValidationResultResponseBodyCollection []*EnduroCollectionValidationResultResponseBody
+
+// EnduroCollectionValidationResultResponseBody is used to define fields on
+// response body types.
+type EnduroCollectionValidationResultResponseBody struct {
+	// Name of the validator
+	Validator string `form:"validator" json:"validator" xml:"validator"`
+	// Severity of the validator
+	Severity string `form:"severity" json:"severity" xml:"severity"`
+	// Outcome of the validator
+	Status string `form:"status" json:"status" xml:"status"`
+	// Description of the problems found
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Validation datetime
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// EnduroCollectionFailureResponseBody is used to define fields on response
+// body types.
+type EnduroCollectionFailureResponseBody struct {
+	// Category of the failure
+	Category string `form:"category" json:"category" xml:"category"`
+	// Processing stage
+	Stage string `form:"stage" json:"stage" xml:"stage"`
+	// Identifier of the Archivematica transfer or SIP
+	UnitID string `form:"unit_id" json:"unit_id" xml:"unit_id"`
+	// Microservice of the failed job
+	Microservice *string `form:"microservice,omitempty" json:"microservice,omitempty" xml:"microservice,omitempty"`
+	// Name of the failed job
+	Job *string `form:"job,omitempty" json:"job,omitempty" xml:"job,omitempty"`
+	// Excerpt of the standard error of the failed job
+	Stderr *string `form:"stderr,omitempty" json:"stderr,omitempty" xml:"stderr,omitempty"`
+	// Chain of errors seen by Enduro, outermost first
+	Errors []string `form:"errors" json:"errors" xml:"errors"`
+	// Datetime of the failure
+	OccurredAt string `form:"occurred_at" json:"occurred_at" xml:"occurred_at"`
+}
+
 // EnduroCollectionWorkflowHistoryResponseBodyCollection is used to define
 // fields on response body types.
 type EnduroCollectionWorkflowHistoryResponseBodyCollection []*EnduroCollectionWorkflowHistoryResponseBody
@@ -349,8 +627,112 @@ type EnduroCollectionStatusTransitionResponseBody struct {
 	OccurredAt string `form:"occurred_at" json:"occurred_at" xml:"occurred_at"`
 	// Whether the transition starts a fully recorded workflow run
 	IsRunStart bool `form:"is_run_start" json:"is_run_start" xml:"is_run_start"`
-	// Machine-readable reason for the transition
+	// Machine-readable reason for the transition, e.g. workflow_retried or
+	// deadline_exceeded
 	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Person or system that caused the transition: system, api or api:<user>,
+	// bulk:<run> or watcher:<name>
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty" xml:"actor,omitempty"`
+	// Free-form detail provided with the transition
+	Detail *string `form:"detail,omitempty" json:"detail,omitempty" xml:"detail,omitempty"`
+}
+
+// EnduroCollectionNotificationDeliveryResponse is used to define fields on
+// response body types.
+type EnduroCollectionNotificationDeliveryResponse struct {
+	// Identifier of the delivery
+	ID uint64 `form:"id" json:"id" xml:"id"`
+	// Name of the webhook
+	Webhook string `form:"webhook" json:"webhook" xml:"webhook"`
+	// Name of the event
+	Event string `form:"event" json:"event" xml:"event"`
+	// Status of the delivery
+	Status string `form:"status" json:"status" xml:"status"`
+	// Number of delivery attempts
+	Attempts int `form:"attempts" json:"attempts" xml:"attempts"`
+	// HTTP status code of the last response
+	ResponseCode *int `form:"response_code,omitempty" json:"response_code,omitempty" xml:"response_code,omitempty"`
+	// Error of the last attempt
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Creation datetime
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Datetime of the last update
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
+// EnduroCollectionRescanObjectResponse is used to define fields on response
+// body types.
+type EnduroCollectionRescanObjectResponse struct {
+	// Name of the watcher
+	Watcher string `form:"watcher" json:"watcher" xml:"watcher"`
+	// Key of the blob
+	Key string `form:"key" json:"key" xml:"key"`
+	// Name of the bucket, when known
+	Bucket *string `form:"bucket,omitempty" json:"bucket,omitempty" xml:"bucket,omitempty"`
+	// Whether the blob is a directory
+	IsDir bool `form:"is_dir" json:"is_dir" xml:"is_dir"`
+}
+
+// EnduroCollectionRetentionDeletionResponse is used to define fields on
+// response body types.
+type EnduroCollectionRetentionDeletionResponse struct {
+	// Identifier of the collection
+	CollectionID uint `form:"collection_id" json:"collection_id" xml:"collection_id"`
+	// Name of the watcher
+	Watcher string `form:"watcher" json:"watcher" xml:"watcher"`
+	// Key of the original
+	Key string `form:"key" json:"key" xml:"key"`
+	// Status of the deletion
+	Status string `form:"status" json:"status" xml:"status"`
+	// Due datetime
+	DueAt string `form:"due_at" json:"due_at" xml:"due_at"`
+	// Number of deletion attempts
+	Attempts int `form:"attempts" json:"attempts" xml:"attempts"`
+	// Error of the last attempt
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Creation datetime
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Datetime of the last update
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
+// BulkOutcomeResponseBody is used to define fields on response body types.
+type BulkOutcomeResponseBody struct {
+	// Identifier of the collection
+	ID      uint   `form:"id" json:"id" xml:"id"`
+	Outcome string `form:"outcome" json:"outcome" xml:"outcome"`
+	// Why the operation failed or skipped the collection
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// EnduroBulkRunResponse is used to define fields on response body types.
+type EnduroBulkRunResponse struct {
+	// Identifier of the bulk operation
+	ID         uint   `form:"id" json:"id" xml:"id"`
+	WorkflowID string `form:"workflow_id" json:"workflow_id" xml:"workflow_id"`
+	RunID      string `form:"run_id" json:"run_id" xml:"run_id"`
+	Operation  string `form:"operation" json:"operation" xml:"operation"`
+	// Status of the affected collections
+	Status string `form:"status" json:"status" xml:"status"`
+	// Decision option of the decide operation
+	Option *string `form:"option,omitempty" json:"option,omitempty" xml:"option,omitempty"`
+	State  string  `form:"state" json:"state" xml:"state"`
+	// Error that stopped the operation
+	Error       *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	CreatedAt   string  `form:"created_at" json:"created_at" xml:"created_at"`
+	CompletedAt *string `form:"completed_at,omitempty" json:"completed_at,omitempty" xml:"completed_at,omitempty"`
+	// Outcome of the operation on every collection, only included when showing a
+	// single operation
+	Outcomes []*BulkOutcomeResponse `form:"outcomes,omitempty" json:"outcomes,omitempty" xml:"outcomes,omitempty"`
+}
+
+// BulkOutcomeResponse is used to define fields on response body types.
+type BulkOutcomeResponse struct {
+	// Identifier of the collection
+	ID      uint   `form:"id" json:"id" xml:"id"`
+	Outcome string `form:"outcome" json:"outcome" xml:"outcome"`
+	// Why the operation failed or skipped the collection
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
 }
 
 // NewMonitorResponseBody builds the HTTP response body from the result of the
@@ -364,6 +746,9 @@ func NewMonitorResponseBody(res *collection.EnduroMonitorUpdate) *MonitorRespons
 	if res.Item != nil {
 		body.Item = marshalCollectionEnduroStoredCollectionToEnduroStoredCollectionResponseBody(res.Item)
 	}
+	if res.Progress != nil {
+		body.Progress = marshalCollectionEnduroCollectionProgressToEnduroCollectionProgressResponseBody(res.Progress)
+	}
 	return body
 }
 
@@ -408,6 +793,30 @@ func NewShowResponseBody(res *collectionviews.EnduroDetailedStoredCollectionView
 		ReconciliationStatus:    res.ReconciliationStatus,
 		ReconciliationCheckedAt: res.ReconciliationCheckedAt,
 		ReconciliationError:     res.ReconciliationError,
+		LegalHold:               *res.LegalHold,
+		LegalHoldReason:         res.LegalHoldReason,
+		LegalHoldActor:          res.LegalHoldActor,
+		LegalHoldAt:             res.LegalHoldAt,
+		PipelineSelection:       res.PipelineSelection,
+	}
+	if res.Decision != nil {
+		body.Decision = marshalCollectionviewsEnduroCollectionPendingDecisionViewToEnduroCollectionPendingDecisionResponseBody(res.Decision)
+	}
+	if res.Validation != nil {
+		body.Validation = make([]*EnduroCollectionValidationResultResponseBody, len(res.Validation))
+		for i, val := range res.Validation {
+			if val == nil {
+				body.Validation[i] = nil
+				continue
+			}
+			body.Validation[i] = marshalCollectionviewsEnduroCollectionValidationResultViewToEnduroCollectionValidationResultResponseBody(val)
+		}
+	}
+	if res.Progress != nil {
+		body.Progress = marshalCollectionviewsEnduroCollectionProgressViewToEnduroCollectionProgressResponseBody(res.Progress)
+	}
+	if res.Failure != nil {
+		body.Failure = marshalCollectionviewsEnduroCollectionFailureViewToEnduroCollectionFailureResponseBody(res.Failure)
 	}
 	return body
 }
@@ -461,12 +870,67 @@ func NewStatusHistoryResponseBody(res *collectionviews.EnduroCollectionStatusHis
 	return body
 }
 
+// NewEnduroCollectionNotificationDeliveryResponseCollection builds the HTTP
+// response body from the result of the "notifications" endpoint of the
+// "collection" service.
+func NewEnduroCollectionNotificationDeliveryResponseCollection(res collectionviews.EnduroCollectionNotificationDeliveryCollectionView) EnduroCollectionNotificationDeliveryResponseCollection {
+	body := make([]*EnduroCollectionNotificationDeliveryResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalCollectionviewsEnduroCollectionNotificationDeliveryViewToEnduroCollectionNotificationDeliveryResponse(val)
+	}
+	return body
+}
+
+// NewEnduroCollectionRescanObjectResponseCollection builds the HTTP response
+// body from the result of the "rescan" endpoint of the "collection" service.
+func NewEnduroCollectionRescanObjectResponseCollection(res collectionviews.EnduroCollectionRescanObjectCollectionView) EnduroCollectionRescanObjectResponseCollection {
+	body := make([]*EnduroCollectionRescanObjectResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalCollectionviewsEnduroCollectionRescanObjectViewToEnduroCollectionRescanObjectResponse(val)
+	}
+	return body
+}
+
+// NewEnduroCollectionRetentionDeletionResponseCollection builds the HTTP
+// response body from the result of the "retention" endpoint of the
+// "collection" service.
+func NewEnduroCollectionRetentionDeletionResponseCollection(res collectionviews.EnduroCollectionRetentionDeletionCollectionView) EnduroCollectionRetentionDeletionResponseCollection {
+	body := make([]*EnduroCollectionRetentionDeletionResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalCollectionviewsEnduroCollectionRetentionDeletionViewToEnduroCollectionRetentionDeletionResponse(val)
+	}
+	return body
+}
+
+// NewRetentionMigrateResponseBody builds the HTTP response body from the
+// result of the "retention_migrate" endpoint of the "collection" service.
+func NewRetentionMigrateResponseBody(res *collection.RetentionMigrateResult) *RetentionMigrateResponseBody {
+	body := &RetentionMigrateResponseBody{
+		Signaled: res.Signaled,
+	}
+	return body
+}
+
 // NewBulkResponseBody builds the HTTP response body from the result of the
 // "bulk" endpoint of the "collection" service.
 func NewBulkResponseBody(res *collection.BulkResult) *BulkResponseBody {
 	body := &BulkResponseBody{
+		ID:         res.ID,
 		WorkflowID: res.WorkflowID,
 		RunID:      res.RunID,
+		Count:      res.Count,
 	}
 	return body
 }
@@ -482,6 +946,58 @@ func NewBulkStatusResponseBody(res *collection.BulkStatusResult) *BulkStatusResp
 		WorkflowID: res.WorkflowID,
 		RunID:      res.RunID,
 	}
+	if res.Outcomes != nil {
+		body.Outcomes = make([]*BulkOutcomeResponseBody, len(res.Outcomes))
+		for i, val := range res.Outcomes {
+			if val == nil {
+				body.Outcomes[i] = nil
+				continue
+			}
+			body.Outcomes[i] = marshalCollectionBulkOutcomeToBulkOutcomeResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewBulkRunsResponseBody builds the HTTP response body from the result of the
+// "bulk_runs" endpoint of the "collection" service.
+func NewBulkRunsResponseBody(res []*collection.EnduroBulkRun) BulkRunsResponseBody {
+	body := make([]*EnduroBulkRunResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalCollectionEnduroBulkRunToEnduroBulkRunResponse(val)
+	}
+	return body
+}
+
+// NewBulkRunResponseBody builds the HTTP response body from the result of the
+// "bulk_run" endpoint of the "collection" service.
+func NewBulkRunResponseBody(res *collection.EnduroBulkRun) *BulkRunResponseBody {
+	body := &BulkRunResponseBody{
+		ID:          res.ID,
+		WorkflowID:  res.WorkflowID,
+		RunID:       res.RunID,
+		Operation:   res.Operation,
+		Status:      res.Status,
+		Option:      res.Option,
+		State:       res.State,
+		Error:       res.Error,
+		CreatedAt:   res.CreatedAt,
+		CompletedAt: res.CompletedAt,
+	}
+	if res.Outcomes != nil {
+		body.Outcomes = make([]*BulkOutcomeResponseBody, len(res.Outcomes))
+		for i, val := range res.Outcomes {
+			if val == nil {
+				body.Outcomes[i] = nil
+				continue
+			}
+			body.Outcomes[i] = marshalCollectionBulkOutcomeToBulkOutcomeResponseBody(val)
+		}
+	}
 	return body
 }
 
@@ -505,6 +1021,20 @@ func NewDeleteNotFoundResponseBody(res *collection.CollectionNotfound) *DeleteNo
 	return body
 }
 
+// NewDeleteLegalHoldResponseBody builds the HTTP response body from the result
+// of the "delete" endpoint of the "collection" service.
+func NewDeleteLegalHoldResponseBody(res *goa.ServiceError) *DeleteLegalHoldResponseBody {
+	body := &DeleteLegalHoldResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
 // NewCancelNotFoundResponseBody builds the HTTP response body from the result
 // of the "cancel" endpoint of the "collection" service.
 func NewCancelNotFoundResponseBody(res *collection.CollectionNotfound) *CancelNotFoundResponseBody {
@@ -573,6 +1103,70 @@ func NewStatusHistoryNotFoundResponseBody(res *collection.CollectionNotfound) *S
 	return body
 }
 
+// NewNotificationsNotFoundResponseBody builds the HTTP response body from the
+// result of the "notifications" endpoint of the "collection" service.
+func NewNotificationsNotFoundResponseBody(res *collection.CollectionNotfound) *NotificationsNotFoundResponseBody {
+	body := &NotificationsNotFoundResponseBody{
+		Message: res.Message,
+		ID:      res.ID,
+	}
+	return body
+}
+
+// NewRescanNotValidResponseBody builds the HTTP response body from the result
+// of the "rescan" endpoint of the "collection" service.
+func NewRescanNotValidResponseBody(res *goa.ServiceError) *RescanNotValidResponseBody {
+	body := &RescanNotValidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRetentionPostponeNotFoundResponseBody builds the HTTP response body from
+// the result of the "retention_postpone" endpoint of the "collection" service.
+func NewRetentionPostponeNotFoundResponseBody(res *collection.CollectionNotfound) *RetentionPostponeNotFoundResponseBody {
+	body := &RetentionPostponeNotFoundResponseBody{
+		Message: res.Message,
+		ID:      res.ID,
+	}
+	return body
+}
+
+// NewRetentionCancelNotFoundResponseBody builds the HTTP response body from
+// the result of the "retention_cancel" endpoint of the "collection" service.
+func NewRetentionCancelNotFoundResponseBody(res *collection.CollectionNotfound) *RetentionCancelNotFoundResponseBody {
+	body := &RetentionCancelNotFoundResponseBody{
+		Message: res.Message,
+		ID:      res.ID,
+	}
+	return body
+}
+
+// NewSetLegalHoldNotFoundResponseBody builds the HTTP response body from the
+// result of the "set_legal_hold" endpoint of the "collection" service.
+func NewSetLegalHoldNotFoundResponseBody(res *collection.CollectionNotfound) *SetLegalHoldNotFoundResponseBody {
+	body := &SetLegalHoldNotFoundResponseBody{
+		Message: res.Message,
+		ID:      res.ID,
+	}
+	return body
+}
+
+// NewClearLegalHoldNotFoundResponseBody builds the HTTP response body from the
+// result of the "clear_legal_hold" endpoint of the "collection" service.
+func NewClearLegalHoldNotFoundResponseBody(res *collection.CollectionNotfound) *ClearLegalHoldNotFoundResponseBody {
+	body := &ClearLegalHoldNotFoundResponseBody{
+		Message: res.Message,
+		ID:      res.ID,
+	}
+	return body
+}
+
 // NewDownloadNotFoundResponseBody builds the HTTP response body from the
 // result of the "download" endpoint of the "collection" service.
 func NewDownloadNotFoundResponseBody(res *collection.CollectionNotfound) *DownloadNotFoundResponseBody {
@@ -635,8 +1229,18 @@ func NewBulkNotValidResponseBody(res *goa.ServiceError) *BulkNotValidResponseBod
 	return body
 }
 
+// NewBulkRunNotFoundResponseBody builds the HTTP response body from the result
+// of the "bulk_run" endpoint of the "collection" service.
+func NewBulkRunNotFoundResponseBody(res *collection.BulkRunNotFound) *BulkRunNotFoundResponseBody {
+	body := &BulkRunNotFoundResponseBody{
+		Message: res.Message,
+		ID:      res.ID,
+	}
+	return body
+}
+
 // NewListPayload builds a collection service list endpoint payload.
-func NewListPayload(name *string, originalID *string, transferID *string, aipID *string, pipelineID *string, earliestCreatedTime *string, latestCreatedTime *string, status *string, cursor *string) *collection.ListPayload {
+func NewListPayload(name *string, originalID *string, transferID *string, aipID *string, pipelineID *string, earliestCreatedTime *string, latestCreatedTime *string, status *string, decisionActivity *string, failureCategory *string, cursor *string) *collection.ListPayload {
 	v := &collection.ListPayload{}
 	v.Name = name
 	v.OriginalID = originalID
@@ -646,6 +1250,8 @@ func NewListPayload(name *string, originalID *string, transferID *string, aipID
 	v.EarliestCreatedTime = earliestCreatedTime
 	v.LatestCreatedTime = latestCreatedTime
 	v.Status = status
+	v.DecisionActivity = decisionActivity
+	v.FailureCategory = failureCategory
 	v.Cursor = cursor
 
 	return v
@@ -700,6 +1306,72 @@ func NewStatusHistoryPayload(id uint) *collection.StatusHistoryPayload {
 	return v
 }
 
+// NewNotificationsPayload builds a collection service notifications endpoint
+// payload.
+func NewNotificationsPayload(id uint) *collection.NotificationsPayload {
+	v := &collection.NotificationsPayload{}
+	v.ID = id
+
+	return v
+}
+
+// NewRescanPayload builds a collection service rescan endpoint payload.
+func NewRescanPayload(watcher string) *collection.RescanPayload {
+	v := &collection.RescanPayload{}
+	v.Watcher = watcher
+
+	return v
+}
+
+// NewRetentionPayload builds a collection service retention endpoint payload.
+func NewRetentionPayload(status *string) *collection.RetentionPayload {
+	v := &collection.RetentionPayload{}
+	v.Status = status
+
+	return v
+}
+
+// NewRetentionPostponePayload builds a collection service retention_postpone
+// endpoint payload.
+func NewRetentionPostponePayload(body *RetentionPostponeRequestBody, id uint) *collection.RetentionPostponePayload {
+	v := &collection.RetentionPostponePayload{
+		DueAt: *body.DueAt,
+	}
+	v.ID = id
+
+	return v
+}
+
+// NewRetentionCancelPayload builds a collection service retention_cancel
+// endpoint payload.
+func NewRetentionCancelPayload(id uint) *collection.RetentionCancelPayload {
+	v := &collection.RetentionCancelPayload{}
+	v.ID = id
+
+	return v
+}
+
+// NewSetLegalHoldPayload builds a collection service set_legal_hold endpoint
+// payload.
+func NewSetLegalHoldPayload(body *SetLegalHoldRequestBody, id uint) *collection.SetLegalHoldPayload {
+	v := &collection.SetLegalHoldPayload{
+		Reason: *body.Reason,
+	}
+	v.ID = id
+
+	return v
+}
+
+// NewClearLegalHoldPayload builds a collection service clear_legal_hold
+// endpoint payload.
+func NewClearLegalHoldPayload(id uint, reason string) *collection.ClearLegalHoldPayload {
+	v := &collection.ClearLegalHoldPayload{}
+	v.ID = id
+	v.Reason = reason
+
+	return v
+}
+
 // NewDownloadPayload builds a collection service download endpoint payload.
 func NewDownloadPayload(id uint) *collection.DownloadPayload {
 	v := &collection.DownloadPayload{}
@@ -725,19 +1397,69 @@ func NewDecidePayload(body struct {
 // NewBulkPayload builds a collection service bulk endpoint payload.
 func NewBulkPayload(body *BulkRequestBody) *collection.BulkPayload {
 	v := &collection.BulkPayload{
-		Operation: *body.Operation,
-		Status:    *body.Status,
+		Operation:           *body.Operation,
+		Status:              *body.Status,
+		Option:              body.Option,
+		Name:                body.Name,
+		OriginalID:          body.OriginalID,
+		TransferID:          body.TransferID,
+		AipID:               body.AipID,
+		PipelineID:          body.PipelineID,
+		EarliestCreatedTime: body.EarliestCreatedTime,
+		LatestCreatedTime:   body.LatestCreatedTime,
+		DecisionActivity:    body.DecisionActivity,
 	}
 	if body.Size != nil {
 		v.Size = *body.Size
 	}
+	if body.DryRun != nil {
+		v.DryRun = *body.DryRun
+	}
 	if body.Size == nil {
 		v.Size = 100
 	}
+	if body.Ids != nil {
+		v.Ids = make([]uint, len(body.Ids))
+		for i, val := range body.Ids {
+			v.Ids[i] = val
+		}
+	}
+	if body.DryRun == nil {
+		v.DryRun = false
+	}
+
+	return v
+}
+
+// NewBulkRunPayload builds a collection service bulk_run endpoint payload.
+func NewBulkRunPayload(id uint) *collection.BulkRunPayload {
+	v := &collection.BulkRunPayload{}
+	v.ID = id
 
 	return v
 }
 
+// ValidateRetentionPostponeRequestBody runs the validations defined on
+// retention_postpone_request_body
+func ValidateRetentionPostponeRequestBody(body *RetentionPostponeRequestBody) (err error) {
+	if body.DueAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("due_at", "body"))
+	}
+	if body.DueAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.due_at", *body.DueAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateSetLegalHoldRequestBody runs the validations defined on
+// set_legal_hold_request_body
+func ValidateSetLegalHoldRequestBody(body *SetLegalHoldRequestBody) (err error) {
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	return
+}
+
 // ValidateBulkRequestBody runs the validations defined on BulkRequestBody
 func ValidateBulkRequestBody(body *BulkRequestBody) (err error) {
 	if body.Operation == nil {
@@ -747,8 +1469,8 @@ func ValidateBulkRequestBody(body *BulkRequestBody) (err error) {
 		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
 	}
 	if body.Operation != nil {
-		if !(*body.Operation == "retry" || *body.Operation == "cancel" || *body.Operation == "abandon") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.operation", *body.Operation, []any{"retry", "cancel", "abandon"}))
+		if !(*body.Operation == "retry" || *body.Operation == "cancel" || *body.Operation == "abandon" || *body.Operation == "decide") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.operation", *body.Operation, []any{"retry", "cancel", "abandon", "decide"}))
 		}
 	}
 	if body.Status != nil {
@@ -756,5 +1478,25 @@ func ValidateBulkRequestBody(body *BulkRequestBody) (err error) {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"new", "in progress", "done", "error", "unknown", "queued", "pending", "abandoned"}))
 		}
 	}
+	if body.Option != nil {
+		if !(*body.Option == "RETRY" || *body.Option == "RETRY_ONCE" || *body.Option == "ABANDON" || *body.Option == "SKIP") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.option", *body.Option, []any{"RETRY", "RETRY_ONCE", "ABANDON", "SKIP"}))
+		}
+	}
+	if body.TransferID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.transfer_id", *body.TransferID, goa.FormatUUID))
+	}
+	if body.AipID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.aip_id", *body.AipID, goa.FormatUUID))
+	}
+	if body.PipelineID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.pipeline_id", *body.PipelineID, goa.FormatUUID))
+	}
+	if body.EarliestCreatedTime != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.earliest_created_time", *body.EarliestCreatedTime, goa.FormatDateTime))
+	}
+	if body.LatestCreatedTime != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.latest_created_time", *body.LatestCreatedTime, goa.FormatDateTime))
+	}
 	return
 }
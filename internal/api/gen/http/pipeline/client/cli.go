@@ -60,6 +60,104 @@ func BuildShowPayload(pipelineShowID string) (*pipeline.ShowPayload, error) {
 	return v, nil
 }
 
+// BuildRoutePayload builds the payload for the pipeline route endpoint from
+// CLI flags.
+func BuildRoutePayload(pipelineRouteKey string, pipelineRouteWatcher string, pipelineRouteTransferType string, pipelineRouteSize string) (*pipeline.RoutePayload, error) {
+	var key string
+	{
+		key = pipelineRouteKey
+	}
+	var watcher *string
+	{
+		if pipelineRouteWatcher != "" {
+			watcher = &pipelineRouteWatcher
+		}
+	}
+	var transferType string
+	{
+		if pipelineRouteTransferType != "" {
+			transferType = pipelineRouteTransferType
+		}
+	}
+	var size *int64
+	{
+		if pipelineRouteSize != "" {
+			val, err := strconv.ParseInt(pipelineRouteSize, 10, 64)
+			size = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for size, must be INT64")
+			}
+			if *size < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("size", *size, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &pipeline.RoutePayload{}
+	v.Key = key
+	v.Watcher = watcher
+	v.TransferType = transferType
+	v.Size = size
+
+	return v, nil
+}
+
+// BuildPausePayload builds the payload for the pipeline pause endpoint from
+// CLI flags.
+func BuildPausePayload(pipelinePauseID string) (*pipeline.PausePayload, error) {
+	var err error
+	var id string
+	{
+		id = pipelinePauseID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &pipeline.PausePayload{}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildDrainPayload builds the payload for the pipeline drain endpoint from
+// CLI flags.
+func BuildDrainPayload(pipelineDrainID string) (*pipeline.DrainPayload, error) {
+	var err error
+	var id string
+	{
+		id = pipelineDrainID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &pipeline.DrainPayload{}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildResumePayload builds the payload for the pipeline resume endpoint from
+// CLI flags.
+func BuildResumePayload(pipelineResumeID string) (*pipeline.ResumePayload, error) {
+	var err error
+	var id string
+	{
+		id = pipelineResumeID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &pipeline.ResumePayload{}
+	v.ID = id
+
+	return v, nil
+}
+
 // BuildProcessingPayload builds the payload for the pipeline processing
 // endpoint from CLI flags.
 func BuildProcessingPayload(pipelineProcessingID string) (*pipeline.ProcessingPayload, error) {
@@ -24,6 +24,18 @@ type Client struct {
 	// Show Doer is the HTTP client used to make requests to the show endpoint.
 	ShowDoer goahttp.Doer
 
+	// Route Doer is the HTTP client used to make requests to the route endpoint.
+	RouteDoer goahttp.Doer
+
+	// Pause Doer is the HTTP client used to make requests to the pause endpoint.
+	PauseDoer goahttp.Doer
+
+	// Drain Doer is the HTTP client used to make requests to the drain endpoint.
+	DrainDoer goahttp.Doer
+
+	// Resume Doer is the HTTP client used to make requests to the resume endpoint.
+	ResumeDoer goahttp.Doer
+
 	// Processing Doer is the HTTP client used to make requests to the processing
 	// endpoint.
 	ProcessingDoer goahttp.Doer
@@ -53,6 +65,10 @@ func NewClient(
 	return &Client{
 		ListDoer:            doer,
 		ShowDoer:            doer,
+		RouteDoer:           doer,
+		PauseDoer:           doer,
+		DrainDoer:           doer,
+		ResumeDoer:          doer,
 		ProcessingDoer:      doer,
 		CORSDoer:            doer,
 		RestoreResponseBody: restoreBody,
@@ -106,6 +122,87 @@ func (c *Client) Show() goa.Endpoint {
 	}
 }
 
+// Route returns an endpoint that makes HTTP requests to the pipeline service
+// route server.
+func (c *Client) Route() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRouteRequest(c.encoder)
+		decodeResponse = DecodeRouteResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRouteRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RouteDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("pipeline", "route", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Pause returns an endpoint that makes HTTP requests to the pipeline service
+// pause server.
+func (c *Client) Pause() goa.Endpoint {
+	var (
+		decodeResponse = DecodePauseResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPauseRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PauseDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("pipeline", "pause", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Drain returns an endpoint that makes HTTP requests to the pipeline service
+// drain server.
+func (c *Client) Drain() goa.Endpoint {
+	var (
+		decodeResponse = DecodeDrainResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDrainRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DrainDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("pipeline", "drain", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Resume returns an endpoint that makes HTTP requests to the pipeline service
+// resume server.
+func (c *Client) Resume() goa.Endpoint {
+	var (
+		decodeResponse = DecodeResumeResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildResumeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ResumeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("pipeline", "resume", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // Processing returns an endpoint that makes HTTP requests to the pipeline
 // service processing server.
 func (c *Client) Processing() goa.Endpoint {
@@ -185,6 +185,335 @@ func DecodeShowResponse(decoder func(*http.Response) goahttp.Decoder, restoreBod
 	}
 }
 
+// BuildRouteRequest instantiates a HTTP request object with method and path
+// set to call the "pipeline" service "route" endpoint
+func (c *Client) BuildRouteRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RoutePipelinePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("pipeline", "route", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRouteRequest returns an encoder for requests sent to the pipeline
+// route server.
+func EncodeRouteRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*pipeline.RoutePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("pipeline", "route", "*pipeline.RoutePayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("key", p.Key)
+		if p.Watcher != nil {
+			values.Add("watcher", *p.Watcher)
+		}
+		values.Add("transfer_type", p.TransferType)
+		if p.Size != nil {
+			values.Add("size", fmt.Sprintf("%v", *p.Size))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeRouteResponse returns a decoder for responses returned by the pipeline
+// route endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+func DecodeRouteResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RouteResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("pipeline", "route", err)
+			}
+			err = ValidateRouteResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("pipeline", "route", err)
+			}
+			res := NewRouteEnduroPipelineRouteOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("pipeline", "route", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildPauseRequest instantiates a HTTP request object with method and path
+// set to call the "pipeline" service "pause" endpoint
+func (c *Client) BuildPauseRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*pipeline.PausePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("pipeline", "pause", "*pipeline.PausePayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PausePipelinePath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("pipeline", "pause", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodePauseResponse returns a decoder for responses returned by the pipeline
+// pause endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodePauseResponse may return the following errors:
+//   - "not_found" (type *pipeline.PipelineNotFound): http.StatusNotFound
+//   - error: internal error
+func DecodePauseResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body PauseResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("pipeline", "pause", err)
+			}
+			p := NewPauseEnduroStoredPipelineOK(&body)
+			view := "default"
+			vres := &pipelineviews.EnduroStoredPipeline{Projected: p, View: view}
+			if err = pipelineviews.ValidateEnduroStoredPipeline(vres); err != nil {
+				return nil, goahttp.ErrValidationError("pipeline", "pause", err)
+			}
+			res := pipeline.NewEnduroStoredPipeline(vres)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body PauseNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("pipeline", "pause", err)
+			}
+			err = ValidatePauseNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("pipeline", "pause", err)
+			}
+			return nil, NewPauseNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("pipeline", "pause", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDrainRequest instantiates a HTTP request object with method and path
+// set to call the "pipeline" service "drain" endpoint
+func (c *Client) BuildDrainRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*pipeline.DrainPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("pipeline", "drain", "*pipeline.DrainPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DrainPipelinePath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("pipeline", "drain", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeDrainResponse returns a decoder for responses returned by the pipeline
+// drain endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeDrainResponse may return the following errors:
+//   - "not_found" (type *pipeline.PipelineNotFound): http.StatusNotFound
+//   - error: internal error
+func DecodeDrainResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body DrainResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("pipeline", "drain", err)
+			}
+			p := NewDrainEnduroStoredPipelineOK(&body)
+			view := "default"
+			vres := &pipelineviews.EnduroStoredPipeline{Projected: p, View: view}
+			if err = pipelineviews.ValidateEnduroStoredPipeline(vres); err != nil {
+				return nil, goahttp.ErrValidationError("pipeline", "drain", err)
+			}
+			res := pipeline.NewEnduroStoredPipeline(vres)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body DrainNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("pipeline", "drain", err)
+			}
+			err = ValidateDrainNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("pipeline", "drain", err)
+			}
+			return nil, NewDrainNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("pipeline", "drain", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildResumeRequest instantiates a HTTP request object with method and path
+// set to call the "pipeline" service "resume" endpoint
+func (c *Client) BuildResumeRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*pipeline.ResumePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("pipeline", "resume", "*pipeline.ResumePayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ResumePipelinePath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("pipeline", "resume", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeResumeResponse returns a decoder for responses returned by the
+// pipeline resume endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeResumeResponse may return the following errors:
+//   - "not_found" (type *pipeline.PipelineNotFound): http.StatusNotFound
+//   - error: internal error
+func DecodeResumeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ResumeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("pipeline", "resume", err)
+			}
+			p := NewResumeEnduroStoredPipelineOK(&body)
+			view := "default"
+			vres := &pipelineviews.EnduroStoredPipeline{Projected: p, View: view}
+			if err = pipelineviews.ValidateEnduroStoredPipeline(vres); err != nil {
+				return nil, goahttp.ErrValidationError("pipeline", "resume", err)
+			}
+			res := pipeline.NewEnduroStoredPipeline(vres)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body ResumeNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("pipeline", "resume", err)
+			}
+			err = ValidateResumeNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("pipeline", "resume", err)
+			}
+			return nil, NewResumeNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("pipeline", "resume", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // BuildProcessingRequest instantiates a HTTP request object with method and
 // path set to call the "pipeline" service "processing" endpoint
 func (c *Client) BuildProcessingRequest(ctx context.Context, v any) (*http.Request, error) {
@@ -272,6 +601,64 @@ func unmarshalEnduroStoredPipelineResponseToPipelineEnduroStoredPipeline(v *Endu
 		Capacity: v.Capacity,
 		Current:  v.Current,
 		Status:   v.Status,
+		State:    v.State,
+	}
+	if v.Holders != nil {
+		res.Holders = make([]*pipeline.EnduroPipelineLease, len(v.Holders))
+		for i, val := range v.Holders {
+			if val == nil {
+				res.Holders[i] = nil
+				continue
+			}
+			res.Holders[i] = unmarshalEnduroPipelineLeaseResponseToPipelineEnduroPipelineLease(val)
+		}
+	}
+
+	return res
+}
+
+// unmarshalEnduroPipelineLeaseResponseToPipelineEnduroPipelineLease builds a
+// value of type *pipeline.EnduroPipelineLease from a value of type
+// *EnduroPipelineLeaseResponse.
+func unmarshalEnduroPipelineLeaseResponseToPipelineEnduroPipelineLease(v *EnduroPipelineLeaseResponse) *pipeline.EnduroPipelineLease {
+	if v == nil {
+		return nil
+	}
+	res := &pipeline.EnduroPipelineLease{
+		Holder:     *v.Holder,
+		Worker:     *v.Worker,
+		AcquiredAt: *v.AcquiredAt,
+		ExpiresAt:  *v.ExpiresAt,
+	}
+
+	return res
+}
+
+// unmarshalEnduroPipelineLeaseResponseBodyToPipelineviewsEnduroPipelineLeaseView
+// builds a value of type *pipelineviews.EnduroPipelineLeaseView from a value
+// of type *EnduroPipelineLeaseResponseBody.
+func unmarshalEnduroPipelineLeaseResponseBodyToPipelineviewsEnduroPipelineLeaseView(v *EnduroPipelineLeaseResponseBody) *pipelineviews.EnduroPipelineLeaseView {
+	if v == nil {
+		return nil
+	}
+	res := &pipelineviews.EnduroPipelineLeaseView{
+		Holder:     v.Holder,
+		Worker:     v.Worker,
+		AcquiredAt: v.AcquiredAt,
+		ExpiresAt:  v.ExpiresAt,
+	}
+
+	return res
+}
+
+// unmarshalEnduroPipelineRuleEvaluationResponseBodyToPipelineEnduroPipelineRuleEvaluation
+// builds a value of type *pipeline.EnduroPipelineRuleEvaluation from a value
+// of type *EnduroPipelineRuleEvaluationResponseBody.
+func unmarshalEnduroPipelineRuleEvaluationResponseBodyToPipelineEnduroPipelineRuleEvaluation(v *EnduroPipelineRuleEvaluationResponseBody) *pipeline.EnduroPipelineRuleEvaluation {
+	res := &pipeline.EnduroPipelineRuleEvaluation{
+		Rule:    *v.Rule,
+		Matched: *v.Matched,
+		Reason:  v.Reason,
 	}
 
 	return res
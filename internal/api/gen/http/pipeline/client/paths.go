@@ -22,6 +22,26 @@ func ShowPipelinePath(id string) string {
 	return fmt.Sprintf("/pipeline/%v", id)
 }
 
+// RoutePipelinePath returns the URL path to the pipeline service route HTTP endpoint.
+func RoutePipelinePath() string {
+	return "/pipeline/route"
+}
+
+// PausePipelinePath returns the URL path to the pipeline service pause HTTP endpoint.
+func PausePipelinePath(id string) string {
+	return fmt.Sprintf("/pipeline/%v/pause", id)
+}
+
+// DrainPipelinePath returns the URL path to the pipeline service drain HTTP endpoint.
+func DrainPipelinePath(id string) string {
+	return fmt.Sprintf("/pipeline/%v/drain", id)
+}
+
+// ResumePipelinePath returns the URL path to the pipeline service resume HTTP endpoint.
+func ResumePipelinePath(id string) string {
+	return fmt.Sprintf("/pipeline/%v/resume", id)
+}
+
 // ProcessingPipelinePath returns the URL path to the pipeline service processing HTTP endpoint.
 func ProcessingPipelinePath(id string) string {
 	return fmt.Sprintf("/pipeline/%v/processing", id)
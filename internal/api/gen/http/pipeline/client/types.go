@@ -26,6 +26,77 @@ type ShowResponseBody struct {
 	// Current transfers
 	Current *int64  `form:"current,omitempty" json:"current,omitempty" xml:"current,omitempty"`
 	Status  *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Maintenance state
+	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// Workflows holding a slot of the pipeline, only included by show
+	Holders []*EnduroPipelineLeaseResponseBody `form:"holders,omitempty" json:"holders,omitempty" xml:"holders,omitempty"`
+}
+
+// RouteResponseBody is the type of the "pipeline" service "route" endpoint
+// HTTP response body.
+type RouteResponseBody struct {
+	// Name of the matching rule, not included when no rule matches
+	Rule *string `form:"rule,omitempty" json:"rule,omitempty" xml:"rule,omitempty"`
+	// Candidate pipelines of the matching rule
+	Pipelines []string `form:"pipelines,omitempty" json:"pipelines,omitempty" xml:"pipelines,omitempty"`
+	// Processing configuration of the matching rule
+	ProcessingConfig *string `form:"processing_config,omitempty" json:"processing_config,omitempty" xml:"processing_config,omitempty"`
+	// Result of every rule in order
+	Evaluations []*EnduroPipelineRuleEvaluationResponseBody `form:"evaluations,omitempty" json:"evaluations,omitempty" xml:"evaluations,omitempty"`
+}
+
+// PauseResponseBody is the type of the "pipeline" service "pause" endpoint
+// HTTP response body.
+type PauseResponseBody struct {
+	// Identifier of pipeline
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the pipeline
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Maximum concurrent transfers
+	Capacity *int64 `form:"capacity,omitempty" json:"capacity,omitempty" xml:"capacity,omitempty"`
+	// Current transfers
+	Current *int64  `form:"current,omitempty" json:"current,omitempty" xml:"current,omitempty"`
+	Status  *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Maintenance state
+	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// Workflows holding a slot of the pipeline, only included by show
+	Holders []*EnduroPipelineLeaseResponseBody `form:"holders,omitempty" json:"holders,omitempty" xml:"holders,omitempty"`
+}
+
+// DrainResponseBody is the type of the "pipeline" service "drain" endpoint
+// HTTP response body.
+type DrainResponseBody struct {
+	// Identifier of pipeline
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the pipeline
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Maximum concurrent transfers
+	Capacity *int64 `form:"capacity,omitempty" json:"capacity,omitempty" xml:"capacity,omitempty"`
+	// Current transfers
+	Current *int64  `form:"current,omitempty" json:"current,omitempty" xml:"current,omitempty"`
+	Status  *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Maintenance state
+	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// Workflows holding a slot of the pipeline, only included by show
+	Holders []*EnduroPipelineLeaseResponseBody `form:"holders,omitempty" json:"holders,omitempty" xml:"holders,omitempty"`
+}
+
+// ResumeResponseBody is the type of the "pipeline" service "resume" endpoint
+// HTTP response body.
+type ResumeResponseBody struct {
+	// Identifier of pipeline
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the pipeline
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Maximum concurrent transfers
+	Capacity *int64 `form:"capacity,omitempty" json:"capacity,omitempty" xml:"capacity,omitempty"`
+	// Current transfers
+	Current *int64  `form:"current,omitempty" json:"current,omitempty" xml:"current,omitempty"`
+	Status  *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Maintenance state
+	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// Workflows holding a slot of the pipeline, only included by show
+	Holders []*EnduroPipelineLeaseResponseBody `form:"holders,omitempty" json:"holders,omitempty" xml:"holders,omitempty"`
 }
 
 // ShowNotFoundResponseBody is the type of the "pipeline" service "show"
@@ -37,6 +108,33 @@ type ShowNotFoundResponseBody struct {
 	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
 }
 
+// PauseNotFoundResponseBody is the type of the "pipeline" service "pause"
+// endpoint HTTP response body for the "not_found" error.
+type PauseNotFoundResponseBody struct {
+	// Message of error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Identifier of missing pipeline
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
+// DrainNotFoundResponseBody is the type of the "pipeline" service "drain"
+// endpoint HTTP response body for the "not_found" error.
+type DrainNotFoundResponseBody struct {
+	// Message of error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Identifier of missing pipeline
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
+// ResumeNotFoundResponseBody is the type of the "pipeline" service "resume"
+// endpoint HTTP response body for the "not_found" error.
+type ResumeNotFoundResponseBody struct {
+	// Message of error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Identifier of missing pipeline
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
 // ProcessingNotFoundResponseBody is the type of the "pipeline" service
 // "processing" endpoint HTTP response body for the "not_found" error.
 type ProcessingNotFoundResponseBody struct {
@@ -57,6 +155,45 @@ type EnduroStoredPipelineResponse struct {
 	// Current transfers
 	Current *int64  `form:"current,omitempty" json:"current,omitempty" xml:"current,omitempty"`
 	Status  *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Maintenance state
+	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// Workflows holding a slot of the pipeline, only included by show
+	Holders []*EnduroPipelineLeaseResponse `form:"holders,omitempty" json:"holders,omitempty" xml:"holders,omitempty"`
+}
+
+// EnduroPipelineLeaseResponse is used to define fields on response body types.
+type EnduroPipelineLeaseResponse struct {
+	// Identifier of the workflow holding the slot
+	Holder *string `form:"holder,omitempty" json:"holder,omitempty" xml:"holder,omitempty"`
+	// Enduro instance that acquired the slot
+	Worker *string `form:"worker,omitempty" json:"worker,omitempty" xml:"worker,omitempty"`
+	// Datetime when the slot was acquired
+	AcquiredAt *string `form:"acquired_at,omitempty" json:"acquired_at,omitempty" xml:"acquired_at,omitempty"`
+	// Datetime when the lease expires unless it is renewed
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+}
+
+// EnduroPipelineLeaseResponseBody is used to define fields on response body
+// types.
+type EnduroPipelineLeaseResponseBody struct {
+	// Identifier of the workflow holding the slot
+	Holder *string `form:"holder,omitempty" json:"holder,omitempty" xml:"holder,omitempty"`
+	// Enduro instance that acquired the slot
+	Worker *string `form:"worker,omitempty" json:"worker,omitempty" xml:"worker,omitempty"`
+	// Datetime when the slot was acquired
+	AcquiredAt *string `form:"acquired_at,omitempty" json:"acquired_at,omitempty" xml:"acquired_at,omitempty"`
+	// Datetime when the lease expires unless it is renewed
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+}
+
+// EnduroPipelineRuleEvaluationResponseBody is used to define fields on
+// response body types.
+type EnduroPipelineRuleEvaluationResponseBody struct {
+	// Name of the rule
+	Rule    *string `form:"rule,omitempty" json:"rule,omitempty" xml:"rule,omitempty"`
+	Matched *bool   `form:"matched,omitempty" json:"matched,omitempty" xml:"matched,omitempty"`
+	// Condition that was not met
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
 }
 
 // NewListEnduroStoredPipelineOK builds a "pipeline" service "list" endpoint
@@ -83,6 +220,17 @@ func NewShowEnduroStoredPipelineOK(body *ShowResponseBody) *pipelineviews.Enduro
 		Capacity: body.Capacity,
 		Current:  body.Current,
 		Status:   body.Status,
+		State:    body.State,
+	}
+	if body.Holders != nil {
+		v.Holders = make([]*pipelineviews.EnduroPipelineLeaseView, len(body.Holders))
+		for i, val := range body.Holders {
+			if val == nil {
+				v.Holders[i] = nil
+				continue
+			}
+			v.Holders[i] = unmarshalEnduroPipelineLeaseResponseBodyToPipelineviewsEnduroPipelineLeaseView(val)
+		}
 	}
 
 	return v
@@ -98,6 +246,134 @@ func NewShowNotFound(body *ShowNotFoundResponseBody) *pipeline.PipelineNotFound
 	return v
 }
 
+// NewRouteEnduroPipelineRouteOK builds a "pipeline" service "route" endpoint
+// result from a HTTP "OK" response.
+func NewRouteEnduroPipelineRouteOK(body *RouteResponseBody) *pipeline.EnduroPipelineRoute {
+	v := &pipeline.EnduroPipelineRoute{
+		Rule:             body.Rule,
+		ProcessingConfig: body.ProcessingConfig,
+	}
+	v.Pipelines = make([]string, len(body.Pipelines))
+	for i, val := range body.Pipelines {
+		v.Pipelines[i] = val
+	}
+	v.Evaluations = make([]*pipeline.EnduroPipelineRuleEvaluation, len(body.Evaluations))
+	for i, val := range body.Evaluations {
+		if val == nil {
+			v.Evaluations[i] = nil
+			continue
+		}
+		v.Evaluations[i] = unmarshalEnduroPipelineRuleEvaluationResponseBodyToPipelineEnduroPipelineRuleEvaluation(val)
+	}
+
+	return v
+}
+
+// NewPauseEnduroStoredPipelineOK builds a "pipeline" service "pause" endpoint
+// result from a HTTP "OK" response.
+func NewPauseEnduroStoredPipelineOK(body *PauseResponseBody) *pipelineviews.EnduroStoredPipelineView {
+	v := &pipelineviews.EnduroStoredPipelineView{
+		ID:       body.ID,
+		Name:     body.Name,
+		Capacity: body.Capacity,
+		Current:  body.Current,
+		Status:   body.Status,
+		State:    body.State,
+	}
+	if body.Holders != nil {
+		v.Holders = make([]*pipelineviews.EnduroPipelineLeaseView, len(body.Holders))
+		for i, val := range body.Holders {
+			if val == nil {
+				v.Holders[i] = nil
+				continue
+			}
+			v.Holders[i] = unmarshalEnduroPipelineLeaseResponseBodyToPipelineviewsEnduroPipelineLeaseView(val)
+		}
+	}
+
+	return v
+}
+
+// NewPauseNotFound builds a pipeline service pause endpoint not_found error.
+func NewPauseNotFound(body *PauseNotFoundResponseBody) *pipeline.PipelineNotFound {
+	v := &pipeline.PipelineNotFound{
+		Message: *body.Message,
+		ID:      *body.ID,
+	}
+
+	return v
+}
+
+// NewDrainEnduroStoredPipelineOK builds a "pipeline" service "drain" endpoint
+// result from a HTTP "OK" response.
+func NewDrainEnduroStoredPipelineOK(body *DrainResponseBody) *pipelineviews.EnduroStoredPipelineView {
+	v := &pipelineviews.EnduroStoredPipelineView{
+		ID:       body.ID,
+		Name:     body.Name,
+		Capacity: body.Capacity,
+		Current:  body.Current,
+		Status:   body.Status,
+		State:    body.State,
+	}
+	if body.Holders != nil {
+		v.Holders = make([]*pipelineviews.EnduroPipelineLeaseView, len(body.Holders))
+		for i, val := range body.Holders {
+			if val == nil {
+				v.Holders[i] = nil
+				continue
+			}
+			v.Holders[i] = unmarshalEnduroPipelineLeaseResponseBodyToPipelineviewsEnduroPipelineLeaseView(val)
+		}
+	}
+
+	return v
+}
+
+// NewDrainNotFound builds a pipeline service drain endpoint not_found error.
+func NewDrainNotFound(body *DrainNotFoundResponseBody) *pipeline.PipelineNotFound {
+	v := &pipeline.PipelineNotFound{
+		Message: *body.Message,
+		ID:      *body.ID,
+	}
+
+	return v
+}
+
+// NewResumeEnduroStoredPipelineOK builds a "pipeline" service "resume"
+// endpoint result from a HTTP "OK" response.
+func NewResumeEnduroStoredPipelineOK(body *ResumeResponseBody) *pipelineviews.EnduroStoredPipelineView {
+	v := &pipelineviews.EnduroStoredPipelineView{
+		ID:       body.ID,
+		Name:     body.Name,
+		Capacity: body.Capacity,
+		Current:  body.Current,
+		Status:   body.Status,
+		State:    body.State,
+	}
+	if body.Holders != nil {
+		v.Holders = make([]*pipelineviews.EnduroPipelineLeaseView, len(body.Holders))
+		for i, val := range body.Holders {
+			if val == nil {
+				v.Holders[i] = nil
+				continue
+			}
+			v.Holders[i] = unmarshalEnduroPipelineLeaseResponseBodyToPipelineviewsEnduroPipelineLeaseView(val)
+		}
+	}
+
+	return v
+}
+
+// NewResumeNotFound builds a pipeline service resume endpoint not_found error.
+func NewResumeNotFound(body *ResumeNotFoundResponseBody) *pipeline.PipelineNotFound {
+	v := &pipeline.PipelineNotFound{
+		Message: *body.Message,
+		ID:      *body.ID,
+	}
+
+	return v
+}
+
 // NewProcessingNotFound builds a pipeline service processing endpoint
 // not_found error.
 func NewProcessingNotFound(body *ProcessingNotFoundResponseBody) *pipeline.PipelineNotFound {
@@ -109,6 +385,24 @@ func NewProcessingNotFound(body *ProcessingNotFoundResponseBody) *pipeline.Pipel
 	return v
 }
 
+// ValidateRouteResponseBody runs the validations defined on RouteResponseBody
+func ValidateRouteResponseBody(body *RouteResponseBody) (err error) {
+	if body.Pipelines == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("pipelines", "body"))
+	}
+	if body.Evaluations == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("evaluations", "body"))
+	}
+	for _, e := range body.Evaluations {
+		if e != nil {
+			if err2 := ValidateEnduroPipelineRuleEvaluationResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
 // ValidateShowNotFoundResponseBody runs the validations defined on
 // show_not_found_response_body
 func ValidateShowNotFoundResponseBody(body *ShowNotFoundResponseBody) (err error) {
@@ -121,6 +415,42 @@ func ValidateShowNotFoundResponseBody(body *ShowNotFoundResponseBody) (err error
 	return
 }
 
+// ValidatePauseNotFoundResponseBody runs the validations defined on
+// pause_not_found_response_body
+func ValidatePauseNotFoundResponseBody(body *PauseNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	return
+}
+
+// ValidateDrainNotFoundResponseBody runs the validations defined on
+// drain_not_found_response_body
+func ValidateDrainNotFoundResponseBody(body *DrainNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	return
+}
+
+// ValidateResumeNotFoundResponseBody runs the validations defined on
+// resume_not_found_response_body
+func ValidateResumeNotFoundResponseBody(body *ResumeNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	return
+}
+
 // ValidateProcessingNotFoundResponseBody runs the validations defined on
 // processing_not_found_response_body
 func ValidateProcessingNotFoundResponseBody(body *ProcessingNotFoundResponseBody) (err error) {
@@ -142,5 +472,77 @@ func ValidateEnduroStoredPipelineResponse(body *EnduroStoredPipelineResponse) (e
 	if body.ID != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
 	}
+	if body.State != nil {
+		if !(*body.State == "running" || *body.State == "paused" || *body.State == "draining") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.state", *body.State, []any{"running", "paused", "draining"}))
+		}
+	}
+	for _, e := range body.Holders {
+		if e != nil {
+			if err2 := ValidateEnduroPipelineLeaseResponse(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateEnduroPipelineLeaseResponse runs the validations defined on
+// EnduroPipelineLeaseResponse
+func ValidateEnduroPipelineLeaseResponse(body *EnduroPipelineLeaseResponse) (err error) {
+	if body.Holder == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("holder", "body"))
+	}
+	if body.Worker == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("worker", "body"))
+	}
+	if body.AcquiredAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("acquired_at", "body"))
+	}
+	if body.ExpiresAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expires_at", "body"))
+	}
+	if body.AcquiredAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.acquired_at", *body.AcquiredAt, goa.FormatDateTime))
+	}
+	if body.ExpiresAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.expires_at", *body.ExpiresAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateEnduroPipelineLeaseResponseBody runs the validations defined on
+// EnduroPipelineLeaseResponseBody
+func ValidateEnduroPipelineLeaseResponseBody(body *EnduroPipelineLeaseResponseBody) (err error) {
+	if body.Holder == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("holder", "body"))
+	}
+	if body.Worker == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("worker", "body"))
+	}
+	if body.AcquiredAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("acquired_at", "body"))
+	}
+	if body.ExpiresAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expires_at", "body"))
+	}
+	if body.AcquiredAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.acquired_at", *body.AcquiredAt, goa.FormatDateTime))
+	}
+	if body.ExpiresAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.expires_at", *body.ExpiresAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateEnduroPipelineRuleEvaluationResponseBody runs the validations
+// defined on EnduroPipelineRuleEvaluationResponseBody
+func ValidateEnduroPipelineRuleEvaluationResponseBody(body *EnduroPipelineRuleEvaluationResponseBody) (err error) {
+	if body.Rule == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rule", "body"))
+	}
+	if body.Matched == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("matched", "body"))
+	}
 	return
 }
@@ -129,6 +129,258 @@ func EncodeShowError(encoder func(context.Context, http.ResponseWriter) goahttp.
 	}
 }
 
+// EncodeRouteResponse returns an encoder for responses returned by the
+// pipeline route endpoint.
+func EncodeRouteResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*pipeline.EnduroPipelineRoute)
+		enc := encoder(ctx, w)
+		body := NewRouteResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeRouteRequest returns a decoder for requests sent to the pipeline route
+// endpoint.
+func DecodeRouteRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*pipeline.RoutePayload, error) {
+	return func(r *http.Request) (*pipeline.RoutePayload, error) {
+		var payload *pipeline.RoutePayload
+		var (
+			key          string
+			watcher      *string
+			transferType string
+			size         *int64
+			err          error
+		)
+		qp := r.URL.Query()
+		key = qp.Get("key")
+		if key == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("key", "query string"))
+		}
+		watcherRaw := qp.Get("watcher")
+		if watcherRaw != "" {
+			watcher = &watcherRaw
+		}
+		transferTypeRaw := qp.Get("transfer_type")
+		if transferTypeRaw != "" {
+			transferType = transferTypeRaw
+		} else {
+			transferType = "standard"
+		}
+		{
+			sizeRaw := qp.Get("size")
+			if sizeRaw != "" {
+				v, err2 := strconv.ParseInt(sizeRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("size", sizeRaw, "integer"))
+				}
+				size = &v
+			}
+		}
+		if size != nil {
+			if *size < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("size", *size, 0, true))
+			}
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewRoutePayload(key, watcher, transferType, size)
+
+		return payload, nil
+	}
+}
+
+// EncodePauseResponse returns an encoder for responses returned by the
+// pipeline pause endpoint.
+func EncodePauseResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*pipelineviews.EnduroStoredPipeline)
+		enc := encoder(ctx, w)
+		body := NewPauseResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodePauseRequest returns a decoder for requests sent to the pipeline pause
+// endpoint.
+func DecodePauseRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*pipeline.PausePayload, error) {
+	return func(r *http.Request) (*pipeline.PausePayload, error) {
+		var payload *pipeline.PausePayload
+		var (
+			id  string
+			err error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return payload, err
+		}
+		payload = NewPausePayload(id)
+
+		return payload, nil
+	}
+}
+
+// EncodePauseError returns an encoder for errors returned by the pause
+// pipeline endpoint.
+func EncodePauseError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_found":
+			var res *pipeline.PipelineNotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPauseNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeDrainResponse returns an encoder for responses returned by the
+// pipeline drain endpoint.
+func EncodeDrainResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*pipelineviews.EnduroStoredPipeline)
+		enc := encoder(ctx, w)
+		body := NewDrainResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeDrainRequest returns a decoder for requests sent to the pipeline drain
+// endpoint.
+func DecodeDrainRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*pipeline.DrainPayload, error) {
+	return func(r *http.Request) (*pipeline.DrainPayload, error) {
+		var payload *pipeline.DrainPayload
+		var (
+			id  string
+			err error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return payload, err
+		}
+		payload = NewDrainPayload(id)
+
+		return payload, nil
+	}
+}
+
+// EncodeDrainError returns an encoder for errors returned by the drain
+// pipeline endpoint.
+func EncodeDrainError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_found":
+			var res *pipeline.PipelineNotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDrainNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeResumeResponse returns an encoder for responses returned by the
+// pipeline resume endpoint.
+func EncodeResumeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*pipelineviews.EnduroStoredPipeline)
+		enc := encoder(ctx, w)
+		body := NewResumeResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeResumeRequest returns a decoder for requests sent to the pipeline
+// resume endpoint.
+func DecodeResumeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*pipeline.ResumePayload, error) {
+	return func(r *http.Request) (*pipeline.ResumePayload, error) {
+		var payload *pipeline.ResumePayload
+		var (
+			id  string
+			err error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return payload, err
+		}
+		payload = NewResumePayload(id)
+
+		return payload, nil
+	}
+}
+
+// EncodeResumeError returns an encoder for errors returned by the resume
+// pipeline endpoint.
+func EncodeResumeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_found":
+			var res *pipeline.PipelineNotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewResumeNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
 // EncodeProcessingResponse returns an encoder for responses returned by the
 // pipeline processing endpoint.
 func EncodeProcessingResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
@@ -202,6 +454,64 @@ func marshalPipelineEnduroStoredPipelineToEnduroStoredPipelineResponse(v *pipeli
 		Capacity: v.Capacity,
 		Current:  v.Current,
 		Status:   v.Status,
+		State:    v.State,
+	}
+	if v.Holders != nil {
+		res.Holders = make([]*EnduroPipelineLeaseResponse, len(v.Holders))
+		for i, val := range v.Holders {
+			if val == nil {
+				res.Holders[i] = nil
+				continue
+			}
+			res.Holders[i] = marshalPipelineEnduroPipelineLeaseToEnduroPipelineLeaseResponse(val)
+		}
+	}
+
+	return res
+}
+
+// marshalPipelineEnduroPipelineLeaseToEnduroPipelineLeaseResponse builds a
+// value of type *EnduroPipelineLeaseResponse from a value of type
+// *pipeline.EnduroPipelineLease.
+func marshalPipelineEnduroPipelineLeaseToEnduroPipelineLeaseResponse(v *pipeline.EnduroPipelineLease) *EnduroPipelineLeaseResponse {
+	if v == nil {
+		return nil
+	}
+	res := &EnduroPipelineLeaseResponse{
+		Holder:     v.Holder,
+		Worker:     v.Worker,
+		AcquiredAt: v.AcquiredAt,
+		ExpiresAt:  v.ExpiresAt,
+	}
+
+	return res
+}
+
+// marshalPipelineviewsEnduroPipelineLeaseViewToEnduroPipelineLeaseResponseBody
+// builds a value of type *EnduroPipelineLeaseResponseBody from a value of type
+// *pipelineviews.EnduroPipelineLeaseView.
+func marshalPipelineviewsEnduroPipelineLeaseViewToEnduroPipelineLeaseResponseBody(v *pipelineviews.EnduroPipelineLeaseView) *EnduroPipelineLeaseResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &EnduroPipelineLeaseResponseBody{
+		Holder:     *v.Holder,
+		Worker:     *v.Worker,
+		AcquiredAt: *v.AcquiredAt,
+		ExpiresAt:  *v.ExpiresAt,
+	}
+
+	return res
+}
+
+// marshalPipelineEnduroPipelineRuleEvaluationToEnduroPipelineRuleEvaluationResponseBody
+// builds a value of type *EnduroPipelineRuleEvaluationResponseBody from a
+// value of type *pipeline.EnduroPipelineRuleEvaluation.
+func marshalPipelineEnduroPipelineRuleEvaluationToEnduroPipelineRuleEvaluationResponseBody(v *pipeline.EnduroPipelineRuleEvaluation) *EnduroPipelineRuleEvaluationResponseBody {
+	res := &EnduroPipelineRuleEvaluationResponseBody{
+		Rule:    v.Rule,
+		Matched: v.Matched,
+		Reason:  v.Reason,
 	}
 
 	return res
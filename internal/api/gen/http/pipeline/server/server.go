@@ -23,6 +23,10 @@ type Server struct {
 	Mounts     []*MountPoint
 	List       http.Handler
 	Show       http.Handler
+	Route      http.Handler
+	Pause      http.Handler
+	Drain      http.Handler
+	Resume     http.Handler
 	Processing http.Handler
 	CORS       http.Handler
 }
@@ -56,13 +60,25 @@ func New(
 		Mounts: []*MountPoint{
 			{"List", "GET", "/pipeline"},
 			{"Show", "GET", "/pipeline/{id}"},
+			{"Route", "GET", "/pipeline/route"},
+			{"Pause", "POST", "/pipeline/{id}/pause"},
+			{"Drain", "POST", "/pipeline/{id}/drain"},
+			{"Resume", "POST", "/pipeline/{id}/resume"},
 			{"Processing", "GET", "/pipeline/{id}/processing"},
 			{"CORS", "OPTIONS", "/pipeline"},
 			{"CORS", "OPTIONS", "/pipeline/{id}"},
+			{"CORS", "OPTIONS", "/pipeline/route"},
+			{"CORS", "OPTIONS", "/pipeline/{id}/pause"},
+			{"CORS", "OPTIONS", "/pipeline/{id}/drain"},
+			{"CORS", "OPTIONS", "/pipeline/{id}/resume"},
 			{"CORS", "OPTIONS", "/pipeline/{id}/processing"},
 		},
 		List:       NewListHandler(e.List, mux, decoder, encoder, errhandler, formatter),
 		Show:       NewShowHandler(e.Show, mux, decoder, encoder, errhandler, formatter),
+		Route:      NewRouteHandler(e.Route, mux, decoder, encoder, errhandler, formatter),
+		Pause:      NewPauseHandler(e.Pause, mux, decoder, encoder, errhandler, formatter),
+		Drain:      NewDrainHandler(e.Drain, mux, decoder, encoder, errhandler, formatter),
+		Resume:     NewResumeHandler(e.Resume, mux, decoder, encoder, errhandler, formatter),
 		Processing: NewProcessingHandler(e.Processing, mux, decoder, encoder, errhandler, formatter),
 		CORS:       NewCORSHandler(),
 	}
@@ -75,6 +91,10 @@ func (s *Server) Service() string { return "pipeline" }
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.List = m(s.List)
 	s.Show = m(s.Show)
+	s.Route = m(s.Route)
+	s.Pause = m(s.Pause)
+	s.Drain = m(s.Drain)
+	s.Resume = m(s.Resume)
 	s.Processing = m(s.Processing)
 	s.CORS = m(s.CORS)
 }
@@ -86,6 +106,10 @@ func (s *Server) MethodNames() []string { return pipeline.MethodNames[:] }
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountListHandler(mux, h.List)
 	MountShowHandler(mux, h.Show)
+	MountRouteHandler(mux, h.Route)
+	MountPauseHandler(mux, h.Pause)
+	MountDrainHandler(mux, h.Drain)
+	MountResumeHandler(mux, h.Resume)
 	MountProcessingHandler(mux, h.Processing)
 	MountCORSHandler(mux, h.CORS)
 }
@@ -201,6 +225,218 @@ func NewShowHandler(
 	})
 }
 
+// MountRouteHandler configures the mux to serve the "pipeline" service "route"
+// endpoint.
+func MountRouteHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/pipeline/route", f)
+}
+
+// NewRouteHandler creates a HTTP handler which loads the HTTP request and
+// calls the "pipeline" service "route" endpoint.
+func NewRouteHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRouteRequest(mux, decoder)
+		encodeResponse = EncodeRouteResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "route")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "pipeline")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountPauseHandler configures the mux to serve the "pipeline" service "pause"
+// endpoint.
+func MountPauseHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/pipeline/{id}/pause", f)
+}
+
+// NewPauseHandler creates a HTTP handler which loads the HTTP request and
+// calls the "pipeline" service "pause" endpoint.
+func NewPauseHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePauseRequest(mux, decoder)
+		encodeResponse = EncodePauseResponse(encoder)
+		encodeError    = EncodePauseError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "pause")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "pipeline")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountDrainHandler configures the mux to serve the "pipeline" service "drain"
+// endpoint.
+func MountDrainHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/pipeline/{id}/drain", f)
+}
+
+// NewDrainHandler creates a HTTP handler which loads the HTTP request and
+// calls the "pipeline" service "drain" endpoint.
+func NewDrainHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDrainRequest(mux, decoder)
+		encodeResponse = EncodeDrainResponse(encoder)
+		encodeError    = EncodeDrainError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "drain")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "pipeline")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountResumeHandler configures the mux to serve the "pipeline" service
+// "resume" endpoint.
+func MountResumeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/pipeline/{id}/resume", f)
+}
+
+// NewResumeHandler creates a HTTP handler which loads the HTTP request and
+// calls the "pipeline" service "resume" endpoint.
+func NewResumeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeResumeRequest(mux, decoder)
+		encodeResponse = EncodeResumeResponse(encoder)
+		encodeError    = EncodeResumeError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "resume")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "pipeline")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountProcessingHandler configures the mux to serve the "pipeline" service
 // "processing" endpoint.
 func MountProcessingHandler(mux goahttp.Muxer, h http.Handler) {
@@ -260,6 +496,10 @@ func MountCORSHandler(mux goahttp.Muxer, h http.Handler) {
 	h = HandlePipelineOrigin(h)
 	mux.Handle("OPTIONS", "/pipeline", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/pipeline/{id}", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/pipeline/route", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/pipeline/{id}/pause", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/pipeline/{id}/drain", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/pipeline/{id}/resume", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/pipeline/{id}/processing", h.ServeHTTP)
 }
 
@@ -287,7 +527,7 @@ func HandlePipelineOrigin(h http.Handler) http.Handler {
 			if acrm := r.Header.Get("Access-Control-Request-Method"); acrm != "" {
 				// We are handling a preflight request
 				w.Header().Set("Access-Control-Allow-Methods", "GET, HEAD, POST, PUT, DELETE, OPTIONS")
-				w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+				w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
 				w.WriteHeader(204)
 				return
 			}
@@ -29,6 +29,77 @@ type ShowResponseBody struct {
 	// Current transfers
 	Current *int64  `form:"current,omitempty" json:"current,omitempty" xml:"current,omitempty"`
 	Status  *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Maintenance state
+	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// Workflows holding a slot of the pipeline, only included by show
+	Holders []*EnduroPipelineLeaseResponseBody `form:"holders,omitempty" json:"holders,omitempty" xml:"holders,omitempty"`
+}
+
+// RouteResponseBody is the type of the "pipeline" service "route" endpoint
+// HTTP response body.
+type RouteResponseBody struct {
+	// Name of the matching rule, not included when no rule matches
+	Rule *string `form:"rule,omitempty" json:"rule,omitempty" xml:"rule,omitempty"`
+	// Candidate pipelines of the matching rule
+	Pipelines []string `form:"pipelines" json:"pipelines" xml:"pipelines"`
+	// Processing configuration of the matching rule
+	ProcessingConfig *string `form:"processing_config,omitempty" json:"processing_config,omitempty" xml:"processing_config,omitempty"`
+	// Result of every rule in order
+	Evaluations []*EnduroPipelineRuleEvaluationResponseBody `form:"evaluations" json:"evaluations" xml:"evaluations"`
+}
+
+// PauseResponseBody is the type of the "pipeline" service "pause" endpoint
+// HTTP response body.
+type PauseResponseBody struct {
+	// Identifier of pipeline
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the pipeline
+	Name string `form:"name" json:"name" xml:"name"`
+	// Maximum concurrent transfers
+	Capacity *int64 `form:"capacity,omitempty" json:"capacity,omitempty" xml:"capacity,omitempty"`
+	// Current transfers
+	Current *int64  `form:"current,omitempty" json:"current,omitempty" xml:"current,omitempty"`
+	Status  *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Maintenance state
+	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// Workflows holding a slot of the pipeline, only included by show
+	Holders []*EnduroPipelineLeaseResponseBody `form:"holders,omitempty" json:"holders,omitempty" xml:"holders,omitempty"`
+}
+
+// DrainResponseBody is the type of the "pipeline" service "drain" endpoint
+// HTTP response body.
+type DrainResponseBody struct {
+	// Identifier of pipeline
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the pipeline
+	Name string `form:"name" json:"name" xml:"name"`
+	// Maximum concurrent transfers
+	Capacity *int64 `form:"capacity,omitempty" json:"capacity,omitempty" xml:"capacity,omitempty"`
+	// Current transfers
+	Current *int64  `form:"current,omitempty" json:"current,omitempty" xml:"current,omitempty"`
+	Status  *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Maintenance state
+	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// Workflows holding a slot of the pipeline, only included by show
+	Holders []*EnduroPipelineLeaseResponseBody `form:"holders,omitempty" json:"holders,omitempty" xml:"holders,omitempty"`
+}
+
+// ResumeResponseBody is the type of the "pipeline" service "resume" endpoint
+// HTTP response body.
+type ResumeResponseBody struct {
+	// Identifier of pipeline
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the pipeline
+	Name string `form:"name" json:"name" xml:"name"`
+	// Maximum concurrent transfers
+	Capacity *int64 `form:"capacity,omitempty" json:"capacity,omitempty" xml:"capacity,omitempty"`
+	// Current transfers
+	Current *int64  `form:"current,omitempty" json:"current,omitempty" xml:"current,omitempty"`
+	Status  *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Maintenance state
+	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// Workflows holding a slot of the pipeline, only included by show
+	Holders []*EnduroPipelineLeaseResponseBody `form:"holders,omitempty" json:"holders,omitempty" xml:"holders,omitempty"`
 }
 
 // ShowNotFoundResponseBody is the type of the "pipeline" service "show"
@@ -40,6 +111,33 @@ type ShowNotFoundResponseBody struct {
 	ID string `form:"id" json:"id" xml:"id"`
 }
 
+// PauseNotFoundResponseBody is the type of the "pipeline" service "pause"
+// endpoint HTTP response body for the "not_found" error.
+type PauseNotFoundResponseBody struct {
+	// Message of error
+	Message string `form:"message" json:"message" xml:"message"`
+	// Identifier of missing pipeline
+	ID string `form:"id" json:"id" xml:"id"`
+}
+
+// DrainNotFoundResponseBody is the type of the "pipeline" service "drain"
+// endpoint HTTP response body for the "not_found" error.
+type DrainNotFoundResponseBody struct {
+	// Message of error
+	Message string `form:"message" json:"message" xml:"message"`
+	// Identifier of missing pipeline
+	ID string `form:"id" json:"id" xml:"id"`
+}
+
+// ResumeNotFoundResponseBody is the type of the "pipeline" service "resume"
+// endpoint HTTP response body for the "not_found" error.
+type ResumeNotFoundResponseBody struct {
+	// Message of error
+	Message string `form:"message" json:"message" xml:"message"`
+	// Identifier of missing pipeline
+	ID string `form:"id" json:"id" xml:"id"`
+}
+
 // ProcessingNotFoundResponseBody is the type of the "pipeline" service
 // "processing" endpoint HTTP response body for the "not_found" error.
 type ProcessingNotFoundResponseBody struct {
@@ -60,6 +158,45 @@ type EnduroStoredPipelineResponse struct {
 	// Current transfers
 	Current *int64  `form:"current,omitempty" json:"current,omitempty" xml:"current,omitempty"`
 	Status  *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Maintenance state
+	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// Workflows holding a slot of the pipeline, only included by show
+	Holders []*EnduroPipelineLeaseResponse `form:"holders,omitempty" json:"holders,omitempty" xml:"holders,omitempty"`
+}
+
+// EnduroPipelineLeaseResponse is used to define fields on response body types.
+type EnduroPipelineLeaseResponse struct {
+	// Identifier of the workflow holding the slot
+	Holder string `form:"holder" json:"holder" xml:"holder"`
+	// Enduro instance that acquired the slot
+	Worker string `form:"worker" json:"worker" xml:"worker"`
+	// Datetime when the slot was acquired
+	AcquiredAt string `form:"acquired_at" json:"acquired_at" xml:"acquired_at"`
+	// Datetime when the lease expires unless it is renewed
+	ExpiresAt string `form:"expires_at" json:"expires_at" xml:"expires_at"`
+}
+
+// EnduroPipelineLeaseResponseBody is used to define fields on response body
+// types.
+type EnduroPipelineLeaseResponseBody struct {
+	// Identifier of the workflow holding the slot
+	Holder string `form:"holder" json:"holder" xml:"holder"`
+	// Enduro instance that acquired the slot
+	Worker string `form:"worker" json:"worker" xml:"worker"`
+	// Datetime when the slot was acquired
+	AcquiredAt string `form:"acquired_at" json:"acquired_at" xml:"acquired_at"`
+	// Datetime when the lease expires unless it is renewed
+	ExpiresAt string `form:"expires_at" json:"expires_at" xml:"expires_at"`
+}
+
+// EnduroPipelineRuleEvaluationResponseBody is used to define fields on
+// response body types.
+type EnduroPipelineRuleEvaluationResponseBody struct {
+	// Name of the rule
+	Rule    string `form:"rule" json:"rule" xml:"rule"`
+	Matched bool   `form:"matched" json:"matched" xml:"matched"`
+	// Condition that was not met
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
 }
 
 // NewListResponseBody builds the HTTP response body from the result of the
@@ -85,6 +222,119 @@ func NewShowResponseBody(res *pipelineviews.EnduroStoredPipelineView) *ShowRespo
 		Capacity: res.Capacity,
 		Current:  res.Current,
 		Status:   res.Status,
+		State:    res.State,
+	}
+	if res.Holders != nil {
+		body.Holders = make([]*EnduroPipelineLeaseResponseBody, len(res.Holders))
+		for i, val := range res.Holders {
+			if val == nil {
+				body.Holders[i] = nil
+				continue
+			}
+			body.Holders[i] = marshalPipelineviewsEnduroPipelineLeaseViewToEnduroPipelineLeaseResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewRouteResponseBody builds the HTTP response body from the result of the
+// "route" endpoint of the "pipeline" service.
+func NewRouteResponseBody(res *pipeline.EnduroPipelineRoute) *RouteResponseBody {
+	body := &RouteResponseBody{
+		Rule:             res.Rule,
+		ProcessingConfig: res.ProcessingConfig,
+	}
+	if res.Pipelines != nil {
+		body.Pipelines = make([]string, len(res.Pipelines))
+		for i, val := range res.Pipelines {
+			body.Pipelines[i] = val
+		}
+	} else {
+		body.Pipelines = []string{}
+	}
+	if res.Evaluations != nil {
+		body.Evaluations = make([]*EnduroPipelineRuleEvaluationResponseBody, len(res.Evaluations))
+		for i, val := range res.Evaluations {
+			if val == nil {
+				body.Evaluations[i] = nil
+				continue
+			}
+			body.Evaluations[i] = marshalPipelineEnduroPipelineRuleEvaluationToEnduroPipelineRuleEvaluationResponseBody(val)
+		}
+	} else {
+		body.Evaluations = []*EnduroPipelineRuleEvaluationResponseBody{}
+	}
+	return body
+}
+
+// NewPauseResponseBody builds the HTTP response body from the result of the
+// "pause" endpoint of the "pipeline" service.
+func NewPauseResponseBody(res *pipelineviews.EnduroStoredPipelineView) *PauseResponseBody {
+	body := &PauseResponseBody{
+		ID:       res.ID,
+		Name:     *res.Name,
+		Capacity: res.Capacity,
+		Current:  res.Current,
+		Status:   res.Status,
+		State:    res.State,
+	}
+	if res.Holders != nil {
+		body.Holders = make([]*EnduroPipelineLeaseResponseBody, len(res.Holders))
+		for i, val := range res.Holders {
+			if val == nil {
+				body.Holders[i] = nil
+				continue
+			}
+			body.Holders[i] = marshalPipelineviewsEnduroPipelineLeaseViewToEnduroPipelineLeaseResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewDrainResponseBody builds the HTTP response body from the result of the
+// "drain" endpoint of the "pipeline" service.
+func NewDrainResponseBody(res *pipelineviews.EnduroStoredPipelineView) *DrainResponseBody {
+	body := &DrainResponseBody{
+		ID:       res.ID,
+		Name:     *res.Name,
+		Capacity: res.Capacity,
+		Current:  res.Current,
+		Status:   res.Status,
+		State:    res.State,
+	}
+	if res.Holders != nil {
+		body.Holders = make([]*EnduroPipelineLeaseResponseBody, len(res.Holders))
+		for i, val := range res.Holders {
+			if val == nil {
+				body.Holders[i] = nil
+				continue
+			}
+			body.Holders[i] = marshalPipelineviewsEnduroPipelineLeaseViewToEnduroPipelineLeaseResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewResumeResponseBody builds the HTTP response body from the result of the
+// "resume" endpoint of the "pipeline" service.
+func NewResumeResponseBody(res *pipelineviews.EnduroStoredPipelineView) *ResumeResponseBody {
+	body := &ResumeResponseBody{
+		ID:       res.ID,
+		Name:     *res.Name,
+		Capacity: res.Capacity,
+		Current:  res.Current,
+		Status:   res.Status,
+		State:    res.State,
+	}
+	if res.Holders != nil {
+		body.Holders = make([]*EnduroPipelineLeaseResponseBody, len(res.Holders))
+		for i, val := range res.Holders {
+			if val == nil {
+				body.Holders[i] = nil
+				continue
+			}
+			body.Holders[i] = marshalPipelineviewsEnduroPipelineLeaseViewToEnduroPipelineLeaseResponseBody(val)
+		}
 	}
 	return body
 }
@@ -99,6 +349,36 @@ func NewShowNotFoundResponseBody(res *pipeline.PipelineNotFound) *ShowNotFoundRe
 	return body
 }
 
+// NewPauseNotFoundResponseBody builds the HTTP response body from the result
+// of the "pause" endpoint of the "pipeline" service.
+func NewPauseNotFoundResponseBody(res *pipeline.PipelineNotFound) *PauseNotFoundResponseBody {
+	body := &PauseNotFoundResponseBody{
+		Message: res.Message,
+		ID:      res.ID,
+	}
+	return body
+}
+
+// NewDrainNotFoundResponseBody builds the HTTP response body from the result
+// of the "drain" endpoint of the "pipeline" service.
+func NewDrainNotFoundResponseBody(res *pipeline.PipelineNotFound) *DrainNotFoundResponseBody {
+	body := &DrainNotFoundResponseBody{
+		Message: res.Message,
+		ID:      res.ID,
+	}
+	return body
+}
+
+// NewResumeNotFoundResponseBody builds the HTTP response body from the result
+// of the "resume" endpoint of the "pipeline" service.
+func NewResumeNotFoundResponseBody(res *pipeline.PipelineNotFound) *ResumeNotFoundResponseBody {
+	body := &ResumeNotFoundResponseBody{
+		Message: res.Message,
+		ID:      res.ID,
+	}
+	return body
+}
+
 // NewProcessingNotFoundResponseBody builds the HTTP response body from the
 // result of the "processing" endpoint of the "pipeline" service.
 func NewProcessingNotFoundResponseBody(res *pipeline.PipelineNotFound) *ProcessingNotFoundResponseBody {
@@ -126,6 +406,41 @@ func NewShowPayload(id string) *pipeline.ShowPayload {
 	return v
 }
 
+// NewRoutePayload builds a pipeline service route endpoint payload.
+func NewRoutePayload(key string, watcher *string, transferType string, size *int64) *pipeline.RoutePayload {
+	v := &pipeline.RoutePayload{}
+	v.Key = key
+	v.Watcher = watcher
+	v.TransferType = transferType
+	v.Size = size
+
+	return v
+}
+
+// NewPausePayload builds a pipeline service pause endpoint payload.
+func NewPausePayload(id string) *pipeline.PausePayload {
+	v := &pipeline.PausePayload{}
+	v.ID = id
+
+	return v
+}
+
+// NewDrainPayload builds a pipeline service drain endpoint payload.
+func NewDrainPayload(id string) *pipeline.DrainPayload {
+	v := &pipeline.DrainPayload{}
+	v.ID = id
+
+	return v
+}
+
+// NewResumePayload builds a pipeline service resume endpoint payload.
+func NewResumePayload(id string) *pipeline.ResumePayload {
+	v := &pipeline.ResumePayload{}
+	v.ID = id
+
+	return v
+}
+
 // NewProcessingPayload builds a pipeline service processing endpoint payload.
 func NewProcessingPayload(id string) *pipeline.ProcessingPayload {
 	v := &pipeline.ProcessingPayload{}
@@ -18,14 +18,22 @@ import (
 type Client struct {
 	ListEndpoint       goa.Endpoint
 	ShowEndpoint       goa.Endpoint
+	RouteEndpoint      goa.Endpoint
+	PauseEndpoint      goa.Endpoint
+	DrainEndpoint      goa.Endpoint
+	ResumeEndpoint     goa.Endpoint
 	ProcessingEndpoint goa.Endpoint
 }
 
 // NewClient initializes a "pipeline" service client given the endpoints.
-func NewClient(list, show, processing goa.Endpoint) *Client {
+func NewClient(list, show, route, pause, drain, resume, processing goa.Endpoint) *Client {
 	return &Client{
 		ListEndpoint:       list,
 		ShowEndpoint:       show,
+		RouteEndpoint:      route,
+		PauseEndpoint:      pause,
+		DrainEndpoint:      drain,
+		ResumeEndpoint:     resume,
 		ProcessingEndpoint: processing,
 	}
 }
@@ -53,6 +61,55 @@ func (c *Client) Show(ctx context.Context, p *ShowPayload) (res *EnduroStoredPip
 	return ires.(*EnduroStoredPipeline), nil
 }
 
+// Route calls the "route" endpoint of the "pipeline" service.
+func (c *Client) Route(ctx context.Context, p *RoutePayload) (res *EnduroPipelineRoute, err error) {
+	var ires any
+	ires, err = c.RouteEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*EnduroPipelineRoute), nil
+}
+
+// Pause calls the "pause" endpoint of the "pipeline" service.
+// Pause may return the following errors:
+//   - "not_found" (type *PipelineNotFound): Pipeline not found
+//   - error: internal error
+func (c *Client) Pause(ctx context.Context, p *PausePayload) (res *EnduroStoredPipeline, err error) {
+	var ires any
+	ires, err = c.PauseEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*EnduroStoredPipeline), nil
+}
+
+// Drain calls the "drain" endpoint of the "pipeline" service.
+// Drain may return the following errors:
+//   - "not_found" (type *PipelineNotFound): Pipeline not found
+//   - error: internal error
+func (c *Client) Drain(ctx context.Context, p *DrainPayload) (res *EnduroStoredPipeline, err error) {
+	var ires any
+	ires, err = c.DrainEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*EnduroStoredPipeline), nil
+}
+
+// Resume calls the "resume" endpoint of the "pipeline" service.
+// Resume may return the following errors:
+//   - "not_found" (type *PipelineNotFound): Pipeline not found
+//   - error: internal error
+func (c *Client) Resume(ctx context.Context, p *ResumePayload) (res *EnduroStoredPipeline, err error) {
+	var ires any
+	ires, err = c.ResumeEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*EnduroStoredPipeline), nil
+}
+
 // Processing calls the "processing" endpoint of the "pipeline" service.
 // Processing may return the following errors:
 //   - "not_found" (type *PipelineNotFound): Pipeline not found
@@ -18,6 +18,10 @@ import (
 type Endpoints struct {
 	List       goa.Endpoint
 	Show       goa.Endpoint
+	Route      goa.Endpoint
+	Pause      goa.Endpoint
+	Drain      goa.Endpoint
+	Resume     goa.Endpoint
 	Processing goa.Endpoint
 }
 
@@ -26,6 +30,10 @@ func NewEndpoints(s Service) *Endpoints {
 	return &Endpoints{
 		List:       NewListEndpoint(s),
 		Show:       NewShowEndpoint(s),
+		Route:      NewRouteEndpoint(s),
+		Pause:      NewPauseEndpoint(s),
+		Drain:      NewDrainEndpoint(s),
+		Resume:     NewResumeEndpoint(s),
 		Processing: NewProcessingEndpoint(s),
 	}
 }
@@ -34,6 +42,10 @@ func NewEndpoints(s Service) *Endpoints {
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.List = m(e.List)
 	e.Show = m(e.Show)
+	e.Route = m(e.Route)
+	e.Pause = m(e.Pause)
+	e.Drain = m(e.Drain)
+	e.Resume = m(e.Resume)
 	e.Processing = m(e.Processing)
 }
 
@@ -60,6 +72,57 @@ func NewShowEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewRouteEndpoint returns an endpoint function that calls the method "route"
+// of service "pipeline".
+func NewRouteEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RoutePayload)
+		return s.Route(ctx, p)
+	}
+}
+
+// NewPauseEndpoint returns an endpoint function that calls the method "pause"
+// of service "pipeline".
+func NewPauseEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*PausePayload)
+		res, err := s.Pause(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedEnduroStoredPipeline(res, "default")
+		return vres, nil
+	}
+}
+
+// NewDrainEndpoint returns an endpoint function that calls the method "drain"
+// of service "pipeline".
+func NewDrainEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DrainPayload)
+		res, err := s.Drain(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedEnduroStoredPipeline(res, "default")
+		return vres, nil
+	}
+}
+
+// NewResumeEndpoint returns an endpoint function that calls the method
+// "resume" of service "pipeline".
+func NewResumeEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ResumePayload)
+		res, err := s.Resume(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedEnduroStoredPipeline(res, "default")
+		return vres, nil
+	}
+}
+
 // NewProcessingEndpoint returns an endpoint function that calls the method
 // "processing" of service "pipeline".
 func NewProcessingEndpoint(s Service) goa.Endpoint {
@@ -20,6 +20,14 @@ type Service interface {
 	List(context.Context, *ListPayload) (res []*EnduroStoredPipeline, err error)
 	// Show pipeline by ID
 	Show(context.Context, *ShowPayload) (res *EnduroStoredPipeline, err error)
+	// Explain which routing rule selects the pipeline of a transfer
+	Route(context.Context, *RoutePayload) (res *EnduroPipelineRoute, err error)
+	// Hold new transfers of a pipeline until it is resumed
+	Pause(context.Context, *PausePayload) (res *EnduroStoredPipeline, err error)
+	// Send new transfers of a pipeline to other pipelines until it is resumed
+	Drain(context.Context, *DrainPayload) (res *EnduroStoredPipeline, err error)
+	// Resume sending new transfers to a paused or draining pipeline
+	Resume(context.Context, *ResumePayload) (res *EnduroStoredPipeline, err error)
 	// List all processing configurations of a pipeline given its ID
 	Processing(context.Context, *ProcessingPayload) (res []string, err error)
 }
@@ -38,7 +46,46 @@ const ServiceName = "pipeline"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [3]string{"list", "show", "processing"}
+var MethodNames = [7]string{"list", "show", "route", "pause", "drain", "resume", "processing"}
+
+// DrainPayload is the payload type of the pipeline service drain method.
+type DrainPayload struct {
+	// Identifier of pipeline
+	ID string
+}
+
+// EnduroPipelineLease describes a slot of a pipeline held by a workflow.
+type EnduroPipelineLease struct {
+	// Identifier of the workflow holding the slot
+	Holder string
+	// Enduro instance that acquired the slot
+	Worker string
+	// Datetime when the slot was acquired
+	AcquiredAt string
+	// Datetime when the lease expires unless it is renewed
+	ExpiresAt string
+}
+
+// EnduroPipelineRoute is the result type of the pipeline service route method.
+type EnduroPipelineRoute struct {
+	// Name of the matching rule, not included when no rule matches
+	Rule *string
+	// Candidate pipelines of the matching rule
+	Pipelines []string
+	// Processing configuration of the matching rule
+	ProcessingConfig *string
+	// Result of every rule in order
+	Evaluations []*EnduroPipelineRuleEvaluation
+}
+
+// EnduroPipelineRuleEvaluation describes the result of a routing rule.
+type EnduroPipelineRuleEvaluation struct {
+	// Name of the rule
+	Rule    string
+	Matched bool
+	// Condition that was not met
+	Reason *string
+}
 
 // EnduroStoredPipeline is the result type of the pipeline service show method.
 type EnduroStoredPipeline struct {
@@ -51,6 +98,10 @@ type EnduroStoredPipeline struct {
 	// Current transfers
 	Current *int64
 	Status  *string
+	// Maintenance state
+	State *string
+	// Workflows holding a slot of the pipeline, only included by show
+	Holders []*EnduroPipelineLease
 }
 
 // ListPayload is the payload type of the pipeline service list method.
@@ -59,6 +110,12 @@ type ListPayload struct {
 	Status bool
 }
 
+// PausePayload is the payload type of the pipeline service pause method.
+type PausePayload struct {
+	// Identifier of pipeline
+	ID string
+}
+
 // Pipeline not found.
 type PipelineNotFound struct {
 	// Message of error
@@ -74,6 +131,24 @@ type ProcessingPayload struct {
 	ID string
 }
 
+// ResumePayload is the payload type of the pipeline service resume method.
+type ResumePayload struct {
+	// Identifier of pipeline
+	ID string
+}
+
+// RoutePayload is the payload type of the pipeline service route method.
+type RoutePayload struct {
+	// Key (name) of the transfer
+	Key string
+	// Name of the watcher
+	Watcher *string
+	// Archivematica transfer type
+	TransferType string
+	// Size of the transfer in bytes
+	Size *int64
+}
+
 // ShowPayload is the payload type of the pipeline service show method.
 type ShowPayload struct {
 	// Identifier of pipeline to show
@@ -119,10 +194,21 @@ func newEnduroStoredPipeline(vres *pipelineviews.EnduroStoredPipelineView) *Endu
 		Capacity: vres.Capacity,
 		Current:  vres.Current,
 		Status:   vres.Status,
+		State:    vres.State,
 	}
 	if vres.Name != nil {
 		res.Name = *vres.Name
 	}
+	if vres.Holders != nil {
+		res.Holders = make([]*EnduroPipelineLease, len(vres.Holders))
+		for i, val := range vres.Holders {
+			if val == nil {
+				res.Holders[i] = nil
+				continue
+			}
+			res.Holders[i] = transformPipelineviewsEnduroPipelineLeaseViewToEnduroPipelineLease(val)
+		}
+	}
 	return res
 }
 
@@ -135,6 +221,51 @@ func newEnduroStoredPipelineView(res *EnduroStoredPipeline) *pipelineviews.Endur
 		Capacity: res.Capacity,
 		Current:  res.Current,
 		Status:   res.Status,
+		State:    res.State,
+	}
+	if res.Holders != nil {
+		vres.Holders = make([]*pipelineviews.EnduroPipelineLeaseView, len(res.Holders))
+		for i, val := range res.Holders {
+			if val == nil {
+				vres.Holders[i] = nil
+				continue
+			}
+			vres.Holders[i] = transformEnduroPipelineLeaseToPipelineviewsEnduroPipelineLeaseView(val)
+		}
 	}
 	return vres
 }
+
+// transformPipelineviewsEnduroPipelineLeaseViewToEnduroPipelineLease builds a
+// value of type *EnduroPipelineLease from a value of type
+// *pipelineviews.EnduroPipelineLeaseView.
+func transformPipelineviewsEnduroPipelineLeaseViewToEnduroPipelineLease(v *pipelineviews.EnduroPipelineLeaseView) *EnduroPipelineLease {
+	if v == nil {
+		return nil
+	}
+	res := &EnduroPipelineLease{
+		Holder:     *v.Holder,
+		Worker:     *v.Worker,
+		AcquiredAt: *v.AcquiredAt,
+		ExpiresAt:  *v.ExpiresAt,
+	}
+
+	return res
+}
+
+// transformEnduroPipelineLeaseToPipelineviewsEnduroPipelineLeaseView builds a
+// value of type *pipelineviews.EnduroPipelineLeaseView from a value of type
+// *EnduroPipelineLease.
+func transformEnduroPipelineLeaseToPipelineviewsEnduroPipelineLeaseView(v *EnduroPipelineLease) *pipelineviews.EnduroPipelineLeaseView {
+	if v == nil {
+		return nil
+	}
+	res := &pipelineviews.EnduroPipelineLeaseView{
+		Holder:     &v.Holder,
+		Worker:     &v.Worker,
+		AcquiredAt: &v.AcquiredAt,
+		ExpiresAt:  &v.ExpiresAt,
+	}
+
+	return res
+}
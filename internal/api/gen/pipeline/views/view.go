@@ -32,6 +32,22 @@ type EnduroStoredPipelineView struct {
 	// Current transfers
 	Current *int64
 	Status  *string
+	// Maintenance state
+	State *string
+	// Workflows holding a slot of the pipeline, only included by show
+	Holders []*EnduroPipelineLeaseView
+}
+
+// EnduroPipelineLeaseView is a type that runs validations on a projected type.
+type EnduroPipelineLeaseView struct {
+	// Identifier of the workflow holding the slot
+	Holder *string
+	// Enduro instance that acquired the slot
+	Worker *string
+	// Datetime when the slot was acquired
+	AcquiredAt *string
+	// Datetime when the lease expires unless it is renewed
+	ExpiresAt *string
 }
 
 var (
@@ -44,6 +60,8 @@ var (
 			"capacity",
 			"current",
 			"status",
+			"state",
+			"holders",
 		},
 	}
 )
@@ -69,5 +87,41 @@ func ValidateEnduroStoredPipelineView(result *EnduroStoredPipelineView) (err err
 	if result.ID != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("result.id", *result.ID, goa.FormatUUID))
 	}
+	if result.State != nil {
+		if !(*result.State == "running" || *result.State == "paused" || *result.State == "draining") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.state", *result.State, []any{"running", "paused", "draining"}))
+		}
+	}
+	for _, e := range result.Holders {
+		if e != nil {
+			if err2 := ValidateEnduroPipelineLeaseView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateEnduroPipelineLeaseView runs the validations defined on
+// EnduroPipelineLeaseView.
+func ValidateEnduroPipelineLeaseView(result *EnduroPipelineLeaseView) (err error) {
+	if result.Holder == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("holder", "result"))
+	}
+	if result.Worker == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("worker", "result"))
+	}
+	if result.AcquiredAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("acquired_at", "result"))
+	}
+	if result.ExpiresAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expires_at", "result"))
+	}
+	if result.AcquiredAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.acquired_at", *result.AcquiredAt, goa.FormatDateTime))
+	}
+	if result.ExpiresAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.expires_at", *result.ExpiresAt, goa.FormatDateTime))
+	}
 	return
 }
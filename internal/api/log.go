@@ -1,7 +1,13 @@
 package api
 
 import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
 	"github.com/go-logr/logr"
+	goahttpmwr "goa.design/goa/v3/http/middleware"
 	"goa.design/goa/v3/middleware"
 )
 
@@ -22,3 +28,63 @@ func (a *adapter) Log(keyvals ...any) error {
 	a.logger.Info("", keyvals...)
 	return nil
 }
+
+// redacted replaces the credentials found in the logged requests.
+const redacted = "REDACTED"
+
+// redactURL returns the URL without the bearer token that event streams pass
+// in the access_token query parameter.
+func redactURL(u *url.URL) *url.URL {
+	query := u.Query()
+	if !query.Has("access_token") {
+		return u
+	}
+	query.Set("access_token", redacted)
+
+	res := *u
+	res.RawQuery = query.Encode()
+
+	return &res
+}
+
+// redactHeader returns the values of a request header, hiding the credentials
+// of the Authorization header but not its scheme.
+func redactHeader(name string, values []string) string {
+	if !strings.EqualFold(name, "Authorization") {
+		return strings.Join(values, ", ")
+	}
+
+	res := make([]string, len(values))
+	for i, v := range values {
+		scheme, _, _ := strings.Cut(v, " ")
+		res[i] = scheme + " " + redacted
+	}
+
+	return strings.Join(res, ", ")
+}
+
+type unredactedURLKey struct{}
+
+// logRequests is Goa's logging middleware with the access tokens redacted from
+// the logged URLs. The handler still receives the original URL.
+func logRequests(logger middleware.Logger) func(http.Handler) http.Handler {
+	log := goahttpmwr.Log(logger)
+
+	return func(h http.Handler) http.Handler {
+		logged := log(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if u, ok := r.Context().Value(unredactedURLKey{}).(*url.URL); ok {
+				r = r.WithContext(r.Context())
+				r.URL = u
+			}
+			h.ServeHTTP(w, r)
+		}))
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if u := redactURL(r.URL); u != r.URL {
+				r = r.WithContext(context.WithValue(r.Context(), unredactedURLKey{}, r.URL))
+				r.URL = u
+			}
+			logged.ServeHTTP(w, r)
+		})
+	}
+}
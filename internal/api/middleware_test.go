@@ -1,10 +1,14 @@
 package api
 
 import (
+	"bytes"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	goahttp "goa.design/goa/v3/http"
 	"gotest.tools/v3/assert"
 
 	batchsvr "github.com/artefactual-labs/enduro/internal/api/gen/http/batch/server"
@@ -244,11 +248,49 @@ func TestGeneratedCORSPreflightHeaders(t *testing.T) {
 	assertHeaders(t, rec.Header(), map[string]string{
 		"Access-Control-Allow-Origin":   "https://dashboard.example.org",
 		"Access-Control-Allow-Methods":  "GET, HEAD, POST, PUT, DELETE, OPTIONS",
-		"Access-Control-Allow-Headers":  "Content-Type",
+		"Access-Control-Allow-Headers":  "Content-Type, Authorization",
 		"Access-Control-Expose-Headers": "X-Enduro-Version",
 	})
 }
 
+type bufferLogger struct {
+	bytes.Buffer
+}
+
+func (l *bufferLogger) Log(keyvals ...any) error {
+	fmt.Fprintln(&l.Buffer, keyvals...)
+	return nil
+}
+
+func TestLoggingRedactsAccessTokens(t *testing.T) {
+	t.Parallel()
+
+	const token = "eyJhbGciOiJSUzI1NiJ9.e30.c2ln"
+	var seen string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = r.URL.Query().Get("access_token")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	var logged bufferLogger
+	var debugged bytes.Buffer
+	handler := logRequests(&logged)(h)
+	handler = debug(goahttp.NewMuxer(), &debugged)(handler)
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/collection/monitor?access_token="+token, nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, rec.Code, http.StatusNoContent)
+	assert.Equal(t, seen, token)
+	assert.Assert(t, strings.Contains(logged.String(), "/collection/monitor?access_token=REDACTED"), logged.String())
+	assert.Assert(t, strings.Contains(debugged.String(), "/collection/monitor?access_token=REDACTED"), debugged.String())
+	assert.Assert(t, strings.Contains(debugged.String(), "Authorization: Bearer REDACTED"), debugged.String())
+	assert.Assert(t, !strings.Contains(logged.String()+debugged.String(), token))
+}
+
 func setHeaders(h http.Header, headers map[string]string) {
 	for k, v := range headers {
 		h.Set(k, v)
@@ -61,7 +61,8 @@ func (s *batchImpl) Submit(ctx context.Context, payload *goabatch.SubmitPayload)
 		return nil, goabatch.MakeNotValid(errors.New("error starting batch - path is empty"))
 	}
 	input := BatchWorkflowInput{
-		Path: payload.Path,
+		Path:  payload.Path,
+		Actor: collection.ActorFromContext(ctx),
 	}
 	if payload.Pipeline != nil {
 		input.PipelineName = *payload.Pipeline
@@ -83,6 +83,7 @@ func TestBatchServiceSubmit(t *testing.T) {
 				CompletedDir:     completedDir,
 				RetentionPeriod:  &dur,
 				TransferType:     transferType,
+				Actor:            "api",
 			},
 		).Return(
 			workflowRun, nil,
@@ -36,6 +36,10 @@ type BatchWorkflowInput struct {
 	TransferType       string
 	MetadataConfig     metadata.Config
 	Depth              int32
+
+	// Actor that submitted the batch, recorded with the status transitions
+	// of its collections.
+	Actor string
 }
 
 func BatchWorkflow(ctx temporalsdk_workflow.Context, params BatchWorkflowInput) error {
@@ -93,6 +97,7 @@ func (a *BatchActivity) Execute(ctx context.Context, params BatchWorkflowInput)
 			Key:                entry.Name(),
 			IsDir:              entry.IsDir(),
 			PipelineName:       params.PipelineName,
+			Route:              params.PipelineName == "",
 			ProcessingConfig:   params.ProcessingConfig,
 			CompletedDir:       params.CompletedDir,
 			RetentionPeriod:    params.RetentionPeriod,
@@ -100,6 +105,7 @@ func (a *BatchActivity) Execute(ctx context.Context, params BatchWorkflowInput)
 			ExcludeHiddenFiles: params.ExcludeHiddenFiles,
 			TransferType:       params.TransferType,
 			MetadataConfig:     params.MetadataConfig,
+			Actor:              params.Actor,
 		}
 
 		if err := a.batchsvc.InitProcessingWorkflow(ctx, &req); err != nil {
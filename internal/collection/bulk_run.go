@@ -0,0 +1,171 @@
+package collection
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	goacollection "github.com/artefactual-labs/enduro/internal/api/gen/collection"
+)
+
+// States of a bulk run.
+const (
+	BulkRunStateRunning   = "running"
+	BulkRunStateCompleted = "completed"
+	BulkRunStateFailed    = "failed"
+)
+
+// bulkRunsLimit is the number of runs listed, the most recent first.
+const bulkRunsLimit = 20
+
+// BulkRun is a bulk operation recorded in the database.
+type BulkRun struct {
+	ID               uint           `db:"id"`
+	WorkflowID       string         `db:"workflow_id"`
+	RunID            string         `db:"run_id"`
+	Operation        string         `db:"operation"`
+	CollectionStatus Status         `db:"collection_status"`
+	Decision         sql.NullString `db:"decision"`
+	State            string         `db:"state"`
+	Error            sql.NullString `db:"error"`
+	CreatedAt        time.Time      `db:"created_at"`
+	CompletedAt      sql.NullTime   `db:"completed_at"`
+}
+
+// Goa returns the API representation of the bulk run, without outcomes.
+func (r BulkRun) Goa() *goacollection.EnduroBulkRun {
+	run := &goacollection.EnduroBulkRun{
+		ID:         r.ID,
+		WorkflowID: r.WorkflowID,
+		RunID:      r.RunID,
+		Operation:  r.Operation,
+		Status:     r.CollectionStatus.String(),
+		State:      r.State,
+		CreatedAt:  r.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	if r.Decision.Valid {
+		run.Option = &r.Decision.String
+	}
+	if r.Error.Valid {
+		run.Error = &r.Error.String
+	}
+	if r.CompletedAt.Valid {
+		completedAt := r.CompletedAt.Time.UTC().Format(time.RFC3339)
+		run.CompletedAt = &completedAt
+	}
+
+	return run
+}
+
+func (svc *collectionImpl) createBulkRun(ctx context.Context, input BulkWorkflowInput, workflowID string) (uint, error) {
+	query := `INSERT INTO bulk_run (workflow_id, operation, collection_status, decision, state) VALUES ((?), (?), (?), (?), (?))`
+	args := []any{
+		workflowID,
+		input.Operation,
+		input.Status,
+		sql.NullString{String: string(input.Decision), Valid: input.Decision != ""},
+		BulkRunStateRunning,
+	}
+
+	res, err := svc.db.ExecContext(ctx, svc.db.Rebind(query), args...)
+	if err != nil {
+		return 0, fmt.Errorf("error inserting bulk run: %w", err)
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("error retrieving insert ID: %w", err)
+	}
+
+	return uint(id), nil
+}
+
+func (svc *collectionImpl) setBulkRunID(ctx context.Context, ID uint, runID string) error {
+	query := `UPDATE bulk_run SET run_id = (?) WHERE id = (?)`
+	if _, err := svc.db.ExecContext(ctx, svc.db.Rebind(query), runID, ID); err != nil {
+		return fmt.Errorf("error updating bulk run: %w", err)
+	}
+
+	return nil
+}
+
+// RecordBulkOutcome records the outcome of a bulk run on a collection.
+func (svc *collectionImpl) RecordBulkOutcome(ctx context.Context, bulkRunID uint, outcome BulkOutcome) error {
+	query := `INSERT INTO bulk_run_result (bulk_run_id, collection_id, outcome, error) VALUES ((?), (?), (?), (?))`
+	args := []any{
+		bulkRunID,
+		outcome.ID,
+		outcome.Outcome,
+		sql.NullString{String: outcome.Error, Valid: outcome.Error != ""},
+	}
+	if _, err := svc.db.ExecContext(ctx, svc.db.Rebind(query), args...); err != nil {
+		return fmt.Errorf("error inserting bulk run result: %w", err)
+	}
+
+	return nil
+}
+
+// CompleteBulkRun records the end of a bulk run, runErr is the error that
+// stopped it if any.
+func (svc *collectionImpl) CompleteBulkRun(ctx context.Context, bulkRunID uint, runErr error) error {
+	query := `UPDATE bulk_run SET state = (?), error = (?), completed_at = (?) WHERE id = (?)`
+	args := []any{
+		BulkRunStateCompleted,
+		sql.NullString{},
+		time.Now().UTC(),
+		bulkRunID,
+	}
+	if runErr != nil {
+		args[0] = BulkRunStateFailed
+		args[1] = sql.NullString{String: runErr.Error(), Valid: true}
+	}
+	if _, err := svc.db.ExecContext(ctx, svc.db.Rebind(query), args...); err != nil {
+		return fmt.Errorf("error updating bulk run: %w", err)
+	}
+
+	return nil
+}
+
+const bulkRunColumns = "id, workflow_id, run_id, operation, collection_status, decision, state, error, CONVERT_TZ(created_at, @@session.time_zone, '+00:00') AS created_at, CONVERT_TZ(completed_at, @@session.time_zone, '+00:00') AS completed_at"
+
+func (svc *collectionImpl) readBulkRun(ctx context.Context, ID uint) (*BulkRun, error) {
+	query := "SELECT " + bulkRunColumns + " FROM bulk_run WHERE id = (?)"
+	run := BulkRun{}
+	if err := svc.db.GetContext(ctx, &run, svc.db.Rebind(query), ID); err != nil {
+		return nil, err
+	}
+
+	return &run, nil
+}
+
+func (svc *collectionImpl) readLatestBulkRun(ctx context.Context) (*BulkRun, error) {
+	query := "SELECT " + bulkRunColumns + " FROM bulk_run ORDER BY id DESC LIMIT 1"
+	run := BulkRun{}
+	if err := svc.db.GetContext(ctx, &run, query); err != nil {
+		return nil, err
+	}
+
+	return &run, nil
+}
+
+// listBulkRuns returns the most recent bulk runs.
+func (svc *collectionImpl) listBulkRuns(ctx context.Context) ([]BulkRun, error) {
+	query := fmt.Sprintf("SELECT %s FROM bulk_run ORDER BY id DESC LIMIT %d", bulkRunColumns, bulkRunsLimit)
+	runs := []BulkRun{}
+	if err := svc.db.SelectContext(ctx, &runs, query); err != nil {
+		return nil, fmt.Errorf("error reading bulk runs: %w", err)
+	}
+
+	return runs, nil
+}
+
+func (svc *collectionImpl) readBulkOutcomes(ctx context.Context, bulkRunID uint) ([]BulkOutcome, error) {
+	query := `SELECT collection_id, outcome, COALESCE(error, '') AS error FROM bulk_run_result WHERE bulk_run_id = (?) ORDER BY id ASC`
+	outcomes := []BulkOutcome{}
+	if err := svc.db.SelectContext(ctx, &outcomes, svc.db.Rebind(query), bulkRunID); err != nil {
+		return nil, fmt.Errorf("error reading bulk run results: %w", err)
+	}
+
+	return outcomes, nil
+}
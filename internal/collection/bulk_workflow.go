@@ -16,8 +16,9 @@ import (
 )
 
 const (
-	BulkWorkflowName              = "collection-bulk-workflow"
-	BulkWorkflowID                = "collection-bulk-workflow"
+	BulkWorkflowName = "collection-bulk-workflow"
+	// BulkWorkflowIDPrefix is the prefix of the workflow ID of every bulk run.
+	BulkWorkflowIDPrefix          = "collection-bulk-workflow"
 	BulkWorkflowStateQueryHandler = "collection-bulk-state"
 	BulkActivityName              = "collection-bulk-activity"
 	bulkDecisionTimeout           = 30 * time.Second
@@ -30,12 +31,61 @@ type BulkProgress struct {
 	Max       uint
 }
 
+// Outcomes of a bulk operation on a collection.
+const (
+	BulkOutcomeSucceeded = "succeeded"
+	BulkOutcomeSkipped   = "skipped"
+	BulkOutcomeFailed    = "failed"
+)
+
+// BulkOutcome is the outcome of a bulk operation on a collection. Error
+// describes why the collection failed or was skipped.
+type BulkOutcome struct {
+	ID      uint   `db:"collection_id"`
+	Outcome string `db:"outcome"`
+	Error   string `db:"error"`
+}
+
+func newBulkOutcome(ID uint, err error) BulkOutcome {
+	outcome := BulkOutcome{ID: ID, Outcome: BulkOutcomeSucceeded}
+	if errors.Is(err, errBulkSkipped) {
+		outcome.Outcome = BulkOutcomeSkipped
+		outcome.Error = err.Error()
+	} else if err != nil {
+		outcome.Outcome = BulkOutcomeFailed
+		outcome.Error = err.Error()
+	}
+
+	return outcome
+}
+
+// BulkWorkflowResult is the result of the bulk workflow. The outcomes of the
+// collections are recorded in the bulk run, only their numbers are returned so
+// large runs do not exceed the payload size limit of Temporal.
+type BulkWorkflowResult struct {
+	Succeeded uint
+	Skipped   uint
+	Failed    uint
+}
+
+func (r *BulkWorkflowResult) add(outcome BulkOutcome) {
+	switch outcome.Outcome {
+	case BulkOutcomeSucceeded:
+		r.Succeeded++
+	case BulkOutcomeSkipped:
+		r.Skipped++
+	case BulkOutcomeFailed:
+		r.Failed++
+	}
+}
+
 type BulkWorkflowOperation string
 
 const (
 	BulkWorkflowOperationRetry   BulkWorkflowOperation = "retry"
 	BulkWorkflowOperationCancel  BulkWorkflowOperation = "cancel"
 	BulkWorkflowOperationAbandon BulkWorkflowOperation = "abandon"
+	BulkWorkflowOperationDecide  BulkWorkflowOperation = "decide"
 )
 
 type bulkWorkflowAction uint
@@ -46,7 +96,9 @@ const (
 	bulkWorkflowActionCancel
 )
 
-var errBulkCancelSkipped = errors.New("bulk cancel skipped")
+// errBulkSkipped is wrapped by the errors of the collections that are skipped
+// by the operation.
+var errBulkSkipped = errors.New("skipped")
 
 type BulkWorkflowInput struct {
 	// Status of collections where bulk is performed.
@@ -57,6 +109,56 @@ type BulkWorkflowInput struct {
 
 	// Max. number of collections affected. Zero means no cap established.
 	Size uint
+
+	// Decision sent to the pending collections by the decide operation.
+	Decision ProcessingWorkflowDecision
+
+	// Filter narrows down the affected collections.
+	Filter BulkFilter
+
+	// IDs of the affected collections. Collections that are not in Status are
+	// skipped. Filter is ignored when the list is not empty.
+	IDs []uint
+
+	// Identifier of the bulk run where the outcomes are recorded. Zero in runs
+	// started before the runs were recorded.
+	BulkRunID uint
+}
+
+// BulkFilter narrows down the collections affected by a bulk operation. It
+// supports a subset of the filters of the list endpoint.
+type BulkFilter struct {
+	// Prefix of the name of the collections.
+	Name *string
+
+	OriginalID          *string
+	TransferID          *string
+	AIPID               *string
+	PipelineID          *string
+	EarliestCreatedTime *string
+	LatestCreatedTime   *string
+
+	// Activity that failed in pending collections.
+	DecisionActivity *string
+}
+
+// listPayload returns the payload that lists the collections affected by the
+// operation, starting from the given cursor.
+func (params BulkWorkflowInput) listPayload(cursor *string) *collection.ListPayload {
+	status := params.Status.String()
+
+	return &collection.ListPayload{
+		Name:                params.Filter.Name,
+		OriginalID:          params.Filter.OriginalID,
+		TransferID:          params.Filter.TransferID,
+		AipID:               params.Filter.AIPID,
+		PipelineID:          params.Filter.PipelineID,
+		EarliestCreatedTime: params.Filter.EarliestCreatedTime,
+		LatestCreatedTime:   params.Filter.LatestCreatedTime,
+		Status:              &status,
+		DecisionActivity:    params.Filter.DecisionActivity,
+		Cursor:              cursor,
+	}
 }
 
 type bulkCollectionService interface {
@@ -67,6 +169,11 @@ type bulkCollectionService interface {
 	Decide(context.Context, *collection.DecidePayload) error
 }
 
+type bulkRunRecorder interface {
+	RecordBulkOutcome(ctx context.Context, bulkRunID uint, outcome BulkOutcome) error
+	CompleteBulkRun(ctx context.Context, bulkRunID uint, runErr error) error
+}
+
 func bulkWorkflowInputAction(params BulkWorkflowInput) (bulkWorkflowAction, string, error) {
 	switch params.Operation {
 	case BulkWorkflowOperationRetry:
@@ -84,13 +191,21 @@ func bulkWorkflowInputAction(params BulkWorkflowInput) (bulkWorkflowAction, stri
 		if params.Status == StatusQueued {
 			return bulkWorkflowActionCancel, "", nil
 		}
+	case BulkWorkflowOperationDecide:
+		if params.Status == StatusPending {
+			decision, err := ParseProcessingWorkflowDecision(string(params.Decision))
+			if err != nil {
+				return 0, "", err
+			}
+			return bulkWorkflowActionDecide, string(decision), nil
+		}
 	}
 
 	return 0, "", fmt.Errorf("bulk %s is not supported for %s collections", params.Operation, params.Status)
 }
 
 // BulkWorkflow is a Temporal workflow that performs bulk operations.
-func BulkWorkflow(ctx temporalsdk_workflow.Context, params BulkWorkflowInput) error {
+func BulkWorkflow(ctx temporalsdk_workflow.Context, params BulkWorkflowInput) (*BulkWorkflowResult, error) {
 	opts := temporalsdk_workflow.WithActivityOptions(ctx, temporalsdk_workflow.ActivityOptions{
 		StartToCloseTimeout: time.Hour * 24 * 365,
 		WaitForCancellation: true,
@@ -100,26 +215,37 @@ func BulkWorkflow(ctx temporalsdk_workflow.Context, params BulkWorkflowInput) er
 		},
 	})
 
-	return temporalsdk_workflow.ExecuteActivity(opts, BulkActivityName, params).Get(opts, nil)
+	result := &BulkWorkflowResult{}
+	if err := temporalsdk_workflow.ExecuteActivity(opts, BulkActivityName, params).Get(opts, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
 }
 
 type BulkActivity struct {
 	colsvc bulkCollectionService
+	runs   bulkRunRecorder
 }
 
 func NewBulkActivity(colsvc Service) *BulkActivity {
-	return newBulkActivity(colsvc.Goa())
+	return newBulkActivity(colsvc.Goa(), colsvc)
 }
 
-func newBulkActivity(colsvc bulkCollectionService) *BulkActivity {
+func newBulkActivity(colsvc bulkCollectionService, runs bulkRunRecorder) *BulkActivity {
 	return &BulkActivity{
 		colsvc: colsvc,
+		runs:   runs,
 	}
 }
 
-func (a *BulkActivity) Execute(ctx context.Context, params BulkWorkflowInput) error {
-	if _, _, err := bulkWorkflowInputAction(params); err != nil {
-		return err
+// Execute performs the operation on the matching collections. Failed
+// decisions are recorded and the operation continues with the next collection,
+// other operations stop at the first failure.
+func (a *BulkActivity) Execute(ctx context.Context, params BulkWorkflowInput) (*BulkWorkflowResult, error) {
+	action, _, err := bulkWorkflowInputAction(params)
+	if err != nil {
+		return nil, err
 	}
 
 	var group run.Group
@@ -127,6 +253,7 @@ func (a *BulkActivity) Execute(ctx context.Context, params BulkWorkflowInput) er
 	// One actor does the work while updating progress.
 	// The other one sends the heartbeats.
 	progress := &BulkProgress{}
+	result := &BulkWorkflowResult{}
 	var mu sync.RWMutex
 
 	{
@@ -162,47 +289,83 @@ func (a *BulkActivity) Execute(ctx context.Context, params BulkWorkflowInput) er
 
 		group.Add(
 			func() error {
-				var nextCursor *string
-				status := params.Status.String()
 				var count uint
+
+				// process executes the operation on a collection and reports
+				// whether the cap has been reached.
+				process := func(ID uint) (bool, error) {
+					mu.Lock()
+					progress = &BulkProgress{
+						CurrentID: ID,
+						Count:     count + 1,
+						Max:       params.Size,
+					}
+					mu.Unlock()
+
+					var err error
+					if len(params.IDs) > 0 {
+						err = a.checkStatus(ctx, params, ID)
+					}
+					if err == nil {
+						err = a.executeOperation(ctx, params, ID)
+					}
+
+					outcome := newBulkOutcome(ID, err)
+					result.add(outcome)
+					if params.BulkRunID > 0 {
+						if err := a.runs.RecordBulkOutcome(ctx, params.BulkRunID, outcome); err != nil {
+							return false, err
+						}
+					}
+
+					if outcome.Outcome == BulkOutcomeSkipped {
+						return false, nil
+					}
+					if err != nil && action != bulkWorkflowActionDecide {
+						return false, fmt.Errorf("error executing bulk %s (failed on collection %d): %v", params.Operation, ID, err)
+					}
+
+					// Stop when cap is reached.
+					count++
+					if params.Size > 0 && count == params.Size {
+						return true, nil
+					}
+
+					// Making sure that we don't overwork the system.
+					time.Sleep(time.Millisecond * 50)
+
+					return false, nil
+				}
+
+				if len(params.IDs) > 0 {
+					for _, ID := range params.IDs {
+						select {
+						case <-cancel:
+							return nil
+						default:
+							if done, err := process(ID); done || err != nil {
+								return err
+							}
+						}
+					}
+					return nil
+				}
+
+				var nextCursor *string
 				for {
 					select {
 					case <-cancel:
 						return nil
 					default:
-						res, err := a.colsvc.List(ctx, &collection.ListPayload{
-							Status: &status,
-							Cursor: nextCursor,
-						})
+						res, err := a.colsvc.List(ctx, params.listPayload(nextCursor))
 						if err != nil {
 							return err
 						}
 
 						for _, item := range res.Items {
-							mu.Lock()
-							progress = &BulkProgress{
-								CurrentID: item.ID,
-								Count:     count + 1,
-								Max:       params.Size,
-							}
-							mu.Unlock()
-
-							err = a.executeOperation(ctx, params, item.ID)
-							if errors.Is(err, errBulkCancelSkipped) {
-								continue
-							}
-							if err != nil {
-								return fmt.Errorf("error executing bulk %s (failed on collection %d): %v", params.Operation, item.ID, err)
+							if done, err := process(item.ID); done || err != nil {
+								return err
 							}
-
-							// Stop when cap is reached.
-							count++
-							if params.Size > 0 && count == params.Size {
-								return nil
-							}
-
-							// Making sure that we don't overwork the system.
-							time.Sleep(time.Millisecond * 50)
 						}
 
 						nextCursor = res.NextCursor
@@ -218,7 +381,31 @@ func (a *BulkActivity) Execute(ctx context.Context, params BulkWorkflowInput) er
 		)
 	}
 
-	return group.Run()
+	err = group.Run()
+	if params.BulkRunID > 0 {
+		if completeErr := a.runs.CompleteBulkRun(context.WithoutCancel(ctx), params.BulkRunID, err); completeErr != nil && err == nil {
+			err = completeErr
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// checkStatus skips the listed collections that are not in the status targeted
+// by the operation.
+func (a *BulkActivity) checkStatus(ctx context.Context, params BulkWorkflowInput, ID uint) error {
+	col, err := a.colsvc.Show(ctx, &collection.ShowPayload{ID: ID})
+	if err != nil {
+		return err
+	}
+	if col.Status != params.Status.String() {
+		return fmt.Errorf("%w: collection is %s", errBulkSkipped, col.Status)
+	}
+
+	return nil
 }
 
 func (a *BulkActivity) executeOperation(ctx context.Context, params BulkWorkflowInput, ID uint) error {
@@ -227,6 +414,9 @@ func (a *BulkActivity) executeOperation(ctx context.Context, params BulkWorkflow
 		return err
 	}
 
+	// Transitions caused by the operation are attributed to the bulk run.
+	ctx = WithActor(ctx, BulkActor(params.BulkRunID))
+
 	switch action {
 	case bulkWorkflowActionRetry:
 		return a.Retry(ctx, ID)
@@ -279,7 +469,7 @@ func (a *BulkActivity) Cancel(ctx context.Context, ID uint) error {
 		return err
 	}
 	if skip {
-		return errBulkCancelSkipped
+		return fmt.Errorf("%w: transfer already started", errBulkSkipped)
 	}
 
 	return a.colsvc.Cancel(ctx, &collection.CancelPayload{ID: ID})
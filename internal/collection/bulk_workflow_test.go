@@ -2,6 +2,7 @@ package collection
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -65,6 +66,31 @@ func TestBulkWorkflowInputAction(t *testing.T) {
 			},
 			wantErr: "bulk abandon is not supported for error collections",
 		},
+		"decide pending collections": {
+			params: BulkWorkflowInput{
+				Operation: BulkWorkflowOperationDecide,
+				Status:    StatusPending,
+				Decision:  ProcessingWorkflowDecisionSkip,
+			},
+			wantAction:   bulkWorkflowActionDecide,
+			wantDecision: string(ProcessingWorkflowDecisionSkip),
+		},
+		"reject unknown decisions": {
+			params: BulkWorkflowInput{
+				Operation: BulkWorkflowOperationDecide,
+				Status:    StatusPending,
+				Decision:  "RETRY_LATER",
+			},
+			wantErr: `unknown decision option "RETRY_LATER"`,
+		},
+		"reject decide for errors": {
+			params: BulkWorkflowInput{
+				Operation: BulkWorkflowOperationDecide,
+				Status:    StatusError,
+				Decision:  ProcessingWorkflowDecisionRetry,
+			},
+			wantErr: "bulk decide is not supported for error collections",
+		},
 		"reject cancel for pending collections": {
 			params: BulkWorkflowInput{
 				Operation: BulkWorkflowOperationCancel,
@@ -105,7 +131,7 @@ func TestBulkDecisionAllowsWorkflowUpdateRoundTrip(t *testing.T) {
 		return nil
 	})
 
-	err := newBulkActivity(colsvc).Decide(
+	err := newBulkActivity(colsvc, nil).Decide(
 		context.Background(),
 		42,
 		string(ProcessingWorkflowDecisionAbandon),
@@ -207,12 +233,147 @@ func TestBulkActivityExecuteSkipsQueuedCollectionsWithTransfer(t *testing.T) {
 			return nil
 		})
 
-	activity := newBulkActivity(colsvc)
-	err := activity.Execute(context.Background(), BulkWorkflowInput{
+	runs := NewMockBulkRunRecorder(ctrl)
+	gomock.InOrder(
+		runs.EXPECT().RecordBulkOutcome(gomock.Any(), uint(7), BulkOutcome{ID: 10, Outcome: BulkOutcomeSkipped, Error: "skipped: transfer already started"}).Return(nil),
+		runs.EXPECT().RecordBulkOutcome(gomock.Any(), uint(7), BulkOutcome{ID: 11, Outcome: BulkOutcomeSucceeded}).Return(nil),
+		runs.EXPECT().CompleteBulkRun(gomock.Any(), uint(7), nil).Return(nil),
+	)
+
+	activity := newBulkActivity(colsvc, runs)
+	result, err := activity.Execute(context.Background(), BulkWorkflowInput{
 		Status:    StatusQueued,
 		Operation: BulkWorkflowOperationCancel,
 		Size:      1,
+		BulkRunID: 7,
+	})
+
+	assert.NilError(t, err)
+	assert.DeepEqual(t, result, &BulkWorkflowResult{Succeeded: 1, Skipped: 1})
+}
+
+func TestBulkActivityExecuteDecidesPendingCollections(t *testing.T) {
+	t.Parallel()
+
+	pipelineID := "e1d563b0-1474-4155-beed-f2d3a12e1529"
+	activityName := "receipt-send-activity"
+	ctrl := gomock.NewController(t)
+	colsvc := NewMockBulkCollectionService(ctrl)
+
+	colsvc.EXPECT().
+		List(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, payload *goacollection.ListPayload) (*goacollection.ListResult, error) {
+			assert.Equal(t, *payload.Status, StatusPending.String())
+			assert.Equal(t, *payload.PipelineID, pipelineID)
+			assert.Equal(t, *payload.DecisionActivity, activityName)
+
+			return &goacollection.ListResult{
+				Items: []*goacollection.EnduroStoredCollection{
+					{ID: 10, Status: StatusPending.String()},
+					{ID: 11, Status: StatusPending.String()},
+				},
+			}, nil
+		})
+	colsvc.EXPECT().
+		Decide(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, payload *goacollection.DecidePayload) error {
+			assert.Equal(t, payload.Option, string(ProcessingWorkflowDecisionSkip))
+			if payload.ID == 10 {
+				return errors.New("workflow is not awaiting an operator decision")
+			}
+			return nil
+		}).
+		Times(2)
+
+	runs := NewMockBulkRunRecorder(ctrl)
+	gomock.InOrder(
+		runs.EXPECT().RecordBulkOutcome(gomock.Any(), uint(7), BulkOutcome{ID: 10, Outcome: BulkOutcomeFailed, Error: "workflow is not awaiting an operator decision"}).Return(nil),
+		runs.EXPECT().RecordBulkOutcome(gomock.Any(), uint(7), BulkOutcome{ID: 11, Outcome: BulkOutcomeSucceeded}).Return(nil),
+		runs.EXPECT().CompleteBulkRun(gomock.Any(), uint(7), nil).Return(nil),
+	)
+
+	activity := newBulkActivity(colsvc, runs)
+	result, err := activity.Execute(context.Background(), BulkWorkflowInput{
+		Status:    StatusPending,
+		Operation: BulkWorkflowOperationDecide,
+		Decision:  ProcessingWorkflowDecisionSkip,
+		Filter: BulkFilter{
+			PipelineID:       &pipelineID,
+			DecisionActivity: &activityName,
+		},
+		BulkRunID: 7,
 	})
 
 	assert.NilError(t, err)
+	assert.DeepEqual(t, result, &BulkWorkflowResult{Succeeded: 1, Failed: 1})
+}
+
+func TestBulkActivityExecuteRecordsListedCollections(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	colsvc := NewMockBulkCollectionService(ctrl)
+	runs := NewMockBulkRunRecorder(ctrl)
+
+	colsvc.EXPECT().
+		Show(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, payload *goacollection.ShowPayload) (*goacollection.EnduroDetailedStoredCollection, error) {
+			status := StatusPending
+			if payload.ID == 11 {
+				status = StatusDone
+			}
+			return &goacollection.EnduroDetailedStoredCollection{ID: payload.ID, Status: status.String()}, nil
+		}).
+		Times(2)
+	colsvc.EXPECT().
+		Decide(gomock.Any(), &goacollection.DecidePayload{
+			ID:     10,
+			Option: string(ProcessingWorkflowDecisionAbandon),
+		}).
+		Return(nil)
+	gomock.InOrder(
+		runs.EXPECT().RecordBulkOutcome(gomock.Any(), uint(7), BulkOutcome{ID: 10, Outcome: BulkOutcomeSucceeded}).Return(nil),
+		runs.EXPECT().RecordBulkOutcome(gomock.Any(), uint(7), BulkOutcome{ID: 11, Outcome: BulkOutcomeSkipped, Error: "skipped: collection is done"}).Return(nil),
+		runs.EXPECT().CompleteBulkRun(gomock.Any(), uint(7), nil).Return(nil),
+	)
+
+	activity := newBulkActivity(colsvc, runs)
+	result, err := activity.Execute(context.Background(), BulkWorkflowInput{
+		Status:    StatusPending,
+		Operation: BulkWorkflowOperationAbandon,
+		Size:      2,
+		IDs:       []uint{10, 11},
+		BulkRunID: 7,
+	})
+
+	assert.NilError(t, err)
+	assert.DeepEqual(t, result, &BulkWorkflowResult{Succeeded: 1, Skipped: 1})
+}
+
+func TestBulkActivityExecuteCompletesFailedRun(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	colsvc := NewMockBulkCollectionService(ctrl)
+	runs := NewMockBulkRunRecorder(ctrl)
+
+	colsvc.EXPECT().
+		List(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("database is gone"))
+	runs.EXPECT().
+		CompleteBulkRun(gomock.Any(), uint(7), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ uint, runErr error) error {
+			assert.Error(t, runErr, "database is gone")
+			return nil
+		})
+
+	activity := newBulkActivity(colsvc, runs)
+	_, err := activity.Execute(context.Background(), BulkWorkflowInput{
+		Status:    StatusError,
+		Operation: BulkWorkflowOperationRetry,
+		Size:      1,
+		BulkRunID: 7,
+	})
+
+	assert.Error(t, err, "database is gone")
 }
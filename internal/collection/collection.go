@@ -4,14 +4,20 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	temporalsdk_client "go.temporal.io/sdk/client"
 
 	goacollection "github.com/artefactual-labs/enduro/internal/api/gen/collection"
+	"github.com/artefactual-labs/enduro/internal/notification"
 	"github.com/artefactual-labs/enduro/internal/pipeline"
+	"github.com/artefactual-labs/enduro/internal/retention"
+	"github.com/artefactual-labs/enduro/internal/validation"
+	"github.com/artefactual-labs/enduro/internal/watcher"
 )
 
 type Service interface {
@@ -19,13 +25,44 @@ type Service interface {
 	Goa() goacollection.Service
 	Create(context.Context, *Collection) error
 	CheckDuplicate(ctx context.Context, id uint) (bool, error)
+	// Read returns the collection with the given ID.
+	Read(ctx context.Context, ID uint) (*Collection, error)
 	UpdateWorkflowStatus(ctx context.Context, ID uint, name, workflowID, runID, transferID, aipID, pipelineID string, status Status, storedAt time.Time) error
 	// UpdateReconciliationState replaces the stored reconciliation columns. Nil
 	// values clear the corresponding database fields.
 	UpdateReconciliationState(ctx context.Context, ID uint, aipStoredAt, checkedAt *time.Time, status, errMsg *string) error
 	SetStatus(ctx context.Context, ID uint, status Status) error
+	// SetPendingDecision sets the status to pending and records the failure
+	// that the operator is asked to decide about.
+	SetPendingDecision(ctx context.Context, ID uint, decision PendingDecision) error
+	// RemindPendingDecision announces that the operator decision has been
+	// pending for the given time.
+	RemindPendingDecision(ctx context.Context, ID uint, pendingFor time.Duration) error
 	SetStatusInProgress(ctx context.Context, ID uint, startedAt time.Time) error
 	SetOriginalID(ctx context.Context, ID uint, originalID string) error
+	// SetPipelineSelection records the decision of the pipeline scheduler.
+	SetPipelineSelection(ctx context.Context, ID uint, selection string) error
+	// SetFailure records why Archivematica could not process the collection.
+	SetFailure(ctx context.Context, ID uint, failure pipeline.Failure) error
+	// SetProgress records the latest progress reported by Archivematica.
+	SetProgress(ctx context.Context, ID uint, progress pipeline.Progress) error
+	// SetValidationResults replaces the recorded results of the transfer
+	// validators.
+	SetValidationResults(ctx context.Context, ID uint, results []validation.Result) error
+	// Rescan lists the blobs of a watcher that were never dispatched and are
+	// not known collections.
+	Rescan(ctx context.Context, watcherName string) ([]*watcher.BlobEvent, error)
+	// RecordDispatch records that a blob of a watcher was dispatched so it is
+	// left out of later rescans.
+	RecordDispatch(ctx context.Context, watcherName, key string) error
+	// LegalHold reports whether the collection is under legal hold.
+	LegalHold(ctx context.Context, ID uint) (bool, error)
+	// SetLegalHold places or releases the legal hold of a collection.
+	SetLegalHold(ctx context.Context, ID uint, held bool, reason, actor string) error
+	// RecordBulkOutcome records the outcome of a bulk run on a collection.
+	RecordBulkOutcome(ctx context.Context, bulkRunID uint, outcome BulkOutcome) error
+	// CompleteBulkRun records the end of a bulk run.
+	CompleteBulkRun(ctx context.Context, bulkRunID uint, runErr error) error
 }
 
 type collectionImpl struct {
@@ -38,18 +75,30 @@ type collectionImpl struct {
 
 	// Destination for events to be published.
 	events EventService
+
+	// Delivers status transitions to the configured webhooks, optional.
+	notifications notification.Service
+
+	// Lists the contents of the watched buckets, optional.
+	watchers watcher.Service
+
+	// Schedules the deletion of the originals, optional.
+	retention retention.Service
 }
 
 var _ Service = (*collectionImpl)(nil)
 
-func NewService(logger logr.Logger, db *sql.DB, cc temporalsdk_client.Client, taskQueue string, registry *pipeline.Registry) *collectionImpl {
+func NewService(logger logr.Logger, db *sql.DB, cc temporalsdk_client.Client, taskQueue string, registry *pipeline.Registry, notifications notification.Service, watchers watcher.Service, retention retention.Service) *collectionImpl {
 	return &collectionImpl{
-		logger:    logger,
-		db:        sqlx.NewDb(db, "mysql"),
-		cc:        cc,
-		taskQueue: taskQueue,
-		registry:  registry,
-		events:    NewEventService(),
+		logger:        logger,
+		db:            sqlx.NewDb(db, "mysql"),
+		cc:            cc,
+		taskQueue:     taskQueue,
+		registry:      registry,
+		events:        NewEventService(),
+		notifications: notifications,
+		watchers:      watchers,
+		retention:     retention,
 	}
 }
 
@@ -66,9 +115,10 @@ func (svc *collectionImpl) Create(ctx context.Context, col *Collection) error {
 	}
 	defer func() { _ = tx.Rollback() }()
 
-	query := `INSERT INTO collection (name, workflow_id, run_id, transfer_id, aip_id, original_id, pipeline_id, status) VALUES ((?), (?), (?), (?), (?), (?), (?), (?))`
+	query := `INSERT INTO collection (name, watcher_name, workflow_id, run_id, transfer_id, aip_id, original_id, pipeline_id, status) VALUES ((?), (?), (?), (?), (?), (?), (?), (?), (?))`
 	args := []any{
 		col.Name,
+		col.WatcherName,
 		col.WorkflowID,
 		col.RunID,
 		col.TransferID,
@@ -90,11 +140,19 @@ func (svc *collectionImpl) Create(ctx context.Context, col *Collection) error {
 	}
 
 	col.ID = uint(id)
+	cause := statusTransitionCauseFromContext(ctx)
+	actor := cause.Actor
+	if actor == "" && col.WatcherName != "" {
+		actor = WatcherActor(col.WatcherName)
+	}
+	if actor == "" {
+		actor = ActorSystem
+	}
 	if err := insertStatusTransition(ctx, tx, col.ID, nil, collectionStatusState{
 		WorkflowID: col.WorkflowID,
 		RunID:      col.RunID,
 		Status:     col.Status,
-	}, true, "collection_created"); err != nil {
+	}, true, ReasonCollectionCreated, actor, cause.Detail); err != nil {
 		return err
 	}
 
@@ -103,6 +161,11 @@ func (svc *collectionImpl) Create(ctx context.Context, col *Collection) error {
 	}
 
 	publishEvent(ctx, svc.events, EventTypeCollectionCreated, col.ID)
+	svc.notify(ctx, col.ID, nil, collectionStatusState{
+		WorkflowID: col.WorkflowID,
+		RunID:      col.RunID,
+		Status:     col.Status,
+	}, ReasonCollectionCreated)
 
 	return nil
 }
@@ -129,6 +192,10 @@ func publishEvent(ctx context.Context, events EventService, eventType string, id
 	})
 }
 
+func (svc *collectionImpl) Read(ctx context.Context, ID uint) (*Collection, error) {
+	return svc.read(ctx, ID)
+}
+
 func (svc *collectionImpl) UpdateWorkflowStatus(ctx context.Context, ID uint, name, workflowID, runID, transferID, aipID, pipelineID string, status Status, storedAt time.Time) error {
 	// Ensure that storedAt is reset during retries.
 	completedAt := &storedAt
@@ -183,6 +250,65 @@ func (svc *collectionImpl) SetStatus(ctx context.Context, ID uint, status Status
 	return nil
 }
 
+func (svc *collectionImpl) SetPendingDecision(ctx context.Context, ID uint, decision PendingDecision) error {
+	options := make([]string, 0, len(decision.Options))
+	for _, option := range decision.Options {
+		options = append(options, string(option))
+	}
+
+	query := `UPDATE collection SET status = (?), decision_activity = (?), decision_error = (?), decision_options = (?) WHERE id = (?)`
+	args := []any{
+		StatusPending,
+		decision.Activity,
+		decision.Error,
+		strings.Join(options, ","),
+		ID,
+	}
+
+	if err := svc.updateCurrentRunStatus(ctx, ID, StatusPending, query, args); err != nil {
+		return err
+	}
+
+	publishEvent(ctx, svc.events, EventTypeCollectionUpdated, ID)
+
+	return nil
+}
+
+// RemindPendingDecision publishes a reminder to the monitor and to the webhooks
+// subscribed to decision reminders.
+func (svc *collectionImpl) RemindPendingDecision(ctx context.Context, ID uint, pendingFor time.Duration) error {
+	state := collectionStatusState{}
+	query := `SELECT workflow_id, run_id, status FROM collection WHERE id = (?)`
+	if err := svc.db.GetContext(ctx, &state, svc.db.Rebind(query), ID); err != nil {
+		return fmt.Errorf("error reading collection: %w", err)
+	}
+
+	svc.logger.Info("Operator decision pending.", "id", ID, "pendingFor", pendingFor.String())
+	publishEvent(ctx, svc.events, EventTypeCollectionDecisionReminder, ID)
+
+	if svc.notifications == nil {
+		return nil
+	}
+
+	event := notification.Event{
+		ID:         uuid.New().String(),
+		Type:       notification.EventDecisionReminder,
+		OccurredAt: time.Now().UTC(),
+		Collection: notification.EventCollection{
+			ID:             ID,
+			WorkflowID:     state.WorkflowID,
+			RunID:          state.RunID,
+			Status:         state.Status.String(),
+			PendingSeconds: int64(pendingFor.Seconds()),
+		},
+	}
+	if err := svc.notifications.Notify(ctx, event); err != nil {
+		return fmt.Errorf("error scheduling notifications: %w", err)
+	}
+
+	return nil
+}
+
 func (svc *collectionImpl) UpdateReconciliationState(ctx context.Context, ID uint, aipStoredAt, checkedAt *time.Time, status, errMsg *string) error {
 	query := `UPDATE collection SET aip_stored_at = (?), reconciliation_checked_at = (?), reconciliation_status = (?), reconciliation_error = (?) WHERE id = (?)`
 	args := []any{
@@ -239,6 +365,22 @@ func (svc *collectionImpl) SetOriginalID(ctx context.Context, ID uint, originalI
 	return nil
 }
 
+func (svc *collectionImpl) SetPipelineSelection(ctx context.Context, ID uint, selection string) error {
+	query := `UPDATE collection SET pipeline_selection = (?) WHERE id = (?)`
+	args := []any{
+		selection,
+		ID,
+	}
+
+	if _, err := svc.updateRow(ctx, query, args); err != nil {
+		return err
+	}
+
+	publishEvent(ctx, svc.events, EventTypeCollectionUpdated, ID)
+
+	return nil
+}
+
 func (svc *collectionImpl) updateRow(ctx context.Context, query string, args []any) (int64, error) {
 	query = svc.db.Rebind(query)
 	res, err := svc.db.ExecContext(ctx, query, args...)
@@ -269,7 +411,7 @@ func updateRowTx(ctx context.Context, tx *sqlx.Tx, query string, args []any) err
 }
 
 func (svc *collectionImpl) read(ctx context.Context, ID uint) (*Collection, error) {
-	query := "SELECT id, name, workflow_id, run_id, transfer_id, aip_id, original_id, pipeline_id, status, CONVERT_TZ(created_at, @@session.time_zone, '+00:00') AS created_at, CONVERT_TZ(started_at, @@session.time_zone, '+00:00') AS started_at, CONVERT_TZ(completed_at, @@session.time_zone, '+00:00') AS completed_at, CONVERT_TZ(aip_stored_at, @@session.time_zone, '+00:00') AS aip_stored_at, reconciliation_status, CONVERT_TZ(reconciliation_checked_at, @@session.time_zone, '+00:00') AS reconciliation_checked_at, reconciliation_error FROM collection WHERE id = (?)"
+	query := "SELECT id, name, workflow_id, run_id, transfer_id, aip_id, original_id, pipeline_id, status, CONVERT_TZ(created_at, @@session.time_zone, '+00:00') AS created_at, CONVERT_TZ(started_at, @@session.time_zone, '+00:00') AS started_at, CONVERT_TZ(completed_at, @@session.time_zone, '+00:00') AS completed_at, CONVERT_TZ(aip_stored_at, @@session.time_zone, '+00:00') AS aip_stored_at, reconciliation_status, CONVERT_TZ(reconciliation_checked_at, @@session.time_zone, '+00:00') AS reconciliation_checked_at, reconciliation_error, legal_hold, legal_hold_reason, legal_hold_actor, CONVERT_TZ(legal_hold_at, @@session.time_zone, '+00:00') AS legal_hold_at, decision_activity, decision_error, decision_options, pipeline_selection FROM collection WHERE id = (?)"
 	args := []any{ID}
 	c := Collection{}
 
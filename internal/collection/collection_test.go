@@ -14,6 +14,11 @@ import (
 
 	"github.com/go-logr/logr"
 	"gotest.tools/v3/assert"
+
+	goacollection "github.com/artefactual-labs/enduro/internal/api/gen/collection"
+	"github.com/artefactual-labs/enduro/internal/notification"
+	"github.com/artefactual-labs/enduro/internal/pipeline"
+	"github.com/artefactual-labs/enduro/internal/validation"
 )
 
 func TestUpdateReconciliationState(t *testing.T) {
@@ -23,7 +28,7 @@ func TestUpdateReconciliationState(t *testing.T) {
 		t.Parallel()
 
 		recorder := newExecRecorderDB(t)
-		svc := NewService(testLogger(), recorder.db, nil, "", nil)
+		svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
 
 		aipStoredAt := time.Date(2026, time.March, 18, 8, 0, 0, 0, time.UTC)
 		checkedAt := aipStoredAt.Add(5 * time.Minute)
@@ -46,7 +51,7 @@ func TestUpdateReconciliationState(t *testing.T) {
 		t.Parallel()
 
 		recorder := newExecRecorderDB(t)
-		svc := NewService(testLogger(), recorder.db, nil, "", nil)
+		svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
 
 		err := svc.UpdateReconciliationState(context.Background(), 42, nil, nil, nil, nil)
 
@@ -68,7 +73,7 @@ func TestSetStatusInProgress(t *testing.T) {
 
 		recorder := newExecRecorderDB(t)
 		recorder.row = &Collection{WorkflowID: "workflow-42", RunID: "run-42", Status: StatusQueued}
-		svc := NewService(testLogger(), recorder.db, nil, "", nil)
+		svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
 		startedAt := time.Date(2026, time.June, 24, 8, 30, 0, 0, time.UTC)
 
 		err := svc.SetStatusInProgress(context.Background(), 42, startedAt)
@@ -80,7 +85,7 @@ func TestSetStatusInProgress(t *testing.T) {
 			startedAt,
 			int64(42),
 		})
-		assert.Equal(t, recorder.execQueries[1], "INSERT INTO collection_status_transition (collection_id, workflow_id, run_id, previous_status, status, is_run_start, reason) VALUES ((?), (?), (?), (?), (?), (?), (?))")
+		assert.Equal(t, recorder.execQueries[1], "INSERT INTO collection_status_transition (collection_id, workflow_id, run_id, previous_status, status, is_run_start, reason, actor, detail) VALUES ((?), (?), (?), (?), (?), (?), (?), (?), (?))")
 		assert.DeepEqual(t, recorder.execArgsList[1], []any{
 			int64(42),
 			"workflow-42",
@@ -89,6 +94,8 @@ func TestSetStatusInProgress(t *testing.T) {
 			int64(StatusInProgress),
 			false,
 			"pipeline_acquired",
+			"system",
+			nil,
 		})
 		assert.Assert(t, recorder.committed)
 	})
@@ -98,7 +105,7 @@ func TestSetStatusInProgress(t *testing.T) {
 
 		recorder := newExecRecorderDB(t)
 		recorder.row = &Collection{WorkflowID: "workflow-42", RunID: "run-42", Status: StatusPending}
-		svc := NewService(testLogger(), recorder.db, nil, "", nil)
+		svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
 
 		err := svc.SetStatusInProgress(context.Background(), 42, time.Time{})
 
@@ -118,7 +125,7 @@ func TestSetStatusDoesNotDuplicateTransition(t *testing.T) {
 
 	recorder := newExecRecorderDB(t)
 	recorder.row = &Collection{WorkflowID: "workflow-42", RunID: "run-42", Status: StatusPending}
-	svc := NewService(testLogger(), recorder.db, nil, "", nil)
+	svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
 
 	err := svc.SetStatus(context.Background(), 42, StatusPending)
 
@@ -132,7 +139,7 @@ func TestSetStatusRecordsOperatorDecision(t *testing.T) {
 
 	recorder := newExecRecorderDB(t)
 	recorder.row = &Collection{WorkflowID: "workflow-42", RunID: "run-42", Status: StatusInProgress}
-	svc := NewService(testLogger(), recorder.db, nil, "", nil)
+	svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
 
 	err := svc.SetStatus(context.Background(), 42, StatusPending)
 
@@ -145,14 +152,170 @@ func TestSetStatusRecordsOperatorDecision(t *testing.T) {
 		int64(StatusPending),
 		false,
 		"operator_decision_required",
+		"system",
+		nil,
+	})
+}
+
+func TestSetPendingDecision(t *testing.T) {
+	t.Parallel()
+
+	recorder := newExecRecorderDB(t)
+	recorder.row = &Collection{WorkflowID: "workflow-42", RunID: "run-42", Status: StatusInProgress}
+	svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
+
+	err := svc.SetPendingDecision(context.Background(), 42, PendingDecision{
+		Activity: "receipt-send-activity",
+		Error:    "connection refused",
+		Options: []ProcessingWorkflowDecision{
+			ProcessingWorkflowDecisionRetry,
+			ProcessingWorkflowDecisionSkip,
+		},
+	})
+
+	assert.NilError(t, err)
+	assert.Equal(t, recorder.execQueries[0], "UPDATE collection SET status = (?), decision_activity = (?), decision_error = (?), decision_options = (?) WHERE id = (?)")
+	assert.DeepEqual(t, recorder.execArgsList[0], []any{
+		int64(StatusPending),
+		"receipt-send-activity",
+		"connection refused",
+		"RETRY,SKIP",
+		int64(42),
+	})
+	assert.Equal(t, recorder.execArgsList[1][6], "operator_decision_required")
+}
+
+func TestSetPipelineSelection(t *testing.T) {
+	t.Parallel()
+
+	recorder := newExecRecorderDB(t)
+	svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
+
+	err := svc.SetPipelineSelection(context.Background(), 42, "am2 (active, 1/3 in use, weight 1)")
+
+	assert.NilError(t, err)
+	assert.Equal(t, recorder.execQueries[0], "UPDATE collection SET pipeline_selection = (?) WHERE id = (?)")
+	assert.DeepEqual(t, recorder.execArgsList[0], []any{
+		"am2 (active, 1/3 in use, weight 1)",
+		int64(42),
+	})
+}
+
+func TestSetProgress(t *testing.T) {
+	t.Parallel()
+
+	recorder := newExecRecorderDB(t)
+	svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
+	sub, err := svc.events.Subscribe(context.Background())
+	assert.NilError(t, err)
+	defer sub.Close()
+
+	err = svc.SetProgress(context.Background(), 42, pipeline.Progress{
+		Stage:         pipeline.ProgressStageIngest,
+		Microservice:  "Normalize",
+		Job:           "Normalize for preservation",
+		JobsCompleted: 3,
+		JobsTotal:     4,
+		FailedJobs: []pipeline.FailedJob{
+			{ID: "j2", Name: "Validate formats", Microservice: "Validation"},
+		},
+	})
+
+	assert.NilError(t, err)
+	assert.Assert(t, strings.HasPrefix(recorder.execQueries[0], "INSERT INTO collection_progress"))
+	assert.DeepEqual(t, recorder.execArgsList[0][:7], []any{
+		int64(42),
+		"ingest",
+		"Normalize",
+		"Normalize for preservation",
+		int64(3),
+		int64(4),
+		`[{"id":"j2","name":"Validate formats","microservice":"Validation"}]`,
+	})
+
+	update := <-sub.C()
+	assert.Equal(t, update.Type, EventTypeCollectionProgress)
+	assert.Equal(t, update.ID, uint(42))
+	assert.DeepEqual(t, update.Progress, &goacollection.EnduroCollectionProgress{
+		Stage:         "ingest",
+		Microservice:  new("Normalize"),
+		Job:           new("Normalize for preservation"),
+		JobsCompleted: 3,
+		JobsTotal:     4,
+		Percent:       75,
+		FailedJobs: []*goacollection.EnduroCollectionFailedJob{
+			{ID: "j2", Name: "Validate formats", Microservice: "Validation"},
+		},
+		UpdatedAt: update.Timestamp,
 	})
 }
 
+func TestSetFailure(t *testing.T) {
+	t.Parallel()
+
+	recorder := newExecRecorderDB(t)
+	svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
+
+	err := svc.SetFailure(context.Background(), 42, pipeline.Failure{
+		Category:     pipeline.FailureCategoryFailed,
+		Stage:        pipeline.ProgressStageIngest,
+		UnitID:       "0f1d2a36-1a48-4e55-8b2e-5bd1d3b3a1f1",
+		Job:          "Normalize for preservation",
+		Microservice: "Normalize",
+		Errors:       []string{"ingest is in a state that we can't handle: FAILED"},
+	})
+
+	assert.NilError(t, err)
+	assert.Assert(t, strings.HasPrefix(recorder.execQueries[0], "INSERT INTO collection_failure"))
+	assert.DeepEqual(t, recorder.execArgsList[0][:8], []any{
+		int64(42),
+		"failed",
+		"ingest",
+		"0f1d2a36-1a48-4e55-8b2e-5bd1d3b3a1f1",
+		"Normalize",
+		"Normalize for preservation",
+		nil,
+		`["ingest is in a state that we can't handle: FAILED"]`,
+	})
+}
+
+func TestCollectionPendingDecision(t *testing.T) {
+	t.Parallel()
+
+	col := Collection{
+		Status:           StatusPending,
+		DecisionActivity: sql.NullString{String: "receipt-send-activity", Valid: true},
+		DecisionError:    sql.NullString{String: "connection refused", Valid: true},
+		DecisionOptions:  sql.NullString{String: "RETRY,SKIP", Valid: true},
+	}
+
+	decision, ok := col.PendingDecision()
+	assert.Assert(t, ok)
+	assert.DeepEqual(t, decision, PendingDecision{
+		Activity: "receipt-send-activity",
+		Error:    "connection refused",
+		Options: []ProcessingWorkflowDecision{
+			ProcessingWorkflowDecisionRetry,
+			ProcessingWorkflowDecisionSkip,
+		},
+	})
+	assert.DeepEqual(t, col.GoaDetail().Decision, &goacollection.EnduroCollectionPendingDecision{
+		Activity: "receipt-send-activity",
+		Error:    "connection refused",
+		Options:  []string{"RETRY", "SKIP"},
+	})
+
+	col.Status = StatusInProgress
+	_, ok = col.PendingDecision()
+	assert.Assert(t, !ok)
+	assert.Assert(t, col.GoaDetail().Decision == nil)
+}
+
 func TestCreateRecordsRunStartTransition(t *testing.T) {
 	t.Parallel()
 
 	recorder := newExecRecorderDB(t)
-	svc := NewService(testLogger(), recorder.db, nil, "", nil)
+	svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
 	col := &Collection{
 		Name:       "collection",
 		WorkflowID: "workflow-42",
@@ -173,6 +336,8 @@ func TestCreateRecordsRunStartTransition(t *testing.T) {
 		int64(StatusQueued),
 		true,
 		"collection_created",
+		"system",
+		nil,
 	})
 	assert.Assert(t, recorder.committed)
 }
@@ -184,7 +349,7 @@ func TestStatusTransitionFailureRollsBack(t *testing.T) {
 	recorder.row = &Collection{WorkflowID: "workflow-42", RunID: "run-42", Status: StatusQueued}
 	recorder.execErr = errTestDB
 	recorder.execErrAt = 2
-	svc := NewService(testLogger(), recorder.db, nil, "", nil)
+	svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
 
 	err := svc.SetStatus(context.Background(), 42, StatusError)
 
@@ -198,7 +363,7 @@ func TestUpdateWorkflowStatusRecordsRetryRunStart(t *testing.T) {
 
 	recorder := newExecRecorderDB(t)
 	recorder.row = &Collection{WorkflowID: "workflow-42", RunID: "old-run", Status: StatusError}
-	svc := NewService(testLogger(), recorder.db, nil, "", nil)
+	svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
 
 	err := svc.UpdateWorkflowStatus(
 		context.Background(),
@@ -222,7 +387,157 @@ func TestUpdateWorkflowStatusRecordsRetryRunStart(t *testing.T) {
 		int64(StatusQueued),
 		true,
 		"workflow_retried",
+		"system",
+		nil,
+	})
+}
+
+func TestStatusTransitionRecordsCause(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Records the actor of a retry", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := newExecRecorderDB(t)
+		recorder.row = &Collection{WorkflowID: "workflow-42", RunID: "old-run", Status: StatusError}
+		svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
+		ctx := WithActor(context.Background(), APIActor("alice"))
+
+		err := svc.UpdateWorkflowStatus(ctx, 42, "collection", "workflow-42", "new-run", "", "", "", StatusQueued, time.Time{})
+
+		assert.NilError(t, err)
+		assert.DeepEqual(t, recorder.execArgsList[1][6:], []any{"workflow_retried", "api:alice", nil})
+	})
+
+	t.Run("Records the reason and the detail given", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := newExecRecorderDB(t)
+		recorder.row = &Collection{WorkflowID: "workflow-42", RunID: "run-42", Status: StatusInProgress}
+		svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
+		ctx := WithStatusTransitionCause(context.Background(), StatusTransitionCause{
+			Reason: ReasonDeadlineExceeded,
+			Detail: "transfer deadline (1h0m0s) exceeded",
+		})
+
+		err := svc.SetStatus(ctx, 42, StatusError)
+
+		assert.NilError(t, err)
+		assert.DeepEqual(t, recorder.execArgsList[1][6:], []any{"deadline_exceeded", "system", "transfer deadline (1h0m0s) exceeded"})
+	})
+
+	t.Run("Attributes created collections to their watcher", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := newExecRecorderDB(t)
+		svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
+
+		err := svc.Create(context.Background(), &Collection{Name: "collection", WatcherName: "dev-fs", Status: StatusQueued})
+
+		assert.NilError(t, err)
+		assert.DeepEqual(t, recorder.execArgsList[1][6:], []any{"collection_created", "watcher:dev-fs", nil})
+	})
+}
+
+func TestActors(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, APIActor(""), "api")
+	assert.Equal(t, APIActor("alice"), "api:alice")
+	assert.Equal(t, BulkActor(0), "bulk")
+	assert.Equal(t, BulkActor(7), "bulk:7")
+	assert.Equal(t, WatcherActor("dev-fs"), "watcher:dev-fs")
+	assert.Equal(t, ActorFromContext(context.Background()), "api")
+	assert.Equal(t, ActorFromContext(WithActor(context.Background(), BulkActor(7))), "bulk:7")
+}
+
+func TestStatusTransitionNotifiesWebhooks(t *testing.T) {
+	t.Parallel()
+
+	recorder := newExecRecorderDB(t)
+	recorder.row = &Collection{WorkflowID: "workflow-42", RunID: "run-42", Status: StatusInProgress}
+	notifications := &notificationRecorder{}
+	svc := NewService(testLogger(), recorder.db, nil, "", nil, notifications, nil, nil)
+
+	err := svc.SetStatus(context.Background(), 42, StatusDone)
+	assert.NilError(t, err)
+
+	// Updates that do not change the status are not notified.
+	err = svc.SetStatus(context.Background(), 42, StatusInProgress)
+	assert.NilError(t, err)
+
+	assert.Equal(t, len(notifications.events), 1)
+	event := notifications.events[0]
+	assert.Equal(t, event.Type, notification.EventDone)
+	assert.DeepEqual(t, event.Collection, notification.EventCollection{
+		ID:             42,
+		WorkflowID:     "workflow-42",
+		RunID:          "run-42",
+		Status:         "done",
+		PreviousStatus: "in progress",
+		Reason:         "workflow_completed",
+	})
+}
+
+func TestRemindPendingDecision(t *testing.T) {
+	t.Parallel()
+
+	recorder := newExecRecorderDB(t)
+	recorder.row = &Collection{WorkflowID: "workflow-42", RunID: "run-42", Status: StatusPending}
+	notifications := &notificationRecorder{}
+	svc := NewService(testLogger(), recorder.db, nil, "", nil, notifications, nil, nil)
+	sub, err := svc.events.Subscribe(context.Background())
+	assert.NilError(t, err)
+	defer sub.Close()
+
+	err = svc.RemindPendingDecision(context.Background(), 42, 24*time.Hour)
+
+	assert.NilError(t, err)
+	update := <-sub.C()
+	assert.Equal(t, update.Type, EventTypeCollectionDecisionReminder)
+	assert.Equal(t, update.ID, uint(42))
+	assert.Equal(t, len(notifications.events), 1)
+	event := notifications.events[0]
+	assert.Equal(t, event.Type, notification.EventDecisionReminder)
+	assert.DeepEqual(t, event.Collection, notification.EventCollection{
+		ID:             42,
+		WorkflowID:     "workflow-42",
+		RunID:          "run-42",
+		Status:         "pending",
+		PendingSeconds: 86400,
+	})
+}
+
+type notificationRecorder struct {
+	notification.Service
+	events []notification.Event
+}
+
+func (r *notificationRecorder) Notify(_ context.Context, event notification.Event) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+func TestSetValidationResultsReplacesResults(t *testing.T) {
+	t.Parallel()
+
+	recorder := newExecRecorderDB(t)
+	svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
+
+	err := svc.SetValidationResults(context.Background(), 42, []validation.Result{
+		{Validator: "checksum-manifest", Severity: validation.SeverityFail, Status: validation.StatusPassed},
+		{Validator: "forbidden-extensions", Severity: validation.SeverityWarn, Status: validation.StatusWarned, Message: "file \"a.exe\" uses a forbidden extension"},
+	})
+
+	assert.NilError(t, err)
+	assert.DeepEqual(t, recorder.execQueries, []string{
+		"DELETE FROM collection_validation_result WHERE collection_id = (?)",
+		"INSERT INTO collection_validation_result (collection_id, validator, severity, status, message) VALUES ((?), (?), (?), (?), (?))",
+		"INSERT INTO collection_validation_result (collection_id, validator, severity, status, message) VALUES ((?), (?), (?), (?), (?))",
 	})
+	assert.DeepEqual(t, recorder.execArgsList[1], []any{int64(42), "checksum-manifest", "fail", "passed", nil})
+	assert.DeepEqual(t, recorder.execArgsList[2], []any{int64(42), "forbidden-extensions", "warn", "warned", "file \"a.exe\" uses a forbidden extension"})
+	assert.Assert(t, recorder.committed)
 }
 
 func TestStatusHistoryAvailability(t *testing.T) {
@@ -271,7 +586,7 @@ func TestCheckDuplicateIgnoresFailedAndAbandonedCollections(t *testing.T) {
 	duplicateExists := false
 	recorder := newExecRecorderDB(t)
 	recorder.queryBool = &duplicateExists
-	svc := NewService(testLogger(), recorder.db, nil, "", nil)
+	svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
 
 	got, err := svc.CheckDuplicate(context.Background(), 42)
 
@@ -305,7 +620,14 @@ type execRecorderDB struct {
 	queryErr     error
 	row          *Collection
 	queryBool    *bool
+	count        int64
 	transitions  []StatusTransition
+	validations  []ValidationResult
+	progress     *Progress
+	failure      *Failure
+	names        []string
+	bulkRun      *BulkRun
+	bulkOutcomes []BulkOutcome
 	lastInsertID int64
 	committed    bool
 	rolledBack   bool
@@ -392,9 +714,33 @@ func (c execRecorderConn) QueryContext(_ context.Context, query string, args []d
 	if strings.Contains(query, "FROM collection_status_transition") {
 		return &statusTransitionRows{transitions: c.recorder.transitions}, nil
 	}
+	if strings.Contains(query, "FROM collection_validation_result") {
+		return &validationResultRows{results: c.recorder.validations}, nil
+	}
+	if strings.Contains(query, "FROM collection_progress") {
+		return &progressRows{progress: c.recorder.progress}, nil
+	}
+	if strings.Contains(query, "FROM collection_failure") {
+		return &failureRows{failure: c.recorder.failure}, nil
+	}
+	if strings.Contains(query, "SELECT name FROM collection") {
+		return &nameRows{names: c.recorder.names}, nil
+	}
 	if strings.Contains(query, "SELECT workflow_id, run_id, status FROM collection") {
 		return &collectionStatusRows{row: c.recorder.row}, nil
 	}
+	if strings.Contains(query, "SELECT legal_hold FROM collection") {
+		return &legalHoldRows{row: c.recorder.row}, nil
+	}
+	if strings.Contains(query, "SELECT COUNT(*) FROM collection") {
+		return &countRows{value: c.recorder.count}, nil
+	}
+	if strings.Contains(query, "FROM bulk_run_result") {
+		return &bulkOutcomeRows{outcomes: c.recorder.bulkOutcomes}, nil
+	}
+	if strings.Contains(query, "FROM bulk_run") {
+		return &bulkRunRows{run: c.recorder.bulkRun}, nil
+	}
 
 	return &collectionRows{row: c.recorder.row}, nil
 }
@@ -476,6 +822,88 @@ func (r *statusTransitionRows) Next(dest []driver.Value) error {
 	return nil
 }
 
+type validationResultRows struct {
+	results []ValidationResult
+	index   int
+}
+
+func (r *validationResultRows) Columns() []string {
+	return []string{"id", "collection_id", "validator", "severity", "status", "message", "created_at"}
+}
+
+func (r *validationResultRows) Close() error { return nil }
+
+func (r *validationResultRows) Next(dest []driver.Value) error {
+	if r.index >= len(r.results) {
+		return io.EOF
+	}
+	result := r.results[r.index]
+	r.index++
+	dest[0] = int64(result.ID)
+	dest[1] = int64(result.CollectionID)
+	dest[2] = result.Validator
+	dest[3] = result.Severity
+	dest[4] = result.Status
+	dest[5] = nullStringValue(result.Message)
+	dest[6] = result.CreatedAt
+	return nil
+}
+
+type progressRows struct {
+	progress *Progress
+	done     bool
+}
+
+func (r *progressRows) Columns() []string {
+	return []string{"collection_id", "stage", "microservice", "job", "jobs_completed", "jobs_total", "failed_jobs", "updated_at"}
+}
+
+func (r *progressRows) Close() error { return nil }
+
+func (r *progressRows) Next(dest []driver.Value) error {
+	if r.done || r.progress == nil {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = int64(r.progress.CollectionID)
+	dest[1] = r.progress.Stage
+	dest[2] = nullStringValue(r.progress.Microservice)
+	dest[3] = nullStringValue(r.progress.Job)
+	dest[4] = int64(r.progress.JobsCompleted)
+	dest[5] = int64(r.progress.JobsTotal)
+	dest[6] = nullStringValue(r.progress.FailedJobs)
+	dest[7] = r.progress.UpdatedAt
+	return nil
+}
+
+type failureRows struct {
+	failure *Failure
+	done    bool
+}
+
+func (r *failureRows) Columns() []string {
+	return []string{"collection_id", "category", "stage", "unit_id", "microservice", "job", "stderr", "errors", "occurred_at"}
+}
+
+func (r *failureRows) Close() error { return nil }
+
+func (r *failureRows) Next(dest []driver.Value) error {
+	if r.done || r.failure == nil {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = int64(r.failure.CollectionID)
+	dest[1] = r.failure.Category
+	dest[2] = r.failure.Stage
+	dest[3] = r.failure.UnitID
+	dest[4] = nullStringValue(r.failure.Microservice)
+	dest[5] = nullStringValue(r.failure.Job)
+	dest[6] = nullStringValue(r.failure.Stderr)
+	dest[7] = r.failure.Errors
+	dest[8] = r.failure.OccurredAt
+	return nil
+}
+
 type boolRows struct {
 	value bool
 	done  bool
@@ -499,6 +927,129 @@ func (r *boolRows) Next(dest []driver.Value) error {
 	return nil
 }
 
+type countRows struct {
+	value int64
+	done  bool
+}
+
+func (r *countRows) Columns() []string {
+	return []string{"count"}
+}
+
+func (r *countRows) Close() error {
+	return nil
+}
+
+func (r *countRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = r.value
+
+	return nil
+}
+
+type bulkRunRows struct {
+	run  *BulkRun
+	done bool
+}
+
+func (r *bulkRunRows) Columns() []string {
+	return []string{"id", "workflow_id", "run_id", "operation", "collection_status", "decision", "state", "error", "created_at", "completed_at"}
+}
+
+func (r *bulkRunRows) Close() error { return nil }
+
+func (r *bulkRunRows) Next(dest []driver.Value) error {
+	if r.done || r.run == nil {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = int64(r.run.ID)
+	dest[1] = r.run.WorkflowID
+	dest[2] = r.run.RunID
+	dest[3] = r.run.Operation
+	dest[4] = int64(r.run.CollectionStatus)
+	dest[5] = nullStringValue(r.run.Decision)
+	dest[6] = r.run.State
+	dest[7] = nullStringValue(r.run.Error)
+	dest[8] = r.run.CreatedAt
+	dest[9] = nil
+	if r.run.CompletedAt.Valid {
+		dest[9] = r.run.CompletedAt.Time
+	}
+	return nil
+}
+
+type bulkOutcomeRows struct {
+	outcomes []BulkOutcome
+	index    int
+}
+
+func (r *bulkOutcomeRows) Columns() []string {
+	return []string{"collection_id", "outcome", "error"}
+}
+
+func (r *bulkOutcomeRows) Close() error { return nil }
+
+func (r *bulkOutcomeRows) Next(dest []driver.Value) error {
+	if r.index >= len(r.outcomes) {
+		return io.EOF
+	}
+	outcome := r.outcomes[r.index]
+	r.index++
+	dest[0] = int64(outcome.ID)
+	dest[1] = outcome.Outcome
+	dest[2] = outcome.Error
+	return nil
+}
+
+type legalHoldRows struct {
+	row  *Collection
+	done bool
+}
+
+func (r *legalHoldRows) Columns() []string {
+	return []string{"legal_hold"}
+}
+
+func (r *legalHoldRows) Close() error {
+	return nil
+}
+
+func (r *legalHoldRows) Next(dest []driver.Value) error {
+	if r.done || r.row == nil {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = r.row.LegalHold
+
+	return nil
+}
+
+type nameRows struct {
+	names []string
+}
+
+func (r *nameRows) Columns() []string {
+	return []string{"name"}
+}
+
+func (r *nameRows) Close() error {
+	return nil
+}
+
+func (r *nameRows) Next(dest []driver.Value) error {
+	if len(r.names) == 0 {
+		return io.EOF
+	}
+	dest[0] = r.names[0]
+	r.names = r.names[1:]
+
+	return nil
+}
+
 type collectionRows struct {
 	row  *Collection
 	done bool
@@ -522,6 +1073,11 @@ func (r *collectionRows) Columns() []string {
 		"reconciliation_status",
 		"reconciliation_checked_at",
 		"reconciliation_error",
+		"legal_hold",
+		"decision_activity",
+		"decision_error",
+		"decision_options",
+		"pipeline_selection",
 	}
 }
 
@@ -552,6 +1108,11 @@ func (r *collectionRows) Next(dest []driver.Value) error {
 		nullStringValue(r.row.ReconciliationStatus),
 		nullTimeValue(r.row.ReconciliationCheckedAt),
 		nullStringValue(r.row.ReconciliationError),
+		r.row.LegalHold,
+		nullStringValue(r.row.DecisionActivity),
+		nullStringValue(r.row.DecisionError),
+		nullStringValue(r.row.DecisionOptions),
+		nullStringValue(r.row.PipelineSelection),
 	}
 	copy(dest, values)
 
@@ -16,6 +16,14 @@ const (
 	EventTypeCollectionCreated = "collection:created"
 	EventTypeCollectionUpdated = "collection:updated"
 	EventTypeCollectionDeleted = "collection:deleted"
+
+	// EventTypeCollectionDecisionReminder is published while an operator
+	// decision is pending longer than the configured reminder thresholds.
+	EventTypeCollectionDecisionReminder = "collection:decision-reminder"
+
+	// EventTypeCollectionProgress is published with the progress reported by
+	// Archivematica while the collection is processed.
+	EventTypeCollectionProgress = "collection:progress"
 )
 
 // EventService represents a service for managing event dispatch and event
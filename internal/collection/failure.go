@@ -0,0 +1,106 @@
+package collection
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	goacollection "github.com/artefactual-labs/enduro/internal/api/gen/collection"
+	"github.com/artefactual-labs/enduro/internal/pipeline"
+)
+
+// Failure is the persisted description of why Archivematica could not process
+// a collection. Only the latest failure is kept.
+type Failure struct {
+	CollectionID uint           `db:"collection_id"`
+	Category     string         `db:"category"`
+	Stage        string         `db:"stage"`
+	UnitID       string         `db:"unit_id"`
+	Microservice sql.NullString `db:"microservice"`
+	Job          sql.NullString `db:"job"`
+	Stderr       sql.NullString `db:"stderr"`
+	Errors       string         `db:"errors"`
+	OccurredAt   time.Time      `db:"occurred_at"`
+}
+
+// SetFailure records the failure of a collection in Archivematica, replacing
+// the failure recorded previously.
+func (svc *collectionImpl) SetFailure(ctx context.Context, ID uint, failure pipeline.Failure) error {
+	errs := failure.Errors
+	if errs == nil {
+		errs = []string{}
+	}
+	blob, err := json.Marshal(errs)
+	if err != nil {
+		return fmt.Errorf("error encoding failure errors: %w", err)
+	}
+
+	query := `INSERT INTO collection_failure (collection_id, category, stage, unit_id, microservice, job, stderr, errors, occurred_at) VALUES ((?), (?), (?), (?), (?), (?), (?), (?), (?)) ON DUPLICATE KEY UPDATE category = VALUES(category), stage = VALUES(stage), unit_id = VALUES(unit_id), microservice = VALUES(microservice), job = VALUES(job), stderr = VALUES(stderr), errors = VALUES(errors), occurred_at = VALUES(occurred_at)`
+	args := []any{
+		ID,
+		failure.Category,
+		failure.Stage,
+		failure.UnitID,
+		sql.NullString{String: failure.Microservice, Valid: failure.Microservice != ""},
+		sql.NullString{String: failure.Job, Valid: failure.Job != ""},
+		sql.NullString{String: failure.Stderr, Valid: failure.Stderr != ""},
+		string(blob),
+		time.Now().UTC(),
+	}
+	if _, err := svc.db.ExecContext(ctx, svc.db.Rebind(query), args...); err != nil {
+		return fmt.Errorf("error updating collection failure: %w", err)
+	}
+
+	return nil
+}
+
+// clearFailure removes the failure recorded for the collection.
+func (svc *collectionImpl) clearFailure(ctx context.Context, ID uint) error {
+	query := `DELETE FROM collection_failure WHERE collection_id = (?)`
+	if _, err := svc.db.ExecContext(ctx, svc.db.Rebind(query), ID); err != nil {
+		return fmt.Errorf("error deleting collection failure: %w", err)
+	}
+
+	return nil
+}
+
+// readFailure returns the failure recorded for the collection, or nil when
+// none was recorded.
+func (svc *collectionImpl) readFailure(ctx context.Context, collectionID uint) (*Failure, error) {
+	query := `SELECT collection_id, category, stage, unit_id, microservice, job, stderr, errors, CONVERT_TZ(occurred_at, @@session.time_zone, '+00:00') AS occurred_at FROM collection_failure WHERE collection_id = (?)`
+	failure := Failure{}
+	err := svc.db.GetContext(ctx, &failure, svc.db.Rebind(query), collectionID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading collection failure: %w", err)
+	}
+
+	return &failure, nil
+}
+
+func goaFailure(failure *Failure) *goacollection.EnduroCollectionFailure {
+	if failure == nil {
+		return nil
+	}
+
+	res := &goacollection.EnduroCollectionFailure{
+		Category:     failure.Category,
+		Stage:        failure.Stage,
+		UnitID:       failure.UnitID,
+		Microservice: formatOptionalNullString(failure.Microservice),
+		Job:          formatOptionalNullString(failure.Job),
+		Stderr:       formatOptionalNullString(failure.Stderr),
+		Errors:       []string{},
+		OccurredAt:   formatTime(failure.OccurredAt),
+	}
+
+	// Errors are encoded by SetFailure, ignore unreadable values.
+	_ = json.Unmarshal([]byte(failure.Errors), &res.Errors)
+
+	return res
+}
@@ -16,6 +16,9 @@ import (
 
 	collection "github.com/artefactual-labs/enduro/internal/api/gen/collection"
 	collection0 "github.com/artefactual-labs/enduro/internal/collection"
+	pipeline "github.com/artefactual-labs/enduro/internal/pipeline"
+	validation "github.com/artefactual-labs/enduro/internal/validation"
+	watcher "github.com/artefactual-labs/enduro/internal/watcher"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -82,6 +85,44 @@ func (c *MockServiceCheckDuplicateCall) DoAndReturn(f func(context.Context, uint
 	return c
 }
 
+// CompleteBulkRun mocks base method.
+func (m *MockService) CompleteBulkRun(ctx context.Context, bulkRunID uint, runErr error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteBulkRun", ctx, bulkRunID, runErr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteBulkRun indicates an expected call of CompleteBulkRun.
+func (mr *MockServiceMockRecorder) CompleteBulkRun(ctx, bulkRunID, runErr any) *MockServiceCompleteBulkRunCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteBulkRun", reflect.TypeOf((*MockService)(nil).CompleteBulkRun), ctx, bulkRunID, runErr)
+	return &MockServiceCompleteBulkRunCall{Call: call}
+}
+
+// MockServiceCompleteBulkRunCall wrap *gomock.Call
+type MockServiceCompleteBulkRunCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceCompleteBulkRunCall) Return(arg0 error) *MockServiceCompleteBulkRunCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceCompleteBulkRunCall) Do(f func(context.Context, uint, error) error) *MockServiceCompleteBulkRunCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceCompleteBulkRunCall) DoAndReturn(f func(context.Context, uint, error) error) *MockServiceCompleteBulkRunCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Create mocks base method.
 func (m *MockService) Create(arg0 context.Context, arg1 *collection0.Collection) error {
 	m.ctrl.T.Helper()
@@ -158,6 +199,313 @@ func (c *MockServiceGoaCall) DoAndReturn(f func() collection.Service) *MockServi
 	return c
 }
 
+// LegalHold mocks base method.
+func (m *MockService) LegalHold(ctx context.Context, ID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LegalHold", ctx, ID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LegalHold indicates an expected call of LegalHold.
+func (mr *MockServiceMockRecorder) LegalHold(ctx, ID any) *MockServiceLegalHoldCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LegalHold", reflect.TypeOf((*MockService)(nil).LegalHold), ctx, ID)
+	return &MockServiceLegalHoldCall{Call: call}
+}
+
+// MockServiceLegalHoldCall wrap *gomock.Call
+type MockServiceLegalHoldCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceLegalHoldCall) Return(arg0 bool, arg1 error) *MockServiceLegalHoldCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceLegalHoldCall) Do(f func(context.Context, uint) (bool, error)) *MockServiceLegalHoldCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceLegalHoldCall) DoAndReturn(f func(context.Context, uint) (bool, error)) *MockServiceLegalHoldCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Read mocks base method.
+func (m *MockService) Read(ctx context.Context, ID uint) (*collection0.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", ctx, ID)
+	ret0, _ := ret[0].(*collection0.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockServiceMockRecorder) Read(ctx, ID any) *MockServiceReadCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockService)(nil).Read), ctx, ID)
+	return &MockServiceReadCall{Call: call}
+}
+
+// MockServiceReadCall wrap *gomock.Call
+type MockServiceReadCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceReadCall) Return(arg0 *collection0.Collection, arg1 error) *MockServiceReadCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceReadCall) Do(f func(context.Context, uint) (*collection0.Collection, error)) *MockServiceReadCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceReadCall) DoAndReturn(f func(context.Context, uint) (*collection0.Collection, error)) *MockServiceReadCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RecordBulkOutcome mocks base method.
+func (m *MockService) RecordBulkOutcome(ctx context.Context, bulkRunID uint, outcome collection0.BulkOutcome) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordBulkOutcome", ctx, bulkRunID, outcome)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordBulkOutcome indicates an expected call of RecordBulkOutcome.
+func (mr *MockServiceMockRecorder) RecordBulkOutcome(ctx, bulkRunID, outcome any) *MockServiceRecordBulkOutcomeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordBulkOutcome", reflect.TypeOf((*MockService)(nil).RecordBulkOutcome), ctx, bulkRunID, outcome)
+	return &MockServiceRecordBulkOutcomeCall{Call: call}
+}
+
+// MockServiceRecordBulkOutcomeCall wrap *gomock.Call
+type MockServiceRecordBulkOutcomeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceRecordBulkOutcomeCall) Return(arg0 error) *MockServiceRecordBulkOutcomeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceRecordBulkOutcomeCall) Do(f func(context.Context, uint, collection0.BulkOutcome) error) *MockServiceRecordBulkOutcomeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceRecordBulkOutcomeCall) DoAndReturn(f func(context.Context, uint, collection0.BulkOutcome) error) *MockServiceRecordBulkOutcomeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RecordDispatch mocks base method.
+func (m *MockService) RecordDispatch(ctx context.Context, watcherName, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordDispatch", ctx, watcherName, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordDispatch indicates an expected call of RecordDispatch.
+func (mr *MockServiceMockRecorder) RecordDispatch(ctx, watcherName, key any) *MockServiceRecordDispatchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordDispatch", reflect.TypeOf((*MockService)(nil).RecordDispatch), ctx, watcherName, key)
+	return &MockServiceRecordDispatchCall{Call: call}
+}
+
+// MockServiceRecordDispatchCall wrap *gomock.Call
+type MockServiceRecordDispatchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceRecordDispatchCall) Return(arg0 error) *MockServiceRecordDispatchCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceRecordDispatchCall) Do(f func(context.Context, string, string) error) *MockServiceRecordDispatchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceRecordDispatchCall) DoAndReturn(f func(context.Context, string, string) error) *MockServiceRecordDispatchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemindPendingDecision mocks base method.
+func (m *MockService) RemindPendingDecision(ctx context.Context, ID uint, pendingFor time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemindPendingDecision", ctx, ID, pendingFor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemindPendingDecision indicates an expected call of RemindPendingDecision.
+func (mr *MockServiceMockRecorder) RemindPendingDecision(ctx, ID, pendingFor any) *MockServiceRemindPendingDecisionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemindPendingDecision", reflect.TypeOf((*MockService)(nil).RemindPendingDecision), ctx, ID, pendingFor)
+	return &MockServiceRemindPendingDecisionCall{Call: call}
+}
+
+// MockServiceRemindPendingDecisionCall wrap *gomock.Call
+type MockServiceRemindPendingDecisionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceRemindPendingDecisionCall) Return(arg0 error) *MockServiceRemindPendingDecisionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceRemindPendingDecisionCall) Do(f func(context.Context, uint, time.Duration) error) *MockServiceRemindPendingDecisionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceRemindPendingDecisionCall) DoAndReturn(f func(context.Context, uint, time.Duration) error) *MockServiceRemindPendingDecisionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Rescan mocks base method.
+func (m *MockService) Rescan(ctx context.Context, watcherName string) ([]*watcher.BlobEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rescan", ctx, watcherName)
+	ret0, _ := ret[0].([]*watcher.BlobEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rescan indicates an expected call of Rescan.
+func (mr *MockServiceMockRecorder) Rescan(ctx, watcherName any) *MockServiceRescanCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rescan", reflect.TypeOf((*MockService)(nil).Rescan), ctx, watcherName)
+	return &MockServiceRescanCall{Call: call}
+}
+
+// MockServiceRescanCall wrap *gomock.Call
+type MockServiceRescanCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceRescanCall) Return(arg0 []*watcher.BlobEvent, arg1 error) *MockServiceRescanCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceRescanCall) Do(f func(context.Context, string) ([]*watcher.BlobEvent, error)) *MockServiceRescanCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceRescanCall) DoAndReturn(f func(context.Context, string) ([]*watcher.BlobEvent, error)) *MockServiceRescanCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetFailure mocks base method.
+func (m *MockService) SetFailure(ctx context.Context, ID uint, failure pipeline.Failure) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFailure", ctx, ID, failure)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFailure indicates an expected call of SetFailure.
+func (mr *MockServiceMockRecorder) SetFailure(ctx, ID, failure any) *MockServiceSetFailureCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFailure", reflect.TypeOf((*MockService)(nil).SetFailure), ctx, ID, failure)
+	return &MockServiceSetFailureCall{Call: call}
+}
+
+// MockServiceSetFailureCall wrap *gomock.Call
+type MockServiceSetFailureCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceSetFailureCall) Return(arg0 error) *MockServiceSetFailureCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceSetFailureCall) Do(f func(context.Context, uint, pipeline.Failure) error) *MockServiceSetFailureCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceSetFailureCall) DoAndReturn(f func(context.Context, uint, pipeline.Failure) error) *MockServiceSetFailureCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetLegalHold mocks base method.
+func (m *MockService) SetLegalHold(ctx context.Context, ID uint, held bool, reason, actor string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLegalHold", ctx, ID, held, reason, actor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLegalHold indicates an expected call of SetLegalHold.
+func (mr *MockServiceMockRecorder) SetLegalHold(ctx, ID, held, reason, actor any) *MockServiceSetLegalHoldCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLegalHold", reflect.TypeOf((*MockService)(nil).SetLegalHold), ctx, ID, held, reason, actor)
+	return &MockServiceSetLegalHoldCall{Call: call}
+}
+
+// MockServiceSetLegalHoldCall wrap *gomock.Call
+type MockServiceSetLegalHoldCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceSetLegalHoldCall) Return(arg0 error) *MockServiceSetLegalHoldCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceSetLegalHoldCall) Do(f func(context.Context, uint, bool, string, string) error) *MockServiceSetLegalHoldCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceSetLegalHoldCall) DoAndReturn(f func(context.Context, uint, bool, string, string) error) *MockServiceSetLegalHoldCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetOriginalID mocks base method.
 func (m *MockService) SetOriginalID(ctx context.Context, ID uint, originalID string) error {
 	m.ctrl.T.Helper()
@@ -196,6 +544,120 @@ func (c *MockServiceSetOriginalIDCall) DoAndReturn(f func(context.Context, uint,
 	return c
 }
 
+// SetPendingDecision mocks base method.
+func (m *MockService) SetPendingDecision(ctx context.Context, ID uint, decision collection0.PendingDecision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPendingDecision", ctx, ID, decision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPendingDecision indicates an expected call of SetPendingDecision.
+func (mr *MockServiceMockRecorder) SetPendingDecision(ctx, ID, decision any) *MockServiceSetPendingDecisionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPendingDecision", reflect.TypeOf((*MockService)(nil).SetPendingDecision), ctx, ID, decision)
+	return &MockServiceSetPendingDecisionCall{Call: call}
+}
+
+// MockServiceSetPendingDecisionCall wrap *gomock.Call
+type MockServiceSetPendingDecisionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceSetPendingDecisionCall) Return(arg0 error) *MockServiceSetPendingDecisionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceSetPendingDecisionCall) Do(f func(context.Context, uint, collection0.PendingDecision) error) *MockServiceSetPendingDecisionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceSetPendingDecisionCall) DoAndReturn(f func(context.Context, uint, collection0.PendingDecision) error) *MockServiceSetPendingDecisionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetPipelineSelection mocks base method.
+func (m *MockService) SetPipelineSelection(ctx context.Context, ID uint, selection string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPipelineSelection", ctx, ID, selection)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPipelineSelection indicates an expected call of SetPipelineSelection.
+func (mr *MockServiceMockRecorder) SetPipelineSelection(ctx, ID, selection any) *MockServiceSetPipelineSelectionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPipelineSelection", reflect.TypeOf((*MockService)(nil).SetPipelineSelection), ctx, ID, selection)
+	return &MockServiceSetPipelineSelectionCall{Call: call}
+}
+
+// MockServiceSetPipelineSelectionCall wrap *gomock.Call
+type MockServiceSetPipelineSelectionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceSetPipelineSelectionCall) Return(arg0 error) *MockServiceSetPipelineSelectionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceSetPipelineSelectionCall) Do(f func(context.Context, uint, string) error) *MockServiceSetPipelineSelectionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceSetPipelineSelectionCall) DoAndReturn(f func(context.Context, uint, string) error) *MockServiceSetPipelineSelectionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetProgress mocks base method.
+func (m *MockService) SetProgress(ctx context.Context, ID uint, progress pipeline.Progress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProgress", ctx, ID, progress)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetProgress indicates an expected call of SetProgress.
+func (mr *MockServiceMockRecorder) SetProgress(ctx, ID, progress any) *MockServiceSetProgressCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProgress", reflect.TypeOf((*MockService)(nil).SetProgress), ctx, ID, progress)
+	return &MockServiceSetProgressCall{Call: call}
+}
+
+// MockServiceSetProgressCall wrap *gomock.Call
+type MockServiceSetProgressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceSetProgressCall) Return(arg0 error) *MockServiceSetProgressCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceSetProgressCall) Do(f func(context.Context, uint, pipeline.Progress) error) *MockServiceSetProgressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceSetProgressCall) DoAndReturn(f func(context.Context, uint, pipeline.Progress) error) *MockServiceSetProgressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetStatus mocks base method.
 func (m *MockService) SetStatus(ctx context.Context, ID uint, status collection0.Status) error {
 	m.ctrl.T.Helper()
@@ -272,6 +734,44 @@ func (c *MockServiceSetStatusInProgressCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// SetValidationResults mocks base method.
+func (m *MockService) SetValidationResults(ctx context.Context, ID uint, results []validation.Result) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetValidationResults", ctx, ID, results)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetValidationResults indicates an expected call of SetValidationResults.
+func (mr *MockServiceMockRecorder) SetValidationResults(ctx, ID, results any) *MockServiceSetValidationResultsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetValidationResults", reflect.TypeOf((*MockService)(nil).SetValidationResults), ctx, ID, results)
+	return &MockServiceSetValidationResultsCall{Call: call}
+}
+
+// MockServiceSetValidationResultsCall wrap *gomock.Call
+type MockServiceSetValidationResultsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceSetValidationResultsCall) Return(arg0 error) *MockServiceSetValidationResultsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceSetValidationResultsCall) Do(f func(context.Context, uint, []validation.Result) error) *MockServiceSetValidationResultsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceSetValidationResultsCall) DoAndReturn(f func(context.Context, uint, []validation.Result) error) *MockServiceSetValidationResultsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateReconciliationState mocks base method.
 func (m *MockService) UpdateReconciliationState(ctx context.Context, ID uint, aipStoredAt, checkedAt *time.Time, status, errMsg *string) error {
 	m.ctrl.T.Helper()
@@ -11,20 +11,22 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
 	"go.opentelemetry.io/otel/trace/noop"
 	temporalapi_common "go.temporal.io/api/common/v1"
 	temporalapi_enums "go.temporal.io/api/enums/v1"
 	temporalapi_serviceerror "go.temporal.io/api/serviceerror"
 	temporalsdk_client "go.temporal.io/sdk/client"
+	temporalsdk_converter "go.temporal.io/sdk/converter"
 	temporalsdk_temporal "go.temporal.io/sdk/temporal"
 
 	goacollection "github.com/artefactual-labs/enduro/internal/api/gen/collection"
 	"github.com/artefactual-labs/enduro/internal/pipeline"
+	"github.com/artefactual-labs/enduro/internal/retention"
 	"github.com/artefactual-labs/enduro/internal/temporal"
 )
 
-var ErrBulkStatusUnavailable = errors.New("bulk status unavailable")
-
 // GoaWrapper returns a collectionImpl wrapper that implements
 // goacollection.Service. It can handle types that are specific to the Goa API.
 type goaWrapper struct {
@@ -93,7 +95,52 @@ func (w *goaWrapper) List(ctx context.Context, payload *goacollection.ListPayloa
 	const limit = 20
 	const limitSQL = "21"
 
+	conds, args := listConditions(payload)
+
+	if payload.Cursor != nil {
+		args = append(args, *payload.Cursor)
+		conds = append(conds, [2]string{"AND", "id <= (?)"})
+	}
+
+	query += whereClause(conds) + " ORDER BY id DESC LIMIT " + limitSQL
+
+	query = w.db.Rebind(query)
+	rows, err := w.db.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error querying the database: %w", err)
+	}
+	defer rows.Close()
+
+	cols := []*goacollection.EnduroStoredCollection{}
+	for rows.Next() {
+		c := Collection{}
+		if err := rows.StructScan(&c); err != nil {
+			return nil, fmt.Errorf("error scanning database result: %w", err)
+		}
+		cols = append(cols, c.GoaSummary())
+	}
+
+	res := &goacollection.ListResult{
+		Items: cols,
+	}
+
+	length := len(cols)
+	if length > limit {
+		last := cols[length-1]               // Capture last item.
+		lastID := strconv.Itoa(int(last.ID)) // We also need its ID (cursor).
+		res.Items = cols[:len(cols)-1]       // Remove it from the results.
+		res.NextCursor = &lastID             // Populate cursor.
+	}
+
+	return res, nil
+}
+
+// listConditions returns the conditions and the arguments of the query that
+// selects the collections matching the filters of the payload. The cursor is
+// not included.
+func listConditions(payload *goacollection.ListPayload) ([][2]string, []any) {
 	conds := [][2]string{}
+	args := []any{}
 
 	if payload.Name != nil {
 		name := patternMatchingCharReplacer.Replace(*payload.Name) + "%"
@@ -128,12 +175,19 @@ func (w *goaWrapper) List(ctx context.Context, payload *goacollection.ListPayloa
 		args = append(args, payload.LatestCreatedTime)
 		conds = append(conds, [2]string{"AND", "created_at <= (?)"})
 	}
-
-	if payload.Cursor != nil {
-		args = append(args, *payload.Cursor)
-		conds = append(conds, [2]string{"AND", "id <= (?)"})
+	if payload.DecisionActivity != nil {
+		args = append(args, payload.DecisionActivity)
+		conds = append(conds, [2]string{"AND", "decision_activity = (?)"})
+	}
+	if payload.FailureCategory != nil {
+		args = append(args, payload.FailureCategory)
+		conds = append(conds, [2]string{"AND", "id IN (SELECT collection_id FROM collection_failure WHERE category = (?))"})
 	}
 
+	return conds, args
+}
+
+func whereClause(conds [][2]string) string {
 	var where string
 	for i, cond := range conds {
 		if i == 0 {
@@ -143,37 +197,7 @@ func (w *goaWrapper) List(ctx context.Context, payload *goacollection.ListPayloa
 		where += fmt.Sprintf(" %s %s", cond[0], cond[1])
 	}
 
-	query += where + " ORDER BY id DESC LIMIT " + limitSQL
-
-	query = w.db.Rebind(query)
-	rows, err := w.db.QueryxContext(ctx, query, args...)
-	if err != nil {
-		return nil, fmt.Errorf("error querying the database: %w", err)
-	}
-	defer rows.Close()
-
-	cols := []*goacollection.EnduroStoredCollection{}
-	for rows.Next() {
-		c := Collection{}
-		if err := rows.StructScan(&c); err != nil {
-			return nil, fmt.Errorf("error scanning database result: %w", err)
-		}
-		cols = append(cols, c.GoaSummary())
-	}
-
-	res := &goacollection.ListResult{
-		Items: cols,
-	}
-
-	length := len(cols)
-	if length > limit {
-		last := cols[length-1]               // Capture last item.
-		lastID := strconv.Itoa(int(last.ID)) // We also need its ID (cursor).
-		res.Items = cols[:len(cols)-1]       // Remove it from the results.
-		res.NextCursor = &lastID             // Populate cursor.
-	}
-
-	return res, nil
+	return where
 }
 
 // Show collection by ID. It implements goacollection.Service.
@@ -185,14 +209,35 @@ func (w *goaWrapper) Show(ctx context.Context, payload *goacollection.ShowPayloa
 		return nil, err
 	}
 
-	return c.GoaDetail(), nil
+	results, err := w.readValidationResults(ctx, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := w.readProgress(ctx, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	failure, err := w.readFailure(ctx, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	col := c.GoaDetail()
+	col.Validation = goaValidationResults(results)
+	col.Progress = goaProgress(progress)
+	col.Failure = goaFailure(failure)
+
+	return col, nil
 }
 
-// Delete collection by ID. It implements goacollection.Service.
+// Delete collection by ID. It implements goacollection.Service. Collections
+// under legal hold are not deleted.
 //
 // TODO: return error if it's still running?
 func (w *goaWrapper) Delete(ctx context.Context, payload *goacollection.DeletePayload) error {
-	query := "DELETE FROM collection WHERE id = (?)"
+	query := "DELETE FROM collection WHERE id = (?) AND legal_hold = FALSE"
 
 	query = w.db.Rebind(query)
 	res, err := w.db.ExecContext(ctx, query, payload.ID)
@@ -204,6 +249,15 @@ func (w *goaWrapper) Delete(ctx context.Context, payload *goacollection.DeletePa
 		return err
 	}
 	if n != 1 {
+		held, err := w.LegalHold(ctx, payload.ID)
+		if err == sql.ErrNoRows {
+			return &goacollection.CollectionNotfound{ID: payload.ID, Message: "not_found"}
+		} else if err != nil {
+			return err
+		}
+		if held {
+			return goacollection.MakeLegalHold(errors.New("collection is under legal hold"))
+		}
 		return &goacollection.CollectionNotfound{ID: payload.ID, Message: "not_found"}
 	}
 
@@ -220,6 +274,13 @@ func (w *goaWrapper) Cancel(ctx context.Context, payload *goacollection.CancelPa
 		return err
 	}
 
+	// Tell the workflow who canceled it. Workflows that are not running will
+	// fail to be canceled as well.
+	signal := ProcessingWorkflowCancelSignal{Actor: ActorFromContext(ctx)}
+	if err := w.cc.SignalWorkflow(ctx, *goacol.WorkflowID, *goacol.RunID, ProcessingWorkflowCancelSignalName, signal); err != nil {
+		return err
+	}
+
 	if err := w.cc.CancelWorkflow(ctx, *goacol.WorkflowID, *goacol.RunID); err != nil {
 		// TODO: return custom errors
 		return err
@@ -275,8 +336,16 @@ func (w *goaWrapper) Retry(ctx context.Context, payload *goacollection.RetryPayl
 
 	req.WorkflowID = *goacol.WorkflowID
 	req.CollectionID = goacol.ID
+	req.Actor = ActorFromContext(ctx)
 	retryMode := retryModeForCollection(w.registry, req.PipelineName, col)
 	req.RetryMode = retryMode
+	if retryMode == RetryModeReconcileExistingAIP && req.PipelineName == "" {
+		// Reconciliation needs the pipeline that created the AIP, not the
+		// one chosen by the scheduler.
+		if p, err := w.registry.ByID(col.PipelineID); err == nil {
+			req.PipelineName = p.Config().Name
+		}
+	}
 	req.ExistingTransferID = col.TransferID
 	req.ExistingAIPID = col.AIPID
 	req.ExistingPipelineID = col.PipelineID
@@ -285,6 +354,11 @@ func (w *goaWrapper) Retry(ctx context.Context, payload *goacollection.RetryPayl
 		return nil, fmt.Errorf("error starting the new workflow instance: %w", err)
 	}
 
+	// The failure of the previous run no longer describes the collection.
+	if err := w.clearFailure(ctx, payload.ID); err != nil {
+		w.logger.Error(err, "Cannot clear collection failure.", "id", payload.ID)
+	}
+
 	publishEvent(ctx, w.events, EventTypeCollectionUpdated, payload.ID)
 
 	return newRetryResult(retryMode), nil
@@ -348,12 +422,178 @@ func (w *goaWrapper) StatusHistory(ctx context.Context, payload *goacollection.S
 		if transition.Reason.Valid {
 			item.Reason = new(transition.Reason.String)
 		}
+		if transition.Actor.Valid {
+			item.Actor = new(transition.Actor.String)
+		}
+		if transition.Detail.Valid {
+			item.Detail = new(transition.Detail.String)
+		}
 		result.Transitions = append(result.Transitions, item)
 	}
 
 	return result, nil
 }
 
+func (w *goaWrapper) Notifications(ctx context.Context, payload *goacollection.NotificationsPayload) (goacollection.EnduroCollectionNotificationDeliveryCollection, error) {
+	_, err := w.read(ctx, payload.ID)
+	if err == sql.ErrNoRows {
+		return nil, &goacollection.CollectionNotfound{ID: payload.ID, Message: "not_found"}
+	} else if err != nil {
+		return nil, err
+	}
+
+	result := goacollection.EnduroCollectionNotificationDeliveryCollection{}
+	if w.notifications == nil {
+		return result, nil
+	}
+
+	deliveries, err := w.notifications.Deliveries(ctx, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, delivery := range deliveries {
+		item := &goacollection.EnduroCollectionNotificationDelivery{
+			ID:        delivery.ID,
+			Webhook:   delivery.Webhook,
+			Event:     delivery.Event,
+			Status:    delivery.Status,
+			Attempts:  delivery.Attempts,
+			CreatedAt: delivery.CreatedAt.UTC().Format(time.RFC3339Nano),
+			UpdatedAt: delivery.UpdatedAt.UTC().Format(time.RFC3339Nano),
+		}
+		if delivery.ResponseCode.Valid {
+			item.ResponseCode = new(int(delivery.ResponseCode.Int64))
+		}
+		if delivery.Error.Valid {
+			item.Error = new(delivery.Error.String)
+		}
+		result = append(result, item)
+	}
+
+	return result, nil
+}
+
+func (w *goaWrapper) Rescan(ctx context.Context, payload *goacollection.RescanPayload) (goacollection.EnduroCollectionRescanObjectCollection, error) {
+	if w.watchers == nil {
+		return nil, goacollection.MakeNotValid(errors.New("watchers are not available"))
+	}
+	if _, err := w.watchers.ByName(payload.Watcher); err != nil {
+		return nil, goacollection.MakeNotValid(err)
+	}
+
+	events, err := w.collectionImpl.Rescan(ctx, payload.Watcher)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(goacollection.EnduroCollectionRescanObjectCollection, 0, len(events))
+	for _, event := range events {
+		item := &goacollection.EnduroCollectionRescanObject{
+			Watcher: event.WatcherName,
+			Key:     event.Key,
+			IsDir:   event.IsDir,
+		}
+		if event.Bucket != "" {
+			item.Bucket = new(event.Bucket)
+		}
+		result = append(result, item)
+	}
+
+	return result, nil
+}
+
+func (w *goaWrapper) Retention(ctx context.Context, payload *goacollection.RetentionPayload) (goacollection.EnduroCollectionRetentionDeletionCollection, error) {
+	result := goacollection.EnduroCollectionRetentionDeletionCollection{}
+	if w.retention == nil {
+		return result, nil
+	}
+
+	var status string
+	if payload.Status != nil {
+		status = *payload.Status
+	}
+	deletions, err := w.retention.List(ctx, status)
+	if err != nil {
+		return nil, err
+	}
+	for _, deletion := range deletions {
+		item := &goacollection.EnduroCollectionRetentionDeletion{
+			CollectionID: deletion.CollectionID,
+			Watcher:      deletion.WatcherName,
+			Key:          deletion.Key,
+			Status:       deletion.Status,
+			DueAt:        deletion.DueAt.UTC().Format(time.RFC3339),
+			Attempts:     deletion.Attempts,
+			CreatedAt:    deletion.CreatedAt.UTC().Format(time.RFC3339),
+			UpdatedAt:    deletion.UpdatedAt.UTC().Format(time.RFC3339),
+		}
+		if deletion.Error.Valid {
+			item.Error = new(deletion.Error.String)
+		}
+		result = append(result, item)
+	}
+
+	return result, nil
+}
+
+func (w *goaWrapper) RetentionPostpone(ctx context.Context, payload *goacollection.RetentionPostponePayload) error {
+	dueAt, err := time.Parse(time.RFC3339, payload.DueAt)
+	if err != nil {
+		return err
+	}
+	if w.retention == nil {
+		return &goacollection.CollectionNotfound{ID: payload.ID, Message: "not_found"}
+	}
+
+	err = w.retention.Postpone(ctx, payload.ID, dueAt)
+	if errors.Is(err, retention.ErrNotFound) {
+		return &goacollection.CollectionNotfound{ID: payload.ID, Message: "not_found"}
+	}
+
+	return err
+}
+
+func (w *goaWrapper) RetentionCancel(ctx context.Context, payload *goacollection.RetentionCancelPayload) error {
+	if w.retention == nil {
+		return &goacollection.CollectionNotfound{ID: payload.ID, Message: "not_found"}
+	}
+
+	err := w.retention.Cancel(ctx, payload.ID)
+	if errors.Is(err, retention.ErrNotFound) {
+		return &goacollection.CollectionNotfound{ID: payload.ID, Message: "not_found"}
+	}
+
+	return err
+}
+
+// SetLegalHold records the authenticated user as the actor of the hold.
+func (w *goaWrapper) SetLegalHold(ctx context.Context, payload *goacollection.SetLegalHoldPayload) error {
+	err := w.collectionImpl.SetLegalHold(ctx, payload.ID, true, payload.Reason, ActorFromContext(ctx))
+	if err == sql.ErrNoRows {
+		return &goacollection.CollectionNotfound{ID: payload.ID, Message: "not_found"}
+	}
+
+	return err
+}
+
+func (w *goaWrapper) ClearLegalHold(ctx context.Context, payload *goacollection.ClearLegalHoldPayload) error {
+	err := w.collectionImpl.SetLegalHold(ctx, payload.ID, false, payload.Reason, ActorFromContext(ctx))
+	if err == sql.ErrNoRows {
+		return &goacollection.CollectionNotfound{ID: payload.ID, Message: "not_found"}
+	}
+
+	return err
+}
+
+func (w *goaWrapper) RetentionMigrate(ctx context.Context) (*goacollection.RetentionMigrateResult, error) {
+	signaled, err := w.handOffRetention(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &goacollection.RetentionMigrateResult{Signaled: signaled}, nil
+}
+
 func (w *goaWrapper) Workflow(ctx context.Context, payload *goacollection.WorkflowPayload) (res *goacollection.EnduroCollectionWorkflowStatus, err error) {
 	var goacol *goacollection.EnduroDetailedStoredCollection
 	if goacol, err = w.Show(ctx, &goacollection.ShowPayload{ID: payload.ID}); err != nil {
@@ -454,12 +694,15 @@ func (w *goaWrapper) Decide(ctx context.Context, payload *goacollection.DecidePa
 	if err != nil {
 		return goacollection.MakeNotValid(err)
 	}
+	if pending, ok := c.PendingDecision(); ok && !pending.Allows(decision) {
+		return goacollection.MakeNotValid(fmt.Errorf("decision option %q is not accepted by the workflow", decision))
+	}
 
 	handle, err := w.cc.UpdateWorkflow(ctx, temporalsdk_client.UpdateWorkflowOptions{
 		WorkflowID:   c.WorkflowID,
 		RunID:        c.RunID,
 		UpdateName:   ProcessingWorkflowDecisionUpdateName,
-		Args:         []any{decision},
+		Args:         []any{decision, ActorFromContext(ctx)},
 		WaitForStage: temporalsdk_client.WorkflowUpdateStageCompleted,
 	})
 	if err != nil {
@@ -482,94 +725,247 @@ func (w *goaWrapper) Bulk(ctx context.Context, payload *goacollection.BulkPayloa
 	if payload.Size == 0 {
 		return nil, goacollection.MakeNotValid(errors.New("size is zero"))
 	}
+	if uint(len(payload.Ids)) > payload.Size {
+		return nil, goacollection.MakeNotValid(errors.New("number of identifiers exceeds size"))
+	}
 	input := BulkWorkflowInput{
 		Operation: BulkWorkflowOperation(payload.Operation),
 		Status:    NewStatus(payload.Status),
 		Size:      payload.Size,
+		Filter: BulkFilter{
+			Name:                payload.Name,
+			OriginalID:          payload.OriginalID,
+			TransferID:          payload.TransferID,
+			AIPID:               payload.AipID,
+			PipelineID:          payload.PipelineID,
+			EarliestCreatedTime: payload.EarliestCreatedTime,
+			LatestCreatedTime:   payload.LatestCreatedTime,
+			DecisionActivity:    payload.DecisionActivity,
+		},
+		IDs: payload.Ids,
+	}
+	if payload.Option != nil {
+		input.Decision = ProcessingWorkflowDecision(*payload.Option)
 	}
 	if _, _, err := bulkWorkflowInputAction(input); err != nil {
 		return nil, goacollection.MakeNotValid(err)
 	}
 
+	if payload.DryRun {
+		count, err := w.countBulk(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		return &goacollection.BulkResult{Count: &count}, nil
+	}
+
+	// Every run has its own workflow so several runs can progress at the
+	// same time.
+	workflowID := fmt.Sprintf("%s-%s", BulkWorkflowIDPrefix, uuid.New().String())
+	bulkRunID, err := w.createBulkRun(ctx, input, workflowID)
+	if err != nil {
+		return nil, err
+	}
+	input.BulkRunID = bulkRunID
+
 	opts := temporalsdk_client.StartWorkflowOptions{
-		ID:                       BulkWorkflowID,
-		WorkflowIDReusePolicy:    temporalapi_enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		ID:                       workflowID,
 		TaskQueue:                w.taskQueue,
 		WorkflowExecutionTimeout: time.Hour,
 	}
 	exec, err := w.cc.ExecuteWorkflow(ctx, opts, BulkWorkflowName, input)
 	if err != nil {
-		switch err := err.(type) {
-		case *temporalapi_serviceerror.NotFound:
-			return nil, goacollection.MakeNotAvailable(
-				fmt.Errorf("error starting bulk - operation is already in progress (workflowID=%s)", BulkWorkflowID),
-			)
-		default:
-			w.logger.Info("error starting bulk", "err", err)
-			return nil, fmt.Errorf("error starting bulk")
+		w.logger.Info("error starting bulk", "err", err)
+		if err := w.CompleteBulkRun(ctx, bulkRunID, err); err != nil {
+			w.logger.Error(err, "Error updating bulk run.", "id", bulkRunID)
 		}
+		return nil, fmt.Errorf("error starting bulk")
+	}
+
+	runID := exec.GetRunID()
+	if err := w.setBulkRunID(ctx, bulkRunID, runID); err != nil {
+		return nil, err
 	}
 
 	return &goacollection.BulkResult{
-		WorkflowID: exec.GetID(),
-		RunID:      exec.GetRunID(),
+		ID:         &bulkRunID,
+		WorkflowID: &workflowID,
+		RunID:      &runID,
 	}, nil
 }
 
+// countBulk returns the number of collections affected by a bulk operation.
+func (w *goaWrapper) countBulk(ctx context.Context, input BulkWorkflowInput) (uint, error) {
+	conds, args := listConditions(input.listPayload(nil))
+	query := "SELECT COUNT(*) FROM collection" + whereClause(conds)
+	if len(input.IDs) > 0 {
+		var err error
+		query, args, err = sqlx.In("SELECT COUNT(*) FROM collection WHERE status = (?) AND id IN (?)", input.Status, input.IDs)
+		if err != nil {
+			return 0, fmt.Errorf("error counting collections: %w", err)
+		}
+	}
+
+	var count uint
+	if err := w.db.GetContext(ctx, &count, w.db.Rebind(query), args...); err != nil {
+		return 0, fmt.Errorf("error counting collections: %w", err)
+	}
+	if input.Size > 0 && count > input.Size {
+		count = input.Size
+	}
+
+	return count, nil
+}
+
+// BulkStatus describes the most recent bulk run. It implements
+// goacollection.Service.
 func (w *goaWrapper) BulkStatus(ctx context.Context) (*goacollection.BulkStatusResult, error) {
 	result := &goacollection.BulkStatusResult{}
 
-	resp, err := w.cc.DescribeWorkflowExecution(ctx, BulkWorkflowID, "")
+	run, err := w.readLatestBulkRun(ctx)
+	if err == sql.ErrNoRows {
+		// We've never seen a bulk run before.
+		return result, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	w.reconcileBulkRun(ctx, run)
+
+	result.WorkflowID = &run.WorkflowID
+	result.RunID = &run.RunID
+	startedAt := run.CreatedAt.UTC().Format(time.RFC3339)
+	result.StartedAt = &startedAt
+	if run.CompletedAt.Valid {
+		closedAt := run.CompletedAt.Time.UTC().Format(time.RFC3339)
+		result.ClosedAt = &closedAt
+	}
+	result.Status = &run.State
+	result.Running = run.State == BulkRunStateRunning
+
+	outcomes, err := w.readBulkOutcomes(ctx, run.ID)
 	if err != nil {
-		switch err := err.(type) {
-		case *temporalapi_serviceerror.NotFound:
-			// We've never seen a workflow run before.
-			return result, nil
-		default:
-			w.logger.Info("error retrieving workflow", "err", err)
-			return nil, ErrBulkStatusUnavailable
+		return nil, err
+	}
+	result.Outcomes = goaBulkOutcomes(outcomes)
+
+	if result.Running {
+		if status := w.bulkProgress(ctx, run); status != "" {
+			result.Status = &status
 		}
 	}
 
-	if resp.WorkflowExecutionInfo == nil {
-		w.logger.Info("error retrieving workflow execution details")
-		return nil, ErrBulkStatusUnavailable
+	return result, nil
+}
+
+// bulkProgress describes the progress of a running bulk run using the latest
+// heartbeat of its activity. It is empty when the progress is unknown.
+func (w *goaWrapper) bulkProgress(ctx context.Context, run *BulkRun) string {
+	resp, err := w.cc.DescribeWorkflowExecution(ctx, run.WorkflowID, run.RunID)
+	if err != nil {
+		w.logger.Info("error retrieving workflow", "err", err)
+		return ""
+	}
+
+	length := len(resp.PendingActivities)
+	if length == 0 {
+		return ""
+	}
+	latest := resp.PendingActivities[length-1]
+	progress := &BulkProgress{}
+	if err := temporalsdk_converter.GetDefaultDataConverter().FromPayloads(latest.HeartbeatDetails, progress); err != nil {
+		return ""
+	}
+
+	return fmt.Sprintf("Processing collection %d (done: %d)", progress.CurrentID, progress.Count)
+}
+
+// reconcileBulkRun records the end of a running bulk run whose workflow is
+// closed, e.g. because it timed out or it was terminated before its activity
+// could record it.
+func (w *goaWrapper) reconcileBulkRun(ctx context.Context, run *BulkRun) {
+	if run.State != BulkRunStateRunning {
+		return
 	}
 
-	result.WorkflowID = &resp.WorkflowExecutionInfo.Execution.WorkflowId
-	result.RunID = &resp.WorkflowExecutionInfo.Execution.RunId
+	resp, err := w.cc.DescribeWorkflowExecution(ctx, run.WorkflowID, run.RunID)
+	if err != nil {
+		w.logger.Info("error retrieving workflow", "err", err)
+		return
+	}
+	status := resp.WorkflowExecutionInfo.Status
+	if status == temporalapi_enums.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		return
+	}
 
-	if resp.WorkflowExecutionInfo.StartTime != nil {
-		t := resp.WorkflowExecutionInfo.StartTime.AsTime().Format(time.RFC3339)
-		result.StartedAt = &t
+	var runErr error
+	if status != temporalapi_enums.WORKFLOW_EXECUTION_STATUS_COMPLETED {
+		runErr = fmt.Errorf("bulk workflow closed with status %s", status)
+	}
+	if err := w.CompleteBulkRun(ctx, run.ID, runErr); err != nil {
+		w.logger.Error(err, "Error updating bulk run.", "id", run.ID)
+		return
 	}
 
-	if resp.WorkflowExecutionInfo.CloseTime != nil {
-		t := resp.WorkflowExecutionInfo.CloseTime.AsTime().Format(time.RFC3339)
-		result.ClosedAt = &t
+	run.State = BulkRunStateCompleted
+	if runErr != nil {
+		run.State = BulkRunStateFailed
+		run.Error = sql.NullString{String: runErr.Error(), Valid: true}
 	}
+	run.CompletedAt = sql.NullTime{Time: time.Now().UTC(), Valid: true}
+}
 
-	// Workflow is not running!
-	if resp.WorkflowExecutionInfo.Status != temporalapi_enums.WORKFLOW_EXECUTION_STATUS_RUNNING {
-		st := strings.ToLower(resp.WorkflowExecutionInfo.Status.String())
-		result.Status = &st
+// BulkRuns lists the most recent bulk runs. It implements
+// goacollection.Service.
+func (w *goaWrapper) BulkRuns(ctx context.Context) ([]*goacollection.EnduroBulkRun, error) {
+	runs, err := w.listBulkRuns(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-		return result, nil
+	res := make([]*goacollection.EnduroBulkRun, 0, len(runs))
+	for _, run := range runs {
+		w.reconcileBulkRun(ctx, &run)
+		res = append(res, run.Goa())
 	}
 
-	result.Running = true
+	return res, nil
+}
 
-	// We can use the status property to communicate progress from heartbeats.
-	length := len(resp.PendingActivities)
-	if length > 0 {
-		latest := resp.PendingActivities[length-1]
-		progress := &BulkProgress{}
-		details := latest.HeartbeatDetails.String()
-		if err := json.Unmarshal([]byte(details), progress); err == nil {
-			status := fmt.Sprintf("Processing collection %d (done: %d)", progress.CurrentID, progress.Count)
-			result.Status = &status
+// BulkRun shows a bulk run with its outcomes. It implements
+// goacollection.Service.
+func (w *goaWrapper) BulkRun(ctx context.Context, payload *goacollection.BulkRunPayload) (*goacollection.EnduroBulkRun, error) {
+	run, err := w.readBulkRun(ctx, payload.ID)
+	if err == sql.ErrNoRows {
+		return nil, &goacollection.BulkRunNotFound{ID: payload.ID, Message: "not_found"}
+	} else if err != nil {
+		return nil, err
+	}
+	w.reconcileBulkRun(ctx, run)
+
+	outcomes, err := w.readBulkOutcomes(ctx, run.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := run.Goa()
+	res.Outcomes = goaBulkOutcomes(outcomes)
+
+	return res, nil
+}
+
+func goaBulkOutcomes(outcomes []BulkOutcome) []*goacollection.BulkOutcome {
+	items := make([]*goacollection.BulkOutcome, 0, len(outcomes))
+	for _, outcome := range outcomes {
+		item := &goacollection.BulkOutcome{
+			ID:      outcome.ID,
+			Outcome: outcome.Outcome,
 		}
+		if outcome.Error != "" {
+			item.Error = &outcome.Error
+		}
+		items = append(items, item)
 	}
 
-	return result, nil
+	return items
 }
@@ -9,14 +9,20 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/mock"
+	temporalapi_enums "go.temporal.io/api/enums/v1"
+	temporalapi_workflow "go.temporal.io/api/workflow/v1"
+	temporalapi_workflowservice "go.temporal.io/api/workflowservice/v1"
 	temporalsdk_client "go.temporal.io/sdk/client"
 	temporalsdk_mocks "go.temporal.io/sdk/mocks"
 	temporalsdk_temporal "go.temporal.io/sdk/temporal"
+	"goa.design/goa/v3/pkg"
 	"gotest.tools/v3/assert"
 	"gotest.tools/v3/poll"
 
 	goacollection "github.com/artefactual-labs/enduro/internal/api/gen/collection"
+	"github.com/artefactual-labs/enduro/internal/notification"
 	"github.com/artefactual-labs/enduro/internal/pipeline"
+	"github.com/artefactual-labs/enduro/internal/retention"
 )
 
 func TestGoaMonitor(t *testing.T) {
@@ -61,11 +67,13 @@ func TestGoaDelete(t *testing.T) {
 	t.Parallel()
 
 	tests := map[string]struct {
-		rowsAffected int64
-		execErr      error
-		wantErr      error
-		wantNotFound bool
-		wantEvent    bool
+		row           *Collection
+		rowsAffected  int64
+		execErr       error
+		wantErr       error
+		wantNotFound  bool
+		wantLegalHold bool
+		wantEvent     bool
 	}{
 		"deletes existing collection": {
 			rowsAffected: 1,
@@ -75,6 +83,11 @@ func TestGoaDelete(t *testing.T) {
 			rowsAffected: 0,
 			wantNotFound: true,
 		},
+		"refuses collection under legal hold": {
+			row:           &Collection{ID: 42, LegalHold: true},
+			rowsAffected:  0,
+			wantLegalHold: true,
+		},
 		"returns database error": {
 			rowsAffected: 1,
 			execErr:      errTestDB,
@@ -92,6 +105,7 @@ func TestGoaDelete(t *testing.T) {
 
 			ctx := context.Background()
 			recorder := newExecRecorderDB(t)
+			recorder.row = tc.row
 			recorder.rowsAffected = tc.rowsAffected
 			recorder.execErr = tc.execErr
 
@@ -101,15 +115,21 @@ func TestGoaDelete(t *testing.T) {
 			assert.NilError(t, err)
 			defer sub.Close()
 
-			svc := NewService(testLogger(), recorder.db, client, "", nil)
+			svc := NewService(testLogger(), recorder.db, client, "", nil, nil, nil, nil)
 			svc.events = events
 
 			err = svc.Goa().Delete(ctx, &goacollection.DeletePayload{ID: 42})
 
-			assert.Equal(t, recorder.execQuery, "DELETE FROM collection WHERE id = (?)")
+			assert.Equal(t, recorder.execQuery, "DELETE FROM collection WHERE id = (?) AND legal_hold = FALSE")
 			assert.DeepEqual(t, recorder.execArgs, []any{int64(42)})
 
-			assertGoaServiceErr(t, err, tc.wantErr, tc.wantNotFound)
+			if tc.wantLegalHold {
+				var serviceErr *goa.ServiceError
+				assert.Assert(t, errors.As(err, &serviceErr))
+				assert.Equal(t, serviceErr.Name, "legal_hold")
+			} else {
+				assertGoaServiceErr(t, err, tc.wantErr, tc.wantNotFound)
+			}
 			assertCollectionEvent(t, sub, tc.wantEvent, EventTypeCollectionDeleted, 42)
 			client.AssertExpectations(t)
 		})
@@ -175,6 +195,14 @@ func TestGoaCancel(t *testing.T) {
 
 			client := &temporalsdk_mocks.Client{}
 			if tc.wantCancel {
+				client.On(
+					"SignalWorkflow",
+					mock.Anything,
+					row.WorkflowID,
+					row.RunID,
+					ProcessingWorkflowCancelSignalName,
+					ProcessingWorkflowCancelSignal{Actor: "api:alice"},
+				).Return(nil).Once()
 				client.On(
 					"CancelWorkflow",
 					mock.Anything,
@@ -188,10 +216,10 @@ func TestGoaCancel(t *testing.T) {
 			assert.NilError(t, err)
 			defer sub.Close()
 
-			svc := NewService(testLogger(), recorder.db, client, "", nil)
+			svc := NewService(testLogger(), recorder.db, client, "", nil, nil, nil, nil)
 			svc.events = events
 
-			err = svc.Goa().Cancel(ctx, &goacollection.CancelPayload{ID: 42})
+			err = svc.Goa().Cancel(WithActor(ctx, APIActor("alice")), &goacollection.CancelPayload{ID: 42})
 
 			assert.Equal(t, recorder.execQuery, "")
 			assert.DeepEqual(t, recorder.queryArgs, []any{int64(42)})
@@ -239,6 +267,19 @@ func TestGoaDecide(t *testing.T) {
 			option:       string(ProcessingWorkflowDecisionRetryOnce),
 			wantNotValid: true,
 		},
+		"rejects option not accepted by the workflow": {
+			row: &Collection{
+				ID:               42,
+				WorkflowID:       "processing-workflow-42",
+				RunID:            "run-42",
+				Status:           StatusPending,
+				DecisionActivity: sql.NullString{String: "receipt-send-activity", Valid: true},
+				DecisionError:    sql.NullString{String: "connection refused", Valid: true},
+				DecisionOptions:  sql.NullString{String: "RETRY,RETRY_ONCE,ABANDON", Valid: true},
+			},
+			option:       string(ProcessingWorkflowDecisionSkip),
+			wantNotValid: true,
+		},
 		"rejects unknown option": {
 			row: &Collection{
 				ID:         42,
@@ -299,7 +340,7 @@ func TestGoaDecide(t *testing.T) {
 						WorkflowID:   tc.row.WorkflowID,
 						RunID:        tc.row.RunID,
 						UpdateName:   ProcessingWorkflowDecisionUpdateName,
-						Args:         []any{decision},
+						Args:         []any{decision, "api"},
 						WaitForStage: temporalsdk_client.WorkflowUpdateStageCompleted,
 					},
 				).Return(handle, tc.updateErr).Once()
@@ -313,7 +354,7 @@ func TestGoaDecide(t *testing.T) {
 			assert.NilError(t, err)
 			defer sub.Close()
 
-			svc := NewService(testLogger(), recorder.db, client, "", nil)
+			svc := NewService(testLogger(), recorder.db, client, "", nil, nil, nil, nil)
 			svc.events = events
 			err = svc.Goa().Decide(ctx, &goacollection.DecidePayload{ID: 42, Option: tc.option})
 
@@ -337,6 +378,184 @@ func TestGoaDecide(t *testing.T) {
 	}
 }
 
+func TestGoaBulk(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Counts the affected collections in dry runs", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := newExecRecorderDB(t)
+		recorder.count = 250
+		svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
+
+		got, err := svc.Goa().Bulk(context.Background(), &goacollection.BulkPayload{
+			Operation:        string(BulkWorkflowOperationDecide),
+			Status:           StatusPending.String(),
+			Size:             100,
+			Option:           new(string(ProcessingWorkflowDecisionSkip)),
+			Name:             new("DPJ-SIP"),
+			DecisionActivity: new("receipt-send-activity"),
+			DryRun:           true,
+		})
+
+		assert.NilError(t, err)
+		assert.DeepEqual(t, got, &goacollection.BulkResult{Count: new(uint(100))})
+		assert.Equal(t, recorder.querySQL, "SELECT COUNT(*) FROM collection WHERE name LIKE (?) AND status = (?) AND decision_activity = (?)")
+		assert.DeepEqual(t, recorder.queryArgs, []any{"DPJ-SIP%", int64(StatusPending), "receipt-send-activity"})
+	})
+
+	t.Run("Rejects decide without option", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := newExecRecorderDB(t)
+		svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
+
+		_, err := svc.Goa().Bulk(context.Background(), &goacollection.BulkPayload{
+			Operation: string(BulkWorkflowOperationDecide),
+			Status:    StatusPending.String(),
+			Size:      100,
+			DryRun:    true,
+		})
+
+		var serviceErr *goa.ServiceError
+		assert.Assert(t, errors.As(err, &serviceErr))
+		assert.Equal(t, serviceErr.Name, "not_valid")
+		assert.Equal(t, recorder.querySQL, "")
+	})
+
+	t.Run("Counts the listed collections in dry runs", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := newExecRecorderDB(t)
+		recorder.count = 2
+		svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
+
+		got, err := svc.Goa().Bulk(context.Background(), &goacollection.BulkPayload{
+			Operation: string(BulkWorkflowOperationRetry),
+			Status:    StatusError.String(),
+			Size:      3,
+			Name:      new("ignored"),
+			Ids:       []uint{10, 11, 12},
+			DryRun:    true,
+		})
+
+		assert.NilError(t, err)
+		assert.DeepEqual(t, got, &goacollection.BulkResult{Count: new(uint(2))})
+		assert.Equal(t, recorder.querySQL, "SELECT COUNT(*) FROM collection WHERE status = (?) AND id IN (?, ?, ?)")
+		assert.DeepEqual(t, recorder.queryArgs, []any{int64(StatusError), int64(10), int64(11), int64(12)})
+	})
+
+	t.Run("Rejects more identifiers than size", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := newExecRecorderDB(t)
+		svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
+
+		_, err := svc.Goa().Bulk(context.Background(), &goacollection.BulkPayload{
+			Operation: string(BulkWorkflowOperationRetry),
+			Status:    StatusError.String(),
+			Size:      1,
+			Ids:       []uint{10, 11},
+			DryRun:    true,
+		})
+
+		var serviceErr *goa.ServiceError
+		assert.Assert(t, errors.As(err, &serviceErr))
+		assert.Equal(t, serviceErr.Name, "not_valid")
+	})
+}
+
+func TestGoaBulkRun(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, time.October, 14, 8, 0, 0, 0, time.UTC)
+
+	t.Run("Returns the run with its outcomes", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := newExecRecorderDB(t)
+		recorder.bulkRun = &BulkRun{
+			ID:               7,
+			WorkflowID:       "collection-bulk-workflow-7",
+			RunID:            "run-7",
+			Operation:        string(BulkWorkflowOperationDecide),
+			CollectionStatus: StatusPending,
+			Decision:         sql.NullString{String: string(ProcessingWorkflowDecisionSkip), Valid: true},
+			State:            BulkRunStateCompleted,
+			CreatedAt:        createdAt,
+			CompletedAt:      sql.NullTime{Time: createdAt.Add(time.Minute), Valid: true},
+		}
+		recorder.bulkOutcomes = []BulkOutcome{
+			{ID: 10, Outcome: BulkOutcomeSucceeded},
+			{ID: 11, Outcome: BulkOutcomeFailed, Error: "workflow is not awaiting an operator decision"},
+		}
+		svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
+
+		got, err := svc.Goa().BulkRun(context.Background(), &goacollection.BulkRunPayload{ID: 7})
+
+		assert.NilError(t, err)
+		assert.DeepEqual(t, got, &goacollection.EnduroBulkRun{
+			ID:          7,
+			WorkflowID:  "collection-bulk-workflow-7",
+			RunID:       "run-7",
+			Operation:   "decide",
+			Status:      "pending",
+			Option:      new(string(ProcessingWorkflowDecisionSkip)),
+			State:       "completed",
+			CreatedAt:   "2026-10-14T08:00:00Z",
+			CompletedAt: new("2026-10-14T08:01:00Z"),
+			Outcomes: []*goacollection.BulkOutcome{
+				{ID: 10, Outcome: "succeeded"},
+				{ID: 11, Outcome: "failed", Error: new("workflow is not awaiting an operator decision")},
+			},
+		})
+		assert.Equal(t, recorder.querySQL, "SELECT collection_id, outcome, COALESCE(error, '') AS error FROM bulk_run_result WHERE bulk_run_id = (?) ORDER BY id ASC")
+	})
+
+	t.Run("Fails running runs whose workflow timed out", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := newExecRecorderDB(t)
+		recorder.bulkRun = &BulkRun{
+			ID:               7,
+			WorkflowID:       "collection-bulk-workflow-7",
+			RunID:            "run-7",
+			Operation:        string(BulkWorkflowOperationRetry),
+			CollectionStatus: StatusError,
+			State:            BulkRunStateRunning,
+			CreatedAt:        createdAt,
+		}
+		client := &temporalsdk_mocks.Client{}
+		client.On("DescribeWorkflowExecution", mock.Anything, "collection-bulk-workflow-7", "run-7").Return(&temporalapi_workflowservice.DescribeWorkflowExecutionResponse{
+			WorkflowExecutionInfo: &temporalapi_workflow.WorkflowExecutionInfo{
+				Status: temporalapi_enums.WORKFLOW_EXECUTION_STATUS_TIMED_OUT,
+			},
+		}, nil).Once()
+		svc := NewService(testLogger(), recorder.db, client, "", nil, nil, nil, nil)
+
+		got, err := svc.Goa().BulkRun(context.Background(), &goacollection.BulkRunPayload{ID: 7})
+
+		assert.NilError(t, err)
+		assert.Equal(t, got.State, BulkRunStateFailed)
+		assert.Equal(t, *got.Error, "bulk workflow closed with status TimedOut")
+		assert.Assert(t, got.CompletedAt != nil)
+		assert.Equal(t, recorder.execQuery, "UPDATE bulk_run SET state = (?), error = (?), completed_at = (?) WHERE id = (?)")
+		assert.Equal(t, recorder.execArgs[0], BulkRunStateFailed)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("Returns not found", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := newExecRecorderDB(t)
+		svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
+
+		_, err := svc.Goa().BulkRun(context.Background(), &goacollection.BulkRunPayload{ID: 7})
+
+		assert.DeepEqual(t, err, &goacollection.BulkRunNotFound{ID: 7, Message: "not_found"})
+	})
+}
+
 func TestGoaStatusHistory(t *testing.T) {
 	t.Parallel()
 
@@ -371,7 +590,7 @@ func TestGoaStatusHistory(t *testing.T) {
 			Reason:         sql.NullString{String: "pipeline_acquired", Valid: true},
 		},
 	}
-	svc := NewService(testLogger(), recorder.db, nil, "", nil)
+	svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
 
 	got, err := svc.Goa().StatusHistory(context.Background(), &goacollection.StatusHistoryPayload{ID: 42})
 
@@ -386,6 +605,141 @@ func TestGoaStatusHistory(t *testing.T) {
 	assert.Equal(t, got.Transitions[1].OccurredAt, "2026-07-19T10:31:00Z")
 }
 
+func TestGoaShowIncludesValidationResults(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, time.October, 12, 9, 0, 0, 0, time.UTC)
+	recorder := newExecRecorderDB(t)
+	recorder.row = &Collection{
+		ID:        42,
+		Status:    StatusError,
+		CreatedAt: createdAt,
+	}
+	recorder.validations = []ValidationResult{
+		{
+			ID:           1,
+			CollectionID: 42,
+			Validator:    "checksum-manifest",
+			Severity:     "fail",
+			Status:       "failed",
+			Message:      sql.NullString{String: "checksum.md5: objects/a.txt: checksum mismatch", Valid: true},
+			CreatedAt:    createdAt.Add(time.Minute),
+		},
+		{
+			ID:           2,
+			CollectionID: 42,
+			Validator:    "max-file-count",
+			Severity:     "warn",
+			Status:       "passed",
+			CreatedAt:    createdAt.Add(time.Minute),
+		},
+	}
+	svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
+
+	got, err := svc.Goa().Show(context.Background(), &goacollection.ShowPayload{ID: 42})
+
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got.Validation, goacollection.EnduroCollectionValidationResultCollection{
+		{
+			Validator: "checksum-manifest",
+			Severity:  "fail",
+			Status:    "failed",
+			Message:   new("checksum.md5: objects/a.txt: checksum mismatch"),
+			CreatedAt: "2026-10-12T09:01:00Z",
+		},
+		{
+			Validator: "max-file-count",
+			Severity:  "warn",
+			Status:    "passed",
+			CreatedAt: "2026-10-12T09:01:00Z",
+		},
+	})
+}
+
+func TestGoaShowIncludesProgress(t *testing.T) {
+	t.Parallel()
+
+	updatedAt := time.Date(2026, time.October, 12, 9, 30, 0, 0, time.UTC)
+	recorder := newExecRecorderDB(t)
+	recorder.row = &Collection{
+		ID:        42,
+		Status:    StatusInProgress,
+		CreatedAt: updatedAt.Add(-time.Hour),
+	}
+	recorder.progress = &Progress{
+		CollectionID:  42,
+		Stage:         "transfer",
+		Microservice:  sql.NullString{String: "Scan for viruses", Valid: true},
+		Job:           sql.NullString{String: "Scan for viruses", Valid: true},
+		JobsCompleted: 1,
+		JobsTotal:     3,
+		UpdatedAt:     updatedAt,
+	}
+	svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
+
+	got, err := svc.Goa().Show(context.Background(), &goacollection.ShowPayload{ID: 42})
+
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got.Progress, &goacollection.EnduroCollectionProgress{
+		Stage:         "transfer",
+		Microservice:  new("Scan for viruses"),
+		Job:           new("Scan for viruses"),
+		JobsCompleted: 1,
+		JobsTotal:     3,
+		Percent:       33,
+		UpdatedAt:     "2026-10-12T09:30:00Z",
+	})
+}
+
+func TestGoaShowIncludesFailure(t *testing.T) {
+	t.Parallel()
+
+	occurredAt := time.Date(2026, time.October, 12, 9, 30, 0, 0, time.UTC)
+	recorder := newExecRecorderDB(t)
+	recorder.row = &Collection{
+		ID:        42,
+		Status:    StatusError,
+		CreatedAt: occurredAt.Add(-time.Hour),
+	}
+	recorder.failure = &Failure{
+		CollectionID: 42,
+		Category:     "failed",
+		Stage:        "ingest",
+		UnitID:       "0f1d2a36-1a48-4e55-8b2e-5bd1d3b3a1f1",
+		Microservice: sql.NullString{String: "Normalize", Valid: true},
+		Job:          sql.NullString{String: "Normalize for preservation", Valid: true},
+		Stderr:       sql.NullString{String: "ffmpeg: invalid data", Valid: true},
+		Errors:       `["ingest failed"]`,
+		OccurredAt:   occurredAt,
+	}
+	svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
+
+	got, err := svc.Goa().Show(context.Background(), &goacollection.ShowPayload{ID: 42})
+
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got.Failure, &goacollection.EnduroCollectionFailure{
+		Category:     "failed",
+		Stage:        "ingest",
+		UnitID:       "0f1d2a36-1a48-4e55-8b2e-5bd1d3b3a1f1",
+		Microservice: new("Normalize"),
+		Job:          new("Normalize for preservation"),
+		Stderr:       new("ffmpeg: invalid data"),
+		Errors:       []string{"ingest failed"},
+		OccurredAt:   "2026-10-12T09:30:00Z",
+	})
+}
+
+func TestListConditionsFailureCategory(t *testing.T) {
+	t.Parallel()
+
+	conds, args := listConditions(&goacollection.ListPayload{FailureCategory: new("rejected")})
+
+	assert.DeepEqual(t, conds, [][2]string{
+		{"AND", "id IN (SELECT collection_id FROM collection_failure WHERE category = (?))"},
+	})
+	assert.DeepEqual(t, args, []any{new("rejected")})
+}
+
 func TestGoaStatusHistoryUnavailableForLegacyCollection(t *testing.T) {
 	t.Parallel()
 
@@ -397,7 +751,7 @@ func TestGoaStatusHistoryUnavailableForLegacyCollection(t *testing.T) {
 		Status:     StatusDone,
 		CreatedAt:  time.Now().UTC(),
 	}
-	svc := NewService(testLogger(), recorder.db, nil, "", nil)
+	svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
 
 	got, err := svc.Goa().StatusHistory(context.Background(), &goacollection.StatusHistoryPayload{ID: 42})
 
@@ -410,7 +764,7 @@ func TestGoaStatusHistoryReturnsNotFound(t *testing.T) {
 	t.Parallel()
 
 	recorder := newExecRecorderDB(t)
-	svc := NewService(testLogger(), recorder.db, nil, "", nil)
+	svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
 
 	got, err := svc.Goa().StatusHistory(context.Background(), &goacollection.StatusHistoryPayload{ID: 42})
 
@@ -708,3 +1062,127 @@ func (s *monitorTestStream) waitEventByType(t *testing.T, eventType string) *goa
 
 	return matched
 }
+
+func TestGoaNotificationsListsDeliveries(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, time.October, 12, 9, 0, 0, 0, time.UTC)
+	recorder := newExecRecorderDB(t)
+	recorder.row = &Collection{
+		ID:        42,
+		Status:    StatusDone,
+		CreatedAt: createdAt,
+	}
+	notifications := &deliveriesStub{deliveries: []notification.Delivery{
+		{
+			ID:           3,
+			CollectionID: 42,
+			Webhook:      "dashboard",
+			Event:        notification.EventDone,
+			Status:       notification.DeliveryStatusPending,
+			Attempts:     2,
+			ResponseCode: sql.NullInt64{Int64: 502, Valid: true},
+			Error:        sql.NullString{String: "unexpected response status: 502 Bad Gateway", Valid: true},
+			CreatedAt:    createdAt,
+			UpdatedAt:    createdAt.Add(time.Minute),
+		},
+	}}
+	svc := NewService(testLogger(), recorder.db, nil, "", nil, notifications, nil, nil)
+
+	got, err := svc.Goa().Notifications(context.Background(), &goacollection.NotificationsPayload{ID: 42})
+
+	assert.NilError(t, err)
+	assert.Equal(t, notifications.collectionID, uint(42))
+	assert.DeepEqual(t, got, goacollection.EnduroCollectionNotificationDeliveryCollection{
+		{
+			ID:           3,
+			Webhook:      "dashboard",
+			Event:        "done",
+			Status:       "pending",
+			Attempts:     2,
+			ResponseCode: new(502),
+			Error:        new("unexpected response status: 502 Bad Gateway"),
+			CreatedAt:    "2026-10-12T09:00:00Z",
+			UpdatedAt:    "2026-10-12T09:01:00Z",
+		},
+	})
+}
+
+type deliveriesStub struct {
+	notification.Service
+	deliveries   []notification.Delivery
+	collectionID uint
+}
+
+func (s *deliveriesStub) Deliveries(_ context.Context, collectionID uint) ([]notification.Delivery, error) {
+	s.collectionID = collectionID
+	return s.deliveries, nil
+}
+
+func TestGoaRetentionCancel(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Cancels the pending deletion", func(t *testing.T) {
+		t.Parallel()
+
+		deletions := &retentionStub{}
+		svc := NewService(testLogger(), nil, nil, "", nil, nil, nil, deletions)
+
+		err := svc.Goa().RetentionCancel(context.Background(), &goacollection.RetentionCancelPayload{ID: 42})
+
+		assert.NilError(t, err)
+		assert.Equal(t, deletions.canceled, uint(42))
+	})
+
+	t.Run("Returns not found without a pending deletion", func(t *testing.T) {
+		t.Parallel()
+
+		deletions := &retentionStub{err: retention.ErrNotFound}
+		svc := NewService(testLogger(), nil, nil, "", nil, nil, nil, deletions)
+
+		err := svc.Goa().RetentionCancel(context.Background(), &goacollection.RetentionCancelPayload{ID: 42})
+
+		var notFound *goacollection.CollectionNotfound
+		assert.Assert(t, errors.As(err, &notFound))
+		assert.Equal(t, notFound.ID, uint(42))
+	})
+}
+
+func TestGoaRetentionMigrateSignalsRunningWorkflows(t *testing.T) {
+	t.Parallel()
+
+	recorder := newExecRecorderDB(t)
+	recorder.row = &Collection{
+		WorkflowID: "processing-workflow-42",
+		RunID:      "run-42",
+		Status:     StatusInProgress,
+	}
+	client := &temporalsdk_mocks.Client{}
+	client.On(
+		"SignalWorkflow",
+		mock.Anything,
+		"processing-workflow-42",
+		"run-42",
+		ProcessingWorkflowRetentionHandoffSignalName,
+		nil,
+	).Return(nil).Once()
+	svc := NewService(testLogger(), recorder.db, client, "", nil, nil, nil, nil)
+
+	got, err := svc.Goa().RetentionMigrate(context.Background())
+
+	assert.NilError(t, err)
+	assert.Equal(t, got.Signaled, uint(1))
+	assert.DeepEqual(t, recorder.queryArgs, []any{int64(StatusInProgress)})
+	client.AssertExpectations(t)
+}
+
+type retentionStub struct {
+	retention.Service
+	err      error
+	canceled uint
+}
+
+func (s *retentionStub) Cancel(_ context.Context, collectionID uint) error {
+	s.canceled = collectionID
+	return s.err
+}
@@ -0,0 +1,57 @@
+package collection
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// LegalHold reports whether the collection is under legal hold.
+func (svc *collectionImpl) LegalHold(ctx context.Context, ID uint) (bool, error) {
+	query := `SELECT legal_hold FROM collection WHERE id = (?)`
+	var held bool
+	if err := svc.db.GetContext(ctx, &held, svc.db.Rebind(query), ID); err != nil {
+		return false, err
+	}
+
+	return held, nil
+}
+
+// SetLegalHold places or releases the legal hold of a collection. The change
+// is recorded in the status history as a transition that keeps the status.
+func (svc *collectionImpl) SetLegalHold(ctx context.Context, ID uint, held bool, reason, actor string) error {
+	tx, err := svc.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning legal hold update: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	state := collectionStatusState{}
+	query := `SELECT workflow_id, run_id, status FROM collection WHERE id = (?) FOR UPDATE`
+	if err := tx.GetContext(ctx, &state, tx.Rebind(query), ID); err != nil {
+		return err
+	}
+
+	query = `UPDATE collection SET legal_hold = (?), legal_hold_reason = (?), legal_hold_actor = (?), legal_hold_at = (?) WHERE id = (?)`
+	args := []any{held, nil, nil, nil, ID}
+	transitionReason := ReasonLegalHoldCleared
+	if held {
+		args = []any{held, reason, actor, time.Now().UTC(), ID}
+		transitionReason = ReasonLegalHoldSet
+	}
+	if err := updateRowTx(ctx, tx, query, args); err != nil {
+		return err
+	}
+
+	if err := insertStatusTransition(ctx, tx, ID, &state.Status, state, false, transitionReason, actor, reason); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing legal hold update: %w", err)
+	}
+
+	publishEvent(ctx, svc.events, EventTypeCollectionUpdated, ID)
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package collection
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	goacollection "github.com/artefactual-labs/enduro/internal/api/gen/collection"
+)
+
+func TestSetLegalHold(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Places the hold and records the transition", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := newExecRecorderDB(t)
+		recorder.row = &Collection{WorkflowID: "workflow-42", RunID: "run-42", Status: StatusDone}
+		svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
+
+		err := svc.SetLegalHold(context.Background(), 42, true, "Litigation 2026-17", "jdoe")
+
+		assert.NilError(t, err)
+		assert.Equal(t, recorder.execQueries[0], "UPDATE collection SET legal_hold = (?), legal_hold_reason = (?), legal_hold_actor = (?), legal_hold_at = (?) WHERE id = (?)")
+		assert.Equal(t, recorder.execArgsList[0][0], true)
+		assert.Equal(t, recorder.execArgsList[0][1], "Litigation 2026-17")
+		assert.Equal(t, recorder.execArgsList[0][2], "jdoe")
+		assert.Assert(t, !recorder.execArgsList[0][3].(time.Time).IsZero())
+		assert.DeepEqual(t, recorder.execArgsList[1], []any{
+			int64(42),
+			"workflow-42",
+			"run-42",
+			int64(StatusDone),
+			int64(StatusDone),
+			false,
+			"legal_hold_set",
+			"jdoe",
+			"Litigation 2026-17",
+		})
+		assert.Assert(t, recorder.committed)
+	})
+
+	t.Run("Clears the hold and records the transition", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := newExecRecorderDB(t)
+		recorder.row = &Collection{WorkflowID: "workflow-42", RunID: "run-42", Status: StatusDone}
+		svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
+
+		err := svc.SetLegalHold(context.Background(), 42, false, "Case closed", "jdoe")
+
+		assert.NilError(t, err)
+		assert.DeepEqual(t, recorder.execArgsList[0], []any{false, nil, nil, nil, int64(42)})
+		assert.DeepEqual(t, recorder.execArgsList[1], []any{
+			int64(42),
+			"workflow-42",
+			"run-42",
+			int64(StatusDone),
+			int64(StatusDone),
+			false,
+			"legal_hold_cleared",
+			"jdoe",
+			"Case closed",
+		})
+	})
+
+	t.Run("Returns ErrNoRows for unknown collections", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := newExecRecorderDB(t)
+		svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
+
+		err := svc.SetLegalHold(context.Background(), 42, true, "Litigation 2026-17", "jdoe")
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		assert.Equal(t, len(recorder.execQueries), 0)
+		assert.Assert(t, !recorder.committed)
+	})
+
+	t.Run("Records the authenticated user as the actor of API requests", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := newExecRecorderDB(t)
+		recorder.row = &Collection{WorkflowID: "workflow-42", RunID: "run-42", Status: StatusDone}
+		svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
+		ctx := WithActor(context.Background(), APIActor("jdoe"))
+
+		err := svc.Goa().SetLegalHold(ctx, &goacollection.SetLegalHoldPayload{ID: 42, Reason: "Litigation 2026-17"})
+
+		assert.NilError(t, err)
+		assert.Equal(t, recorder.execArgsList[0][2], "api:jdoe")
+		assert.Equal(t, recorder.execArgsList[1][7], "api:jdoe")
+	})
+}
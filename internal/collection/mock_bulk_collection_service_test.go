@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -typed -source=./internal/collection/bulk_workflow.go -destination=./internal/collection/mock_bulk_collection_service_test.go -package=collection -mock_names=bulkCollectionService=MockBulkCollectionService
+//	mockgen -typed -source=./internal/collection/bulk_workflow.go -destination=./internal/collection/mock_bulk_collection_service_test.go -package=collection -mock_names=bulkCollectionService=MockBulkCollectionService,bulkRunRecorder=MockBulkRunRecorder
 //
 
 // Package collection is a generated GoMock package.
@@ -233,3 +233,103 @@ func (c *MockBulkCollectionServiceShowCall) DoAndReturn(f func(context.Context,
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockBulkRunRecorder is a mock of bulkRunRecorder interface.
+type MockBulkRunRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockBulkRunRecorderMockRecorder
+	isgomock struct{}
+}
+
+// MockBulkRunRecorderMockRecorder is the mock recorder for MockBulkRunRecorder.
+type MockBulkRunRecorderMockRecorder struct {
+	mock *MockBulkRunRecorder
+}
+
+// NewMockBulkRunRecorder creates a new mock instance.
+func NewMockBulkRunRecorder(ctrl *gomock.Controller) *MockBulkRunRecorder {
+	mock := &MockBulkRunRecorder{ctrl: ctrl}
+	mock.recorder = &MockBulkRunRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBulkRunRecorder) EXPECT() *MockBulkRunRecorderMockRecorder {
+	return m.recorder
+}
+
+// CompleteBulkRun mocks base method.
+func (m *MockBulkRunRecorder) CompleteBulkRun(ctx context.Context, bulkRunID uint, runErr error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteBulkRun", ctx, bulkRunID, runErr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteBulkRun indicates an expected call of CompleteBulkRun.
+func (mr *MockBulkRunRecorderMockRecorder) CompleteBulkRun(ctx, bulkRunID, runErr any) *MockBulkRunRecorderCompleteBulkRunCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteBulkRun", reflect.TypeOf((*MockBulkRunRecorder)(nil).CompleteBulkRun), ctx, bulkRunID, runErr)
+	return &MockBulkRunRecorderCompleteBulkRunCall{Call: call}
+}
+
+// MockBulkRunRecorderCompleteBulkRunCall wrap *gomock.Call
+type MockBulkRunRecorderCompleteBulkRunCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBulkRunRecorderCompleteBulkRunCall) Return(arg0 error) *MockBulkRunRecorderCompleteBulkRunCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBulkRunRecorderCompleteBulkRunCall) Do(f func(context.Context, uint, error) error) *MockBulkRunRecorderCompleteBulkRunCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBulkRunRecorderCompleteBulkRunCall) DoAndReturn(f func(context.Context, uint, error) error) *MockBulkRunRecorderCompleteBulkRunCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RecordBulkOutcome mocks base method.
+func (m *MockBulkRunRecorder) RecordBulkOutcome(ctx context.Context, bulkRunID uint, outcome BulkOutcome) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordBulkOutcome", ctx, bulkRunID, outcome)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordBulkOutcome indicates an expected call of RecordBulkOutcome.
+func (mr *MockBulkRunRecorderMockRecorder) RecordBulkOutcome(ctx, bulkRunID, outcome any) *MockBulkRunRecorderRecordBulkOutcomeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordBulkOutcome", reflect.TypeOf((*MockBulkRunRecorder)(nil).RecordBulkOutcome), ctx, bulkRunID, outcome)
+	return &MockBulkRunRecorderRecordBulkOutcomeCall{Call: call}
+}
+
+// MockBulkRunRecorderRecordBulkOutcomeCall wrap *gomock.Call
+type MockBulkRunRecorderRecordBulkOutcomeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBulkRunRecorderRecordBulkOutcomeCall) Return(arg0 error) *MockBulkRunRecorderRecordBulkOutcomeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBulkRunRecorderRecordBulkOutcomeCall) Do(f func(context.Context, uint, BulkOutcome) error) *MockBulkRunRecorderRecordBulkOutcomeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBulkRunRecorderRecordBulkOutcomeCall) DoAndReturn(f func(context.Context, uint, BulkOutcome) error) *MockBulkRunRecorderRecordBulkOutcomeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,119 @@
+package collection
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	goacollection "github.com/artefactual-labs/enduro/internal/api/gen/collection"
+	"github.com/artefactual-labs/enduro/internal/pipeline"
+)
+
+// Progress is the persisted snapshot of the Archivematica jobs of a
+// collection. Only the latest snapshot is kept.
+type Progress struct {
+	CollectionID  uint           `db:"collection_id"`
+	Stage         string         `db:"stage"`
+	Microservice  sql.NullString `db:"microservice"`
+	Job           sql.NullString `db:"job"`
+	JobsCompleted uint           `db:"jobs_completed"`
+	JobsTotal     uint           `db:"jobs_total"`
+	FailedJobs    sql.NullString `db:"failed_jobs"`
+	UpdatedAt     time.Time      `db:"updated_at"`
+}
+
+// SetProgress records the latest progress reported by Archivematica and
+// publishes it to the monitor.
+func (svc *collectionImpl) SetProgress(ctx context.Context, ID uint, progress pipeline.Progress) error {
+	record := Progress{
+		CollectionID:  ID,
+		Stage:         progress.Stage,
+		Microservice:  sql.NullString{String: progress.Microservice, Valid: progress.Microservice != ""},
+		Job:           sql.NullString{String: progress.Job, Valid: progress.Job != ""},
+		JobsCompleted: progress.JobsCompleted,
+		JobsTotal:     progress.JobsTotal,
+		UpdatedAt:     time.Now().UTC(),
+	}
+	if len(progress.FailedJobs) > 0 {
+		blob, err := json.Marshal(progress.FailedJobs)
+		if err != nil {
+			return fmt.Errorf("error encoding failed jobs: %w", err)
+		}
+		record.FailedJobs = sql.NullString{String: string(blob), Valid: true}
+	}
+
+	query := `INSERT INTO collection_progress (collection_id, stage, microservice, job, jobs_completed, jobs_total, failed_jobs, updated_at) VALUES ((?), (?), (?), (?), (?), (?), (?), (?)) ON DUPLICATE KEY UPDATE stage = VALUES(stage), microservice = VALUES(microservice), job = VALUES(job), jobs_completed = VALUES(jobs_completed), jobs_total = VALUES(jobs_total), failed_jobs = VALUES(failed_jobs), updated_at = VALUES(updated_at)`
+	args := []any{
+		record.CollectionID,
+		record.Stage,
+		record.Microservice,
+		record.Job,
+		record.JobsCompleted,
+		record.JobsTotal,
+		record.FailedJobs,
+		record.UpdatedAt,
+	}
+	if _, err := svc.db.ExecContext(ctx, svc.db.Rebind(query), args...); err != nil {
+		return fmt.Errorf("error updating collection progress: %w", err)
+	}
+
+	svc.events.PublishEvent(&goacollection.EnduroMonitorUpdate{
+		Timestamp: record.UpdatedAt.Format(time.RFC3339),
+		ID:        ID,
+		Type:      EventTypeCollectionProgress,
+		Progress:  goaProgress(&record),
+	})
+
+	return nil
+}
+
+// readProgress returns the latest progress of the collection, or nil when
+// none was recorded.
+func (svc *collectionImpl) readProgress(ctx context.Context, collectionID uint) (*Progress, error) {
+	query := `SELECT collection_id, stage, microservice, job, jobs_completed, jobs_total, failed_jobs, CONVERT_TZ(updated_at, @@session.time_zone, '+00:00') AS updated_at FROM collection_progress WHERE collection_id = (?)`
+	progress := Progress{}
+	err := svc.db.GetContext(ctx, &progress, svc.db.Rebind(query), collectionID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading collection progress: %w", err)
+	}
+
+	return &progress, nil
+}
+
+func goaProgress(progress *Progress) *goacollection.EnduroCollectionProgress {
+	if progress == nil {
+		return nil
+	}
+
+	percent := pipeline.Progress{JobsCompleted: progress.JobsCompleted, JobsTotal: progress.JobsTotal}.Percent()
+	res := &goacollection.EnduroCollectionProgress{
+		Stage:         progress.Stage,
+		Microservice:  formatOptionalNullString(progress.Microservice),
+		Job:           formatOptionalNullString(progress.Job),
+		JobsCompleted: progress.JobsCompleted,
+		JobsTotal:     progress.JobsTotal,
+		Percent:       percent,
+		UpdatedAt:     formatTime(progress.UpdatedAt),
+	}
+
+	var failedJobs []pipeline.FailedJob
+	if progress.FailedJobs.Valid {
+		// Failed jobs are encoded by SetProgress, ignore unreadable values.
+		_ = json.Unmarshal([]byte(progress.FailedJobs.String), &failedJobs)
+	}
+	for _, job := range failedJobs {
+		res.FailedJobs = append(res.FailedJobs, &goacollection.EnduroCollectionFailedJob{
+			ID:           job.ID,
+			Name:         job.Name,
+			Microservice: job.Microservice,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,82 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/artefactual-labs/enduro/internal/watcher"
+)
+
+// rescanBatchSize limits the number of keys looked up in a single query.
+const rescanBatchSize = 500
+
+// Rescan lists the blobs available in the location of a watcher and returns
+// the ones that were never dispatched and have no matching collection, e.g.
+// because the event that announced them was lost. The blobs are not
+// dispatched.
+//
+// Dispatched blobs are recorded by RecordDispatch so they are not returned
+// again once their collections are deleted. Collections created before watcher
+// names were recorded match any watcher.
+func (svc *collectionImpl) Rescan(ctx context.Context, watcherName string) ([]*watcher.BlobEvent, error) {
+	if svc.watchers == nil {
+		return nil, errors.New("watcher service is not available")
+	}
+
+	events, err := svc.watchers.Scan(ctx, watcherName)
+	if err != nil {
+		return nil, err
+	}
+
+	known := map[string]struct{}{}
+	for i := 0; i < len(events); i += rescanBatchSize {
+		keys := make([]string, 0, rescanBatchSize)
+		for _, event := range events[i:min(i+rescanBatchSize, len(events))] {
+			keys = append(keys, event.Key)
+		}
+		if err := svc.knownKeys(ctx, watcherName, keys, known); err != nil {
+			return nil, err
+		}
+	}
+
+	unknown := make([]*watcher.BlobEvent, 0, len(events))
+	for _, event := range events {
+		if _, ok := known[event.Key]; !ok {
+			unknown = append(unknown, event)
+		}
+	}
+
+	return unknown, nil
+}
+
+func (svc *collectionImpl) knownKeys(ctx context.Context, watcherName string, keys []string, known map[string]struct{}) error {
+	query, args, err := sqlx.In(
+		"SELECT name FROM collection WHERE name IN (?) AND (watcher_name = ? OR watcher_name = '') UNION SELECT name FROM watcher_dispatch WHERE name IN (?) AND watcher_name = ?",
+		keys, watcherName, keys, watcherName,
+	)
+	if err != nil {
+		return fmt.Errorf("error building query: %w", err)
+	}
+
+	var names []string
+	if err := svc.db.SelectContext(ctx, &names, svc.db.Rebind(query), args...); err != nil {
+		return fmt.Errorf("error querying the database: %w", err)
+	}
+	for _, name := range names {
+		known[name] = struct{}{}
+	}
+
+	return nil
+}
+
+func (svc *collectionImpl) RecordDispatch(ctx context.Context, watcherName, key string) error {
+	query := `INSERT IGNORE INTO watcher_dispatch (watcher_name, name, name_hash) VALUES ((?), (?), UNHEX(SHA2((?), 256)))`
+	if _, err := svc.db.ExecContext(ctx, svc.db.Rebind(query), watcherName, key, key); err != nil {
+		return fmt.Errorf("error recording dispatch: %w", err)
+	}
+
+	return nil
+}
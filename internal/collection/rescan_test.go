@@ -0,0 +1,68 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/enduro/internal/watcher"
+	watcherfake "github.com/artefactual-labs/enduro/internal/watcher/fake"
+)
+
+func TestRescanReturnsUnknownBlobs(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	watchers := watcherfake.NewMockService(ctrl)
+	watchers.EXPECT().
+		Scan(gomock.Any(), "minio").
+		Return([]*watcher.BlobEvent{
+			{WatcherName: "minio", Key: "known.zip"},
+			{WatcherName: "minio", Key: "missed.zip"},
+		}, nil)
+
+	recorder := newExecRecorderDB(t)
+	recorder.names = []string{"known.zip"}
+	svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, watchers, nil)
+
+	events, err := svc.Rescan(context.Background(), "minio")
+
+	assert.NilError(t, err)
+	assert.Equal(t, len(events), 1)
+	assert.Equal(t, events[0].Key, "missed.zip")
+	assert.Equal(t, recorder.querySQL, "SELECT name FROM collection WHERE name IN (?, ?) AND (watcher_name = ? OR watcher_name = '') UNION SELECT name FROM watcher_dispatch WHERE name IN (?, ?) AND watcher_name = ?")
+	assert.DeepEqual(t, recorder.queryArgs, []any{"known.zip", "missed.zip", "minio", "known.zip", "missed.zip", "minio"})
+}
+
+func TestRecordDispatch(t *testing.T) {
+	t.Parallel()
+
+	recorder := newExecRecorderDB(t)
+	svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, nil, nil)
+
+	err := svc.RecordDispatch(context.Background(), "minio", "missed.zip")
+
+	assert.NilError(t, err)
+	assert.Equal(t, recorder.execQueries[0], "INSERT IGNORE INTO watcher_dispatch (watcher_name, name, name_hash) VALUES ((?), (?), UNHEX(SHA2((?), 256)))")
+	assert.DeepEqual(t, recorder.execArgsList[0], []any{"minio", "missed.zip", "missed.zip"})
+}
+
+func TestRescanFailsWhenScanFails(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	watchers := watcherfake.NewMockService(ctrl)
+	watchers.EXPECT().
+		Scan(gomock.Any(), "minio").
+		Return(nil, errors.New("bucket not found"))
+
+	recorder := newExecRecorderDB(t)
+	svc := NewService(testLogger(), recorder.db, nil, "", nil, nil, watchers, nil)
+
+	_, err := svc.Rescan(context.Background(), "minio")
+
+	assert.Error(t, err, "bucket not found")
+}
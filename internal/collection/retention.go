@@ -0,0 +1,36 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	temporalapi_serviceerror "go.temporal.io/api/serviceerror"
+)
+
+// handOffRetention signals the processing workflows that are still running so
+// the ones waiting on their own retention timer hand the deletion of the
+// original over to the retention scheduler. Workflows in other stages ignore
+// the signal. It returns the number of workflows signaled.
+func (svc *collectionImpl) handOffRetention(ctx context.Context) (uint, error) {
+	query := `SELECT workflow_id, run_id, status FROM collection WHERE status = (?) ORDER BY id ASC`
+	executions := []collectionStatusState{}
+	if err := svc.db.SelectContext(ctx, &executions, svc.db.Rebind(query), StatusInProgress); err != nil {
+		return 0, fmt.Errorf("error querying the database: %w", err)
+	}
+
+	var signaled uint
+	for _, execution := range executions {
+		err := svc.cc.SignalWorkflow(ctx, execution.WorkflowID, execution.RunID, ProcessingWorkflowRetentionHandoffSignalName, nil)
+		if err != nil {
+			var notFound *temporalapi_serviceerror.NotFound
+			if errors.As(err, &notFound) {
+				continue
+			}
+			return signaled, fmt.Errorf("error signaling workflow %s: %w", execution.WorkflowID, err)
+		}
+		signaled++
+	}
+
+	return signaled, nil
+}
@@ -6,7 +6,10 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+
+	"github.com/artefactual-labs/enduro/internal/notification"
 )
 
 const (
@@ -15,6 +18,98 @@ const (
 	StatusHistoryUnavailable = "unavailable"
 )
 
+// Reasons recorded with the status transitions.
+const (
+	ReasonCollectionCreated        = "collection_created"
+	ReasonWorkflowQueued           = "workflow_queued"
+	ReasonPipelineAcquired         = "pipeline_acquired"
+	ReasonProcessingResumed        = "processing_resumed"
+	ReasonOperatorDecisionRequired = "operator_decision_required"
+	ReasonOperatorDecisionReceived = "operator_decision_received"
+	ReasonWorkflowCompleted        = "workflow_completed"
+	ReasonWorkflowFailed           = "workflow_failed"
+	ReasonWorkflowAbandoned        = "workflow_abandoned"
+	ReasonWorkflowRetried          = "workflow_retried"
+	ReasonWorkflowCanceled         = "workflow_canceled"
+	ReasonDuplicateRejected        = "duplicate_rejected"
+	ReasonDeadlineExceeded         = "deadline_exceeded"
+	ReasonWorkerLost               = "worker_lost"
+	ReasonLegalHoldSet             = "legal_hold_set"
+	ReasonLegalHoldCleared         = "legal_hold_cleared"
+	ReasonStatusChanged            = "status_changed"
+)
+
+// ActorSystem is the actor of the transitions that Enduro makes on its own,
+// e.g. when processing completes.
+const ActorSystem = "system"
+
+// APIActor returns the actor of the transitions requested by an API user.
+func APIActor(user string) string {
+	if user == "" {
+		return "api"
+	}
+
+	return "api:" + user
+}
+
+// BulkActor returns the actor of the transitions requested by a bulk run.
+func BulkActor(bulkRunID uint) string {
+	if bulkRunID == 0 {
+		return "bulk"
+	}
+
+	return fmt.Sprintf("bulk:%d", bulkRunID)
+}
+
+// WatcherActor returns the actor of the collections created by a watcher.
+func WatcherActor(name string) string {
+	return "watcher:" + name
+}
+
+// StatusTransitionCause attributes the status transitions made with a context.
+type StatusTransitionCause struct {
+	// Reason replaces the reason inferred from the statuses when not empty.
+	Reason string
+
+	// Actor that caused the transition, ActorSystem when empty.
+	Actor string
+
+	// Free-form detail recorded with the transition.
+	Detail string
+}
+
+type statusTransitionCauseKey struct{}
+
+// WithStatusTransitionCause returns a copy of ctx that attributes the status
+// transitions made with it to cause.
+func WithStatusTransitionCause(ctx context.Context, cause StatusTransitionCause) context.Context {
+	return context.WithValue(ctx, statusTransitionCauseKey{}, cause)
+}
+
+// WithActor returns a copy of ctx that attributes the status transitions and
+// the workflows started with it to actor.
+func WithActor(ctx context.Context, actor string) context.Context {
+	cause := statusTransitionCauseFromContext(ctx)
+	cause.Actor = actor
+
+	return WithStatusTransitionCause(ctx, cause)
+}
+
+// ActorFromContext returns the actor set with WithActor, or the anonymous API
+// actor when none was set.
+func ActorFromContext(ctx context.Context) string {
+	if actor := statusTransitionCauseFromContext(ctx).Actor; actor != "" {
+		return actor
+	}
+
+	return APIActor("")
+}
+
+func statusTransitionCauseFromContext(ctx context.Context) StatusTransitionCause {
+	cause, _ := ctx.Value(statusTransitionCauseKey{}).(StatusTransitionCause)
+	return cause
+}
+
 type StatusTransition struct {
 	ID             uint64         `db:"id"`
 	CollectionID   uint           `db:"collection_id"`
@@ -25,6 +120,8 @@ type StatusTransition struct {
 	OccurredAt     time.Time      `db:"occurred_at"`
 	IsRunStart     bool           `db:"is_run_start"`
 	Reason         sql.NullString `db:"reason"`
+	Actor          sql.NullString `db:"actor"`
+	Detail         sql.NullString `db:"detail"`
 }
 
 type collectionStatusState struct {
@@ -61,9 +158,19 @@ func (svc *collectionImpl) updateWithStatusTransition(
 		return err
 	}
 
+	cause := statusTransitionCauseFromContext(ctx)
 	runChanged := previous.WorkflowID != next.WorkflowID || previous.RunID != next.RunID
-	if previous.Status != next.Status || runChanged {
-		if err := insertStatusTransition(ctx, tx, ID, &previous.Status, next, runChanged, transitionReason(previous.Status, next.Status, runChanged)); err != nil {
+	transitioned := previous.Status != next.Status || runChanged
+	reason := cause.Reason
+	if reason == "" {
+		reason = transitionReason(previous.Status, next.Status, runChanged)
+	}
+	actor := cause.Actor
+	if actor == "" {
+		actor = ActorSystem
+	}
+	if transitioned {
+		if err := insertStatusTransition(ctx, tx, ID, &previous.Status, next, runChanged, reason, actor, cause.Detail); err != nil {
 			return err
 		}
 	}
@@ -72,9 +179,46 @@ func (svc *collectionImpl) updateWithStatusTransition(
 		return fmt.Errorf("error committing collection update: %w", err)
 	}
 
+	if transitioned {
+		svc.notify(ctx, ID, &previous.Status, next, reason)
+	}
+
 	return nil
 }
 
+// notify sends the status transition to the notification service. Failures
+// are logged, the transition is already committed.
+func (svc *collectionImpl) notify(ctx context.Context, ID uint, previous *Status, next collectionStatusState, reason string) {
+	if svc.notifications == nil {
+		return
+	}
+
+	eventType, ok := notification.EventForStatus(next.Status.String())
+	if !ok {
+		return
+	}
+
+	event := notification.Event{
+		ID:         uuid.New().String(),
+		Type:       eventType,
+		OccurredAt: time.Now().UTC(),
+		Collection: notification.EventCollection{
+			ID:         ID,
+			WorkflowID: next.WorkflowID,
+			RunID:      next.RunID,
+			Status:     next.Status.String(),
+			Reason:     reason,
+		},
+	}
+	if previous != nil {
+		event.Collection.PreviousStatus = previous.String()
+	}
+
+	if err := svc.notifications.Notify(ctx, event); err != nil {
+		svc.logger.Error(err, "Error scheduling notifications.", "id", ID, "event", eventType)
+	}
+}
+
 func insertStatusTransition(
 	ctx context.Context,
 	tx *sqlx.Tx,
@@ -83,8 +227,10 @@ func insertStatusTransition(
 	next collectionStatusState,
 	isRunStart bool,
 	reason string,
+	actor string,
+	detail string,
 ) error {
-	query := `INSERT INTO collection_status_transition (collection_id, workflow_id, run_id, previous_status, status, is_run_start, reason) VALUES ((?), (?), (?), (?), (?), (?), (?))`
+	query := `INSERT INTO collection_status_transition (collection_id, workflow_id, run_id, previous_status, status, is_run_start, reason, actor, detail) VALUES ((?), (?), (?), (?), (?), (?), (?), (?), (?))`
 	args := []any{
 		collectionID,
 		next.WorkflowID,
@@ -93,6 +239,8 @@ func insertStatusTransition(
 		next.Status,
 		isRunStart,
 		reason,
+		sql.NullString{String: actor, Valid: actor != ""},
+		sql.NullString{String: detail, Valid: detail != ""},
 	}
 	if _, err := tx.ExecContext(ctx, tx.Rebind(query), args...); err != nil {
 		return fmt.Errorf("error inserting collection status transition: %w", err)
@@ -103,35 +251,35 @@ func insertStatusTransition(
 
 func transitionReason(previous, next Status, runStart bool) string {
 	if runStart {
-		return "workflow_retried"
+		return ReasonWorkflowRetried
 	}
 
 	switch next {
 	case StatusQueued:
-		return "workflow_queued"
+		return ReasonWorkflowQueued
 	case StatusInProgress:
 		if previous == StatusQueued {
-			return "pipeline_acquired"
+			return ReasonPipelineAcquired
 		}
 		if previous == StatusPending {
-			return "operator_decision_received"
+			return ReasonOperatorDecisionReceived
 		}
-		return "processing_resumed"
+		return ReasonProcessingResumed
 	case StatusPending:
-		return "operator_decision_required"
+		return ReasonOperatorDecisionRequired
 	case StatusDone:
-		return "workflow_completed"
+		return ReasonWorkflowCompleted
 	case StatusError:
-		return "workflow_failed"
+		return ReasonWorkflowFailed
 	case StatusAbandoned:
-		return "workflow_abandoned"
+		return ReasonWorkflowAbandoned
 	default:
-		return "status_changed"
+		return ReasonStatusChanged
 	}
 }
 
 func (svc *collectionImpl) readStatusTransitions(ctx context.Context, collectionID uint) ([]StatusTransition, error) {
-	query := `SELECT id, collection_id, workflow_id, run_id, previous_status, status, CONVERT_TZ(occurred_at, @@session.time_zone, '+00:00') AS occurred_at, is_run_start, reason FROM collection_status_transition WHERE collection_id = (?) ORDER BY occurred_at ASC, id ASC`
+	query := `SELECT id, collection_id, workflow_id, run_id, previous_status, status, CONVERT_TZ(occurred_at, @@session.time_zone, '+00:00') AS occurred_at, is_run_start, reason, actor, detail FROM collection_status_transition WHERE collection_id = (?) ORDER BY occurred_at ASC, id ASC`
 	transitions := []StatusTransition{}
 	if err := svc.db.SelectContext(ctx, &transitions, svc.db.Rebind(query), collectionID); err != nil {
 		return nil, fmt.Errorf("error reading collection status transitions: %w", err)
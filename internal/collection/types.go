@@ -2,6 +2,7 @@ package collection
 
 import (
 	"database/sql"
+	"strings"
 	"time"
 
 	goacollection "github.com/artefactual-labs/enduro/internal/api/gen/collection"
@@ -9,15 +10,17 @@ import (
 
 // Collection represents a collection in the collection table.
 type Collection struct {
-	ID         uint   `db:"id"`
-	Name       string `db:"name"`
-	WorkflowID string `db:"workflow_id"`
-	RunID      string `db:"run_id"`
-	TransferID string `db:"transfer_id"`
-	AIPID      string `db:"aip_id"`
-	OriginalID string `db:"original_id"`
-	PipelineID string `db:"pipeline_id"`
-	Status     Status `db:"status"`
+	ID   uint   `db:"id"`
+	Name string `db:"name"`
+	// Name of the watcher that received the blob, empty otherwise.
+	WatcherName string `db:"watcher_name"`
+	WorkflowID  string `db:"workflow_id"`
+	RunID       string `db:"run_id"`
+	TransferID  string `db:"transfer_id"`
+	AIPID       string `db:"aip_id"`
+	OriginalID  string `db:"original_id"`
+	PipelineID  string `db:"pipeline_id"`
+	Status      Status `db:"status"`
 
 	// It defaults to CURRENT_TIMESTAMP(6) so populated as soon as possible.
 	CreatedAt time.Time `db:"created_at"`
@@ -39,6 +42,42 @@ type Collection struct {
 
 	// Nullable, populated when reconciliation cannot complete cleanly.
 	ReconciliationError sql.NullString `db:"reconciliation_error"`
+
+	// Held collections cannot be deleted, neither their originals.
+	LegalHold bool `db:"legal_hold"`
+
+	// Nullable, populated while the collection is under legal hold.
+	LegalHoldReason sql.NullString `db:"legal_hold_reason"`
+	LegalHoldActor  sql.NullString `db:"legal_hold_actor"`
+	LegalHoldAt     sql.NullTime   `db:"legal_hold_at"`
+
+	// Nullable, populated when the workflow asks for an operator decision.
+	// Only meaningful while the collection is pending.
+	DecisionActivity sql.NullString `db:"decision_activity"`
+	DecisionError    sql.NullString `db:"decision_error"`
+	DecisionOptions  sql.NullString `db:"decision_options"`
+
+	// Nullable, populated when the pipeline is chosen by the scheduler.
+	PipelineSelection sql.NullString `db:"pipeline_selection"`
+}
+
+// PendingDecision returns the failure awaiting an operator decision, if any.
+func (c Collection) PendingDecision() (PendingDecision, bool) {
+	if c.Status != StatusPending || !c.DecisionActivity.Valid {
+		return PendingDecision{}, false
+	}
+
+	decision := PendingDecision{
+		Activity: c.DecisionActivity.String,
+		Error:    c.DecisionError.String,
+	}
+	for option := range strings.SplitSeq(c.DecisionOptions.String, ",") {
+		if option != "" {
+			decision.Options = append(decision.Options, ProcessingWorkflowDecision(option))
+		}
+	}
+
+	return decision, true
 }
 
 // GoaSummary returns the API representation used by collection lists.
@@ -80,6 +119,21 @@ func (c Collection) GoaDetail() *goacollection.EnduroDetailedStoredCollection {
 		ReconciliationStatus:    formatOptionalNullString(c.ReconciliationStatus),
 		ReconciliationCheckedAt: formatOptionalTime(c.ReconciliationCheckedAt),
 		ReconciliationError:     formatOptionalNullString(c.ReconciliationError),
+		LegalHold:               c.LegalHold,
+		LegalHoldReason:         formatOptionalNullString(c.LegalHoldReason),
+		LegalHoldActor:          formatOptionalNullString(c.LegalHoldActor),
+		LegalHoldAt:             formatOptionalTime(c.LegalHoldAt),
+		PipelineSelection:       formatOptionalNullString(c.PipelineSelection),
+	}
+	if decision, ok := c.PendingDecision(); ok {
+		col.Decision = &goacollection.EnduroCollectionPendingDecision{
+			Activity: decision.Activity,
+			Error:    decision.Error,
+			Options:  make([]string, 0, len(decision.Options)),
+		}
+		for _, option := range decision.Options {
+			col.Decision.Options = append(col.Decision.Options, string(option))
+		}
 	}
 
 	return &col
@@ -0,0 +1,88 @@
+package collection
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	goacollection "github.com/artefactual-labs/enduro/internal/api/gen/collection"
+	"github.com/artefactual-labs/enduro/internal/validation"
+)
+
+// ValidationResult is the persisted outcome of a transfer validator.
+type ValidationResult struct {
+	ID           uint64         `db:"id"`
+	CollectionID uint           `db:"collection_id"`
+	Validator    string         `db:"validator"`
+	Severity     string         `db:"severity"`
+	Status       string         `db:"status"`
+	Message      sql.NullString `db:"message"`
+	CreatedAt    time.Time      `db:"created_at"`
+}
+
+// SetValidationResults replaces the validation results recorded for the
+// collection with the results of the latest validator chain run.
+func (svc *collectionImpl) SetValidationResults(ctx context.Context, ID uint, results []validation.Result) error {
+	tx, err := svc.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning validation results update: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	query := `DELETE FROM collection_validation_result WHERE collection_id = (?)`
+	if _, err := tx.ExecContext(ctx, tx.Rebind(query), ID); err != nil {
+		return fmt.Errorf("error deleting validation results: %w", err)
+	}
+
+	query = `INSERT INTO collection_validation_result (collection_id, validator, severity, status, message) VALUES ((?), (?), (?), (?), (?))`
+	for _, result := range results {
+		args := []any{
+			ID,
+			result.Validator,
+			string(result.Severity),
+			string(result.Status),
+			sql.NullString{String: result.Message, Valid: result.Message != ""},
+		}
+		if _, err := tx.ExecContext(ctx, tx.Rebind(query), args...); err != nil {
+			return fmt.Errorf("error inserting validation result: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing validation results update: %w", err)
+	}
+
+	publishEvent(ctx, svc.events, EventTypeCollectionUpdated, ID)
+
+	return nil
+}
+
+func (svc *collectionImpl) readValidationResults(ctx context.Context, collectionID uint) ([]ValidationResult, error) {
+	query := `SELECT id, collection_id, validator, severity, status, message, CONVERT_TZ(created_at, @@session.time_zone, '+00:00') AS created_at FROM collection_validation_result WHERE collection_id = (?) ORDER BY id ASC`
+	results := []ValidationResult{}
+	if err := svc.db.SelectContext(ctx, &results, svc.db.Rebind(query), collectionID); err != nil {
+		return nil, fmt.Errorf("error reading collection validation results: %w", err)
+	}
+
+	return results, nil
+}
+
+func goaValidationResults(results []ValidationResult) goacollection.EnduroCollectionValidationResultCollection {
+	if len(results) == 0 {
+		return nil
+	}
+
+	items := make(goacollection.EnduroCollectionValidationResultCollection, 0, len(results))
+	for _, result := range results {
+		items = append(items, &goacollection.EnduroCollectionValidationResult{
+			Validator: result.Validator,
+			Severity:  result.Severity,
+			Status:    result.Status,
+			Message:   formatOptionalNullString(result.Message),
+			CreatedAt: formatTime(result.CreatedAt),
+		})
+	}
+
+	return items
+}
@@ -3,6 +3,7 @@ package collection
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -23,6 +24,21 @@ const ProcessingWorkflowName = "processing-workflow"
 // submit an operator decision to a running processing workflow.
 const ProcessingWorkflowDecisionUpdateName = "processing-workflow-decision"
 
+// ProcessingWorkflowCancelSignalName identifies the signal that tells a
+// processing workflow who requested its cancellation. It is sent before the
+// workflow is canceled.
+const ProcessingWorkflowCancelSignalName = "processing-workflow-cancel"
+
+// ProcessingWorkflowCancelSignal is the payload of the cancel signal.
+type ProcessingWorkflowCancelSignal struct {
+	Actor string
+}
+
+// ProcessingWorkflowRetentionHandoffSignalName identifies the signal that
+// hands the deletion of the original over to the retention scheduler in
+// processing workflows still waiting on their own retention timer.
+const ProcessingWorkflowRetentionHandoffSignalName = "processing-workflow-retention-handoff"
+
 // ProcessingWorkflowDecision is an operator's response to a failed processing
 // activity.
 type ProcessingWorkflowDecision string
@@ -31,6 +47,10 @@ const (
 	ProcessingWorkflowDecisionRetry     ProcessingWorkflowDecision = "RETRY"
 	ProcessingWorkflowDecisionRetryOnce ProcessingWorkflowDecision = "RETRY_ONCE"
 	ProcessingWorkflowDecisionAbandon   ProcessingWorkflowDecision = "ABANDON"
+
+	// ProcessingWorkflowDecisionSkip continues the workflow without the failed
+	// activity.
+	ProcessingWorkflowDecisionSkip ProcessingWorkflowDecision = "SKIP"
 )
 
 // ParseProcessingWorkflowDecision validates and converts a decision option.
@@ -39,13 +59,32 @@ func ParseProcessingWorkflowDecision(option string) (ProcessingWorkflowDecision,
 	switch decision {
 	case ProcessingWorkflowDecisionRetry,
 		ProcessingWorkflowDecisionRetryOnce,
-		ProcessingWorkflowDecisionAbandon:
+		ProcessingWorkflowDecisionAbandon,
+		ProcessingWorkflowDecisionSkip:
 		return decision, nil
 	default:
 		return "", fmt.Errorf("unknown decision option %q", option)
 	}
 }
 
+// PendingDecision describes the failure that a processing workflow is waiting
+// on an operator to decide about.
+type PendingDecision struct {
+	// Name of the failed activity.
+	Activity string
+
+	// Error returned by the failed activity.
+	Error string
+
+	// Options accepted by the workflow.
+	Options []ProcessingWorkflowDecision
+}
+
+// Allows reports whether the decision is one of the accepted options.
+func (d PendingDecision) Allows(decision ProcessingWorkflowDecision) bool {
+	return slices.Contains(d.Options, decision)
+}
+
 type RetryMode string
 
 const (
@@ -73,8 +112,21 @@ type ProcessingWorkflowRequest struct {
 	// Name of the watcher that received this blob.
 	WatcherName string
 
+	// Actor that started the workflow, e.g. the API user that retried the
+	// collection. It is recorded with the status transitions of the workflow.
+	Actor string
+
 	PipelineName string
 
+	// Candidate pipelines when PipelineName is empty. The pipeline is chosen
+	// by the scheduler among them, or among all the pipelines when the list is
+	// also empty.
+	PipelineNames []string
+
+	// Whether the routing rules can replace PipelineName. It is set for the
+	// transfers where the pipeline has not been chosen explicitly.
+	Route bool
+
 	// Period of time to schedule the deletion of the original blob from the
 	// watched data source. nil means no deletion.
 	RetentionPeriod *time.Duration
@@ -0,0 +1,13 @@
+CREATE TABLE `collection_validation_result` (
+  `id` BIGINT UNSIGNED AUTO_INCREMENT NOT NULL,
+  `collection_id` INT UNSIGNED NOT NULL,
+  `validator` VARCHAR(64) NOT NULL,
+  `severity` VARCHAR(16) NOT NULL,
+  `status` VARCHAR(16) NOT NULL,
+  `message` TEXT NULL,
+  `created_at` TIMESTAMP(6) DEFAULT CURRENT_TIMESTAMP(6) NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `collection_validation_result_collection_idx` (`collection_id`, `id`),
+  CONSTRAINT `collection_validation_result_collection_fk`
+    FOREIGN KEY (`collection_id`) REFERENCES `collection` (`id`) ON DELETE CASCADE
+);
@@ -0,0 +1,17 @@
+CREATE TABLE `notification_delivery` (
+  `id` BIGINT UNSIGNED AUTO_INCREMENT NOT NULL,
+  `collection_id` INT UNSIGNED NOT NULL,
+  `webhook` VARCHAR(255) NOT NULL,
+  `event` VARCHAR(32) NOT NULL,
+  `status` VARCHAR(16) NOT NULL,
+  `attempts` INT UNSIGNED DEFAULT 0 NOT NULL,
+  `response_code` INT NULL,
+  `error` TEXT NULL,
+  `created_at` TIMESTAMP(6) DEFAULT CURRENT_TIMESTAMP(6) NOT NULL,
+  `updated_at` TIMESTAMP(6) DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6) NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `notification_delivery_collection_idx` (`collection_id`, `id`),
+  INDEX `notification_delivery_status_idx` (`status`, `id`),
+  CONSTRAINT `notification_delivery_collection_fk`
+    FOREIGN KEY (`collection_id`) REFERENCES `collection` (`id`) ON DELETE CASCADE
+);
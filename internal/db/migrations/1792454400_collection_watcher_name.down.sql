@@ -0,0 +1,3 @@
+ALTER TABLE `collection`
+  DROP INDEX `collection_watcher_name_idx`,
+  DROP COLUMN `watcher_name`;
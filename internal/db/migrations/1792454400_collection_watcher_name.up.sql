@@ -0,0 +1,3 @@
+ALTER TABLE `collection`
+  ADD COLUMN `watcher_name` VARCHAR(255) DEFAULT '' NOT NULL AFTER `name`,
+  ADD INDEX `collection_watcher_name_idx` (`watcher_name`, `name`(50));
@@ -0,0 +1,18 @@
+CREATE TABLE `retention_deletion` (
+  `id` BIGINT UNSIGNED AUTO_INCREMENT NOT NULL,
+  `collection_id` INT UNSIGNED NOT NULL,
+  `watcher_name` VARCHAR(255) NOT NULL,
+  `batch_dir` VARCHAR(2048) DEFAULT '' NOT NULL,
+  `object_key` VARCHAR(2048) NOT NULL,
+  `status` VARCHAR(16) NOT NULL,
+  `due_at` TIMESTAMP(6) NOT NULL,
+  `attempts` INT UNSIGNED DEFAULT 0 NOT NULL,
+  `error` TEXT NULL,
+  `created_at` TIMESTAMP(6) DEFAULT CURRENT_TIMESTAMP(6) NOT NULL,
+  `updated_at` TIMESTAMP(6) DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6) NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `retention_deletion_collection_idx` (`collection_id`),
+  INDEX `retention_deletion_due_idx` (`status`, `due_at`),
+  CONSTRAINT `retention_deletion_collection_fk`
+    FOREIGN KEY (`collection_id`) REFERENCES `collection` (`id`) ON DELETE CASCADE
+);
@@ -0,0 +1,9 @@
+ALTER TABLE `collection_status_transition`
+  DROP COLUMN `detail`,
+  DROP COLUMN `actor`;
+
+ALTER TABLE `collection`
+  DROP COLUMN `legal_hold_at`,
+  DROP COLUMN `legal_hold_actor`,
+  DROP COLUMN `legal_hold_reason`,
+  DROP COLUMN `legal_hold`;
@@ -0,0 +1,9 @@
+ALTER TABLE `collection`
+  ADD COLUMN `legal_hold` BOOLEAN DEFAULT FALSE NOT NULL AFTER `reconciliation_error`,
+  ADD COLUMN `legal_hold_reason` TEXT NULL AFTER `legal_hold`,
+  ADD COLUMN `legal_hold_actor` VARCHAR(255) NULL AFTER `legal_hold_reason`,
+  ADD COLUMN `legal_hold_at` TIMESTAMP(6) NULL AFTER `legal_hold_actor`;
+
+ALTER TABLE `collection_status_transition`
+  ADD COLUMN `actor` VARCHAR(255) NULL AFTER `reason`,
+  ADD COLUMN `detail` TEXT NULL AFTER `actor`;
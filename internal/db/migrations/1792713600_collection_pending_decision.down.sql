@@ -0,0 +1,4 @@
+ALTER TABLE `collection`
+  DROP COLUMN `decision_options`,
+  DROP COLUMN `decision_error`,
+  DROP COLUMN `decision_activity`;
@@ -0,0 +1,4 @@
+ALTER TABLE `collection`
+  ADD COLUMN `decision_activity` VARCHAR(255) NULL AFTER `legal_hold_at`,
+  ADD COLUMN `decision_error` TEXT NULL AFTER `decision_activity`,
+  ADD COLUMN `decision_options` VARCHAR(255) NULL AFTER `decision_error`;
@@ -0,0 +1,2 @@
+DROP TABLE `bulk_run_result`;
+DROP TABLE `bulk_run`;
@@ -0,0 +1,27 @@
+CREATE TABLE `bulk_run` (
+  `id` INT UNSIGNED AUTO_INCREMENT NOT NULL,
+  `workflow_id` VARCHAR(255) NOT NULL,
+  `run_id` VARCHAR(36) NOT NULL DEFAULT '',
+  `operation` VARCHAR(32) NOT NULL,
+  `collection_status` TINYINT NOT NULL,
+  `decision` VARCHAR(32) NULL,
+  `state` VARCHAR(16) NOT NULL,
+  `error` TEXT NULL,
+  `created_at` TIMESTAMP(6) DEFAULT CURRENT_TIMESTAMP(6) NOT NULL,
+  `completed_at` TIMESTAMP(6) NULL,
+  PRIMARY KEY (`id`),
+  INDEX `bulk_run_state_idx` (`state`, `id`)
+);
+
+CREATE TABLE `bulk_run_result` (
+  `id` BIGINT UNSIGNED AUTO_INCREMENT NOT NULL,
+  `bulk_run_id` INT UNSIGNED NOT NULL,
+  `collection_id` INT UNSIGNED NOT NULL,
+  `outcome` VARCHAR(16) NOT NULL,
+  `error` TEXT NULL,
+  `created_at` TIMESTAMP(6) DEFAULT CURRENT_TIMESTAMP(6) NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `bulk_run_result_run_idx` (`bulk_run_id`, `id`),
+  CONSTRAINT `bulk_run_result_run_fk`
+    FOREIGN KEY (`bulk_run_id`) REFERENCES `bulk_run` (`id`) ON DELETE CASCADE
+);
@@ -0,0 +1,2 @@
+ALTER TABLE `collection`
+  DROP COLUMN `pipeline_selection`;
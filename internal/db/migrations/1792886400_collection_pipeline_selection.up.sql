@@ -0,0 +1,2 @@
+ALTER TABLE `collection`
+  ADD COLUMN `pipeline_selection` TEXT NULL AFTER `decision_options`;
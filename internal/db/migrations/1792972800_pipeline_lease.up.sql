@@ -0,0 +1,11 @@
+CREATE TABLE `pipeline_lease` (
+  `id` BIGINT UNSIGNED AUTO_INCREMENT NOT NULL,
+  `pipeline` VARCHAR(255) NOT NULL,
+  `holder` VARCHAR(255) NOT NULL,
+  `worker` VARCHAR(255) NOT NULL,
+  `acquired_at` TIMESTAMP(6) NOT NULL,
+  `expires_at` TIMESTAMP(6) NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `pipeline_lease_holder_idx` (`pipeline`, `holder`),
+  INDEX `pipeline_lease_expires_idx` (`pipeline`, `expires_at`)
+);
@@ -0,0 +1,6 @@
+CREATE TABLE `pipeline_state` (
+  `pipeline` VARCHAR(255) NOT NULL,
+  `state` VARCHAR(16) NOT NULL,
+  `updated_at` TIMESTAMP(6) DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6) NOT NULL,
+  PRIMARY KEY (`pipeline`)
+);
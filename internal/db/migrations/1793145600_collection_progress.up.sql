@@ -0,0 +1,13 @@
+CREATE TABLE `collection_progress` (
+  `collection_id` INT UNSIGNED NOT NULL,
+  `stage` VARCHAR(16) NOT NULL,
+  `microservice` VARCHAR(255) NULL,
+  `job` VARCHAR(255) NULL,
+  `jobs_completed` INT UNSIGNED NOT NULL,
+  `jobs_total` INT UNSIGNED NOT NULL,
+  `failed_jobs` TEXT NULL,
+  `updated_at` TIMESTAMP(6) DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6) NOT NULL,
+  PRIMARY KEY (`collection_id`),
+  CONSTRAINT `collection_progress_collection_fk`
+    FOREIGN KEY (`collection_id`) REFERENCES `collection` (`id`) ON DELETE CASCADE
+);
@@ -0,0 +1 @@
+DROP TABLE `collection_failure`;
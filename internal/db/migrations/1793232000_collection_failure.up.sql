@@ -0,0 +1,15 @@
+CREATE TABLE `collection_failure` (
+  `collection_id` INT UNSIGNED NOT NULL,
+  `category` VARCHAR(32) NOT NULL,
+  `stage` VARCHAR(16) NOT NULL,
+  `unit_id` VARCHAR(36) NOT NULL,
+  `microservice` VARCHAR(255) NULL,
+  `job` VARCHAR(255) NULL,
+  `stderr` TEXT NULL,
+  `errors` TEXT NOT NULL,
+  `occurred_at` TIMESTAMP(6) DEFAULT CURRENT_TIMESTAMP(6) NOT NULL,
+  PRIMARY KEY (`collection_id`),
+  KEY `collection_failure_category_idx` (`category`),
+  CONSTRAINT `collection_failure_collection_fk`
+    FOREIGN KEY (`collection_id`) REFERENCES `collection` (`id`) ON DELETE CASCADE
+);
@@ -0,0 +1 @@
+DROP TABLE `watcher_dispatch`;
@@ -0,0 +1,8 @@
+CREATE TABLE `watcher_dispatch` (
+  `watcher_name` VARCHAR(255) NOT NULL,
+  `name` VARCHAR(2048) NOT NULL,
+  `name_hash` BINARY(32) NOT NULL,
+  `dispatched_at` TIMESTAMP(6) DEFAULT CURRENT_TIMESTAMP(6) NOT NULL,
+  PRIMARY KEY (`watcher_name`, `name_hash`),
+  KEY `watcher_dispatch_name_idx` (`watcher_name`, `name`(50))
+);
@@ -0,0 +1,106 @@
+package decision
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+const (
+	// OnTimeoutAbandon abandons the processing workflow.
+	OnTimeoutAbandon = "abandon"
+
+	// OnTimeoutError fails the processing workflow.
+	OnTimeoutError = "error"
+
+	// OnTimeoutRetryOnce retries the failed activity once. The workflow is
+	// abandoned if the retry fails and the following decision times out too.
+	OnTimeoutRetryOnce = "retry_once"
+)
+
+// DefaultTimeout is the time the workflows wait for an operator decision when
+// no timeout is configured.
+const DefaultTimeout = 7 * 24 * time.Hour
+
+// Config describes how the processing workflows wait for operator decisions.
+// Unset attributes take the value of a fallback configuration, see Merge.
+type Config struct {
+	// Timeout is the maximum time to wait for a decision. Defaults to seven
+	// days.
+	Timeout time.Duration
+
+	// Reminders lists how long a decision can be pending before operators are
+	// reminded about it, e.g. ["1h", "24h"].
+	Reminders []time.Duration
+
+	// OnTimeout is the action taken when the timeout is reached: "abandon"
+	// (default), "error" or "retry_once".
+	OnTimeout string
+}
+
+func (c Config) Validate() error {
+	if c.Timeout < 0 {
+		return errors.New("timeout must not be negative")
+	}
+	for _, reminder := range c.Reminders {
+		if reminder <= 0 {
+			return fmt.Errorf("invalid reminder %q: must be positive", reminder)
+		}
+	}
+
+	switch c.OnTimeout {
+	case "", OnTimeoutAbandon, OnTimeoutError, OnTimeoutRetryOnce:
+	default:
+		return fmt.Errorf("unknown onTimeout action %q", c.OnTimeout)
+	}
+
+	return nil
+}
+
+// Merge returns the configuration with its unset attributes taken from
+// fallback.
+func (c Config) Merge(fallback Config) Config {
+	if c.Timeout == 0 {
+		c.Timeout = fallback.Timeout
+	}
+	if len(c.Reminders) == 0 {
+		c.Reminders = fallback.Reminders
+	}
+	if c.OnTimeout == "" {
+		c.OnTimeout = fallback.OnTimeout
+	}
+
+	return c
+}
+
+// WaitTimeout returns the maximum time to wait for a decision.
+func (c Config) WaitTimeout() time.Duration {
+	if c.Timeout == 0 {
+		return DefaultTimeout
+	}
+	return c.Timeout
+}
+
+// TimeoutAction returns the action taken when the timeout is reached.
+func (c Config) TimeoutAction() string {
+	if c.OnTimeout == "" {
+		return OnTimeoutAbandon
+	}
+	return c.OnTimeout
+}
+
+// ReminderSchedule returns the reminders sent before the timeout is reached,
+// sorted and without duplicates.
+func (c Config) ReminderSchedule() []time.Duration {
+	timeout := c.WaitTimeout()
+	schedule := make([]time.Duration, 0, len(c.Reminders))
+	for _, reminder := range c.Reminders {
+		if reminder > 0 && reminder < timeout {
+			schedule = append(schedule, reminder)
+		}
+	}
+	slices.Sort(schedule)
+
+	return slices.Compact(schedule)
+}
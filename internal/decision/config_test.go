@@ -0,0 +1,92 @@
+package decision_test
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/enduro/internal/decision"
+)
+
+func TestConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		config  decision.Config
+		wantErr string
+	}{
+		"Accepts an empty configuration": {},
+		"Accepts a valid configuration": {
+			config: decision.Config{
+				Timeout:   48 * time.Hour,
+				Reminders: []time.Duration{time.Hour, 24 * time.Hour},
+				OnTimeout: decision.OnTimeoutRetryOnce,
+			},
+		},
+		"Rejects negative timeouts": {
+			config:  decision.Config{Timeout: -time.Hour},
+			wantErr: "timeout must not be negative",
+		},
+		"Rejects non-positive reminders": {
+			config:  decision.Config{Reminders: []time.Duration{time.Hour, 0}},
+			wantErr: `invalid reminder "0s": must be positive`,
+		},
+		"Rejects unknown actions": {
+			config:  decision.Config{OnTimeout: "ignore"},
+			wantErr: `unknown onTimeout action "ignore"`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.config.Validate()
+
+			if tc.wantErr != "" {
+				assert.Error(t, err, tc.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+		})
+	}
+}
+
+func TestConfigMerge(t *testing.T) {
+	t.Parallel()
+
+	global := decision.Config{
+		Timeout:   72 * time.Hour,
+		Reminders: []time.Duration{24 * time.Hour},
+		OnTimeout: decision.OnTimeoutError,
+	}
+
+	config := decision.Config{Timeout: time.Hour}.Merge(global)
+
+	assert.DeepEqual(t, config, decision.Config{
+		Timeout:   time.Hour,
+		Reminders: []time.Duration{24 * time.Hour},
+		OnTimeout: decision.OnTimeoutError,
+	})
+}
+
+func TestConfigDefaults(t *testing.T) {
+	t.Parallel()
+
+	config := decision.Config{}
+
+	assert.Equal(t, config.WaitTimeout(), decision.DefaultTimeout)
+	assert.Equal(t, config.TimeoutAction(), decision.OnTimeoutAbandon)
+	assert.Equal(t, len(config.ReminderSchedule()), 0)
+}
+
+func TestConfigReminderSchedule(t *testing.T) {
+	t.Parallel()
+
+	config := decision.Config{
+		Timeout:   48 * time.Hour,
+		Reminders: []time.Duration{24 * time.Hour, time.Hour, 72 * time.Hour, time.Hour},
+	}
+
+	assert.DeepEqual(t, config.ReminderSchedule(), []time.Duration{time.Hour, 24 * time.Hour})
+}
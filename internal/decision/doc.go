@@ -0,0 +1,4 @@
+// Package decision configures how long processing workflows wait for operator
+// decisions, when operators are reminded about them and what happens when
+// nobody decides in time.
+package decision
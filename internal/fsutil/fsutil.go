@@ -2,7 +2,9 @@ package fsutil
 
 import (
 	"errors"
+	"io/fs"
 	"os"
+	"path/filepath"
 
 	"github.com/otiai10/copy"
 )
@@ -38,3 +40,28 @@ func Move(src, dst string) error {
 
 	return err
 }
+
+// Size returns the size of a file in bytes. The size of a directory is the
+// total size of the regular files that it contains.
+func Size(path string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(path, func(_ string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
@@ -110,3 +110,36 @@ func TestMove(t *testing.T) {
 		assert.Assert(t, fs.Equal(dst, srcManifest))
 	})
 }
+
+func TestSize(t *testing.T) {
+	t.Parallel()
+
+	t.Run("It returns the size of a file", func(t *testing.T) {
+		t.Parallel()
+
+		tmpDir := fs.NewDir(t, "enduro", fs.WithFile("foobar.txt", "foobar"))
+
+		size, err := fsutil.Size(tmpDir.Join("foobar.txt"))
+		assert.NilError(t, err)
+		assert.Equal(t, size, int64(6))
+	})
+
+	t.Run("It returns the size of the files in a directory", func(t *testing.T) {
+		t.Parallel()
+
+		tmpDir := fs.NewDir(t, "enduro", dirOpts...)
+
+		size, err := fsutil.Size(tmpDir.Path())
+		assert.NilError(t, err)
+		assert.Equal(t, size, int64(6))
+	})
+
+	t.Run("It fails if the path does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		tmpDir := fs.NewDir(t, "enduro")
+
+		_, err := fsutil.Size(tmpDir.Join("missing"))
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
@@ -0,0 +1,112 @@
+package notification
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	defaultTimeout     = 10 * time.Second
+	defaultMaxAttempts = 5
+)
+
+type Config struct {
+	Webhook []WebhookConfig
+}
+
+// WebhookConfig describes an endpoint that receives collection events.
+type WebhookConfig struct {
+	// Name identifies the webhook in the delivery log.
+	Name string
+
+	// URL where the events are posted.
+	URL string
+
+	// Secret used to sign the payloads with HMAC-SHA256.
+	Secret string
+
+	// Events the webhook is subscribed to, e.g. ["done", "error"]. All the
+	// events are delivered when empty.
+	Events []string
+
+	// Timeout of the HTTP requests. Defaults to 10 seconds.
+	Timeout time.Duration
+
+	// MaxAttempts is the maximum number of delivery attempts. Defaults to 5.
+	MaxAttempts int
+}
+
+func (c Config) Validate() error {
+	names := map[string]struct{}{}
+	for i, webhook := range c.Webhook {
+		if err := webhook.validate(); err != nil {
+			return fmt.Errorf("invalid notifications configuration (webhook[%d]): %v", i, err)
+		}
+		if _, ok := names[webhook.Name]; ok {
+			return fmt.Errorf("invalid notifications configuration (webhook[%d]): duplicate name %q", i, webhook.Name)
+		}
+		names[webhook.Name] = struct{}{}
+	}
+
+	return nil
+}
+
+// ByName returns the configuration of the webhook with the given name.
+func (c Config) ByName(name string) (WebhookConfig, bool) {
+	for _, webhook := range c.Webhook {
+		if webhook.Name == name {
+			return webhook, true
+		}
+	}
+
+	return WebhookConfig{}, false
+}
+
+func (c WebhookConfig) validate() error {
+	if strings.TrimSpace(c.Name) == "" {
+		return errors.New("name is required")
+	}
+	u, err := url.Parse(c.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid url %q", c.URL)
+	}
+	if c.Secret == "" {
+		return errors.New("secret is required")
+	}
+	for _, event := range c.Events {
+		if !slices.Contains(Events, event) {
+			return fmt.Errorf("unknown event %q", event)
+		}
+	}
+	if c.Timeout < 0 {
+		return errors.New("timeout must not be negative")
+	}
+	if c.MaxAttempts < 0 {
+		return errors.New("maxAttempts must not be negative")
+	}
+
+	return nil
+}
+
+// Subscribed reports whether the webhook receives the given event.
+func (c WebhookConfig) Subscribed(event string) bool {
+	return len(c.Events) == 0 || slices.Contains(c.Events, event)
+}
+
+func (c WebhookConfig) timeout() time.Duration {
+	if c.Timeout == 0 {
+		return defaultTimeout
+	}
+	return c.Timeout
+}
+
+func (c WebhookConfig) maxAttempts() int {
+	if c.MaxAttempts == 0 {
+		return defaultMaxAttempts
+	}
+	return c.MaxAttempts
+}
@@ -0,0 +1,69 @@
+package notification
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		cfg          Config
+		errorMessage string
+	}{
+		"Accepts an empty configuration": {},
+		"Accepts a webhook": {
+			cfg: Config{Webhook: []WebhookConfig{
+				{Name: "dashboard", URL: "https://example.com/hook", Secret: "s3cr3t", Events: []string{EventDone, EventError}},
+			}},
+		},
+		"Rejects webhooks without name": {
+			cfg:          Config{Webhook: []WebhookConfig{{URL: "https://example.com/hook", Secret: "s3cr3t"}}},
+			errorMessage: "invalid notifications configuration (webhook[0]): name is required",
+		},
+		"Rejects invalid URLs": {
+			cfg:          Config{Webhook: []WebhookConfig{{Name: "dashboard", URL: "ftp://example.com", Secret: "s3cr3t"}}},
+			errorMessage: `invalid notifications configuration (webhook[0]): invalid url "ftp://example.com"`,
+		},
+		"Rejects webhooks without secret": {
+			cfg:          Config{Webhook: []WebhookConfig{{Name: "dashboard", URL: "https://example.com/hook"}}},
+			errorMessage: "invalid notifications configuration (webhook[0]): secret is required",
+		},
+		"Rejects unknown events": {
+			cfg:          Config{Webhook: []WebhookConfig{{Name: "dashboard", URL: "https://example.com/hook", Secret: "s3cr3t", Events: []string{"in progress"}}}},
+			errorMessage: `invalid notifications configuration (webhook[0]): unknown event "in progress"`,
+		},
+		"Rejects duplicate names": {
+			cfg: Config{Webhook: []WebhookConfig{
+				{Name: "dashboard", URL: "https://example.com/a", Secret: "s3cr3t"},
+				{Name: "dashboard", URL: "https://example.com/b", Secret: "s3cr3t"},
+			}},
+			errorMessage: `invalid notifications configuration (webhook[1]): duplicate name "dashboard"`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.cfg.Validate()
+			if tc.errorMessage == "" {
+				assert.NilError(t, err)
+			} else {
+				assert.Error(t, err, tc.errorMessage)
+			}
+		})
+	}
+}
+
+func TestEventForStatus(t *testing.T) {
+	t.Parallel()
+
+	event, ok := EventForStatus("in progress")
+	assert.Assert(t, ok)
+	assert.Equal(t, event, EventInProgress)
+
+	_, ok = EventForStatus("unknown")
+	assert.Assert(t, !ok)
+}
@@ -0,0 +1,12 @@
+// Package notification delivers collection lifecycle events to external
+// systems.
+//
+// Every status transition recorded in the collection status history is turned
+// into an Event. The event is sent to the webhooks configured under
+// [[notifications.webhook]] that are subscribed to it, as a JSON document
+// signed with the HMAC-SHA256 of the webhook secret and the time it was sent.
+//
+// Deliveries are executed by a Temporal workflow so failed requests are
+// retried with backoff, and the outcome of every delivery is recorded in the
+// notification_delivery table.
+package notification
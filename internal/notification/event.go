@@ -0,0 +1,84 @@
+package notification
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Names of the events, one for every collection status recorded in the status
+// history.
+const (
+	EventQueued     = "queued"
+	EventInProgress = "in_progress"
+	EventDone       = "done"
+	EventError      = "error"
+	EventPending    = "pending"
+	EventAbandoned  = "abandoned"
+)
+
+// EventDecisionReminder is sent while an operator decision is pending longer
+// than the configured reminder thresholds.
+const EventDecisionReminder = "decision_reminder"
+
+// Events lists the names of the supported events.
+var Events = []string{
+	EventQueued,
+	EventInProgress,
+	EventDone,
+	EventError,
+	EventPending,
+	EventAbandoned,
+	EventDecisionReminder,
+}
+
+// EventForStatus returns the name of the event sent when a collection enters
+// the given status, e.g. "in progress".
+func EventForStatus(status string) (string, bool) {
+	event := strings.ReplaceAll(status, " ", "_")
+	for _, name := range Events {
+		if name == event {
+			return event, true
+		}
+	}
+
+	return "", false
+}
+
+// Event is the payload posted to the webhooks.
+type Event struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Collection EventCollection `json:"collection"`
+}
+
+// EventCollection describes the status transition of a collection.
+type EventCollection struct {
+	ID             uint   `json:"id"`
+	WorkflowID     string `json:"workflow_id"`
+	RunID          string `json:"run_id"`
+	Status         string `json:"status"`
+	PreviousStatus string `json:"previous_status,omitempty"`
+	Reason         string `json:"reason,omitempty"`
+
+	// PendingSeconds is the time the operator decision has been pending,
+	// only sent with decision reminders.
+	PendingSeconds int64 `json:"pending_seconds,omitempty"`
+}
+
+// Sign returns the value of the signature header of a payload, i.e. the
+// hex-encoded HMAC-SHA256 using the webhook secret of the Unix timestamp sent
+// in the timestamp header, a dot and the body, prefixed with the name of the
+// algorithm. Signing the timestamp lets receivers reject replayed requests.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
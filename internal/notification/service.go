@@ -0,0 +1,153 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/jmoiron/sqlx"
+	temporalsdk_api_enums "go.temporal.io/api/enums/v1"
+	temporalsdk_client "go.temporal.io/sdk/client"
+)
+
+// Delivery statuses recorded in the delivery log.
+const (
+	DeliveryStatusPending   = "pending"
+	DeliveryStatusDelivered = "delivered"
+	DeliveryStatusFailed    = "failed"
+)
+
+// Delivery represents an entry of the notification_delivery table.
+type Delivery struct {
+	ID           uint64         `db:"id"`
+	CollectionID uint           `db:"collection_id"`
+	Webhook      string         `db:"webhook"`
+	Event        string         `db:"event"`
+	Status       string         `db:"status"`
+	Attempts     int            `db:"attempts"`
+	ResponseCode sql.NullInt64  `db:"response_code"`
+	Error        sql.NullString `db:"error"`
+	CreatedAt    time.Time      `db:"created_at"`
+	UpdatedAt    time.Time      `db:"updated_at"`
+}
+
+type Service interface {
+	// Notify schedules the delivery of the event to the webhooks subscribed
+	// to it.
+	Notify(ctx context.Context, event Event) error
+	// Deliveries returns the delivery log of a collection.
+	Deliveries(ctx context.Context, collectionID uint) ([]Delivery, error)
+	// UpdateDelivery records the outcome of a delivery attempt.
+	UpdateDelivery(ctx context.Context, ID uint64, status string, attempts, responseCode int, errMsg string) error
+	// FailDelivery records the failure of a delivery that is still pending
+	// once no more attempts are made.
+	FailDelivery(ctx context.Context, ID uint64, errMsg string) error
+}
+
+type serviceImpl struct {
+	logger    logr.Logger
+	db        *sqlx.DB
+	cc        temporalsdk_client.Client
+	taskQueue string
+	cfg       Config
+}
+
+var _ Service = (*serviceImpl)(nil)
+
+func NewService(logger logr.Logger, db *sql.DB, cc temporalsdk_client.Client, taskQueue string, cfg Config) *serviceImpl {
+	return &serviceImpl{
+		logger:    logger,
+		db:        sqlx.NewDb(db, "mysql"),
+		cc:        cc,
+		taskQueue: taskQueue,
+		cfg:       cfg,
+	}
+}
+
+func (svc *serviceImpl) Notify(ctx context.Context, event Event) error {
+	var errs []error
+	for _, webhook := range svc.cfg.Webhook {
+		if !webhook.Subscribed(event.Type) {
+			continue
+		}
+		if err := svc.schedule(ctx, webhook, event); err != nil {
+			errs = append(errs, fmt.Errorf("webhook %q: %v", webhook.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (svc *serviceImpl) schedule(ctx context.Context, webhook WebhookConfig, event Event) error {
+	query := `INSERT INTO notification_delivery (collection_id, webhook, event, status) VALUES ((?), (?), (?), (?))`
+	res, err := svc.db.ExecContext(ctx, svc.db.Rebind(query), event.Collection.ID, webhook.Name, event.Type, DeliveryStatusPending)
+	if err != nil {
+		return fmt.Errorf("error inserting notification delivery: %w", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("error retrieving insert ID: %w", err)
+	}
+
+	input := DeliveryWorkflowInput{
+		DeliveryID:  uint64(id),
+		Webhook:     webhook.Name,
+		MaxAttempts: webhook.maxAttempts(),
+		Event:       event,
+	}
+	opts := temporalsdk_client.StartWorkflowOptions{
+		ID:                    fmt.Sprintf("%s-%d", DeliveryWorkflowName, id),
+		TaskQueue:             svc.taskQueue,
+		WorkflowIDReusePolicy: temporalsdk_api_enums.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+	}
+	if _, err := svc.cc.ExecuteWorkflow(ctx, opts, DeliveryWorkflowName, input); err != nil {
+		_ = svc.UpdateDelivery(ctx, input.DeliveryID, DeliveryStatusFailed, 0, 0, err.Error())
+		return fmt.Errorf("error starting delivery workflow: %w", err)
+	}
+
+	return nil
+}
+
+func (svc *serviceImpl) Deliveries(ctx context.Context, collectionID uint) ([]Delivery, error) {
+	query := `SELECT id, collection_id, webhook, event, status, attempts, response_code, error, CONVERT_TZ(created_at, @@session.time_zone, '+00:00') AS created_at, CONVERT_TZ(updated_at, @@session.time_zone, '+00:00') AS updated_at FROM notification_delivery WHERE collection_id = (?) ORDER BY id ASC`
+	deliveries := []Delivery{}
+	if err := svc.db.SelectContext(ctx, &deliveries, svc.db.Rebind(query), collectionID); err != nil {
+		return nil, fmt.Errorf("error reading notification deliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+func (svc *serviceImpl) UpdateDelivery(ctx context.Context, ID uint64, status string, attempts, responseCode int, errMsg string) error {
+	query := `UPDATE notification_delivery SET status = (?), attempts = (?), response_code = (?), error = (?) WHERE id = (?)`
+	args := []any{
+		status,
+		attempts,
+		sql.NullInt64{Int64: int64(responseCode), Valid: responseCode != 0},
+		sql.NullString{String: errMsg, Valid: errMsg != ""},
+		ID,
+	}
+	if _, err := svc.db.ExecContext(ctx, svc.db.Rebind(query), args...); err != nil {
+		return fmt.Errorf("error updating notification delivery: %w", err)
+	}
+
+	return nil
+}
+
+func (svc *serviceImpl) FailDelivery(ctx context.Context, ID uint64, errMsg string) error {
+	query := `UPDATE notification_delivery SET status = (?), error = (?) WHERE id = (?) AND status = (?)`
+	args := []any{
+		DeliveryStatusFailed,
+		sql.NullString{String: errMsg, Valid: errMsg != ""},
+		ID,
+		DeliveryStatusPending,
+	}
+	if _, err := svc.db.ExecContext(ctx, svc.db.Rebind(query), args...); err != nil {
+		return fmt.Errorf("error updating notification delivery: %w", err)
+	}
+
+	return nil
+}
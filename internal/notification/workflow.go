@@ -0,0 +1,182 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	temporalsdk_activity "go.temporal.io/sdk/activity"
+	temporalsdk_temporal "go.temporal.io/sdk/temporal"
+	temporalsdk_workflow "go.temporal.io/sdk/workflow"
+
+	"github.com/artefactual-labs/enduro/internal/temporal"
+)
+
+const (
+	DeliveryWorkflowName = "notification-delivery-workflow"
+	DeliveryActivityName = "notification-delivery-activity"
+
+	// Headers sent with every delivery.
+	EventHeader     = "X-Enduro-Event"
+	DeliveryHeader  = "X-Enduro-Delivery"
+	TimestampHeader = "X-Enduro-Timestamp"
+	SignatureHeader = "X-Enduro-Signature"
+)
+
+type DeliveryWorkflowInput struct {
+	// Identifier of the entry of the delivery log.
+	DeliveryID uint64
+
+	// Name of the webhook. The rest of its configuration, including the
+	// secret, is loaded by the worker so it is never recorded in the workflow
+	// history.
+	Webhook string
+
+	MaxAttempts int
+
+	Event Event
+}
+
+// deliveryFailureChangeID versions the update of the delivery log after the
+// delivery activity failed so executions started before can be replayed.
+const deliveryFailureChangeID = "delivery-failure"
+
+// DeliveryWorkflow is a Temporal workflow that delivers an event to a webhook,
+// retrying failed requests with exponential backoff.
+type DeliveryWorkflow struct {
+	svc Service
+}
+
+func NewDeliveryWorkflow(svc Service) *DeliveryWorkflow {
+	return &DeliveryWorkflow{svc: svc}
+}
+
+func (w *DeliveryWorkflow) Execute(ctx temporalsdk_workflow.Context, input DeliveryWorkflowInput) error {
+	opts := temporalsdk_workflow.WithActivityOptions(ctx, temporalsdk_workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporalsdk_temporal.RetryPolicy{
+			InitialInterval:    time.Second * 10,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Minute * 10,
+			MaximumAttempts:    int32(input.MaxAttempts), // #nosec G115 -- validated in the config.
+		},
+	})
+
+	err := temporalsdk_workflow.ExecuteActivity(opts, DeliveryActivityName, input).Get(opts, nil)
+	if err == nil {
+		return nil
+	}
+
+	// The last attempt may not have recorded its outcome, e.g. when it timed
+	// out, so the delivery would be left pending.
+	version := temporalsdk_workflow.GetVersion(ctx, deliveryFailureChangeID, temporalsdk_workflow.DefaultVersion, 1)
+	if version == temporalsdk_workflow.DefaultVersion {
+		return err
+	}
+	activityOpts := temporalsdk_workflow.WithLocalActivityOptions(ctx, temporalsdk_workflow.LocalActivityOptions{
+		ScheduleToCloseTimeout: 5 * time.Second,
+		RetryPolicy: &temporalsdk_temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	})
+	if failErr := temporalsdk_workflow.ExecuteLocalActivity(activityOpts, failDeliveryLocalActivity, w.svc, input.DeliveryID, err.Error()).Get(activityOpts, nil); failErr != nil {
+		err = errors.Join(err, failErr)
+	}
+
+	return err
+}
+
+func failDeliveryLocalActivity(ctx context.Context, svc Service, ID uint64, errMsg string) error {
+	return svc.FailDelivery(ctx, ID, errMsg)
+}
+
+type DeliveryActivity struct {
+	svc    Service
+	cfg    Config
+	client *http.Client
+}
+
+func NewDeliveryActivity(svc Service, cfg Config, client *http.Client) *DeliveryActivity {
+	return &DeliveryActivity{
+		svc:    svc,
+		cfg:    cfg,
+		client: client,
+	}
+}
+
+func (a *DeliveryActivity) Execute(ctx context.Context, input DeliveryWorkflowInput) error {
+	attempt := int(temporalsdk_activity.GetInfo(ctx).Attempt)
+
+	webhook, ok := a.cfg.ByName(input.Webhook)
+	if !ok {
+		err := fmt.Errorf("webhook %q is not configured", input.Webhook)
+		_ = a.svc.UpdateDelivery(ctx, input.DeliveryID, DeliveryStatusFailed, attempt, 0, err.Error())
+		return temporal.NewNonRetryableError(err)
+	}
+
+	code, err := a.post(ctx, webhook, input)
+	if err == nil {
+		return a.svc.UpdateDelivery(ctx, input.DeliveryID, DeliveryStatusDelivered, attempt, code, "")
+	}
+
+	// Client errors other than timeouts and rate limiting are not expected to
+	// succeed later.
+	permanent := code >= 400 && code < 500 && code != http.StatusRequestTimeout && code != http.StatusTooManyRequests
+
+	status := DeliveryStatusPending
+	if permanent || attempt >= input.MaxAttempts {
+		status = DeliveryStatusFailed
+	}
+	if updateErr := a.svc.UpdateDelivery(ctx, input.DeliveryID, status, attempt, code, err.Error()); updateErr != nil {
+		err = errors.Join(err, updateErr)
+	}
+	if permanent {
+		return temporal.NewNonRetryableError(err)
+	}
+
+	return err
+}
+
+func (a *DeliveryActivity) post(ctx context.Context, webhook WebhookConfig, input DeliveryWorkflowInput) (int, error) {
+	body, err := json.Marshal(input.Event)
+	if err != nil {
+		return 0, fmt.Errorf("encode event: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, webhook.timeout())
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Enduro-Webhook")
+	req.Header.Set(EventHeader, input.Event.Type)
+	req.Header.Set(DeliveryHeader, strconv.FormatUint(input.DeliveryID, 10))
+	timestamp := time.Now().Unix()
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(webhook.Secret, timestamp, body))
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("post event: %v", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected response status: %s", resp.Status)
+	}
+
+	return resp.StatusCode, nil
+}
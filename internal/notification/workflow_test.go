@@ -0,0 +1,208 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	temporalsdk_activity "go.temporal.io/sdk/activity"
+	temporalsdk_testsuite "go.temporal.io/sdk/testsuite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/enduro/internal/temporal"
+)
+
+type deliveryUpdate struct {
+	ID           uint64
+	Status       string
+	Attempts     int
+	ResponseCode int
+	Error        string
+}
+
+type deliveryRecorder struct {
+	Service
+	updates  []deliveryUpdate
+	failures []deliveryUpdate
+}
+
+func (r *deliveryRecorder) UpdateDelivery(_ context.Context, ID uint64, status string, attempts, responseCode int, errMsg string) error {
+	r.updates = append(r.updates, deliveryUpdate{ID, status, attempts, responseCode, errMsg})
+	return nil
+}
+
+func (r *deliveryRecorder) FailDelivery(_ context.Context, ID uint64, errMsg string) error {
+	r.failures = append(r.failures, deliveryUpdate{ID: ID, Status: DeliveryStatusFailed, Error: errMsg})
+	return nil
+}
+
+func TestDeliveryWorkflow(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		err     error
+		wantErr string
+	}{
+		"Delivers the event": {},
+		"Records the failure once no more attempts are made": {
+			err:     temporal.NewNonRetryableError(errors.New("post event: context deadline exceeded")),
+			wantErr: "post event: context deadline exceeded",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := &deliveryRecorder{}
+			ts := &temporalsdk_testsuite.WorkflowTestSuite{}
+			env := ts.NewTestWorkflowEnvironment()
+			env.RegisterActivityWithOptions(
+				func(ctx context.Context, input DeliveryWorkflowInput) error { return tc.err },
+				temporalsdk_activity.RegisterOptions{Name: DeliveryActivityName},
+			)
+
+			env.ExecuteWorkflow(NewDeliveryWorkflow(svc).Execute, DeliveryWorkflowInput{
+				DeliveryID:  7,
+				Webhook:     "dashboard",
+				MaxAttempts: 5,
+			})
+
+			assert.Assert(t, env.IsWorkflowCompleted())
+			if tc.wantErr == "" {
+				assert.NilError(t, env.GetWorkflowError())
+				assert.Equal(t, len(svc.failures), 0)
+				return
+			}
+			assert.ErrorContains(t, env.GetWorkflowError(), tc.wantErr)
+			assert.Equal(t, len(svc.failures), 1)
+			assert.Equal(t, svc.failures[0].ID, uint64(7))
+			assert.Assert(t, strings.Contains(svc.failures[0].Error, tc.wantErr))
+		})
+	}
+}
+
+func TestDeliveryActivity(t *testing.T) {
+	t.Parallel()
+
+	event := Event{
+		ID:         "6f1c2d0e-8a55-4c1b-9d7e-0a3b2c1d4e5f",
+		Type:       EventDone,
+		OccurredAt: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+		Collection: EventCollection{
+			ID:             12,
+			WorkflowID:     "processing-workflow-12",
+			RunID:          "run-12",
+			Status:         "done",
+			PreviousStatus: "in progress",
+			Reason:         "workflow_completed",
+		},
+	}
+
+	t.Run("Posts a signed event", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			body    []byte
+			headers http.Header
+		)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ = io.ReadAll(r.Body)
+			headers = r.Header
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer srv.Close()
+
+		svc := &deliveryRecorder{}
+		cfg := Config{Webhook: []WebhookConfig{{Name: "dashboard", URL: srv.URL, Secret: "s3cr3t"}}}
+		activity := NewDeliveryActivity(svc, cfg, srv.Client())
+
+		ts := &temporalsdk_testsuite.WorkflowTestSuite{}
+		env := ts.NewTestActivityEnvironment()
+		env.RegisterActivityWithOptions(activity.Execute, temporalsdk_activity.RegisterOptions{Name: DeliveryActivityName})
+
+		_, err := env.ExecuteActivity(DeliveryActivityName, DeliveryWorkflowInput{
+			DeliveryID:  7,
+			Webhook:     "dashboard",
+			MaxAttempts: 5,
+			Event:       event,
+		})
+		assert.NilError(t, err)
+
+		var got Event
+		assert.NilError(t, json.Unmarshal(body, &got))
+		assert.DeepEqual(t, got, event)
+		assert.Equal(t, headers.Get(EventHeader), "done")
+		assert.Equal(t, headers.Get(DeliveryHeader), "7")
+		timestamp, err := strconv.ParseInt(headers.Get(TimestampHeader), 10, 64)
+		assert.NilError(t, err)
+		assert.Assert(t, time.Since(time.Unix(timestamp, 0)) < time.Minute)
+		assert.Equal(t, headers.Get(SignatureHeader), Sign("s3cr3t", timestamp, body))
+		assert.DeepEqual(t, svc.updates, []deliveryUpdate{
+			{ID: 7, Status: DeliveryStatusDelivered, Attempts: 1, ResponseCode: http.StatusNoContent},
+		})
+	})
+
+	t.Run("Retries server errors", func(t *testing.T) {
+		t.Parallel()
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer srv.Close()
+
+		svc := &deliveryRecorder{}
+		cfg := Config{Webhook: []WebhookConfig{{Name: "dashboard", URL: srv.URL, Secret: "s3cr3t"}}}
+		activity := NewDeliveryActivity(svc, cfg, srv.Client())
+
+		ts := &temporalsdk_testsuite.WorkflowTestSuite{}
+		env := ts.NewTestActivityEnvironment()
+		env.RegisterActivityWithOptions(activity.Execute, temporalsdk_activity.RegisterOptions{Name: DeliveryActivityName})
+
+		_, err := env.ExecuteActivity(DeliveryActivityName, DeliveryWorkflowInput{
+			DeliveryID:  7,
+			Webhook:     "dashboard",
+			MaxAttempts: 5,
+			Event:       event,
+		})
+		assert.ErrorContains(t, err, "unexpected response status: 502 Bad Gateway")
+		assert.Assert(t, !temporal.NonRetryableError(err))
+		assert.DeepEqual(t, svc.updates, []deliveryUpdate{
+			{ID: 7, Status: DeliveryStatusPending, Attempts: 1, ResponseCode: http.StatusBadGateway, Error: "unexpected response status: 502 Bad Gateway"},
+		})
+	})
+
+	t.Run("Gives up on client errors", func(t *testing.T) {
+		t.Parallel()
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer srv.Close()
+
+		svc := &deliveryRecorder{}
+		cfg := Config{Webhook: []WebhookConfig{{Name: "dashboard", URL: srv.URL, Secret: "s3cr3t"}}}
+		activity := NewDeliveryActivity(svc, cfg, srv.Client())
+
+		ts := &temporalsdk_testsuite.WorkflowTestSuite{}
+		env := ts.NewTestActivityEnvironment()
+		env.RegisterActivityWithOptions(activity.Execute, temporalsdk_activity.RegisterOptions{Name: DeliveryActivityName})
+
+		_, err := env.ExecuteActivity(DeliveryActivityName, DeliveryWorkflowInput{
+			DeliveryID:  7,
+			Webhook:     "dashboard",
+			MaxAttempts: 5,
+			Event:       event,
+		})
+		assert.Assert(t, temporal.NonRetryableError(err))
+		assert.DeepEqual(t, svc.updates, []deliveryUpdate{
+			{ID: 7, Status: DeliveryStatusFailed, Attempts: 1, ResponseCode: http.StatusUnauthorized, Error: "unexpected response status: 401 Unauthorized"},
+		})
+	})
+}
@@ -0,0 +1,175 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.artefactual.dev/amclient"
+)
+
+// Failure categories describe why Archivematica could not process a unit.
+const (
+	// Archivematica reported that processing failed.
+	FailureCategoryFailed = "failed"
+
+	// Archivematica rejected the unit, e.g. a user rejected the transfer.
+	FailureCategoryRejected = "rejected"
+
+	// Archivematica reported a state that Enduro does not recognize.
+	FailureCategoryUnexpectedState = "unexpected_state"
+
+	// The Archivematica API returned an error that can't be retried.
+	FailureCategoryAPIError = "api_error"
+
+	// Archivematica could not be reached before the retry deadline.
+	FailureCategoryUnreachable = "unreachable"
+)
+
+// FailureCategories lists the known failure categories.
+var FailureCategories = []string{
+	FailureCategoryFailed,
+	FailureCategoryRejected,
+	FailureCategoryUnexpectedState,
+	FailureCategoryAPIError,
+	FailureCategoryUnreachable,
+}
+
+// Maximum length of the stderr excerpt of a failure, only the tail of longer
+// outputs is kept.
+const maxStderrExcerpt = 4096
+
+// Maximum number of tasks of the failed job that are looked up.
+const maxFailedTasks = 5
+
+// Failure describes why Archivematica could not process a unit.
+type Failure struct {
+	Category string
+
+	// Stage is ProgressStageTransfer or ProgressStageIngest.
+	Stage string
+
+	// Archivematica unit, i.e. the transfer or SIP identifier.
+	UnitID string
+
+	// Name and microservice of the failed job, when known.
+	Job          string
+	Microservice string
+
+	// Excerpt of the standard error of the tasks of the failed job.
+	Stderr string
+
+	// Errors is the chain of errors seen by Enduro, outermost first.
+	Errors []string
+}
+
+// FailureCategory classifies the errors returned by TransferStatus and
+// IngestStatus.
+func FailureCategory(err error) string {
+	stateErr := &StateError{}
+	switch {
+	case errors.As(err, &stateErr) && stateErr.State == "FAILED":
+		return FailureCategoryFailed
+	case errors.As(err, &stateErr) && stateErr.State == "REJECTED":
+		return FailureCategoryRejected
+	case errors.As(err, &stateErr):
+		return FailureCategoryUnexpectedState
+	case errors.Is(err, ErrStatusRetryable):
+		return FailureCategoryUnreachable
+	default:
+		return FailureCategoryAPIError
+	}
+}
+
+// NewFailure describes the failure of a unit given the error that ended its
+// processing. Details of the failed job are looked up with the jobs and tasks
+// services when available, the failure is still described when the lookups
+// are not successful.
+func NewFailure(ctx context.Context, jobsService amclient.JobsService, taskService amclient.TaskService, stage, unitID string, err error) (*Failure, error) {
+	failure := &Failure{
+		Category: FailureCategory(err),
+		Stage:    stage,
+		UnitID:   unitID,
+		Errors:   errorChain(err),
+	}
+
+	// There are no failed jobs to look up when Archivematica did not fail.
+	if failure.Category != FailureCategoryFailed && failure.Category != FailureCategoryRejected {
+		return failure, nil
+	}
+	if jobsService == nil {
+		return failure, nil
+	}
+
+	jobs, _, lerr := jobsService.List(ctx, unitID, &amclient.JobsListRequest{Detailed: true})
+	if lerr != nil {
+		return failure, fmt.Errorf("error listing jobs: %w", lerr)
+	}
+
+	var failed *amclient.Job
+	for i := len(jobs) - 1; i >= 0; i-- {
+		if jobs[i].Status == amclient.JobStatusFailed {
+			failed = &jobs[i]
+			break
+		}
+	}
+	if failed == nil {
+		return failure, nil
+	}
+	failure.Job = failed.Name
+	failure.Microservice = failed.Microservice
+
+	if taskService == nil {
+		return failure, nil
+	}
+
+	var stderr []string
+	var looked int
+	for _, task := range failed.Tasks {
+		if task.ExitCode == 0 {
+			continue
+		}
+		if looked == maxFailedTasks {
+			break
+		}
+		looked++
+
+		detail, _, terr := taskService.Read(ctx, task.ID)
+		if terr != nil {
+			return failure, fmt.Errorf("error reading task: %w", terr)
+		}
+		if output := strings.TrimSpace(detail.Stderr); output != "" {
+			stderr = append(stderr, output)
+		}
+	}
+	failure.Stderr = excerpt(strings.Join(stderr, "\n"), maxStderrExcerpt)
+
+	return failure, nil
+}
+
+// errorChain returns the messages of the errors wrapped by err, skipping the
+// ones that do not add to the message of the error that wraps them.
+func errorChain(err error) []string {
+	var chain []string
+	var last string
+	for ; err != nil; err = errors.Unwrap(err) {
+		msg := err.Error()
+		if msg == last {
+			continue
+		}
+		chain = append(chain, msg)
+		last = msg
+	}
+
+	return chain
+}
+
+// excerpt returns the last max bytes of s.
+func excerpt(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+
+	return "…" + strings.ToValidUTF8(s[len(s)-max:], "")
+}
@@ -0,0 +1,132 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"go.artefactual.dev/amclient"
+	"go.artefactual.dev/amclient/amclienttest"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+)
+
+func TestFailureCategory(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		err  error
+		want string
+	}{
+		"Failed": {
+			err:  fmt.Errorf("error checking transfer status (%w): %w", ErrStatusNonRetryable, &StateError{State: "FAILED"}),
+			want: FailureCategoryFailed,
+		},
+		"Rejected": {
+			err:  fmt.Errorf("error checking ingest status (%w): %w", ErrStatusNonRetryable, &StateError{State: "REJECTED"}),
+			want: FailureCategoryRejected,
+		},
+		"Unexpected state": {
+			err:  fmt.Errorf("error checking ingest status (%w): %w", ErrStatusNonRetryable, &StateError{State: "FOOBAR"}),
+			want: FailureCategoryUnexpectedState,
+		},
+		"Unreachable": {
+			err:  fmt.Errorf("network error (%w): timeout", ErrStatusRetryable),
+			want: FailureCategoryUnreachable,
+		},
+		"API error": {
+			err:  fmt.Errorf("server error (%w): forbidden (403)", ErrStatusNonRetryable),
+			want: FailureCategoryAPIError,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, FailureCategory(tc.err), tc.want)
+		})
+	}
+}
+
+func TestNewFailure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	statusErr := fmt.Errorf("error checking ingest status (%w): ingest is in a state that we can't handle: %w", ErrStatusNonRetryable, &StateError{State: "FAILED"})
+	err := fmt.Errorf("ingest failed: %w", statusErr)
+
+	t.Run("Describes the failed job", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		jobsService := amclienttest.NewMockJobsService(ctrl)
+		taskService := amclienttest.NewMockTaskService(ctrl)
+
+		jobsService.EXPECT().
+			List(ctx, "sip-id", &amclient.JobsListRequest{Detailed: true}).
+			Return([]amclient.Job{
+				{ID: "1", Name: "Extract zipped bag", Microservice: "Extract", Status: amclient.JobStatusComplete},
+				{
+					ID:           "2",
+					Name:         "Normalize for preservation",
+					Microservice: "Normalize",
+					Status:       amclient.JobStatusFailed,
+					Tasks: []amclient.Task{
+						{ID: "t1", ExitCode: 0},
+						{ID: "t2", ExitCode: 1},
+					},
+				},
+			}, nil, nil)
+		taskService.EXPECT().
+			Read(ctx, "t2").
+			Return(&amclient.TaskDetailed{ID: "t2", Stderr: "ffmpeg: invalid data\n"}, nil, nil)
+
+		failure, ferr := NewFailure(ctx, jobsService, taskService, ProgressStageIngest, "sip-id", err)
+		assert.NilError(t, ferr)
+		assert.DeepEqual(t, failure, &Failure{
+			Category:     FailureCategoryFailed,
+			Stage:        ProgressStageIngest,
+			UnitID:       "sip-id",
+			Job:          "Normalize for preservation",
+			Microservice: "Normalize",
+			Stderr:       "ffmpeg: invalid data",
+			Errors:       []string{err.Error(), statusErr.Error()},
+		})
+	})
+
+	t.Run("Describes the failure when the jobs can't be listed", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		jobsService := amclienttest.NewMockJobsService(ctrl)
+
+		jobsService.EXPECT().
+			List(ctx, "sip-id", gomock.Any()).
+			Return(nil, nil, errors.New("unavailable"))
+
+		failure, ferr := NewFailure(ctx, jobsService, nil, ProgressStageIngest, "sip-id", err)
+		assert.Error(t, ferr, "error listing jobs: unavailable")
+		assert.Equal(t, failure.Category, FailureCategoryFailed)
+		assert.Equal(t, failure.Job, "")
+	})
+
+	t.Run("Does not look up jobs unless Archivematica failed", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		jobsService := amclienttest.NewMockJobsService(ctrl)
+
+		failure, ferr := NewFailure(ctx, jobsService, nil, ProgressStageTransfer, "transfer-id", fmt.Errorf("network error (%w): timeout", ErrStatusRetryable))
+		assert.NilError(t, ferr)
+		assert.Equal(t, failure.Category, FailureCategoryUnreachable)
+	})
+}
+
+func TestExcerpt(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, excerpt("abc", 5), "abc")
+	assert.Equal(t, excerpt(strings.Repeat("a", 3)+"bcd", 3), "…bcd")
+}
@@ -41,6 +41,45 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// Drain mocks base method.
+func (m *MockService) Drain(arg0 context.Context, arg1 *pipeline.DrainPayload) (*pipeline.EnduroStoredPipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Drain", arg0, arg1)
+	ret0, _ := ret[0].(*pipeline.EnduroStoredPipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Drain indicates an expected call of Drain.
+func (mr *MockServiceMockRecorder) Drain(arg0, arg1 any) *MockServiceDrainCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Drain", reflect.TypeOf((*MockService)(nil).Drain), arg0, arg1)
+	return &MockServiceDrainCall{Call: call}
+}
+
+// MockServiceDrainCall wrap *gomock.Call
+type MockServiceDrainCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceDrainCall) Return(arg0 *pipeline.EnduroStoredPipeline, arg1 error) *MockServiceDrainCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceDrainCall) Do(f func(context.Context, *pipeline.DrainPayload) (*pipeline.EnduroStoredPipeline, error)) *MockServiceDrainCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceDrainCall) DoAndReturn(f func(context.Context, *pipeline.DrainPayload) (*pipeline.EnduroStoredPipeline, error)) *MockServiceDrainCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // List mocks base method.
 func (m *MockService) List(arg0 context.Context, arg1 *pipeline.ListPayload) ([]*pipeline.EnduroStoredPipeline, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +119,45 @@ func (c *MockServiceListCall) DoAndReturn(f func(context.Context, *pipeline.List
 	return c
 }
 
+// Pause mocks base method.
+func (m *MockService) Pause(arg0 context.Context, arg1 *pipeline.PausePayload) (*pipeline.EnduroStoredPipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pause", arg0, arg1)
+	ret0, _ := ret[0].(*pipeline.EnduroStoredPipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pause indicates an expected call of Pause.
+func (mr *MockServiceMockRecorder) Pause(arg0, arg1 any) *MockServicePauseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockService)(nil).Pause), arg0, arg1)
+	return &MockServicePauseCall{Call: call}
+}
+
+// MockServicePauseCall wrap *gomock.Call
+type MockServicePauseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServicePauseCall) Return(arg0 *pipeline.EnduroStoredPipeline, arg1 error) *MockServicePauseCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServicePauseCall) Do(f func(context.Context, *pipeline.PausePayload) (*pipeline.EnduroStoredPipeline, error)) *MockServicePauseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServicePauseCall) DoAndReturn(f func(context.Context, *pipeline.PausePayload) (*pipeline.EnduroStoredPipeline, error)) *MockServicePauseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Processing mocks base method.
 func (m *MockService) Processing(arg0 context.Context, arg1 *pipeline.ProcessingPayload) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -119,6 +197,84 @@ func (c *MockServiceProcessingCall) DoAndReturn(f func(context.Context, *pipelin
 	return c
 }
 
+// Resume mocks base method.
+func (m *MockService) Resume(arg0 context.Context, arg1 *pipeline.ResumePayload) (*pipeline.EnduroStoredPipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resume", arg0, arg1)
+	ret0, _ := ret[0].(*pipeline.EnduroStoredPipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resume indicates an expected call of Resume.
+func (mr *MockServiceMockRecorder) Resume(arg0, arg1 any) *MockServiceResumeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockService)(nil).Resume), arg0, arg1)
+	return &MockServiceResumeCall{Call: call}
+}
+
+// MockServiceResumeCall wrap *gomock.Call
+type MockServiceResumeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceResumeCall) Return(arg0 *pipeline.EnduroStoredPipeline, arg1 error) *MockServiceResumeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceResumeCall) Do(f func(context.Context, *pipeline.ResumePayload) (*pipeline.EnduroStoredPipeline, error)) *MockServiceResumeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceResumeCall) DoAndReturn(f func(context.Context, *pipeline.ResumePayload) (*pipeline.EnduroStoredPipeline, error)) *MockServiceResumeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Route mocks base method.
+func (m *MockService) Route(arg0 context.Context, arg1 *pipeline.RoutePayload) (*pipeline.EnduroPipelineRoute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Route", arg0, arg1)
+	ret0, _ := ret[0].(*pipeline.EnduroPipelineRoute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Route indicates an expected call of Route.
+func (mr *MockServiceMockRecorder) Route(arg0, arg1 any) *MockServiceRouteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Route", reflect.TypeOf((*MockService)(nil).Route), arg0, arg1)
+	return &MockServiceRouteCall{Call: call}
+}
+
+// MockServiceRouteCall wrap *gomock.Call
+type MockServiceRouteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceRouteCall) Return(arg0 *pipeline.EnduroPipelineRoute, arg1 error) *MockServiceRouteCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceRouteCall) Do(f func(context.Context, *pipeline.RoutePayload) (*pipeline.EnduroPipelineRoute, error)) *MockServiceRouteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceRouteCall) DoAndReturn(f func(context.Context, *pipeline.RoutePayload) (*pipeline.EnduroPipelineRoute, error)) *MockServiceRouteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Show mocks base method.
 func (m *MockService) Show(arg0 context.Context, arg1 *pipeline.ShowPayload) (*pipeline.EnduroStoredPipeline, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,160 @@
+package pipeline
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// DefaultLeaseTTL is the time a lease is kept without being renewed.
+const DefaultLeaseTTL = 10 * time.Minute
+
+var ErrLeaseNotFound = errors.New("lease not found")
+
+// Lease is a slot of a pipeline held by a processing workflow.
+type Lease struct {
+	Pipeline string `db:"pipeline"`
+
+	// Identifier of the workflow that holds the slot.
+	Holder string `db:"holder"`
+
+	// Identity of the Enduro instance that acquired the slot.
+	Worker string `db:"worker"`
+
+	AcquiredAt time.Time `db:"acquired_at"`
+	ExpiresAt  time.Time `db:"expires_at"`
+}
+
+// LeaseStore accounts for the slots of the pipelines across all the Enduro
+// instances sharing the database. Leases expire unless they are renewed, so
+// the slots held by workflows that did not release them are recovered.
+type LeaseStore interface {
+	// Acquire takes a slot of the pipeline unless all of them are held. A
+	// holder that has a slot already keeps it and the lease is renewed.
+	Acquire(ctx context.Context, pipeline, holder string, capacity int64, ttl time.Duration) (bool, error)
+	// Renew extends the lease of the holder, it returns ErrLeaseNotFound when
+	// the lease has expired or it was released.
+	Renew(ctx context.Context, pipeline, holder string, ttl time.Duration) error
+	// Release gives up the slot of the holder.
+	Release(ctx context.Context, pipeline, holder string) error
+	// Holders lists the leases of the pipeline that have not expired.
+	Holders(ctx context.Context, pipeline string) ([]Lease, error)
+}
+
+type leaseStoreImpl struct {
+	db     *sqlx.DB
+	worker string
+}
+
+var _ LeaseStore = (*leaseStoreImpl)(nil)
+
+// NewLeaseStore returns a LeaseStore backed by the pipeline_lease table.
+// worker identifies the Enduro instance in the leases that it acquires.
+func NewLeaseStore(db *sql.DB, worker string) *leaseStoreImpl {
+	return &leaseStoreImpl{
+		db:     sqlx.NewDb(db, "mysql"),
+		worker: worker,
+	}
+}
+
+const leaseColumns = "pipeline, holder, worker, CONVERT_TZ(acquired_at, @@session.time_zone, '+00:00') AS acquired_at, CONVERT_TZ(expires_at, @@session.time_zone, '+00:00') AS expires_at"
+
+func (s *leaseStoreImpl) Acquire(ctx context.Context, pipeline, holder string, capacity int64, ttl time.Duration) (_ bool, err error) {
+	now := time.Now().UTC()
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	// Locking the state row of the pipeline serializes concurrent
+	// acquisitions. The leases of the pipeline can't be locked instead: there
+	// are no rows to lock before the first lease is inserted. The upsert
+	// takes an exclusive lock on the row whether it exists or not, and it
+	// leaves the state of existing rows unchanged.
+	query := `INSERT INTO pipeline_state (pipeline, state) VALUES ((?), (?)) ON DUPLICATE KEY UPDATE state = state`
+	if _, err := tx.ExecContext(ctx, tx.Rebind(query), pipeline, StateRunning); err != nil {
+		return false, fmt.Errorf("error locking pipeline: %w", err)
+	}
+
+	query = `DELETE FROM pipeline_lease WHERE pipeline = (?) AND expires_at <= (?)`
+	if _, err := tx.ExecContext(ctx, tx.Rebind(query), pipeline, now); err != nil {
+		return false, fmt.Errorf("error deleting expired leases: %w", err)
+	}
+
+	holders := []string{}
+	query = `SELECT holder FROM pipeline_lease WHERE pipeline = (?) FOR UPDATE`
+	if err := tx.SelectContext(ctx, &holders, tx.Rebind(query), pipeline); err != nil {
+		return false, fmt.Errorf("error reading leases: %w", err)
+	}
+
+	var acquired bool
+	switch {
+	case slices.Contains(holders, holder):
+		query = `UPDATE pipeline_lease SET expires_at = (?) WHERE pipeline = (?) AND holder = (?)`
+		if _, err := tx.ExecContext(ctx, tx.Rebind(query), now.Add(ttl), pipeline, holder); err != nil {
+			return false, fmt.Errorf("error renewing lease: %w", err)
+		}
+		acquired = true
+	case int64(len(holders)) < capacity:
+		query = `INSERT INTO pipeline_lease (pipeline, holder, worker, acquired_at, expires_at) VALUES ((?), (?), (?), (?), (?))`
+		if _, err := tx.ExecContext(ctx, tx.Rebind(query), pipeline, holder, s.worker, now, now.Add(ttl)); err != nil {
+			return false, fmt.Errorf("error inserting lease: %w", err)
+		}
+		acquired = true
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return acquired, nil
+}
+
+func (s *leaseStoreImpl) Renew(ctx context.Context, pipeline, holder string, ttl time.Duration) error {
+	now := time.Now().UTC()
+	query := `UPDATE pipeline_lease SET expires_at = (?) WHERE pipeline = (?) AND holder = (?) AND expires_at > (?)`
+	res, err := s.db.ExecContext(ctx, s.db.Rebind(query), now.Add(ttl), pipeline, holder, now)
+	if err != nil {
+		return fmt.Errorf("error renewing lease: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error retrieving affected rows: %w", err)
+	}
+	if n == 0 {
+		return ErrLeaseNotFound
+	}
+
+	return nil
+}
+
+func (s *leaseStoreImpl) Release(ctx context.Context, pipeline, holder string) error {
+	query := `DELETE FROM pipeline_lease WHERE pipeline = (?) AND holder = (?)`
+	if _, err := s.db.ExecContext(ctx, s.db.Rebind(query), pipeline, holder); err != nil {
+		return fmt.Errorf("error releasing lease: %w", err)
+	}
+
+	return nil
+}
+
+func (s *leaseStoreImpl) Holders(ctx context.Context, pipeline string) ([]Lease, error) {
+	query := "SELECT " + leaseColumns + " FROM pipeline_lease WHERE pipeline = (?) AND expires_at > (?) ORDER BY acquired_at ASC, holder ASC"
+
+	leases := []Lease{}
+	if err := s.db.SelectContext(ctx, &leases, s.db.Rebind(query), pipeline, time.Now().UTC()); err != nil {
+		return nil, fmt.Errorf("error reading leases: %w", err)
+	}
+
+	return leases, nil
+}
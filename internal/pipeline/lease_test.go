@@ -0,0 +1,168 @@
+package pipeline
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"gotest.tools/v3/assert"
+)
+
+// memoryLeaseStore is a LeaseStore shared by the registries of a test, like
+// the database is shared by the Enduro instances.
+type memoryLeaseStore struct {
+	mu     sync.Mutex
+	leases []Lease
+}
+
+func (s *memoryLeaseStore) Acquire(ctx context.Context, pipeline, holder string, capacity int64, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var held int64
+	for i, lease := range s.leases {
+		if lease.Pipeline != pipeline {
+			continue
+		}
+		if lease.Holder == holder {
+			s.leases[i].ExpiresAt = time.Now().Add(ttl)
+			return true, nil
+		}
+		held++
+	}
+	if held >= capacity {
+		return false, nil
+	}
+	s.leases = append(s.leases, Lease{Pipeline: pipeline, Holder: holder, Worker: "worker", ExpiresAt: time.Now().Add(ttl)})
+
+	return true, nil
+}
+
+func (s *memoryLeaseStore) Renew(ctx context.Context, pipeline, holder string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, lease := range s.leases {
+		if lease.Pipeline == pipeline && lease.Holder == holder {
+			s.leases[i].ExpiresAt = time.Now().Add(ttl)
+			return nil
+		}
+	}
+
+	return ErrLeaseNotFound
+}
+
+func (s *memoryLeaseStore) Release(ctx context.Context, pipeline, holder string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.leases = slices.DeleteFunc(s.leases, func(lease Lease) bool {
+		return lease.Pipeline == pipeline && lease.Holder == holder
+	})
+
+	return nil
+}
+
+func (s *memoryLeaseStore) Holders(ctx context.Context, pipeline string) ([]Lease, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	leases := []Lease{}
+	for _, lease := range s.leases {
+		if lease.Pipeline == pipeline {
+			leases = append(leases, lease)
+		}
+	}
+
+	return leases, nil
+}
+
+func TestPipelineLeases(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := &memoryLeaseStore{}
+	newPipeline := func() *Pipeline {
+		registry, err := NewPipelineRegistry(logr.Discard(), []Config{{Name: "am", Capacity: 1}}, nil, nil)
+		assert.NilError(t, err)
+		registry.SetLeases(store, 0)
+		assert.Equal(t, registry.LeaseTTL(), DefaultLeaseTTL)
+
+		p, err := registry.ByName("am")
+		assert.NilError(t, err)
+
+		return p
+	}
+
+	// Two instances share the capacity of the pipeline.
+	p1, p2 := newPipeline(), newPipeline()
+
+	ok, err := p1.Acquire(ctx, "workflow-1")
+	assert.NilError(t, err)
+	assert.Equal(t, ok, true)
+
+	ok, err = p2.Acquire(ctx, "workflow-2")
+	assert.NilError(t, err)
+	assert.Equal(t, ok, false)
+
+	size, cur := p2.Usage(ctx)
+	assert.Equal(t, size, int64(1))
+	assert.Equal(t, cur, int64(1))
+
+	holders, err := p2.Holders(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, len(holders), 1)
+	assert.Equal(t, holders[0].Holder, "workflow-1")
+
+	// The holder keeps its slot when it acquires it again, e.g. after the
+	// worker that acquired it crashed.
+	ok, err = p2.Acquire(ctx, "workflow-1")
+	assert.NilError(t, err)
+	assert.Equal(t, ok, true)
+	assert.NilError(t, p2.RenewLease(ctx, "workflow-1"))
+
+	assert.NilError(t, p2.ReleaseLease(ctx, "workflow-1"))
+	assert.ErrorIs(t, p1.RenewLease(ctx, "workflow-1"), ErrLeaseNotFound)
+
+	ok, err = p2.Acquire(ctx, "workflow-2")
+	assert.NilError(t, err)
+	assert.Equal(t, ok, true)
+
+	// The in-process semaphores are not used.
+	_, cur = p1.Capacity()
+	assert.Equal(t, cur, int64(0))
+}
+
+func TestPipelineWithoutLeases(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	registry, err := NewPipelineRegistry(logr.Discard(), []Config{{Name: "am", Capacity: 1}}, nil, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, registry.LeaseTTL(), time.Duration(0))
+	p, _ := registry.ByName("am")
+
+	ok, err := p.Acquire(ctx, "workflow-1")
+	assert.NilError(t, err)
+	assert.Equal(t, ok, true)
+
+	ok, err = p.Acquire(ctx, "workflow-2")
+	assert.NilError(t, err)
+	assert.Equal(t, ok, false)
+
+	size, cur := p.Usage(ctx)
+	assert.Equal(t, size, int64(1))
+	assert.Equal(t, cur, int64(1))
+
+	holders, err := p.Holders(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, holders == nil)
+
+	assert.NilError(t, p.RenewLease(ctx, "workflow-1"))
+	assert.NilError(t, p.ReleaseLease(ctx, "workflow-1"))
+	_, cur = p.Capacity()
+	assert.Equal(t, cur, int64(0))
+}
@@ -0,0 +1,99 @@
+package pipeline
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// State is the maintenance state of a pipeline. It controls whether new
+// transfers are sent to the pipeline, collections that acquired the pipeline
+// already are not affected.
+type State string
+
+const (
+	// StateRunning accepts new transfers.
+	StateRunning State = "running"
+	// StatePaused holds new transfers until the pipeline is resumed.
+	StatePaused State = "paused"
+	// StateDraining sends new transfers to other pipelines when possible,
+	// they are held otherwise.
+	StateDraining State = "draining"
+)
+
+// StateStore persists the maintenance state of the pipelines, shared by all
+// the Enduro instances using the same database.
+type StateStore interface {
+	State(ctx context.Context, pipeline string) (State, error)
+	SetState(ctx context.Context, pipeline string, state State) error
+}
+
+type stateStoreImpl struct {
+	db *sqlx.DB
+}
+
+var _ StateStore = (*stateStoreImpl)(nil)
+
+// NewStateStore returns a StateStore backed by the pipeline_state table.
+func NewStateStore(db *sql.DB) *stateStoreImpl {
+	return &stateStoreImpl{db: sqlx.NewDb(db, "mysql")}
+}
+
+func (s *stateStoreImpl) State(ctx context.Context, pipeline string) (State, error) {
+	var state State
+	query := `SELECT state FROM pipeline_state WHERE pipeline = (?)`
+	err := s.db.GetContext(ctx, &state, s.db.Rebind(query), pipeline)
+	if errors.Is(err, sql.ErrNoRows) {
+		return StateRunning, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("error reading pipeline state: %w", err)
+	}
+
+	return state, nil
+}
+
+func (s *stateStoreImpl) SetState(ctx context.Context, pipeline string, state State) error {
+	query := `INSERT INTO pipeline_state (pipeline, state) VALUES ((?), (?)) ON DUPLICATE KEY UPDATE state = VALUES(state)`
+	if _, err := s.db.ExecContext(ctx, s.db.Rebind(query), pipeline, state); err != nil {
+		return fmt.Errorf("error updating pipeline state: %w", err)
+	}
+
+	return nil
+}
+
+// State returns the maintenance state of the pipeline. Pipelines are running
+// when their state cannot be determined.
+func (p *Pipeline) State(ctx context.Context) State {
+	if p.states == nil {
+		p.stateLock.RLock()
+		defer p.stateLock.RUnlock()
+		if p.state == "" {
+			return StateRunning
+		}
+		return p.state
+	}
+
+	state, err := p.states.State(ctx, p.config.Name)
+	if err != nil {
+		p.logger.Error(err, "Error reading pipeline state.")
+		return StateRunning
+	}
+
+	return state
+}
+
+// SetState changes the maintenance state of the pipeline.
+func (p *Pipeline) SetState(ctx context.Context, state State) error {
+	if p.states == nil {
+		p.stateLock.Lock()
+		defer p.stateLock.Unlock()
+		p.state = state
+		return nil
+	}
+
+	return p.states.SetState(ctx, p.config.Name, state)
+}
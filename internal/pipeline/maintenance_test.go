@@ -0,0 +1,31 @@
+package pipeline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"gotest.tools/v3/assert"
+)
+
+func TestPipelineState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	registry, err := NewPipelineRegistry(logr.Discard(), []Config{{Name: "am1"}, {Name: "am2"}}, nil, nil)
+	assert.NilError(t, err)
+	am1, _ := registry.ByName("am1")
+	am2, _ := registry.ByName("am2")
+
+	assert.Equal(t, am1.State(ctx), StateRunning)
+
+	assert.NilError(t, am1.SetState(ctx, StatePaused))
+	assert.Equal(t, am1.State(ctx), StatePaused)
+	assert.Equal(t, am2.State(ctx), StateRunning)
+
+	assert.NilError(t, am1.SetState(ctx, StateDraining))
+	assert.Equal(t, am1.State(ctx), StateDraining)
+
+	assert.NilError(t, am1.SetState(ctx, StateRunning))
+	assert.Equal(t, am1.State(ctx), StateRunning)
+}
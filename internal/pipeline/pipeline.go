@@ -19,6 +19,7 @@ import (
 	"go.artefactual.dev/amclient"
 	ssclient "go.artefactual.dev/ssclient"
 
+	"github.com/artefactual-labs/enduro/internal/decision"
 	"github.com/artefactual-labs/enduro/internal/pipeline/sync/semaphore"
 	"github.com/artefactual-labs/enduro/internal/publisher"
 )
@@ -38,11 +39,13 @@ type Config struct {
 	ProcessingConfig     string
 	StorageServiceURL    string
 	Capacity             uint64
+	Weight               uint64
 	RetryDeadline        *time.Duration
 	StatusRequestTimeout *time.Duration
 	TransferDeadline     *time.Duration
 	Unbag                bool
 	Recovery             RecoveryConfig
+	Decision             decision.Config
 }
 
 type RecoveryConfig struct {
@@ -59,11 +62,25 @@ func (c RecoveryConfig) IsStandaloneLocation(locationID string) bool {
 	return slices.Contains(c.StandaloneLocations, locationID)
 }
 
+// weight returns the share of new transfers that the scheduler assigns to the
+// pipeline relative to the others. It defaults to one.
+func (c Config) weight() uint64 {
+	if c.Weight == 0 {
+		return 1
+	}
+
+	return c.Weight
+}
+
 func (c Config) Validate() error {
 	if err := c.TransferPublisher.Validate(); err != nil {
 		return err
 	}
 
+	if err := c.Decision.Validate(); err != nil {
+		return fmt.Errorf("invalid decision configuration: %v", err)
+	}
+
 	if !c.Recovery.ReconcileExistingAIP {
 		return nil
 	}
@@ -85,6 +102,16 @@ type Pipeline struct {
 	// A weighted semaphore to limit concurrent use of this pipeline.
 	sem *semaphore.Weighted
 
+	// Leases shared with other Enduro instances, optional. They replace the
+	// semaphore when configured.
+	leases   LeaseStore
+	leaseTTL time.Duration
+
+	// Maintenance state, persisted in the store when configured.
+	states    StateStore
+	state     State
+	stateLock sync.RWMutex
+
 	// Configuration attributes.
 	config *Config
 
@@ -215,6 +242,60 @@ func (p *Pipeline) Capacity() (size, cur int64) {
 	return p.sem.Capacity()
 }
 
+// Acquire takes a slot of the pipeline for the holder, e.g. a workflow. It
+// uses the leases when configured or the semaphore otherwise.
+func (p *Pipeline) Acquire(ctx context.Context, holder string) (bool, error) {
+	if p.leases == nil {
+		return p.TryAcquire(), nil
+	}
+
+	return p.leases.Acquire(ctx, p.config.Name, holder, int64(p.config.Capacity), p.leaseTTL)
+}
+
+// RenewLease extends the lease of the holder. It's a no-op without leases.
+func (p *Pipeline) RenewLease(ctx context.Context, holder string) error {
+	if p.leases == nil {
+		return nil
+	}
+
+	return p.leases.Renew(ctx, p.config.Name, holder, p.leaseTTL)
+}
+
+// ReleaseLease gives up the slot taken by Acquire.
+func (p *Pipeline) ReleaseLease(ctx context.Context, holder string) error {
+	if p.leases == nil {
+		p.Release()
+		return nil
+	}
+
+	return p.leases.Release(ctx, p.config.Name, holder)
+}
+
+// Usage returns the capacity of the pipeline and the number of slots in use
+// across all the Enduro instances when the leases are configured.
+func (p *Pipeline) Usage(ctx context.Context) (size, cur int64) {
+	if p.leases == nil {
+		return p.Capacity()
+	}
+
+	holders, err := p.leases.Holders(ctx, p.config.Name)
+	if err != nil {
+		p.logger.Error(err, "Error reading pipeline leases.")
+		return p.Capacity()
+	}
+
+	return int64(p.config.Capacity), int64(len(holders))
+}
+
+// Holders lists the leases of the pipeline, nil without leases.
+func (p *Pipeline) Holders(ctx context.Context) ([]Lease, error) {
+	if p.leases == nil {
+		return nil, nil
+	}
+
+	return p.leases.Holders(ctx, p.config.Name)
+}
+
 // loadStatus looks up the status of the pipeline using the HTTP client.
 // TODO: find a better way to ping the API.
 func (p *Pipeline) loadStatus(ctx context.Context) string {
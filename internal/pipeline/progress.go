@@ -0,0 +1,84 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+
+	"go.artefactual.dev/amclient"
+)
+
+const (
+	ProgressStageTransfer = "transfer"
+	ProgressStageIngest   = "ingest"
+)
+
+// Progress is a snapshot of the jobs that Archivematica has run for a unit,
+// i.e. a transfer or a SIP. Archivematica only lists the jobs that have been
+// created, so the totals grow as processing advances.
+type Progress struct {
+	// Stage is ProgressStageTransfer or ProgressStageIngest.
+	Stage string
+
+	// Microservice and name of the job in progress, or of the last job when
+	// none is in progress.
+	Microservice string
+	Job          string
+
+	JobsCompleted uint
+	JobsTotal     uint
+	FailedJobs    []FailedJob
+}
+
+// FailedJob describes a job that did not complete successfully.
+type FailedJob struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Microservice string `json:"microservice"`
+}
+
+// Percent returns the percentage of the jobs listed that completed.
+func (p Progress) Percent() uint {
+	if p.JobsTotal == 0 {
+		return 0
+	}
+
+	return p.JobsCompleted * 100 / p.JobsTotal
+}
+
+// JobsProgress returns the progress of the unit based on its jobs.
+func JobsProgress(ctx context.Context, jobsService amclient.JobsService, stage, unitID string) (*Progress, error) {
+	jobs, _, err := jobsService.List(ctx, unitID, &amclient.JobsListRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing jobs: %w", err)
+	}
+
+	progress := &Progress{
+		Stage:     stage,
+		JobsTotal: uint(len(jobs)),
+	}
+
+	var current *amclient.Job
+	for i, job := range jobs {
+		switch job.Status {
+		case amclient.JobStatusComplete:
+			progress.JobsCompleted++
+		case amclient.JobStatusFailed:
+			progress.FailedJobs = append(progress.FailedJobs, FailedJob{
+				ID:           job.ID,
+				Name:         job.Name,
+				Microservice: job.Microservice,
+			})
+		default:
+			current = &jobs[i]
+		}
+	}
+	if current == nil && len(jobs) > 0 {
+		current = &jobs[len(jobs)-1]
+	}
+	if current != nil {
+		progress.Microservice = current.Microservice
+		progress.Job = current.Name
+	}
+
+	return progress, nil
+}
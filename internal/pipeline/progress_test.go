@@ -0,0 +1,79 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.artefactual.dev/amclient"
+	"go.artefactual.dev/amclient/amclienttest"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+)
+
+func TestJobsProgress(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const unitID = "734abbaf-4e2f-4a68-938c-c8ff6420e525"
+
+	t.Run("Summarizes the jobs of the unit", func(t *testing.T) {
+		t.Parallel()
+
+		jobsService := amclienttest.NewMockJobsService(gomock.NewController(t))
+		jobsService.
+			EXPECT().
+			List(ctx, unitID, &amclient.JobsListRequest{}).
+			Return([]amclient.Job{
+				{ID: "j1", Name: "Extract zipped bag transfer", Microservice: "Extract zipped bag transfer", Status: amclient.JobStatusComplete},
+				{ID: "j2", Name: "Validate formats", Microservice: "Validation", Status: amclient.JobStatusFailed},
+				{ID: "j3", Name: "Scan for viruses", Microservice: "Scan for viruses", Status: amclient.JobStatusProcessing},
+				{ID: "j4", Name: "Assign UUIDs", Microservice: "Characterize and extract metadata", Status: amclient.JobStatusComplete},
+			}, &amclient.Response{}, nil)
+
+		progress, err := JobsProgress(ctx, jobsService, ProgressStageTransfer, unitID)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, progress, &Progress{
+			Stage:         ProgressStageTransfer,
+			Microservice:  "Scan for viruses",
+			Job:           "Scan for viruses",
+			JobsCompleted: 2,
+			JobsTotal:     4,
+			FailedJobs: []FailedJob{
+				{ID: "j2", Name: "Validate formats", Microservice: "Validation"},
+			},
+		})
+		assert.Equal(t, progress.Percent(), uint(50))
+	})
+
+	t.Run("Reports the last job when none is in progress", func(t *testing.T) {
+		t.Parallel()
+
+		jobsService := amclienttest.NewMockJobsService(gomock.NewController(t))
+		jobsService.
+			EXPECT().
+			List(ctx, unitID, &amclient.JobsListRequest{}).
+			Return([]amclient.Job{
+				{ID: "j1", Name: "Store AIP", Microservice: "Store AIP", Status: amclient.JobStatusComplete},
+			}, &amclient.Response{}, nil)
+
+		progress, err := JobsProgress(ctx, jobsService, ProgressStageIngest, unitID)
+		assert.NilError(t, err)
+		assert.Equal(t, progress.Job, "Store AIP")
+		assert.Equal(t, progress.Percent(), uint(100))
+	})
+
+	t.Run("Returns listing errors", func(t *testing.T) {
+		t.Parallel()
+
+		jobsService := amclienttest.NewMockJobsService(gomock.NewController(t))
+		jobsService.
+			EXPECT().
+			List(ctx, unitID, &amclient.JobsListRequest{}).
+			Return(nil, nil, errors.New("unavailable"))
+
+		_, err := JobsProgress(ctx, jobsService, ProgressStageIngest, unitID)
+		assert.ErrorContains(t, err, "error listing jobs: unavailable")
+		assert.Equal(t, Progress{}.Percent(), uint(0))
+	})
+}
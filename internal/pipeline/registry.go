@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 )
@@ -14,6 +15,8 @@ var ErrUnknownPipeline = errors.New("unknown pipeline")
 // Registry is a collection of known pipelines.
 type Registry struct {
 	pipelines map[string]*Pipeline
+	router    *router
+	leaseTTL  time.Duration
 	mu        sync.Mutex
 }
 
@@ -88,3 +91,89 @@ func (r *Registry) Names() []string {
 
 	return names
 }
+
+// SetLeases makes the pipelines account for their capacity with the leases
+// of the store instead of their in-process semaphores. Leases expire unless
+// they are renewed within ttl, DefaultLeaseTTL when zero.
+func (r *Registry) SetLeases(store LeaseStore, ttl time.Duration) {
+	if ttl <= 0 {
+		ttl = DefaultLeaseTTL
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.leaseTTL = ttl
+	for _, p := range r.pipelines {
+		p.leases = store
+		p.leaseTTL = ttl
+	}
+}
+
+// SetStateStore makes the pipelines persist their maintenance state in the
+// store instead of memory.
+func (r *Registry) SetStateStore(store StateStore) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, p := range r.pipelines {
+		p.states = store
+	}
+}
+
+// LeaseTTL returns the time the leases are kept without being renewed, zero
+// when the pipelines do not use leases.
+func (r *Registry) LeaseTTL() time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.leaseTTL
+}
+
+// SetRouting replaces the rules that select the pipeline of new transfers.
+func (r *Registry) SetRouting(config RoutingConfig) error {
+	router, err := newRouter(config)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.router = router
+
+	return nil
+}
+
+// RoutingNeedsSize reports whether the routing rules depend on the size of
+// the transfer.
+func (r *Registry) RoutingNeedsSize() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.router != nil && r.router.needsSize()
+}
+
+// Route evaluates the routing rules. The pipelines of the result are limited
+// to the ones known by the registry.
+func (r *Registry) Route(attrs RouteAttributes) *RouteResult {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.router == nil {
+		return &RouteResult{}
+	}
+
+	result := r.router.route(attrs)
+	if result.Rule != "" {
+		pipelines := make([]string, 0, len(result.Pipelines))
+		for _, name := range result.Pipelines {
+			if _, ok := r.pipelines[name]; ok {
+				pipelines = append(pipelines, name)
+			}
+		}
+		result.Pipelines = pipelines
+	}
+
+	return result
+}
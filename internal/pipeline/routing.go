@@ -0,0 +1,207 @@
+package pipeline
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/artefactual-labs/enduro/internal/nha"
+)
+
+var ErrRoutingConfigInvalid = errors.New("invalid routing configuration")
+
+// RoutingConfig lists the rules that select the pipeline of new transfers.
+type RoutingConfig struct {
+	Rules []RoutingRule
+}
+
+// RoutingRule selects the pipelines and the processing configuration of the
+// transfers that meet all its conditions. Empty conditions are ignored.
+type RoutingRule struct {
+	Name string
+
+	// Regular expression matched against the key (name) of the transfer.
+	Key string
+
+	// Name of the watcher that received the transfer.
+	Watcher string
+
+	// Archivematica transfer type, e.g. "standard" or "zipped bag".
+	TransferType string
+
+	// NHA transfer type inferred from the name, e.g. "DPJ" or "AVLXML".
+	NHATransferType string
+
+	// Size range of the transfer in bytes. Zero means no limit.
+	MinSize int64
+	MaxSize int64
+
+	// Names of the candidate pipelines.
+	Pipelines []string
+
+	// Processing configuration used unless one is given by the request.
+	ProcessingConfig string
+}
+
+func (c RoutingConfig) Validate(pipelines []Config) error {
+	names := make([]string, 0, len(pipelines))
+	for _, p := range pipelines {
+		names = append(names, p.Name)
+	}
+
+	seen := map[string]bool{}
+	for i, rule := range c.Rules {
+		if rule.Name == "" {
+			return fmt.Errorf("%w: rule %d: name is required", ErrRoutingConfigInvalid, i+1)
+		}
+		if seen[rule.Name] {
+			return fmt.Errorf("%w: rule %q: duplicate name", ErrRoutingConfigInvalid, rule.Name)
+		}
+		seen[rule.Name] = true
+
+		if _, err := regexp.Compile(rule.Key); err != nil {
+			return fmt.Errorf("%w: rule %q: invalid key expression: %v", ErrRoutingConfigInvalid, rule.Name, err)
+		}
+		if rule.MinSize < 0 || rule.MaxSize < 0 {
+			return fmt.Errorf("%w: rule %q: sizes cannot be negative", ErrRoutingConfigInvalid, rule.Name)
+		}
+		if rule.MaxSize > 0 && rule.MinSize > rule.MaxSize {
+			return fmt.Errorf("%w: rule %q: minSize exceeds maxSize", ErrRoutingConfigInvalid, rule.Name)
+		}
+		if len(rule.Pipelines) == 0 {
+			return fmt.Errorf("%w: rule %q: pipelines are required", ErrRoutingConfigInvalid, rule.Name)
+		}
+		for _, name := range rule.Pipelines {
+			if !slices.Contains(names, name) {
+				return fmt.Errorf("%w: rule %q: unknown pipeline %q", ErrRoutingConfigInvalid, rule.Name, name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// RouteAttributes describes the transfer evaluated by the routing rules.
+type RouteAttributes struct {
+	Key          string
+	Watcher      string
+	TransferType string
+
+	// Size of the transfer in bytes, nil when unknown. Rules with size
+	// conditions never match transfers of unknown size.
+	Size *int64
+}
+
+// RuleEvaluation explains the result of a rule.
+type RuleEvaluation struct {
+	Rule    string
+	Matched bool
+
+	// Condition that was not met, empty when the rule matched.
+	Reason string
+}
+
+// RouteResult is the result of the routing rules. Rule is empty when no rule
+// matched and the pipeline is chosen as usual.
+type RouteResult struct {
+	Rule             string
+	Pipelines        []string
+	ProcessingConfig string
+	Evaluations      []RuleEvaluation
+}
+
+// router evaluates the routing rules in order, the first rule that matches
+// selects the pipeline.
+type router struct {
+	rules []compiledRoutingRule
+}
+
+type compiledRoutingRule struct {
+	RoutingRule
+	key *regexp.Regexp
+}
+
+func newRouter(config RoutingConfig) (*router, error) {
+	r := &router{rules: make([]compiledRoutingRule, 0, len(config.Rules))}
+	for _, rule := range config.Rules {
+		compiled := compiledRoutingRule{RoutingRule: rule}
+		if rule.Key != "" {
+			key, err := regexp.Compile(rule.Key)
+			if err != nil {
+				return nil, fmt.Errorf("%w: rule %q: invalid key expression: %v", ErrRoutingConfigInvalid, rule.Name, err)
+			}
+			compiled.key = key
+		}
+		r.rules = append(r.rules, compiled)
+	}
+
+	return r, nil
+}
+
+// needsSize reports whether any rule has size conditions.
+func (r *router) needsSize() bool {
+	for _, rule := range r.rules {
+		if rule.MinSize > 0 || rule.MaxSize > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *router) route(attrs RouteAttributes) *RouteResult {
+	result := &RouteResult{Evaluations: make([]RuleEvaluation, 0, len(r.rules))}
+
+	var nhaType string
+	if info, err := nha.ParseName(attrs.Key); err == nil {
+		nhaType = info.Type.String()
+	}
+
+	for _, rule := range r.rules {
+		reason := rule.mismatch(attrs, nhaType)
+		result.Evaluations = append(result.Evaluations, RuleEvaluation{
+			Rule:    rule.Name,
+			Matched: reason == "",
+			Reason:  reason,
+		})
+		if reason == "" && result.Rule == "" {
+			result.Rule = rule.Name
+			result.Pipelines = rule.Pipelines
+			result.ProcessingConfig = rule.ProcessingConfig
+		}
+	}
+
+	return result
+}
+
+// mismatch returns the first condition of the rule that is not met by the
+// transfer, or an empty string when the rule matches.
+func (rule compiledRoutingRule) mismatch(attrs RouteAttributes, nhaType string) string {
+	if rule.key != nil && !rule.key.MatchString(attrs.Key) {
+		return fmt.Sprintf("key does not match %q", rule.Key)
+	}
+	if rule.Watcher != "" && rule.Watcher != attrs.Watcher {
+		return fmt.Sprintf("watcher is not %q", rule.Watcher)
+	}
+	if rule.TransferType != "" && !strings.EqualFold(rule.TransferType, attrs.TransferType) {
+		return fmt.Sprintf("transfer type is not %q", rule.TransferType)
+	}
+	if rule.NHATransferType != "" && !strings.EqualFold(rule.NHATransferType, nhaType) {
+		return fmt.Sprintf("NHA transfer type is not %q", rule.NHATransferType)
+	}
+	if rule.MinSize > 0 || rule.MaxSize > 0 {
+		if attrs.Size == nil {
+			return "size is unknown"
+		}
+		if *attrs.Size < rule.MinSize {
+			return fmt.Sprintf("size is smaller than %d bytes", rule.MinSize)
+		}
+		if rule.MaxSize > 0 && *attrs.Size > rule.MaxSize {
+			return fmt.Sprintf("size is larger than %d bytes", rule.MaxSize)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,141 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/enduro/internal/pipeline"
+)
+
+func TestRoutingConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	pipelines := []pipeline.Config{{Name: "am1"}, {Name: "am2"}}
+	tests := map[string]struct {
+		rules   []pipeline.RoutingRule
+		wantErr string
+	}{
+		"accepts empty configuration": {},
+		"accepts valid rules": {
+			rules: []pipeline.RoutingRule{
+				{Name: "dpj", Key: "^DPJ-", Pipelines: []string{"am1"}},
+				{Name: "large", MinSize: 10, MaxSize: 100, Pipelines: []string{"am1", "am2"}},
+			},
+		},
+		"requires name": {
+			rules:   []pipeline.RoutingRule{{Pipelines: []string{"am1"}}},
+			wantErr: "rule 1: name is required",
+		},
+		"rejects duplicate names": {
+			rules: []pipeline.RoutingRule{
+				{Name: "dpj", Pipelines: []string{"am1"}},
+				{Name: "dpj", Pipelines: []string{"am2"}},
+			},
+			wantErr: `rule "dpj": duplicate name`,
+		},
+		"rejects invalid key expression": {
+			rules:   []pipeline.RoutingRule{{Name: "dpj", Key: "(", Pipelines: []string{"am1"}}},
+			wantErr: `rule "dpj": invalid key expression`,
+		},
+		"rejects inverted size range": {
+			rules:   []pipeline.RoutingRule{{Name: "large", MinSize: 100, MaxSize: 10, Pipelines: []string{"am1"}}},
+			wantErr: `rule "large": minSize exceeds maxSize`,
+		},
+		"requires pipelines": {
+			rules:   []pipeline.RoutingRule{{Name: "dpj"}},
+			wantErr: `rule "dpj": pipelines are required`,
+		},
+		"rejects unknown pipelines": {
+			rules:   []pipeline.RoutingRule{{Name: "dpj", Pipelines: []string{"am3"}}},
+			wantErr: `rule "dpj": unknown pipeline "am3"`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := pipeline.RoutingConfig{Rules: tc.rules}.Validate(pipelines)
+			if tc.wantErr == "" {
+				assert.NilError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, pipeline.ErrRoutingConfigInvalid)
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestRegistryRoute(t *testing.T) {
+	t.Parallel()
+
+	registry, err := pipeline.NewPipelineRegistry(logr.Discard(), []pipeline.Config{
+		{Name: "am1"},
+		{Name: "am2"},
+	}, nil, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, registry.RoutingNeedsSize(), false)
+	assert.DeepEqual(t, registry.Route(pipeline.RouteAttributes{Key: "transfer.zip"}), &pipeline.RouteResult{})
+
+	err = registry.SetRouting(pipeline.RoutingConfig{
+		Rules: []pipeline.RoutingRule{
+			{Name: "avlxml", NHATransferType: "avlxml", Pipelines: []string{"am2"}},
+			{Name: "large", MinSize: 1000, Pipelines: []string{"am2", "am3"}, ProcessingConfig: "large"},
+			{Name: "watcher", Key: `\.zip$`, Watcher: "dpj-ws", TransferType: "zipped bag", Pipelines: []string{"am1"}},
+		},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, registry.RoutingNeedsSize(), true)
+
+	t.Run("Matches the first rule", func(t *testing.T) {
+		t.Parallel()
+
+		size := int64(2000)
+		got := registry.Route(pipeline.RouteAttributes{
+			Key:          "transfer.zip",
+			Watcher:      "dpj-ws",
+			TransferType: "zipped bag",
+			Size:         &size,
+		})
+
+		assert.DeepEqual(t, got, &pipeline.RouteResult{
+			Rule:             "large",
+			Pipelines:        []string{"am2"},
+			ProcessingConfig: "large",
+			Evaluations: []pipeline.RuleEvaluation{
+				{Rule: "avlxml", Reason: `NHA transfer type is not "avlxml"`},
+				{Rule: "large", Matched: true},
+				{Rule: "watcher", Matched: true},
+			},
+		})
+	})
+
+	t.Run("Matches the NHA transfer type", func(t *testing.T) {
+		t.Parallel()
+
+		got := registry.Route(pipeline.RouteAttributes{Key: "AVL-OTHER-SIP_20.100_20201231.xml.tar"})
+
+		assert.Equal(t, got.Rule, "avlxml")
+		assert.DeepEqual(t, got.Pipelines, []string{"am2"})
+	})
+
+	t.Run("Explains why no rule matched", func(t *testing.T) {
+		t.Parallel()
+
+		got := registry.Route(pipeline.RouteAttributes{
+			Key:          "transfer.zip",
+			Watcher:      "other-ws",
+			TransferType: "zipped bag",
+		})
+
+		assert.DeepEqual(t, got, &pipeline.RouteResult{
+			Evaluations: []pipeline.RuleEvaluation{
+				{Rule: "avlxml", Reason: `NHA transfer type is not "avlxml"`},
+				{Rule: "large", Reason: "size is unknown"},
+				{Rule: "watcher", Reason: `watcher is not "dpj-ws"`},
+			},
+		})
+	})
+}
@@ -0,0 +1,187 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+)
+
+// Candidate describes a pipeline considered by the scheduler.
+type Candidate struct {
+	Name     string
+	State    State
+	Status   string
+	Capacity int64
+	InUse    int64
+	Weight   uint64
+
+	// Whether the pipeline was chosen.
+	Selected bool
+
+	// Why the pipeline was not eligible, empty otherwise.
+	Reason string
+}
+
+func (c Candidate) free() bool {
+	return c.InUse < c.Capacity
+}
+
+func (c Candidate) running() bool {
+	return c.State == StateRunning
+}
+
+func (c Candidate) active() bool {
+	return c.running() && c.Status == "active"
+}
+
+// unavailability returns why the pipeline is not running or active.
+func (c Candidate) unavailability() string {
+	if !c.running() {
+		return string(c.State)
+	}
+
+	return c.Status
+}
+
+// Selection is the decision made by the scheduler.
+type Selection struct {
+	// Name of the selected pipeline, empty when there were no candidates.
+	Pipeline   string
+	Candidates []Candidate
+}
+
+// String explains the selection in a single line, e.g.:
+//
+//	am2 (active, 1/3 in use, weight 2); skipped am1 (unavailable)
+func (s Selection) String() string {
+	if s.Pipeline == "" {
+		return "no pipeline available"
+	}
+
+	var chosen string
+	var skipped []string
+	for _, c := range s.Candidates {
+		if c.Selected {
+			chosen = fmt.Sprintf("%s (%s, %d/%d in use, weight %d)", c.Name, c.Status, c.InUse, c.Capacity, c.Weight)
+		} else if c.Reason != "" {
+			skipped = append(skipped, fmt.Sprintf("%s (%s)", c.Name, c.Reason))
+		}
+	}
+	if len(skipped) == 0 {
+		return chosen
+	}
+
+	return chosen + "; skipped " + strings.Join(skipped, ", ")
+}
+
+// Chosen returns the candidate that was selected, if any.
+func (s Selection) Chosen() (Candidate, bool) {
+	for _, c := range s.Candidates {
+		if c.Selected {
+			return c, true
+		}
+	}
+
+	return Candidate{}, false
+}
+
+// Select chooses the pipeline for a new transfer among the given names, or
+// among all the pipelines when the list is empty. Active pipelines with free
+// slots are preferred, then the rest of the active pipelines. Pipelines are
+// picked randomly in proportion to their weights. Paused and draining
+// pipelines are avoided. When none of the pipelines is running and active one
+// is still chosen so the transfer waits for it.
+func (r *Registry) Select(ctx context.Context, names []string) *Selection {
+	if len(names) == 0 {
+		names = r.Names()
+		slices.Sort(names)
+	}
+
+	candidates := make([]Candidate, 0, len(names))
+	for _, name := range names {
+		p, err := r.ByName(name)
+		if err != nil {
+			candidates = append(candidates, Candidate{Name: name, Reason: "unknown pipeline"})
+			continue
+		}
+		size, cur := p.Usage(ctx)
+		candidates = append(candidates, Candidate{
+			Name:     name,
+			State:    p.State(ctx),
+			Status:   p.Status(ctx),
+			Capacity: size,
+			InUse:    cur,
+			Weight:   p.Config().weight(),
+		})
+	}
+
+	return schedule(candidates, rand.Uint64N)
+}
+
+// schedule picks one of the candidates. pick returns a number in [0, n).
+func schedule(candidates []Candidate, pick func(n uint64) uint64) *Selection {
+	tiers := []struct {
+		eligible func(Candidate) bool
+		reason   func(Candidate) string
+	}{
+		{
+			eligible: func(c Candidate) bool { return c.active() && c.free() },
+			reason: func(c Candidate) string {
+				if !c.active() {
+					return c.unavailability()
+				}
+				return "no free slots"
+			},
+		},
+		{
+			eligible: Candidate.active,
+			reason:   Candidate.unavailability,
+		},
+		{
+			eligible: Candidate.running,
+			reason:   Candidate.unavailability,
+		},
+		{
+			eligible: func(c Candidate) bool { return true },
+		},
+	}
+
+	known := slices.DeleteFunc(slices.Clone(candidates), func(c Candidate) bool {
+		return c.Reason != ""
+	})
+	for _, tier := range tiers {
+		var total uint64
+		for _, c := range known {
+			if tier.eligible(c) {
+				total += c.Weight
+			}
+		}
+		if total == 0 {
+			continue
+		}
+
+		n := pick(total)
+		selection := &Selection{Candidates: candidates}
+		for i, c := range candidates {
+			if c.Reason != "" {
+				continue
+			}
+			if !tier.eligible(c) {
+				candidates[i].Reason = tier.reason(c)
+				continue
+			}
+			if selection.Pipeline == "" && n < c.Weight {
+				selection.Pipeline = c.Name
+				candidates[i].Selected = true
+				continue
+			}
+			n -= min(n, c.Weight)
+		}
+
+		return selection
+	}
+
+	return &Selection{Candidates: candidates}
+}
@@ -0,0 +1,185 @@
+package pipeline
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"gotest.tools/v3/assert"
+)
+
+func TestSchedule(t *testing.T) {
+	t.Parallel()
+
+	first := func(n uint64) uint64 { return 0 }
+	last := func(n uint64) uint64 { return n - 1 }
+
+	tests := map[string]struct {
+		candidates []Candidate
+		pick       func(n uint64) uint64
+		want       *Selection
+	}{
+		"Prefers pipelines with free slots": {
+			candidates: []Candidate{
+				{Name: "am1", State: StateRunning, Status: "active", Capacity: 1, InUse: 1, Weight: 1},
+				{Name: "am2", State: StateRunning, Status: "active", Capacity: 3, InUse: 1, Weight: 1},
+			},
+			pick: first,
+			want: &Selection{
+				Pipeline: "am2",
+				Candidates: []Candidate{
+					{Name: "am1", State: StateRunning, Status: "active", Capacity: 1, InUse: 1, Weight: 1, Reason: "no free slots"},
+					{Name: "am2", State: StateRunning, Status: "active", Capacity: 3, InUse: 1, Weight: 1, Selected: true},
+				},
+			},
+		},
+		"Skips pipelines that are not active": {
+			candidates: []Candidate{
+				{Name: "am1", State: StateRunning, Status: "unavailable", Capacity: 3, Weight: 1},
+				{Name: "am2", State: StateRunning, Status: "active", Capacity: 1, InUse: 1, Weight: 1},
+				{Name: "am3", Reason: "unknown pipeline"},
+			},
+			pick: first,
+			want: &Selection{
+				Pipeline: "am2",
+				Candidates: []Candidate{
+					{Name: "am1", State: StateRunning, Status: "unavailable", Capacity: 3, Weight: 1, Reason: "unavailable"},
+					{Name: "am2", State: StateRunning, Status: "active", Capacity: 1, InUse: 1, Weight: 1, Selected: true},
+					{Name: "am3", Reason: "unknown pipeline"},
+				},
+			},
+		},
+		"Falls back to unavailable pipelines": {
+			candidates: []Candidate{
+				{Name: "am1", State: StateRunning, Status: "unavailable", Capacity: 3, Weight: 1},
+			},
+			pick: first,
+			want: &Selection{
+				Pipeline: "am1",
+				Candidates: []Candidate{
+					{Name: "am1", State: StateRunning, Status: "unavailable", Capacity: 3, Weight: 1, Selected: true},
+				},
+			},
+		},
+		"Avoids paused and draining pipelines": {
+			candidates: []Candidate{
+				{Name: "am1", State: StatePaused, Status: "active", Capacity: 3, Weight: 1},
+				{Name: "am2", State: StateDraining, Status: "active", Capacity: 3, Weight: 1},
+				{Name: "am3", State: StateRunning, Status: "active", Capacity: 1, InUse: 1, Weight: 1},
+			},
+			pick: first,
+			want: &Selection{
+				Pipeline: "am3",
+				Candidates: []Candidate{
+					{Name: "am1", State: StatePaused, Status: "active", Capacity: 3, Weight: 1, Reason: "paused"},
+					{Name: "am2", State: StateDraining, Status: "active", Capacity: 3, Weight: 1, Reason: "draining"},
+					{Name: "am3", State: StateRunning, Status: "active", Capacity: 1, InUse: 1, Weight: 1, Selected: true},
+				},
+			},
+		},
+		"Falls back to paused pipelines": {
+			candidates: []Candidate{
+				{Name: "am1", State: StatePaused, Status: "active", Capacity: 3, Weight: 1},
+			},
+			pick: first,
+			want: &Selection{
+				Pipeline: "am1",
+				Candidates: []Candidate{
+					{Name: "am1", State: StatePaused, Status: "active", Capacity: 3, Weight: 1, Selected: true},
+				},
+			},
+		},
+		"Distributes by weight": {
+			candidates: []Candidate{
+				{Name: "am1", State: StateRunning, Status: "active", Capacity: 3, Weight: 1},
+				{Name: "am2", State: StateRunning, Status: "active", Capacity: 3, Weight: 3},
+			},
+			pick: last,
+			want: &Selection{
+				Pipeline: "am2",
+				Candidates: []Candidate{
+					{Name: "am1", State: StateRunning, Status: "active", Capacity: 3, Weight: 1},
+					{Name: "am2", State: StateRunning, Status: "active", Capacity: 3, Weight: 3, Selected: true},
+				},
+			},
+		},
+		"Returns no pipeline without candidates": {
+			candidates: []Candidate{{Name: "am3", Reason: "unknown pipeline"}},
+			pick:       first,
+			want: &Selection{
+				Candidates: []Candidate{{Name: "am3", Reason: "unknown pipeline"}},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.DeepEqual(t, schedule(tc.candidates, tc.pick), tc.want)
+		})
+	}
+}
+
+func TestScheduleWeights(t *testing.T) {
+	t.Parallel()
+
+	candidates := func() []Candidate {
+		return []Candidate{
+			{Name: "am1", State: StateRunning, Status: "active", Capacity: 1, Weight: 1},
+			{Name: "am2", State: StateRunning, Status: "active", Capacity: 1, Weight: 3},
+		}
+	}
+
+	counts := map[string]int{}
+	for n := range uint64(4) {
+		selection := schedule(candidates(), func(uint64) uint64 { return n })
+		counts[selection.Pipeline]++
+	}
+
+	assert.DeepEqual(t, counts, map[string]int{"am1": 1, "am2": 3})
+}
+
+func TestSelectionString(t *testing.T) {
+	t.Parallel()
+
+	s := Selection{
+		Pipeline: "am2",
+		Candidates: []Candidate{
+			{Name: "am1", State: StateRunning, Status: "unavailable", Reason: "unavailable"},
+			{Name: "am2", State: StateRunning, Status: "active", Capacity: 3, InUse: 1, Weight: 2, Selected: true},
+			{Name: "am3", State: StateRunning, Status: "active", Capacity: 3, InUse: 2, Weight: 1},
+		},
+	}
+	assert.Equal(t, s.String(), "am2 (active, 1/3 in use, weight 2); skipped am1 (unavailable)")
+	assert.Equal(t, Selection{}.String(), "no pipeline available")
+}
+
+func TestRegistrySelect(t *testing.T) {
+	t.Parallel()
+
+	registry, err := NewPipelineRegistry(logr.Discard(), []Config{
+		{Name: "am1", Capacity: 1},
+		{Name: "am2", Capacity: 2, Weight: 2},
+	}, nil, nil)
+	assert.NilError(t, err)
+
+	for _, p := range registry.List() {
+		p.status = "active"
+		p.statusUpdatedAt = time.Now()
+	}
+	am1, _ := registry.ByName("am1")
+	assert.Assert(t, am1.TryAcquire())
+
+	got := registry.Select(context.Background(), []string{"am1", "am2", "am3"})
+
+	assert.DeepEqual(t, got, &Selection{
+		Pipeline: "am2",
+		Candidates: []Candidate{
+			{Name: "am1", State: StateRunning, Status: "active", Capacity: 1, InUse: 1, Weight: 1, Reason: "no free slots"},
+			{Name: "am2", State: StateRunning, Status: "active", Capacity: 2, Weight: 2, Selected: true},
+			{Name: "am3", Reason: "unknown pipeline"},
+		},
+	})
+}
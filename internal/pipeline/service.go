@@ -14,6 +14,10 @@ type Service interface {
 	List(context.Context, *goapipeline.ListPayload) ([]*goapipeline.EnduroStoredPipeline, error)
 	Show(context.Context, *goapipeline.ShowPayload) (*goapipeline.EnduroStoredPipeline, error)
 	Processing(context.Context, *goapipeline.ProcessingPayload) ([]string, error)
+	Route(context.Context, *goapipeline.RoutePayload) (*goapipeline.EnduroPipelineRoute, error)
+	Pause(context.Context, *goapipeline.PausePayload) (*goapipeline.EnduroStoredPipeline, error)
+	Drain(context.Context, *goapipeline.DrainPayload) (*goapipeline.EnduroStoredPipeline, error)
+	Resume(context.Context, *goapipeline.ResumePayload) (*goapipeline.EnduroStoredPipeline, error)
 }
 
 type pipelineImpl struct {
@@ -37,7 +41,7 @@ func (w *pipelineImpl) List(ctx context.Context, payload *goapipeline.ListPayloa
 	var wg sync.WaitGroup
 
 	for _, p := range pipelines {
-		r := buildStoredPipeline(p)
+		r := buildStoredPipeline(ctx, p)
 		if payload.Status {
 			wg.Add(1)
 			go func(p *Pipeline, r *goapipeline.EnduroStoredPipeline) {
@@ -59,19 +63,66 @@ func (w *pipelineImpl) Show(ctx context.Context, payload *goapipeline.ShowPayloa
 		return nil, err
 	}
 
-	result := buildStoredPipeline(pipeline)
+	result := buildStoredPipeline(ctx, pipeline)
 	setStoredPipelineStatus(ctx, pipeline, result)
 
+	holders, err := pipeline.Holders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if holders != nil {
+		result.Holders = make([]*goapipeline.EnduroPipelineLease, 0, len(holders))
+		for _, lease := range holders {
+			result.Holders = append(result.Holders, &goapipeline.EnduroPipelineLease{
+				Holder:     lease.Holder,
+				Worker:     lease.Worker,
+				AcquiredAt: lease.AcquiredAt.UTC().Format(time.RFC3339),
+				ExpiresAt:  lease.ExpiresAt.UTC().Format(time.RFC3339),
+			})
+		}
+	}
+
 	return result, nil
 }
 
-func buildStoredPipeline(pipeline *Pipeline) *goapipeline.EnduroStoredPipeline {
+// Pause holds the new transfers of the pipeline.
+func (w *pipelineImpl) Pause(ctx context.Context, payload *goapipeline.PausePayload) (*goapipeline.EnduroStoredPipeline, error) {
+	return w.setState(ctx, payload.ID, StatePaused)
+}
+
+// Drain sends the new transfers of the pipeline to other pipelines.
+func (w *pipelineImpl) Drain(ctx context.Context, payload *goapipeline.DrainPayload) (*goapipeline.EnduroStoredPipeline, error) {
+	return w.setState(ctx, payload.ID, StateDraining)
+}
+
+// Resume sends new transfers to the pipeline again.
+func (w *pipelineImpl) Resume(ctx context.Context, payload *goapipeline.ResumePayload) (*goapipeline.EnduroStoredPipeline, error) {
+	return w.setState(ctx, payload.ID, StateRunning)
+}
+
+func (w *pipelineImpl) setState(ctx context.Context, ID string, state State) (*goapipeline.EnduroStoredPipeline, error) {
+	pipeline, err := w.registry.ByID(ID)
+	if err != nil {
+		return nil, &goapipeline.PipelineNotFound{Message: "not_found", ID: ID}
+	}
+
+	if err := pipeline.SetState(ctx, state); err != nil {
+		return nil, err
+	}
+	w.logger.Info("Pipeline state changed.", "pipeline", pipeline.Config().Name, "state", state)
+
+	return buildStoredPipeline(ctx, pipeline), nil
+}
+
+func buildStoredPipeline(ctx context.Context, pipeline *Pipeline) *goapipeline.EnduroStoredPipeline {
 	c := pipeline.Config()
-	size, cur := pipeline.Capacity()
+	size, cur := pipeline.Usage(ctx)
+	state := string(pipeline.State(ctx))
 	result := &goapipeline.EnduroStoredPipeline{
 		Name:     c.Name,
 		Capacity: &size,
 		Current:  &cur,
+		State:    &state,
 	}
 	if pipeline.ID != "" {
 		result.ID = &pipeline.ID
@@ -99,3 +150,42 @@ func (w *pipelineImpl) Processing(ctx context.Context, payload *goapipeline.Proc
 
 	return ret, err
 }
+
+// Route evaluates the routing rules without starting a transfer.
+func (w *pipelineImpl) Route(ctx context.Context, payload *goapipeline.RoutePayload) (*goapipeline.EnduroPipelineRoute, error) {
+	attrs := RouteAttributes{
+		Key:          payload.Key,
+		TransferType: payload.TransferType,
+		Size:         payload.Size,
+	}
+	if payload.Watcher != nil {
+		attrs.Watcher = *payload.Watcher
+	}
+
+	result := w.registry.Route(attrs)
+	route := &goapipeline.EnduroPipelineRoute{
+		Pipelines:   result.Pipelines,
+		Evaluations: make([]*goapipeline.EnduroPipelineRuleEvaluation, 0, len(result.Evaluations)),
+	}
+	if route.Pipelines == nil {
+		route.Pipelines = []string{}
+	}
+	if result.Rule != "" {
+		route.Rule = &result.Rule
+	}
+	if result.ProcessingConfig != "" {
+		route.ProcessingConfig = &result.ProcessingConfig
+	}
+	for _, evaluation := range result.Evaluations {
+		item := &goapipeline.EnduroPipelineRuleEvaluation{
+			Rule:    evaluation.Rule,
+			Matched: evaluation.Matched,
+		}
+		if evaluation.Reason != "" {
+			item.Reason = &evaluation.Reason
+		}
+		route.Evaluations = append(route.Evaluations, item)
+	}
+
+	return route, nil
+}
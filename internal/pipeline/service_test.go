@@ -62,6 +62,70 @@ func TestService(t *testing.T) {
 	assert.DeepEqual(t, processingresp, []string{"automated", "default"})
 }
 
+func TestServiceRoute(t *testing.T) {
+	t.Parallel()
+
+	registry, err := NewPipelineRegistry(logr.Discard(), []Config{{Name: "am1"}, {Name: "am2"}}, nil, nil)
+	assert.NilError(t, err)
+	assert.NilError(t, registry.SetRouting(RoutingConfig{
+		Rules: []RoutingRule{
+			{Name: "large", MinSize: 1000, Pipelines: []string{"am2"}, ProcessingConfig: "large"},
+			{Name: "zip", Key: `\.zip$`, Pipelines: []string{"am1"}},
+		},
+	}))
+	svc := NewService(logr.Discard(), registry)
+
+	route, err := svc.Route(context.Background(), &goapipeline.RoutePayload{
+		Key:          "transfer.zip",
+		TransferType: "standard",
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, route, &goapipeline.EnduroPipelineRoute{
+		Rule:      new("zip"),
+		Pipelines: []string{"am1"},
+		Evaluations: []*goapipeline.EnduroPipelineRuleEvaluation{
+			{Rule: "large", Matched: false, Reason: new("size is unknown")},
+			{Rule: "zip", Matched: true},
+		},
+	})
+
+	route, err = svc.Route(context.Background(), &goapipeline.RoutePayload{
+		Key:          "transfer.tar",
+		TransferType: "standard",
+		Size:         new(int64(2000)),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, *route.Rule, "large")
+	assert.Equal(t, *route.ProcessingConfig, "large")
+}
+
+func TestServiceMaintenance(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ID := "9f5b1c3e-6d2a-4f7b-8e1c-2a3b4c5d6e7f"
+	registry, err := NewPipelineRegistry(logr.Discard(), []Config{{Name: "am1", ID: ID}}, nil, nil)
+	assert.NilError(t, err)
+	svc := NewService(logr.Discard(), registry)
+	p, _ := registry.ByName("am1")
+
+	stored, err := svc.Drain(ctx, &goapipeline.DrainPayload{ID: ID})
+	assert.NilError(t, err)
+	assert.Equal(t, *stored.State, "draining")
+
+	stored, err = svc.Pause(ctx, &goapipeline.PausePayload{ID: ID})
+	assert.NilError(t, err)
+	assert.Equal(t, *stored.State, "paused")
+	assert.Equal(t, p.State(ctx), StatePaused)
+
+	stored, err = svc.Resume(ctx, &goapipeline.ResumePayload{ID: ID})
+	assert.NilError(t, err)
+	assert.Equal(t, *stored.State, "running")
+
+	_, err = svc.Pause(ctx, &goapipeline.PausePayload{ID: "f6e3a2f1-43b8-4ae6-9b39-3d1bc4d9b0c4"})
+	assert.ErrorType(t, err, &goapipeline.PipelineNotFound{})
+}
+
 func amserver(t *testing.T) *httptest.Server {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprintln(w, `{"processing_configurations": ["automated", "default"]}`)
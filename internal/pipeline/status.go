@@ -13,8 +13,23 @@ var (
 	ErrStatusNonRetryable = errors.New("non retryable error")
 	ErrStatusRetryable    = errors.New("retryable error")
 	ErrStatusInProgress   = errors.New("waitable error")
+
+	// ErrStatusAwaitingDecision indicates that processing is halted until a
+	// user decides how to proceed in Archivematica, e.g. during manual
+	// appraisal. The error message describes the awaited decision.
+	ErrStatusAwaitingDecision = errors.New("awaiting decision")
 )
 
+// StateError reports a transfer or ingest state that processing can't recover
+// from, e.g. FAILED or REJECTED.
+type StateError struct {
+	State string
+}
+
+func (e *StateError) Error() string {
+	return e.State
+}
+
 // processStatusError enriches errors returned by Transfer.Status and
 // Ingest.Status and determines whether they should be retried.
 func processStatusError(err error) error {
@@ -61,14 +76,14 @@ func TransferStatus(ctx context.Context, transferService amclient.TransferServic
 	// State that we can't handle.
 	default:
 		fallthrough
+	case status.Status == "FAILED" || status.Status == "REJECTED":
+		return "", fmt.Errorf("error checking transfer status (%w): transfer is in a state that we can't handle: %w", ErrStatusNonRetryable, &StateError{State: status.Status})
+
+	// States that depend on a user decision in Archivematica.
 	case status.Status == "COMPLETE" && status.SIPID == "BACKLOG":
-		// TODO: (not in POC) SIP arrangement.
-		fallthrough
+		return "", fmt.Errorf("transfer was sent to the backlog, a SIP must be arranged in Archivematica (%w)", ErrStatusAwaitingDecision)
 	case status.Status == "USER_INPUT":
-		// TODO: (not in POC) User interactions with workflow.
-		fallthrough
-	case status.Status == "FAILED" || status.Status == "REJECTED":
-		return "", fmt.Errorf("error checking transfer status (%w): transfer is in a state that we can't handle: %s", ErrStatusNonRetryable, status.Status)
+		return "", fmt.Errorf("transfer is awaiting a decision in Archivematica: %s (%w)", microservice(status.Microservice), ErrStatusAwaitingDecision)
 
 	// Processing state where we want to keep waiting.
 	case status.Status == "COMPLETE" && status.SIPID == "":
@@ -102,9 +117,10 @@ func IngestStatus(ctx context.Context, ingestService amclient.IngestService, ID
 
 	default:
 		fallthrough
-	case "USER_INPUT", "FAILED", "REJECTED":
-		// TODO: (not in POC) User interactions with workflow.
-		return fmt.Errorf("error checking ingest status (%w): ingest is in a state that we can't handle: %s", ErrStatusNonRetryable, status.Status)
+	case "FAILED", "REJECTED":
+		return fmt.Errorf("error checking ingest status (%w): ingest is in a state that we can't handle: %w", ErrStatusNonRetryable, &StateError{State: status.Status})
+	case "USER_INPUT":
+		return fmt.Errorf("ingest is awaiting a decision in Archivematica: %s (%w)", microservice(status.Microservice), ErrStatusAwaitingDecision)
 	case "PROCESSING":
 		return ErrStatusInProgress
 	case "COMPLETE":
@@ -112,3 +128,11 @@ func IngestStatus(ctx context.Context, ingestService amclient.IngestService, ID
 
 	}
 }
+
+func microservice(name string) string {
+	if name == "" {
+		return "unknown microservice"
+	}
+
+	return name
+}
@@ -140,7 +140,7 @@ func TestTransferStatus(t *testing.T) {
 			},
 			wantErr: ErrStatusNonRetryable,
 		},
-		"It returns a ErrStatusNonRetryable when the API reports that the transfer is in backlog": {
+		"It returns a ErrStatusAwaitingDecision when the API reports that the transfer is in backlog": {
 			fakefn: func(tsfake *amclienttest.MockTransferService) {
 				tsfake.
 					EXPECT().
@@ -154,20 +154,20 @@ func TestTransferStatus(t *testing.T) {
 						nil,
 					)
 			},
-			wantErr: ErrStatusNonRetryable,
+			wantErr: ErrStatusAwaitingDecision,
 		},
-		"It returns a ErrStatusNonRetryable when the API reports that the transfer requires user input": {
+		"It returns a ErrStatusAwaitingDecision when the API reports that the transfer requires user input": {
 			fakefn: func(tsfake *amclienttest.MockTransferService) {
 				tsfake.
 					EXPECT().
 					Status(gomock.Eq(ctx), gomock.Eq(tid)).
 					Return(
-						&amclient.TransferStatusResponse{Status: "USER_INPUT"},
+						&amclient.TransferStatusResponse{Status: "USER_INPUT", Microservice: "Create SIP from Transfer"},
 						&amclient.Response{},
 						nil,
 					)
 			},
-			wantErr: ErrStatusNonRetryable,
+			wantErr: ErrStatusAwaitingDecision,
 		},
 		"It returns a ErrStatusInProgress when the API reports in-progress status": {
 			fakefn: func(tsfake *amclienttest.MockTransferService) {
@@ -351,7 +351,7 @@ func TestIngestStatus(t *testing.T) {
 			},
 			wantErr: ErrStatusNonRetryable,
 		},
-		"It returns ErrStatusNonRetryable when the API reports USER_INPUT status": {
+		"It returns ErrStatusAwaitingDecision when the API reports USER_INPUT status": {
 			fakefn: func(isfake *amclienttest.MockIngestService) {
 				isfake.
 					EXPECT().
@@ -362,7 +362,7 @@ func TestIngestStatus(t *testing.T) {
 						nil,
 					)
 			},
-			wantErr: ErrStatusNonRetryable,
+			wantErr: ErrStatusAwaitingDecision,
 		},
 		"It returns ErrStatusNonRetryable when the API reports FAILED status": {
 			fakefn: func(isfake *amclienttest.MockIngestService) {
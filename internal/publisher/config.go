@@ -3,6 +3,7 @@ package publisher
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
 	"strings"
 )
 
@@ -18,6 +19,28 @@ type Config struct {
 	HostKey               string
 	KnownHostsFile        string
 	InsecureIgnoreHostKey bool
+
+	// Verification enables the verification of the files published via SFTP:
+	// "exec" runs sha256sum on the server via SSH, "read" reads the files
+	// back over SFTP. Disabled when empty.
+	Verification string
+
+	// ResumeChecksum makes the SFTP publisher compare the SHA-256 digests of
+	// the files left in the staging location by a previous attempt before
+	// reusing them, instead of relying on the progress reported by that
+	// attempt.
+	ResumeChecksum bool
+
+	// S3 publisher settings.
+	Bucket    string
+	Region    string
+	Endpoint  string
+	PathStyle bool
+	Profile   string
+	Key       string
+	Secret    string
+	Token     string
+	PartSize  int64
 }
 
 type PrivateKeyConfig struct {
@@ -36,10 +59,17 @@ func (c Config) Validate() error {
 
 	switch c.Type {
 	case "sftp":
+		return c.validateSFTP()
+	case "s3":
+		return c.validateS3()
+	case "filesystem":
+		return c.validateFilesystem()
 	default:
 		return fmt.Errorf("invalid transfer publisher type %q", c.Type)
 	}
+}
 
+func (c Config) validateSFTP() error {
 	if strings.TrimSpace(c.Host) == "" {
 		return errors.New("invalid transfer publisher configuration: host is required")
 	}
@@ -55,6 +85,36 @@ func (c Config) Validate() error {
 	if !c.InsecureIgnoreHostKey && strings.TrimSpace(c.HostKey) == "" && strings.TrimSpace(c.KnownHostsFile) == "" {
 		return errors.New("invalid transfer publisher configuration: hostKey or knownHostsFile is required unless insecureIgnoreHostKey is enabled")
 	}
+	switch c.Verification {
+	case "", VerificationExec, VerificationRead:
+	default:
+		return fmt.Errorf("invalid transfer publisher configuration: unsupported verification %q", c.Verification)
+	}
+
+	return nil
+}
+
+func (c Config) validateS3() error {
+	if strings.TrimSpace(c.Bucket) == "" {
+		return errors.New("invalid transfer publisher configuration: bucket is required")
+	}
+	if c.PartSize != 0 && c.PartSize < minS3PartSize {
+		return fmt.Errorf("invalid transfer publisher configuration: partSize must be at least %d bytes", minS3PartSize)
+	}
+	if (c.Key == "") != (c.Secret == "") {
+		return errors.New("invalid transfer publisher configuration: key and secret must be configured together")
+	}
+
+	return nil
+}
+
+func (c Config) validateFilesystem() error {
+	if strings.TrimSpace(c.RemoteDir) == "" {
+		return errors.New("invalid transfer publisher configuration: remoteDir is required")
+	}
+	if !filepath.IsAbs(c.RemoteDir) {
+		return errors.New("invalid transfer publisher configuration: remoteDir must be an absolute path")
+	}
 
 	return nil
 }
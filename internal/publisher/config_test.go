@@ -63,6 +63,64 @@ func TestConfigValidate(t *testing.T) {
 			},
 			errContains: "hostKey or knownHostsFile is required",
 		},
+		"SFTP rejects unknown verification": {
+			cfg: Config{
+				Type:                  "sftp",
+				Host:                  "ambox",
+				User:                  "archivematica",
+				Password:              "12345",
+				InsecureIgnoreHostKey: true,
+				Verification:          "md5",
+			},
+			errContains: `unsupported verification "md5"`,
+		},
+		"S3 accepts a bucket": {
+			cfg: Config{
+				Type:   "s3",
+				Bucket: "transfers",
+			},
+		},
+		"S3 requires bucket": {
+			cfg: Config{
+				Type: "s3",
+			},
+			errContains: "bucket is required",
+		},
+		"S3 rejects small part sizes": {
+			cfg: Config{
+				Type:     "s3",
+				Bucket:   "transfers",
+				PartSize: 1024,
+			},
+			errContains: "partSize must be at least 5242880 bytes",
+		},
+		"S3 requires key and secret together": {
+			cfg: Config{
+				Type:   "s3",
+				Bucket: "transfers",
+				Key:    "minio",
+			},
+			errContains: "key and secret must be configured together",
+		},
+		"Filesystem accepts an absolute remoteDir": {
+			cfg: Config{
+				Type:      "filesystem",
+				RemoteDir: "/mnt/transfers",
+			},
+		},
+		"Filesystem requires remoteDir": {
+			cfg: Config{
+				Type: "filesystem",
+			},
+			errContains: "remoteDir is required",
+		},
+		"Filesystem requires an absolute remoteDir": {
+			cfg: Config{
+				Type:      "filesystem",
+				RemoteDir: "transfers",
+			},
+			errContains: "remoteDir must be an absolute path",
+		},
 		"Unsupported type is rejected": {
 			cfg: Config{
 				Type: "nfs",
@@ -0,0 +1,193 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// filesystemPublisher copies transfers into a directory of the local
+// filesystem, e.g. a transfer source mounted over NFS. Transfers are copied
+// into a hidden staging directory next to their final location and renamed
+// into place once the copy has been verified, so Archivematica never sees a
+// partial transfer.
+type filesystemPublisher struct {
+	cfg      Config
+	progress func(Progress)
+}
+
+func (p *filesystemPublisher) Publish(ctx context.Context, localPath, relPath string) (*PublishedTransfer, error) {
+	relPath, err := cleanRelPath(relPath)
+	if err != nil {
+		return nil, nonRetryable(err)
+	}
+
+	remotePath := filepath.Join(p.cfg.RemoteDir, filepath.FromSlash(relPath))
+	submittedPath := filepath.ToSlash(filepath.Join(p.cfg.SubmittedPathPrefix, filepath.FromSlash(relPath)))
+
+	if err := p.publish(ctx, localPath, remotePath); err != nil {
+		return nil, err
+	}
+
+	return &PublishedTransfer{
+		RelPath:    submittedPath,
+		RemotePath: remotePath,
+	}, nil
+}
+
+func (p *filesystemPublisher) Delete(ctx context.Context, remotePath string) error {
+	if remotePath == "" {
+		return nil
+	}
+
+	if err := os.RemoveAll(stagingPath(remotePath)); err != nil {
+		return fmt.Errorf("remove temporary published transfer: %w", err)
+	}
+	if err := os.RemoveAll(remotePath); err != nil {
+		return fmt.Errorf("remove published transfer: %w", err)
+	}
+
+	return nil
+}
+
+func (p *filesystemPublisher) publish(ctx context.Context, localPath, remotePath string) error {
+	stat, err := os.Stat(localPath)
+	if err != nil {
+		return nonRetryable(LocalTransferMissingError{Path: localPath, err: err})
+	}
+
+	tempPath := stagingPath(remotePath)
+	if err := os.RemoveAll(tempPath); err != nil {
+		return fmt.Errorf("remove temporary published transfer: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(remotePath), 0o750); err != nil {
+		return fmt.Errorf("create published transfer parent: %w", err)
+	}
+
+	if stat.IsDir() {
+		err = p.copyDir(ctx, localPath, tempPath)
+	} else {
+		err = p.copyFile(ctx, localPath, tempPath, stat.Mode())
+	}
+	if err != nil {
+		_ = os.RemoveAll(tempPath)
+		return err
+	}
+
+	if err := os.RemoveAll(remotePath); err != nil {
+		return fmt.Errorf("remove previous published transfer: %w", err)
+	}
+	if err := os.Rename(tempPath, remotePath); err != nil {
+		return fmt.Errorf("publish transfer: %w", err)
+	}
+
+	return nil
+}
+
+func (p *filesystemPublisher) copyDir(ctx context.Context, localDir, remoteDir string) error {
+	return filepath.WalkDir(localDir, func(localPath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("walk local transfer: %w", err)
+		}
+
+		if entry.Type()&os.ModeSymlink != 0 {
+			return nonRetryable(fmt.Errorf("filesystem transfer publisher does not support symlinks: %s", localPath))
+		}
+
+		rel, err := filepath.Rel(localDir, localPath)
+		if err != nil {
+			return nonRetryable(fmt.Errorf("calculate local transfer path: %w", err))
+		}
+		remotePath := filepath.Join(remoteDir, rel)
+
+		info, err := entry.Info()
+		if err != nil {
+			return fmt.Errorf("stat local transfer path: %w", err)
+		}
+
+		if entry.IsDir() {
+			p.report(localPath, 0)
+			if err := os.MkdirAll(remotePath, info.Mode().Perm()|0o700); err != nil {
+				return fmt.Errorf("create published directory: %w", err)
+			}
+			return nil
+		}
+
+		return p.copyFile(ctx, localPath, remotePath, info.Mode())
+	})
+}
+
+// copyFile copies a file and verifies that the size and the SHA-256 digest of
+// the copy match the source.
+func (p *filesystemPublisher) copyFile(ctx context.Context, localPath, remotePath string, mode os.FileMode) error {
+	p.report(localPath, 0)
+
+	src, err := os.Open(localPath) // #nosec G304 -- path is the transfer being published.
+	if err != nil {
+		return fmt.Errorf("open local transfer file: %w", err)
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(remotePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode.Perm()|0o600) // #nosec G304 -- path is built from administrator config.
+	if err != nil {
+		return fmt.Errorf("create published transfer file: %w", err)
+	}
+
+	h := sha256.New()
+	size, copyErr := io.Copy(dst, io.TeeReader(newProgressReader(ctx, src, localPath, p.report), h))
+	var syncErr error
+	if copyErr == nil {
+		syncErr = dst.Sync()
+	}
+	closeErr := dst.Close()
+	if copyErr != nil {
+		return fmt.Errorf("copy transfer file: %w", copyErr)
+	}
+	if syncErr != nil {
+		return fmt.Errorf("sync published transfer file: %w", syncErr)
+	}
+	if closeErr != nil {
+		return fmt.Errorf("close published transfer file: %w", closeErr)
+	}
+
+	return verifyCopy(remotePath, size, h.Sum(nil))
+}
+
+func (p *filesystemPublisher) report(localPath string, bytes int64) {
+	if p.progress != nil {
+		p.progress(Progress{LocalPath: localPath, Bytes: bytes})
+	}
+}
+
+func verifyCopy(name string, size int64, sum []byte) error {
+	f, err := os.Open(name) // #nosec G304 -- path is built from administrator config.
+	if err != nil {
+		return fmt.Errorf("open published transfer file: %w", err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	n, err := io.Copy(h, f)
+	if err != nil {
+		return fmt.Errorf("read published transfer file: %w", err)
+	}
+	if n != size {
+		return fmt.Errorf("published transfer file %q has %d bytes, expected %d", name, n, size)
+	}
+	if !bytes.Equal(h.Sum(nil), sum) {
+		return fmt.Errorf("published transfer file %q checksum mismatch", name)
+	}
+
+	return nil
+}
+
+// stagingPath returns the hidden path used to copy a transfer before it is
+// renamed into place.
+func stagingPath(remotePath string) string {
+	return filepath.Join(filepath.Dir(remotePath), "."+filepath.Base(remotePath)+".uploading")
+}
@@ -0,0 +1,102 @@
+package publisher
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestFilesystemPublisherPublishAndDelete(t *testing.T) {
+	t.Parallel()
+
+	remoteDir := t.TempDir()
+	localDir := t.TempDir()
+	assert.NilError(t, os.MkdirAll(filepath.Join(localDir, "objects", "empty"), 0o755))
+	assert.NilError(t, os.WriteFile(filepath.Join(localDir, "objects", "hello.txt"), []byte("hello"), 0o600))
+
+	var progress []Progress
+	pub, err := New(Config{
+		Type:                "filesystem",
+		RemoteDir:           remoteDir,
+		SubmittedPathPrefix: "transfers",
+	}, WithProgress(func(p Progress) {
+		progress = append(progress, p)
+	}))
+	assert.NilError(t, err)
+
+	res, err := pub.Publish(context.Background(), localDir, "incoming/transfer")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, res, &PublishedTransfer{
+		RelPath:    "transfers/incoming/transfer",
+		RemotePath: filepath.Join(remoteDir, "incoming", "transfer"),
+	})
+	assert.Assert(t, len(progress) > 0)
+	assert.Equal(t, readFile(t, filepath.Join(remoteDir, "incoming", "transfer", "objects", "hello.txt")), "hello")
+	assert.Assert(t, pathExists(filepath.Join(remoteDir, "incoming", "transfer", "objects", "empty")))
+	assert.Assert(t, !pathExists(filepath.Join(remoteDir, "incoming", ".transfer.uploading")))
+
+	assert.NilError(t, pub.Delete(context.Background(), res.RemotePath))
+	assert.Assert(t, !pathExists(filepath.Join(remoteDir, "incoming", "transfer")))
+}
+
+func TestFilesystemPublisherReplacesPreviousTransfer(t *testing.T) {
+	t.Parallel()
+
+	remoteDir := t.TempDir()
+	assert.NilError(t, os.MkdirAll(filepath.Join(remoteDir, "transfer"), 0o755))
+	assert.NilError(t, os.WriteFile(filepath.Join(remoteDir, "transfer", "stale.txt"), []byte("stale"), 0o600))
+	assert.NilError(t, os.MkdirAll(filepath.Join(remoteDir, ".transfer.uploading"), 0o755))
+	assert.NilError(t, os.WriteFile(filepath.Join(remoteDir, ".transfer.uploading", "partial.txt"), []byte("part"), 0o600))
+
+	localDir := t.TempDir()
+	assert.NilError(t, os.WriteFile(filepath.Join(localDir, "hello.txt"), []byte("hello"), 0o600))
+
+	pub, err := New(Config{Type: "filesystem", RemoteDir: remoteDir})
+	assert.NilError(t, err)
+
+	_, err = pub.Publish(context.Background(), localDir, "transfer")
+	assert.NilError(t, err)
+	assert.Equal(t, readFile(t, filepath.Join(remoteDir, "transfer", "hello.txt")), "hello")
+	assert.Assert(t, !pathExists(filepath.Join(remoteDir, "transfer", "stale.txt")))
+	assert.Assert(t, !pathExists(filepath.Join(remoteDir, ".transfer.uploading")))
+}
+
+func TestFilesystemPublisherPublishFile(t *testing.T) {
+	t.Parallel()
+
+	remoteDir := t.TempDir()
+	localDir := t.TempDir()
+	assert.NilError(t, os.WriteFile(filepath.Join(localDir, "transfer.zip"), []byte("zip"), 0o600))
+
+	pub, err := New(Config{Type: "filesystem", RemoteDir: remoteDir})
+	assert.NilError(t, err)
+
+	res, err := pub.Publish(context.Background(), filepath.Join(localDir, "transfer.zip"), "transfer.zip")
+	assert.NilError(t, err)
+	assert.Equal(t, res.RelPath, "transfer.zip")
+	assert.Equal(t, readFile(t, filepath.Join(remoteDir, "transfer.zip")), "zip")
+}
+
+func TestFilesystemPublisherRejectsMissingLocalTransfer(t *testing.T) {
+	t.Parallel()
+
+	pub, err := New(Config{Type: "filesystem", RemoteDir: t.TempDir()})
+	assert.NilError(t, err)
+
+	_, err = pub.Publish(context.Background(), filepath.Join(t.TempDir(), "missing"), "transfer")
+	assert.Assert(t, IsLocalTransferMissing(err))
+	assert.Assert(t, IsNonRetryable(err))
+}
+
+func TestVerifyCopy(t *testing.T) {
+	t.Parallel()
+
+	name := filepath.Join(t.TempDir(), "file")
+	assert.NilError(t, os.WriteFile(name, []byte("hello"), 0o600))
+
+	assert.ErrorContains(t, verifyCopy(name, 4, nil), "has 5 bytes, expected 4")
+	assert.ErrorContains(t, verifyCopy(name, 5, []byte("foobar")), "checksum mismatch")
+}
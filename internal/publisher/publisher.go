@@ -14,6 +14,41 @@ type Publisher interface {
 type PublishedTransfer struct {
 	RelPath    string
 	RemotePath string
+
+	// Manifest lists the files verified after the upload. It is nil unless
+	// the publisher is configured to verify published transfers.
+	Manifest *Manifest
+}
+
+const ManifestAlgorithmSHA256 = "sha256"
+
+// Manifest describes the files of a published transfer whose remote digests
+// matched the local ones.
+type Manifest struct {
+	Algorithm    string
+	Verification string
+	Files        []ManifestFile
+}
+
+// ManifestFile is a file of a published transfer. Path is relative to the
+// published transfer and Digest is computed with the manifest algorithm.
+type ManifestFile struct {
+	Path   string
+	Size   int64
+	Digest string
+}
+
+// ChecksumMismatchError is returned when the digest of a published file does
+// not match the digest of the local file. It is retryable, publishing the
+// transfer again is expected to fix it.
+type ChecksumMismatchError struct {
+	Path     string
+	Expected string
+	Actual   string
+}
+
+func (e ChecksumMismatchError) Error() string {
+	return fmt.Sprintf("published file %q checksum mismatch: expected %s, got %s", e.Path, e.Expected, e.Actual)
 }
 
 type LocalTransferMissingError struct {
@@ -37,13 +72,33 @@ func IsLocalTransferMissing(err error) bool {
 type Progress struct {
 	LocalPath string
 	Bytes     int64
+
+	// Files is the number of files published so far.
+	Files int
+	// ReusedBytes is the number of bytes left in the remote staging location
+	// by a previous attempt that did not need to be sent again.
+	ReusedBytes int64
 }
 
-type Option func(*sftpPublisher)
+type options struct {
+	progress func(Progress)
+	resume   *Progress
+}
+
+type Option func(*options)
 
 func WithProgress(fn func(Progress)) Option {
-	return func(p *sftpPublisher) {
-		p.progress = fn
+	return func(o *options) {
+		o.progress = fn
+	}
+}
+
+// WithResume passes the last progress reported by a previous attempt to
+// publish the same transfer. The SFTP publisher uses it to tell which of the
+// files left in its staging location can be reused.
+func WithResume(progress Progress) Option {
+	return func(o *options) {
+		o.resume = &progress
 	}
 }
 
@@ -52,13 +107,18 @@ func New(cfg Config, opts ...Option) (Publisher, error) {
 		return nil, nonRetryable(err)
 	}
 
+	o := options{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	switch cfg.Type {
 	case "sftp":
-		p := &sftpPublisher{cfg: cfg}
-		for _, opt := range opts {
-			opt(p)
-		}
-		return p, nil
+		return &sftpPublisher{cfg: cfg, progress: o.progress, resume: o.resume}, nil
+	case "s3":
+		return newS3Publisher(cfg, o.progress), nil
+	case "filesystem":
+		return &filesystemPublisher{cfg: cfg, progress: o.progress}, nil
 	case "":
 		return noopPublisher{}, nil
 	default:
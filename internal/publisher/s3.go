@@ -0,0 +1,283 @@
+package publisher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/transfermanager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/s3blob"
+	"gocloud.dev/gcerrors"
+)
+
+// minS3PartSize is the smallest part size accepted by S3 multipart uploads.
+const minS3PartSize = 5 * 1024 * 1024
+
+// s3Publisher uploads transfers to an S3-compatible bucket. Directories are
+// published as a set of objects sharing the key of the transfer as prefix.
+//
+// Objects larger than the configured part size, minS3PartSize by default, are
+// sent using multipart uploads.
+type s3Publisher struct {
+	cfg        Config
+	progress   func(Progress)
+	openBucket func(ctx context.Context) (*blob.Bucket, error)
+}
+
+func newS3Publisher(cfg Config, progress func(Progress)) *s3Publisher {
+	p := &s3Publisher{cfg: cfg, progress: progress}
+	p.openBucket = p.openS3Bucket
+
+	return p
+}
+
+func (p *s3Publisher) Publish(ctx context.Context, localPath, relPath string) (*PublishedTransfer, error) {
+	relPath, err := cleanRelPath(relPath)
+	if err != nil {
+		return nil, nonRetryable(err)
+	}
+
+	key := relPath
+	if prefix := strings.Trim(p.cfg.RemoteDir, "/"); prefix != "" {
+		key = path.Join(prefix, relPath)
+	}
+	submittedPath := path.Join(p.cfg.SubmittedPathPrefix, relPath)
+
+	if err := p.publish(ctx, localPath, key); err != nil {
+		return nil, err
+	}
+
+	return &PublishedTransfer{
+		RelPath:    submittedPath,
+		RemotePath: key,
+	}, nil
+}
+
+func (p *s3Publisher) Delete(ctx context.Context, remotePath string) error {
+	if remotePath == "" {
+		return nil
+	}
+
+	bucket, err := p.openBucket(ctx)
+	if err != nil {
+		return err
+	}
+	defer bucket.Close()
+
+	if err := deleteObjects(ctx, bucket, remotePath, nil); err != nil {
+		return fmt.Errorf("remove published remote transfer: %w", err)
+	}
+
+	return nil
+}
+
+func (p *s3Publisher) publish(ctx context.Context, localPath, key string) error {
+	stat, err := os.Stat(localPath)
+	if err != nil {
+		return nonRetryable(LocalTransferMissingError{Path: localPath, err: err})
+	}
+
+	bucket, err := p.openBucket(ctx)
+	if err != nil {
+		return err
+	}
+	defer bucket.Close()
+
+	uploaded := map[string]struct{}{}
+	if stat.IsDir() {
+		err = p.uploadDir(ctx, bucket, localPath, key, uploaded)
+	} else {
+		err = p.uploadFile(ctx, bucket, localPath, key)
+		uploaded[key] = struct{}{}
+	}
+	if err != nil {
+		return err
+	}
+
+	// Objects cannot be renamed atomically, remove the objects left behind by
+	// previous attempts once the new copy is complete.
+	if err := deleteObjects(ctx, bucket, key, uploaded); err != nil {
+		return fmt.Errorf("remove previous remote transfer: %w", err)
+	}
+
+	return nil
+}
+
+func (p *s3Publisher) openS3Bucket(ctx context.Context) (*blob.Bucket, error) {
+	awsConfig, err := awsconfig.LoadDefaultConfig(ctx,
+		awsconfig.WithSharedConfigProfile(p.cfg.Profile),
+		awsconfig.WithRegion(p.cfg.Region),
+		func(lo *awsconfig.LoadOptions) error {
+			if p.cfg.Key != "" && p.cfg.Secret != "" {
+				lo.Credentials = credentials.StaticCredentialsProvider{
+					Value: aws.Credentials{
+						AccessKeyID:     p.cfg.Key,
+						SecretAccessKey: p.cfg.Secret,
+						SessionToken:    p.cfg.Token,
+					},
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, nonRetryable(fmt.Errorf("load S3 configuration: %w", err))
+	}
+
+	client := s3.NewFromConfig(awsConfig, func(opts *s3.Options) {
+		opts.UsePathStyle = p.cfg.PathStyle
+		opts.Region = p.cfg.Region
+		if p.cfg.Endpoint != "" {
+			opts.BaseEndpoint = &p.cfg.Endpoint
+		}
+	})
+
+	bucket, err := s3blob.OpenBucketV2(ctx, client, p.cfg.Bucket, nil)
+	if err != nil {
+		return nil, fmt.Errorf("open S3 bucket: %w", err)
+	}
+
+	return bucket, nil
+}
+
+func (p *s3Publisher) uploadDir(ctx context.Context, bucket *blob.Bucket, localDir, prefix string, uploaded map[string]struct{}) error {
+	return filepath.WalkDir(localDir, func(localPath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("walk local transfer: %w", err)
+		}
+
+		if entry.Type()&os.ModeSymlink != 0 {
+			return nonRetryable(fmt.Errorf("S3 transfer publisher does not support symlinks: %s", localPath))
+		}
+
+		// Object storage has no directories, they are implied by the keys of
+		// the files they contain.
+		if entry.IsDir() {
+			p.report(localPath, 0)
+			return nil
+		}
+
+		rel, err := filepath.Rel(localDir, localPath)
+		if err != nil {
+			return nonRetryable(fmt.Errorf("calculate local transfer path: %w", err))
+		}
+
+		key := path.Join(prefix, filepath.ToSlash(rel))
+		if err := p.uploadFile(ctx, bucket, localPath, key); err != nil {
+			return err
+		}
+		uploaded[key] = struct{}{}
+
+		return nil
+	})
+}
+
+func (p *s3Publisher) uploadFile(ctx context.Context, bucket *blob.Bucket, localPath, key string) error {
+	p.report(localPath, 0)
+
+	src, err := os.Open(localPath) // #nosec G304 -- path is the transfer being published.
+	if err != nil {
+		return fmt.Errorf("open local transfer file: %w", err)
+	}
+	defer src.Close()
+
+	// Cancelling the context passed to the writer aborts the upload, which
+	// discards the parts that were already sent.
+	writeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	partSize := p.cfg.PartSize
+	if partSize == 0 {
+		partSize = minS3PartSize
+	}
+	dst, err := bucket.NewWriter(writeCtx, key, &blob.WriterOptions{
+		BufferSize: int(partSize),
+		// The transfer manager only uses multipart uploads for objects larger
+		// than its own threshold, replace it with one that uses the part size.
+		BeforeWrite: func(as func(any) bool) error {
+			var client *s3.Client
+			var tm *transfermanager.Client
+			if bucket.As(&client) && as(&tm) {
+				*tm = *transfermanager.New(client, func(opts *transfermanager.Options) {
+					opts.PartSizeBytes = partSize
+					opts.MultipartUploadThreshold = partSize
+				})
+			}
+			return nil
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("create remote transfer object: %w", err)
+	}
+
+	_, copyErr := io.Copy(dst, newProgressReader(ctx, src, localPath, p.report))
+	if copyErr != nil {
+		cancel()
+	}
+	closeErr := dst.Close()
+	if copyErr != nil {
+		return fmt.Errorf("upload transfer file: %w", copyErr)
+	}
+	if closeErr != nil {
+		return fmt.Errorf("close remote transfer object: %w", closeErr)
+	}
+
+	return nil
+}
+
+func (p *s3Publisher) report(localPath string, bytes int64) {
+	if p.progress != nil {
+		p.progress(Progress{LocalPath: localPath, Bytes: bytes})
+	}
+}
+
+// deleteObjects removes the object stored under key and the objects using key
+// as a directory prefix, except those listed in keep.
+func deleteObjects(ctx context.Context, bucket *blob.Bucket, key string, keep map[string]struct{}) error {
+	if _, ok := keep[key]; !ok {
+		exists, err := bucket.Exists(ctx, key)
+		if err != nil {
+			return err
+		}
+		if exists {
+			if err := bucket.Delete(ctx, key); err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+				return err
+			}
+		}
+	}
+
+	// Collect the keys first so deletions do not interfere with pagination.
+	var stale []string
+	iter := bucket.List(&blob.ListOptions{Prefix: key + "/"})
+	for {
+		obj, err := iter.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if _, ok := keep[obj.Key]; !ok {
+			stale = append(stale, obj.Key)
+		}
+	}
+
+	for _, key := range stale {
+		if err := bucket.Delete(ctx, key); err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+			return err
+		}
+	}
+
+	return nil
+}
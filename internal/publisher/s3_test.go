@@ -0,0 +1,373 @@
+package publisher
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/md5" // #nosec G501 -- S3 ETags are MD5 digests.
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestS3PublisherPublishAndDelete(t *testing.T) {
+	t.Parallel()
+
+	localDir := t.TempDir()
+	assert.NilError(t, os.MkdirAll(filepath.Join(localDir, "objects"), 0o755))
+	assert.NilError(t, os.WriteFile(filepath.Join(localDir, "objects", "hello.txt"), []byte("hello"), 0o600))
+	assert.NilError(t, os.MkdirAll(filepath.Join(localDir, "metadata"), 0o755))
+	assert.NilError(t, os.WriteFile(filepath.Join(localDir, "metadata", "checksum.md5"), []byte("5d41402abc4b2a76b9719d911017c592  ../objects/hello.txt\n"), 0o600))
+
+	var progress []Progress
+	s3 := newFakeS3()
+	pub := newTestS3Publisher(t, s3, Config{
+		RemoteDir:           "/incoming/",
+		SubmittedPathPrefix: "transfers",
+	}, func(p Progress) {
+		progress = append(progress, p)
+	})
+
+	res, err := pub.Publish(context.Background(), localDir, "transfer")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, res, &PublishedTransfer{
+		RelPath:    "transfers/transfer",
+		RemotePath: "incoming/transfer",
+	})
+	assert.Assert(t, len(progress) > 0)
+	hello, ok := s3.object("incoming/transfer/objects/hello.txt")
+	assert.Assert(t, ok)
+	assert.Equal(t, hello, "hello")
+	_, ok = s3.object("incoming/transfer/metadata/checksum.md5")
+	assert.Assert(t, ok)
+
+	assert.NilError(t, pub.Delete(context.Background(), res.RemotePath))
+	_, ok = s3.object("incoming/transfer/objects/hello.txt")
+	assert.Assert(t, !ok)
+	_, ok = s3.object("incoming/transfer/metadata/checksum.md5")
+	assert.Assert(t, !ok)
+}
+
+func TestS3PublisherRemovesStaleObjects(t *testing.T) {
+	t.Parallel()
+
+	s3 := newFakeS3()
+	s3.objects["transfer/objects/stale.txt"] = []byte("stale")
+	s3.objects["transfer-2/hello.txt"] = []byte("other")
+
+	localDir := t.TempDir()
+	assert.NilError(t, os.WriteFile(filepath.Join(localDir, "hello.txt"), []byte("hello"), 0o600))
+
+	pub := newTestS3Publisher(t, s3, Config{}, nil)
+
+	res, err := pub.Publish(context.Background(), localDir, "transfer")
+	assert.NilError(t, err)
+	assert.Equal(t, res.RemotePath, "transfer")
+	hello, _ := s3.object("transfer/hello.txt")
+	assert.Equal(t, hello, "hello")
+	_, ok := s3.object("transfer/objects/stale.txt")
+	assert.Assert(t, !ok)
+	// Objects sharing the key as a prefix but not as a directory are kept.
+	_, ok = s3.object("transfer-2/hello.txt")
+	assert.Assert(t, ok)
+}
+
+func TestS3PublisherPublishFile(t *testing.T) {
+	t.Parallel()
+
+	localDir := t.TempDir()
+	assert.NilError(t, os.WriteFile(filepath.Join(localDir, "transfer.zip"), []byte("zip"), 0o600))
+
+	s3 := newFakeS3()
+	pub := newTestS3Publisher(t, s3, Config{SubmittedPathPrefix: "transfers"}, nil)
+
+	res, err := pub.Publish(context.Background(), filepath.Join(localDir, "transfer.zip"), "transfer.zip")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, res, &PublishedTransfer{
+		RelPath:    "transfers/transfer.zip",
+		RemotePath: "transfer.zip",
+	})
+	zip, _ := s3.object("transfer.zip")
+	assert.Equal(t, zip, "zip")
+	assert.Equal(t, s3.multipart, 0)
+}
+
+func TestS3PublisherPublishMultipart(t *testing.T) {
+	t.Parallel()
+
+	// Two parts, the last one smaller than the part size.
+	content := bytes.Repeat([]byte("0123456789abcdef"), (minS3PartSize+1024)/16)
+	localDir := t.TempDir()
+	assert.NilError(t, os.WriteFile(filepath.Join(localDir, "transfer.zip"), content, 0o600))
+
+	s3 := newFakeS3()
+	pub := newTestS3Publisher(t, s3, Config{PartSize: minS3PartSize}, nil)
+
+	_, err := pub.Publish(context.Background(), filepath.Join(localDir, "transfer.zip"), "transfer.zip")
+	assert.NilError(t, err)
+	blob, ok := s3.object("transfer.zip")
+	assert.Assert(t, ok)
+	assert.Assert(t, blob == string(content))
+	assert.Equal(t, s3.multipart, 1)
+	assert.Equal(t, len(s3.uploads), 0)
+}
+
+func TestS3PublisherRejectsMissingLocalTransfer(t *testing.T) {
+	t.Parallel()
+
+	pub := newTestS3Publisher(t, newFakeS3(), Config{}, nil)
+
+	_, err := pub.Publish(context.Background(), filepath.Join(t.TempDir(), "missing"), "transfer")
+	assert.Assert(t, IsLocalTransferMissing(err))
+	assert.Assert(t, IsNonRetryable(err))
+}
+
+func TestNewS3Publisher(t *testing.T) {
+	t.Parallel()
+
+	pub, err := New(Config{Type: "s3", Bucket: "transfers"})
+	assert.NilError(t, err)
+
+	_, ok := pub.(*s3Publisher)
+	assert.Assert(t, ok)
+}
+
+// newTestS3Publisher returns an S3 publisher that uses the bucket served by
+// the fake S3 server.
+func newTestS3Publisher(t *testing.T, s3 *fakeS3, cfg Config, progress func(Progress)) *s3Publisher {
+	t.Helper()
+
+	srv := httptest.NewServer(s3)
+	t.Cleanup(srv.Close)
+
+	cfg.Type = "s3"
+	cfg.Bucket = "transfers"
+	cfg.Region = "us-east-1"
+	cfg.Endpoint = srv.URL
+	cfg.PathStyle = true
+	cfg.Key = "key"
+	cfg.Secret = "secret"
+
+	return newS3Publisher(cfg, progress)
+}
+
+// fakeS3 is an in-process S3 server that keeps the objects of the
+// "transfers" bucket in memory. It implements the operations used by the
+// publisher, including multipart uploads.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	uploads map[string]map[int][]byte
+	// multipart counts the multipart uploads completed.
+	multipart int
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{
+		objects: map[string][]byte{},
+		uploads: map[string]map[int][]byte{},
+	}
+}
+
+func (s *fakeS3) object(key string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	blob, ok := s.objects[key]
+	return string(blob), ok
+}
+
+func (s *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.URL.Path == "/transfers" || r.URL.Path == "/transfers/" {
+		s.list(w, r)
+		return
+	}
+	key, ok := strings.CutPrefix(r.URL.Path, "/transfers/")
+	if !ok {
+		s.error(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+
+	query := r.URL.Query()
+	uploadID := query.Get("uploadId")
+
+	switch {
+	case r.Method == http.MethodHead || r.Method == http.MethodGet:
+		blob, ok := s.objects[key]
+		if !ok {
+			s.error(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(blob)))
+		w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+		w.Header().Set("ETag", etag(blob))
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(blob)
+		}
+	case r.Method == http.MethodPut && uploadID != "":
+		parts, ok := s.uploads[uploadID]
+		if !ok {
+			s.error(w, http.StatusNotFound, "NoSuchUpload")
+			return
+		}
+		number, err := strconv.Atoi(query.Get("partNumber"))
+		if err != nil {
+			s.error(w, http.StatusBadRequest, "InvalidArgument")
+			return
+		}
+		blob, err := readBody(r)
+		if err != nil {
+			s.error(w, http.StatusBadRequest, "IncompleteBody")
+			return
+		}
+		parts[number] = blob
+		w.Header().Set("ETag", etag(blob))
+	case r.Method == http.MethodPut:
+		blob, err := readBody(r)
+		if err != nil {
+			s.error(w, http.StatusBadRequest, "IncompleteBody")
+			return
+		}
+		s.objects[key] = blob
+		w.Header().Set("ETag", etag(blob))
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		uploadID := strconv.Itoa(len(s.uploads) + s.multipart + 1)
+		s.uploads[uploadID] = map[int][]byte{}
+		s.xml(w, struct {
+			XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+			Bucket   string
+			Key      string
+			UploadId string
+		}{Bucket: "transfers", Key: key, UploadId: uploadID})
+	case r.Method == http.MethodPost && uploadID != "":
+		parts, ok := s.uploads[uploadID]
+		if !ok {
+			s.error(w, http.StatusNotFound, "NoSuchUpload")
+			return
+		}
+		numbers := make([]int, 0, len(parts))
+		for number := range parts {
+			numbers = append(numbers, number)
+		}
+		sort.Ints(numbers)
+		var blob []byte
+		for _, number := range numbers {
+			blob = append(blob, parts[number]...)
+		}
+		delete(s.uploads, uploadID)
+		s.objects[key] = blob
+		s.multipart++
+		s.xml(w, struct {
+			XMLName xml.Name `xml:"CompleteMultipartUploadResult"`
+			Bucket  string
+			Key     string
+			ETag    string
+		}{Bucket: "transfers", Key: key, ETag: etag(blob)})
+	case r.Method == http.MethodDelete && uploadID != "":
+		delete(s.uploads, uploadID)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete:
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		s.error(w, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+func (s *fakeS3) list(w http.ResponseWriter, r *http.Request) {
+	type object struct {
+		Key          string
+		Size         int
+		ETag         string
+		LastModified time.Time
+	}
+	prefix := r.URL.Query().Get("prefix")
+	keys := make([]string, 0, len(s.objects))
+	for key := range s.objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	contents := make([]object, 0, len(keys))
+	for _, key := range keys {
+		contents = append(contents, object{
+			Key:          key,
+			Size:         len(s.objects[key]),
+			ETag:         etag(s.objects[key]),
+			LastModified: time.Now().UTC(),
+		})
+	}
+
+	s.xml(w, struct {
+		XMLName     xml.Name `xml:"ListBucketResult"`
+		Name        string
+		Prefix      string
+		KeyCount    int
+		IsTruncated bool
+		Contents    []object
+	}{Name: "transfers", Prefix: prefix, KeyCount: len(contents), Contents: contents})
+}
+
+func (s *fakeS3) xml(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/xml")
+	_ = xml.NewEncoder(w).Encode(v)
+}
+
+func (s *fakeS3) error(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_ = xml.NewEncoder(w).Encode(struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string
+	}{Code: code})
+}
+
+// readBody returns the payload of the request, decoding the aws-chunked
+// encoding used by the SDK to send the checksums as trailers.
+func readBody(r *http.Request) ([]byte, error) {
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return io.ReadAll(r.Body)
+	}
+
+	var blob []byte
+	br := bufio.NewReader(r.Body)
+	for {
+		line, err := br.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, _, _ := strings.Cut(strings.TrimSpace(line), ";")
+		n, err := strconv.ParseInt(size, 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			return blob, nil
+		}
+		chunk := make([]byte, n+2)
+		if _, err := io.ReadFull(br, chunk); err != nil {
+			return nil, err
+		}
+		blob = append(blob, chunk[:n]...)
+	}
+}
+
+func etag(blob []byte) string {
+	return fmt.Sprintf(`"%x"`, md5.Sum(blob)) // #nosec G401 -- S3 ETags are MD5 digests.
+}
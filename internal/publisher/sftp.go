@@ -3,6 +3,8 @@ package publisher
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"io/fs"
@@ -22,6 +24,16 @@ import (
 type sftpPublisher struct {
 	cfg      Config
 	progress func(Progress)
+
+	// The last progress reported by a previous attempt, see WithResume.
+	resume *Progress
+
+	// Counters reported with the progress of the upload.
+	files       int
+	reusedBytes int64
+
+	// Number of files visited, in the order they are walked.
+	visited int
 }
 
 func (p *sftpPublisher) Publish(ctx context.Context, localPath, relPath string) (*PublishedTransfer, error) {
@@ -33,13 +45,15 @@ func (p *sftpPublisher) Publish(ctx context.Context, localPath, relPath string)
 	remotePath := path.Join(defaultString(p.cfg.RemoteDir, "/"), relPath)
 	submittedPath := path.Join(p.cfg.SubmittedPathPrefix, relPath)
 
-	if err := p.publish(ctx, localPath, remotePath); err != nil {
+	manifest, err := p.publish(ctx, localPath, remotePath)
+	if err != nil {
 		return nil, err
 	}
 
 	return &PublishedTransfer{
 		RelPath:    submittedPath,
 		RemotePath: remotePath,
+		Manifest:   manifest,
 	}, nil
 }
 
@@ -61,15 +75,15 @@ func (p *sftpPublisher) Delete(ctx context.Context, remotePath string) error {
 	return nil
 }
 
-func (p *sftpPublisher) publish(ctx context.Context, localPath, remotePath string) error {
+func (p *sftpPublisher) publish(ctx context.Context, localPath, remotePath string) (*Manifest, error) {
 	stat, err := os.Stat(localPath)
 	if err != nil {
-		return nonRetryable(LocalTransferMissingError{Path: localPath, err: err})
+		return nil, nonRetryable(LocalTransferMissingError{Path: localPath, err: err})
 	}
 
 	conn, err := p.connect(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer conn.Close()
 
@@ -77,30 +91,56 @@ func (p *sftpPublisher) publish(ctx context.Context, localPath, remotePath strin
 	remoteBase := path.Base(remotePath)
 	tempRemotePath := path.Join(remoteParent, "."+remoteBase+".uploading")
 
-	if err := removeRemoteAll(conn.sftpClient, tempRemotePath); err != nil {
-		return fmt.Errorf("remove temporary remote transfer: %w", err)
+	// The staging location is kept between attempts so an interrupted upload
+	// can be resumed, unless it does not match the type of the transfer.
+	if tempStat, err := conn.sftpClient.Lstat(tempRemotePath); err == nil && tempStat.IsDir() != stat.IsDir() {
+		if err := removeRemoteAll(conn.sftpClient, tempRemotePath); err != nil {
+			return nil, fmt.Errorf("remove temporary remote transfer: %w", err)
+		}
 	}
 	if err := conn.sftpClient.MkdirAll(remoteParent); err != nil {
-		return fmt.Errorf("create remote transfer parent: %w", err)
+		return nil, fmt.Errorf("create remote transfer parent: %w", err)
 	}
 
+	var files []ManifestFile
 	if stat.IsDir() {
-		err = p.uploadDir(ctx, conn.sftpClient, localPath, tempRemotePath)
+		uploaded := map[string]struct{}{}
+		files, err = p.uploadDir(ctx, conn.sftpClient, localPath, tempRemotePath, uploaded)
+		if err == nil {
+			err = pruneRemote(conn.sftpClient, tempRemotePath, uploaded)
+		}
 	} else {
-		err = p.uploadFile(ctx, conn.sftpClient, localPath, tempRemotePath, stat.Mode())
+		var file ManifestFile
+		file, err = p.uploadFile(ctx, conn.sftpClient, localPath, tempRemotePath, stat)
+		file.Path = path.Base(remotePath)
+		files = []ManifestFile{file}
 	}
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	// Verify the staged copy so a corrupted upload is never renamed into the
+	// location watched by Archivematica.
+	var manifest *Manifest
+	if p.cfg.Verification != "" {
+		if err := p.verify(ctx, conn, tempRemotePath, stat.IsDir(), files); err != nil {
+			return nil, err
+		}
+		manifest = &Manifest{
+			Algorithm:    ManifestAlgorithmSHA256,
+			Verification: p.cfg.Verification,
+			Files:        files,
+		}
 	}
 
 	if err := removeRemoteAll(conn.sftpClient, remotePath); err != nil {
-		return fmt.Errorf("remove previous remote transfer: %w", err)
+		return nil, fmt.Errorf("remove previous remote transfer: %w", err)
 	}
 	if err := conn.sftpClient.Rename(tempRemotePath, remotePath); err != nil {
-		return fmt.Errorf("publish remote transfer: %w", err)
+		return nil, fmt.Errorf("publish remote transfer: %w", err)
 	}
 
-	return nil
+	return manifest, nil
 }
 
 type sftpConnection struct {
@@ -219,8 +259,11 @@ func nonRetryableConnectError(err error) bool {
 		strings.Contains(msg, "host key mismatch")
 }
 
-func (p *sftpPublisher) uploadDir(ctx context.Context, client *sftp.Client, localDir, remoteDir string) error {
-	return filepath.WalkDir(localDir, func(localPath string, entry fs.DirEntry, err error) error {
+// uploadDir uploads the contents of localDir and records the remote paths that
+// belong to the transfer in uploaded.
+func (p *sftpPublisher) uploadDir(ctx context.Context, client *sftp.Client, localDir, remoteDir string, uploaded map[string]struct{}) ([]ManifestFile, error) {
+	var files []ManifestFile
+	err := filepath.WalkDir(localDir, func(localPath string, entry fs.DirEntry, err error) error {
 		if err != nil {
 			return fmt.Errorf("walk local transfer: %w", err)
 		}
@@ -245,8 +288,14 @@ func (p *sftpPublisher) uploadDir(ctx context.Context, client *sftp.Client, loca
 		}
 
 		p.report(localPath, 0)
+		uploaded[remotePath] = struct{}{}
 
 		if entry.IsDir() {
+			if stat, err := client.Lstat(remotePath); err == nil && !stat.IsDir() {
+				if err := client.Remove(remotePath); err != nil {
+					return fmt.Errorf("remove stale remote file: %w", err)
+				}
+			}
 			if err := client.MkdirAll(remotePath); err != nil {
 				return fmt.Errorf("create remote directory: %w", err)
 			}
@@ -254,48 +303,234 @@ func (p *sftpPublisher) uploadDir(ctx context.Context, client *sftp.Client, loca
 			return nil
 		}
 
-		return p.uploadFile(ctx, client, localPath, remotePath, info.Mode())
+		file, err := p.uploadFile(ctx, client, localPath, remotePath, info)
+		if err != nil {
+			return err
+		}
+		file.Path = filepath.ToSlash(rel)
+		files = append(files, file)
+
+		return nil
 	})
+
+	return files, err
 }
 
-func (p *sftpPublisher) uploadFile(ctx context.Context, client *sftp.Client, localPath, remotePath string, mode os.FileMode) error {
+// uploadFile uploads a single file and returns its size and SHA-256 digest,
+// computed while the file is streamed. Uploads left incomplete by a previous
+// attempt are resumed and files that were already uploaded are skipped, see
+// resumeOffset.
+func (p *sftpPublisher) uploadFile(ctx context.Context, client *sftp.Client, localPath, remotePath string, info os.FileInfo) (ManifestFile, error) {
 	p.report(localPath, 0)
 
+	index := p.visited
+	p.visited++
+
 	if err := client.MkdirAll(path.Dir(remotePath)); err != nil {
-		return fmt.Errorf("create remote file parent: %w", err)
+		return ManifestFile{}, fmt.Errorf("create remote file parent: %w", err)
+	}
+
+	offset, err := p.resumeOffset(client, localPath, remotePath, info, index)
+	if err != nil {
+		return ManifestFile{}, err
 	}
 
 	src, err := os.Open(localPath)
 	if err != nil {
-		return fmt.Errorf("open local transfer file: %w", err)
+		return ManifestFile{}, fmt.Errorf("open local transfer file: %w", err)
 	}
 	defer src.Close()
 
-	dst, err := client.OpenFile(remotePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC)
+	// The digest of the bytes already published is computed from the local
+	// copy, which also moves the reader to the resume position.
+	h := sha256.New()
+	if offset > 0 {
+		if _, err := io.CopyN(h, src, offset); err != nil {
+			return ManifestFile{}, fmt.Errorf("read local transfer file: %w", err)
+		}
+		p.reusedBytes += offset
+	}
+	if offset > 0 && offset == info.Size() {
+		p.files++
+		p.report(localPath, offset)
+		return ManifestFile{Size: offset, Digest: hex.EncodeToString(h.Sum(nil))}, nil
+	}
+
+	flags := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	if offset > 0 {
+		flags = os.O_WRONLY
+	}
+	dst, err := client.OpenFile(remotePath, flags)
 	if err != nil {
-		return fmt.Errorf("create remote transfer file: %w", err)
+		return ManifestFile{}, fmt.Errorf("create remote transfer file: %w", err)
+	}
+	if _, err := dst.Seek(offset, io.SeekStart); err != nil {
+		_ = dst.Close()
+		return ManifestFile{}, fmt.Errorf("seek remote transfer file: %w", err)
 	}
 
-	_, copyErr := io.Copy(dst, newProgressReader(ctx, src, localPath, p.report))
+	reader := newProgressReader(ctx, src, localPath, p.report)
+	reader.copied = offset
+	size, copyErr := io.Copy(dst, io.TeeReader(reader, h))
 	closeErr := dst.Close()
 	if copyErr != nil {
-		return fmt.Errorf("upload transfer file: %w", copyErr)
+		return ManifestFile{}, fmt.Errorf("upload transfer file: %w", copyErr)
 	}
 	if closeErr != nil {
-		return fmt.Errorf("close remote transfer file: %w", closeErr)
+		return ManifestFile{}, fmt.Errorf("close remote transfer file: %w", closeErr)
 	}
 
-	_ = client.Chmod(remotePath, mode)
+	_ = client.Chmod(remotePath, info.Mode())
 
-	return nil
+	// The modification time of the local file is copied once the upload is
+	// complete, it is how later attempts tell complete files apart.
+	_ = client.Chtimes(remotePath, info.ModTime(), info.ModTime())
+
+	p.files++
+	p.report(localPath, offset+size)
+
+	return ManifestFile{Size: offset + size, Digest: hex.EncodeToString(h.Sum(nil))}, nil
+}
+
+// resumeOffset returns the number of bytes of localPath that can be reused
+// from remotePath, left there by a previous attempt. index is the position of
+// the file in the order the transfer is walked.
+//
+// A remote file with the size and the modification time of the local file
+// was uploaded completely. A smaller file with a different modification time
+// is the result of an interrupted upload. Any other file is uploaded again.
+//
+// The staging location may also hold files left by an attempt to publish an
+// older version of the transfer, so the remote bytes are only reused when
+// their SHA-256 digest matches the local ones if ResumeChecksum is enabled, or
+// when the progress of the previous attempt accounts for them otherwise.
+func (p *sftpPublisher) resumeOffset(client *sftp.Client, localPath, remotePath string, info os.FileInfo, index int) (int64, error) {
+	stat, err := client.Lstat(remotePath)
+	if err != nil {
+		if errorsIsNotExist(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("stat remote transfer file: %w", err)
+	}
+	if !stat.Mode().IsRegular() {
+		if err := removeRemoteAll(client, remotePath); err != nil {
+			return 0, fmt.Errorf("remove stale remote path: %w", err)
+		}
+		return 0, nil
+	}
+
+	var offset int64
+	complete := stat.Size() == info.Size() && stat.ModTime().Unix() == info.ModTime().Unix()
+	switch {
+	case complete:
+		offset = stat.Size()
+	case stat.Size() < info.Size() && stat.ModTime().Unix() != info.ModTime().Unix():
+		offset = stat.Size()
+	default:
+		return 0, nil
+	}
+
+	if offset == 0 {
+		return 0, nil
+	}
+	if !p.cfg.ResumeChecksum {
+		return p.reportedOffset(localPath, index, offset, complete), nil
+	}
+
+	// The remote file holds exactly offset bytes, its digest is compared
+	// with the digest of the same number of bytes of the local file.
+	remoteDigest, err := remoteSHA256Read(client, remotePath)
+	if err != nil {
+		return 0, err
+	}
+	localDigest, err := localSHA256(localPath, offset)
+	if err != nil {
+		return 0, err
+	}
+	if remoteDigest != localDigest {
+		return 0, nil
+	}
+
+	return offset, nil
+}
+
+// reportedOffset limits offset to the bytes that the previous attempt reported
+// as published: the files it completed, which are walked in the same order,
+// and the bytes it copied of the file it was uploading when it stopped.
+func (p *sftpPublisher) reportedOffset(localPath string, index int, offset int64, complete bool) int64 {
+	switch {
+	case p.resume == nil:
+		return 0
+	case index < p.resume.Files && complete:
+		return offset
+	case localPath == p.resume.LocalPath:
+		return min(offset, p.resume.Bytes)
+	default:
+		return 0
+	}
+}
+
+// localSHA256 returns the SHA-256 digest of the first n bytes of name.
+func localSHA256(name string, n int64) (string, error) {
+	f, err := os.Open(name) // #nosec G304 -- path is the transfer being published.
+	if err != nil {
+		return "", fmt.Errorf("open local transfer file: %w", err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.CopyN(h, f, n); err != nil {
+		return "", fmt.Errorf("read local transfer file: %w", err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
 }
 
 func (p *sftpPublisher) report(localPath string, bytes int64) {
 	if p.progress != nil {
-		p.progress(Progress{LocalPath: localPath, Bytes: bytes})
+		p.progress(Progress{
+			LocalPath:   localPath,
+			Bytes:       bytes,
+			Files:       p.files,
+			ReusedBytes: p.reusedBytes,
+		})
 	}
 }
 
+// pruneRemote removes the files and directories found under root that are not
+// listed in keep, e.g. files left in the staging location by an attempt to
+// publish a different version of the transfer.
+func pruneRemote(client *sftp.Client, root string, keep map[string]struct{}) error {
+	var stale []string
+	walker := client.Walk(root)
+	for walker.Step() {
+		if err := walker.Err(); err != nil {
+			if errorsIsNotExist(err) {
+				continue
+			}
+			return fmt.Errorf("walk remote transfer: %w", err)
+		}
+		if walker.Path() == root {
+			continue
+		}
+		if _, ok := keep[walker.Path()]; ok {
+			continue
+		}
+		stale = append(stale, walker.Path())
+		if walker.Stat().IsDir() {
+			walker.SkipDir()
+		}
+	}
+
+	for _, name := range stale {
+		if err := removeRemoteAll(client, name); err != nil {
+			return fmt.Errorf("remove stale remote path: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func removeRemoteAll(client *sftp.Client, remotePath string) error {
 	if err := client.RemoveAll(remotePath); err != nil {
 		if errorsIsNotExist(err) {
@@ -4,16 +4,20 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/x509"
 	"encoding/binary"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"net"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/pkg/sftp"
 	"golang.org/x/crypto/ssh"
@@ -61,6 +65,225 @@ func TestSFTPPublisherPublishAndDeleteWithPassword(t *testing.T) {
 	assert.Assert(t, !pathExists(filepath.Join(server.root, "incoming", "transfer")))
 }
 
+func TestSFTPPublisherVerifiesPublishedFiles(t *testing.T) {
+	t.Parallel()
+
+	for _, verification := range []string{VerificationExec, VerificationRead} {
+		t.Run(verification, func(t *testing.T) {
+			t.Parallel()
+
+			server := startSFTPServer(t, testSFTPServerConfig{
+				password: "12345",
+			})
+
+			localDir := t.TempDir()
+			assert.NilError(t, os.MkdirAll(filepath.Join(localDir, "objects"), 0o755))
+			assert.NilError(t, os.WriteFile(filepath.Join(localDir, "objects", "hello.txt"), []byte("hello"), 0o600))
+
+			pub, err := New(Config{
+				Type:                  "sftp",
+				Host:                  server.host,
+				Port:                  server.port,
+				User:                  "archivematica",
+				Password:              "12345",
+				RemoteDir:             "incoming",
+				InsecureIgnoreHostKey: true,
+				Verification:          verification,
+			})
+			assert.NilError(t, err)
+
+			res, err := pub.Publish(context.Background(), localDir, "transfer")
+			assert.NilError(t, err)
+			assert.DeepEqual(t, res.Manifest, &Manifest{
+				Algorithm:    ManifestAlgorithmSHA256,
+				Verification: verification,
+				Files: []ManifestFile{
+					{
+						Path:   "objects/hello.txt",
+						Size:   5,
+						Digest: "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestSFTPPublisherRejectsChecksumMismatch(t *testing.T) {
+	t.Parallel()
+
+	server := startSFTPServer(t, testSFTPServerConfig{
+		password:    "12345",
+		wrongDigest: true,
+	})
+
+	localDir := t.TempDir()
+	assert.NilError(t, os.WriteFile(filepath.Join(localDir, "transfer.zip"), []byte("zip"), 0o600))
+
+	pub, err := New(Config{
+		Type:                  "sftp",
+		Host:                  server.host,
+		Port:                  server.port,
+		User:                  "archivematica",
+		Password:              "12345",
+		RemoteDir:             ".",
+		InsecureIgnoreHostKey: true,
+		Verification:          VerificationExec,
+	})
+	assert.NilError(t, err)
+
+	_, err = pub.Publish(context.Background(), filepath.Join(localDir, "transfer.zip"), "transfer.zip")
+	assert.ErrorContains(t, err, `published file "transfer.zip" checksum mismatch`)
+	assert.Assert(t, errors.As(err, &ChecksumMismatchError{}))
+	assert.Assert(t, !IsNonRetryable(err))
+	assert.Assert(t, !pathExists(filepath.Join(server.root, "transfer.zip")))
+}
+
+func TestSFTPPublisherReuploadsMismatchedFiles(t *testing.T) {
+	t.Parallel()
+
+	server := startSFTPServer(t, testSFTPServerConfig{
+		password: "12345",
+	})
+
+	mtime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	localDir := t.TempDir()
+	assert.NilError(t, os.MkdirAll(filepath.Join(localDir, "objects"), 0o755))
+	assert.NilError(t, os.WriteFile(filepath.Join(localDir, "objects", "hello.txt"), []byte("hello"), 0o600))
+	assert.NilError(t, os.Chtimes(filepath.Join(localDir, "objects", "hello.txt"), mtime, mtime))
+
+	// A staged file that looks complete but was corrupted after the upload.
+	staging := filepath.Join(server.root, "incoming", ".transfer.uploading")
+	assert.NilError(t, os.MkdirAll(filepath.Join(staging, "objects"), 0o755))
+	assert.NilError(t, os.WriteFile(filepath.Join(staging, "objects", "hello.txt"), []byte("HELLO"), 0o600))
+	assert.NilError(t, os.Chtimes(filepath.Join(staging, "objects", "hello.txt"), mtime, mtime))
+
+	cfg := Config{
+		Type:                  "sftp",
+		Host:                  server.host,
+		Port:                  server.port,
+		User:                  "archivematica",
+		Password:              "12345",
+		RemoteDir:             "incoming",
+		InsecureIgnoreHostKey: true,
+		Verification:          VerificationRead,
+	}
+	resume := WithResume(Progress{LocalPath: filepath.Join(localDir, "objects", "hello.txt"), Bytes: 5, Files: 1})
+
+	pub, err := New(cfg, resume)
+	assert.NilError(t, err)
+	_, err = pub.Publish(context.Background(), localDir, "transfer")
+	assert.Assert(t, errors.As(err, &ChecksumMismatchError{}))
+	assert.Assert(t, !pathExists(filepath.Join(staging, "objects", "hello.txt")))
+
+	pub, err = New(cfg, resume)
+	assert.NilError(t, err)
+	_, err = pub.Publish(context.Background(), localDir, "transfer")
+	assert.NilError(t, err)
+	assert.Equal(t, readFile(t, filepath.Join(server.root, "incoming", "transfer", "objects", "hello.txt")), "hello")
+}
+
+func TestSFTPPublisherResumesInterruptedUploads(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		resumeChecksum bool
+		resume         func(localDir string) *Progress
+		wantDone       string
+		wantPartial    string
+		wantReused     int64
+	}{
+		"Reuses the files reported by the previous attempt": {
+			resume: func(localDir string) *Progress {
+				return &Progress{LocalPath: filepath.Join(localDir, "objects", "partial.txt"), Bytes: 5, Files: 1}
+			},
+			wantDone:    "KEEP!",
+			wantPartial: "HELLO world",
+			wantReused:  10,
+		},
+		"Reuses the bytes reported by the previous attempt": {
+			resume: func(localDir string) *Progress {
+				return &Progress{LocalPath: filepath.Join(localDir, "objects", "partial.txt"), Bytes: 3, Files: 1}
+			},
+			wantDone:    "KEEP!",
+			wantPartial: "HELlo world",
+			wantReused:  8,
+		},
+		"Uploads the files again without progress of the previous attempt": {
+			wantDone:    "hello",
+			wantPartial: "hello world",
+			wantReused:  0,
+		},
+		"Reuses files with matching checksums": {
+			resumeChecksum: true,
+			wantDone:       "hello",
+			wantPartial:    "hello world",
+			wantReused:     0,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := startSFTPServer(t, testSFTPServerConfig{
+				password: "12345",
+			})
+
+			mtime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+			localDir := t.TempDir()
+			assert.NilError(t, os.MkdirAll(filepath.Join(localDir, "objects"), 0o755))
+			assert.NilError(t, os.WriteFile(filepath.Join(localDir, "objects", "done.txt"), []byte("hello"), 0o600))
+			assert.NilError(t, os.Chtimes(filepath.Join(localDir, "objects", "done.txt"), mtime, mtime))
+			assert.NilError(t, os.WriteFile(filepath.Join(localDir, "objects", "partial.txt"), []byte("hello world"), 0o600))
+			assert.NilError(t, os.Chtimes(filepath.Join(localDir, "objects", "partial.txt"), mtime, mtime))
+
+			// Files left in the staging location by a previous attempt. The
+			// contents differ from the local files so we can tell whether they
+			// were sent again.
+			staging := filepath.Join(server.root, "incoming", ".transfer.uploading")
+			assert.NilError(t, os.MkdirAll(filepath.Join(staging, "objects"), 0o755))
+			assert.NilError(t, os.WriteFile(filepath.Join(staging, "objects", "done.txt"), []byte("KEEP!"), 0o600))
+			assert.NilError(t, os.Chtimes(filepath.Join(staging, "objects", "done.txt"), mtime, mtime))
+			assert.NilError(t, os.WriteFile(filepath.Join(staging, "objects", "partial.txt"), []byte("HELLO"), 0o600))
+			assert.NilError(t, os.WriteFile(filepath.Join(staging, "stale.txt"), []byte("stale"), 0o600))
+
+			var last Progress
+			opts := []Option{WithProgress(func(p Progress) {
+				last = p
+			})}
+			if tc.resume != nil {
+				opts = append(opts, WithResume(*tc.resume(localDir)))
+			}
+			pub, err := New(Config{
+				Type:                  "sftp",
+				Host:                  server.host,
+				Port:                  server.port,
+				User:                  "archivematica",
+				Password:              "12345",
+				RemoteDir:             "incoming",
+				InsecureIgnoreHostKey: true,
+				ResumeChecksum:        tc.resumeChecksum,
+			}, opts...)
+			assert.NilError(t, err)
+
+			_, err = pub.Publish(context.Background(), localDir, "transfer")
+			assert.NilError(t, err)
+
+			published := filepath.Join(server.root, "incoming", "transfer")
+			assert.Equal(t, readFile(t, filepath.Join(published, "objects", "done.txt")), tc.wantDone)
+			assert.Equal(t, readFile(t, filepath.Join(published, "objects", "partial.txt")), tc.wantPartial)
+			assert.Assert(t, !pathExists(filepath.Join(published, "stale.txt")))
+			assert.Assert(t, !pathExists(staging))
+			assert.Equal(t, last.Files, 2)
+			assert.Equal(t, last.ReusedBytes, tc.wantReused)
+
+			stat, err := os.Stat(filepath.Join(published, "objects", "partial.txt"))
+			assert.NilError(t, err)
+			assert.Assert(t, stat.ModTime().Equal(mtime))
+		})
+	}
+}
+
 func TestSFTPPublisherPublishWithPrivateKeyAndKnownHosts(t *testing.T) {
 	t.Parallel()
 
@@ -166,6 +389,8 @@ func TestSFTPPublisherReportsMissingLocalTransfer(t *testing.T) {
 type testSFTPServerConfig struct {
 	password      string
 	authorizedKey ssh.PublicKey
+	// wrongDigest makes the sha256sum command report an unexpected digest.
+	wrongDigest bool
 }
 
 type testSFTPServer struct {
@@ -221,14 +446,14 @@ func startSFTPServer(t *testing.T, cfg testSFTPServerConfig) *testSFTPServer {
 			if err != nil {
 				return
 			}
-			go serveSFTPConn(conn, sshConfig, root)
+			go serveSFTPConn(conn, sshConfig, root, cfg)
 		}
 	}()
 
 	return server
 }
 
-func serveSFTPConn(conn net.Conn, cfg *ssh.ServerConfig, root string) {
+func serveSFTPConn(conn net.Conn, cfg *ssh.ServerConfig, root string, serverCfg testSFTPServerConfig) {
 	_, chans, reqs, err := ssh.NewServerConn(conn, cfg)
 	if err != nil {
 		_ = conn.Close()
@@ -250,6 +475,11 @@ func serveSFTPConn(conn net.Conn, cfg *ssh.ServerConfig, root string) {
 		go func() {
 			defer channel.Close()
 			for req := range requests {
+				if req.Type == "exec" {
+					_ = req.Reply(true, nil)
+					serveSHA256Sum(channel, root, parseSubsystem(req.Payload), serverCfg.wrongDigest)
+					return
+				}
 				if req.Type != "subsystem" || parseSubsystem(req.Payload) != "sftp" {
 					_ = req.Reply(false, nil)
 					continue
@@ -267,6 +497,33 @@ func serveSFTPConn(conn net.Conn, cfg *ssh.ServerConfig, root string) {
 	}
 }
 
+// serveSHA256Sum emulates the sha256sum command offered by SFTP servers such
+// as SFTPGo.
+func serveSHA256Sum(channel ssh.Channel, root, command string, wrongDigest bool) {
+	status := uint32(0)
+	defer func() {
+		_, _ = channel.SendRequest("exit-status", false, binary.BigEndian.AppendUint32(nil, status))
+	}()
+
+	name, ok := strings.CutPrefix(command, "sha256sum ")
+	if !ok {
+		status = 127
+		return
+	}
+	name = strings.Trim(name, "'")
+
+	data, err := os.ReadFile(filepath.Join(root, name))
+	if err != nil {
+		status = 1
+		return
+	}
+	if wrongDigest {
+		data = append(data, '!')
+	}
+
+	_, _ = fmt.Fprintf(channel, "%x  %s\n", sha256.Sum256(data), name)
+}
+
 func parseSubsystem(payload []byte) string {
 	if len(payload) < 4 {
 		return ""
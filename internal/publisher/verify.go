@@ -0,0 +1,110 @@
+package publisher
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	// VerificationExec computes remote digests running sha256sum via SSH.
+	VerificationExec = "exec"
+	// VerificationRead computes remote digests reading the files over SFTP.
+	VerificationRead = "read"
+)
+
+// verify compares the digests of the files uploaded under remotePath with the
+// digests computed during the upload. Mismatched files are removed from the
+// staging location so the next attempt uploads them again instead of reusing
+// them, and the first mismatch is returned once every file was checked.
+func (p *sftpPublisher) verify(ctx context.Context, conn *sftpConnection, remotePath string, isDir bool, files []ManifestFile) error {
+	var mismatch error
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		remoteFile := remotePath
+		if isDir {
+			remoteFile = path.Join(remotePath, file.Path)
+		}
+
+		stat, err := conn.sftpClient.Stat(remoteFile)
+		if err != nil {
+			return fmt.Errorf("stat published file: %w", err)
+		}
+		if stat.Size() != file.Size {
+			return fmt.Errorf("published file %q has %d bytes, expected %d", file.Path, stat.Size(), file.Size)
+		}
+
+		var actual string
+		switch p.cfg.Verification {
+		case VerificationExec:
+			actual, err = remoteSHA256Exec(conn.sshClient, remoteFile)
+		case VerificationRead:
+			actual, err = remoteSHA256Read(conn.sftpClient, remoteFile)
+		default:
+			return nonRetryable(fmt.Errorf("unsupported verification %q", p.cfg.Verification))
+		}
+		if err != nil {
+			return fmt.Errorf("verify published file %q: %w", file.Path, err)
+		}
+		if !strings.EqualFold(actual, file.Digest) {
+			if err := removeRemoteAll(conn.sftpClient, remoteFile); err != nil {
+				return fmt.Errorf("remove mismatched published file %q: %w", file.Path, err)
+			}
+			if mismatch == nil {
+				mismatch = ChecksumMismatchError{Path: file.Path, Expected: file.Digest, Actual: actual}
+			}
+		}
+	}
+
+	return mismatch
+}
+
+func remoteSHA256Exec(client *ssh.Client, remotePath string) (string, error) {
+	session, err := client.NewSession()
+	if err != nil {
+		return "", fmt.Errorf("open SSH session: %w", err)
+	}
+	defer session.Close()
+
+	out, err := session.Output("sha256sum " + shellQuote(remotePath))
+	if err != nil {
+		return "", fmt.Errorf("run sha256sum: %w", err)
+	}
+
+	digest, _, _ := strings.Cut(strings.TrimSpace(string(out)), " ")
+	if _, err := hex.DecodeString(digest); err != nil || len(digest) != sha256.Size*2 {
+		return "", fmt.Errorf("unexpected sha256sum output: %q", out)
+	}
+
+	return digest, nil
+}
+
+func remoteSHA256Read(client *sftp.Client, remotePath string) (string, error) {
+	f, err := client.Open(remotePath)
+	if err != nil {
+		return "", fmt.Errorf("open published file: %w", err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("read published file: %w", err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// shellQuote quotes s so it is passed as a single argument by a POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
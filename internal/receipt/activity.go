@@ -0,0 +1,176 @@
+package receipt
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/artefactual-labs/enduro/internal/collection"
+	"github.com/artefactual-labs/enduro/internal/temporal"
+)
+
+const SendActivityName = "send-receipt-activity"
+
+// maxErrorOutput limits the size of the response or standard error excerpt
+// included in delivery errors.
+const maxErrorOutput = 512
+
+type SendActivityParams struct {
+	Hook     string
+	Transfer TransferInfo
+}
+
+// SendActivity renders and delivers the receipt of a configured hook.
+type SendActivity struct {
+	cfg    Config
+	colsvc collection.Service
+	client *http.Client
+}
+
+func NewSendActivity(cfg Config, colsvc collection.Service, client *http.Client) *SendActivity {
+	return &SendActivity{cfg: cfg, colsvc: colsvc, client: client}
+}
+
+func (a *SendActivity) Execute(ctx context.Context, params *SendActivityParams) error {
+	hook, ok := a.cfg.ByName(params.Hook)
+	if !ok {
+		return temporal.NewNonRetryableError(fmt.Errorf("receipt hook %q is not configured", params.Hook))
+	}
+
+	col, err := a.colsvc.Read(ctx, params.Transfer.CollectionID)
+	if err != nil {
+		return fmt.Errorf("error reading collection: %v", err)
+	}
+
+	data := &Data{Hook: hook.Name, Transfer: params.Transfer, Collection: *col}
+	payload, err := hook.Payload(data)
+	if err != nil {
+		return temporal.NewNonRetryableError(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, hook.DeliveryTimeout())
+	defer cancel()
+
+	switch hook.Type {
+	case TypeHTTPJSON:
+		return a.sendHTTP(ctx, hook, payload)
+	case TypeFilesystemJSON:
+		return writeFile(hook, data, payload)
+	case TypeCommand:
+		return runCommand(ctx, hook, data, payload)
+	default:
+		return temporal.NewNonRetryableError(fmt.Errorf("unknown receipt hook type %q", hook.Type))
+	}
+}
+
+func (a *SendActivity) sendHTTP(ctx context.Context, hook HookConfig, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, hook.method(), hook.URL, bytes.NewReader(payload))
+	if err != nil {
+		return temporal.NewNonRetryableError(fmt.Errorf("error creating request: %v", err))
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range hook.Headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		blob, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorOutput))
+		return fmt.Errorf("unexpected response status: %s: %s", resp.Status, strings.TrimSpace(string(blob)))
+	}
+
+	return nil
+}
+
+// writeFile writes the payload to a temporary file that is renamed once
+// complete so readers of the destination never see partial receipts.
+func writeFile(hook HookConfig, data *Data, payload []byte) error {
+	dest, err := renderString("path", hook.Path, data)
+	if err != nil {
+		return temporal.NewNonRetryableError(err)
+	}
+	dest, err = confinePath(hook.BaseDir, dest)
+	if err != nil {
+		return temporal.NewNonRetryableError(err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(dest), "."+filepath.Base(dest)+".*")
+	if err != nil {
+		return fmt.Errorf("error creating receipt file: %v", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(payload); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("error writing receipt file: %v", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("error writing receipt file: %v", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return fmt.Errorf("error setting receipt file permissions: %v", err)
+	}
+	if err := os.Rename(tmp.Name(), dest); err != nil {
+		return fmt.Errorf("error moving receipt file: %v", err)
+	}
+
+	return nil
+}
+
+// confinePath resolves the rendered path against the base directory and
+// rejects it unless it is a file within that directory.
+func confinePath(base, path string) (string, error) {
+	base = filepath.Clean(base)
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(base, path)
+	}
+	path = filepath.Clean(path)
+
+	rel, err := filepath.Rel(base, path)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("receipt path %q is outside of %q", path, base)
+	}
+
+	return path, nil
+}
+
+func runCommand(ctx context.Context, hook HookConfig, data *Data, payload []byte) error {
+	args := make([]string, len(hook.Command))
+	for i, arg := range hook.Command {
+		var err error
+		if args[i], err = renderString("command", arg, data); err != nil {
+			return temporal.NewNonRetryableError(err)
+		}
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stdin = bytes.NewReader(payload)
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			output := stderr.String()
+			if len(output) > maxErrorOutput {
+				output = output[:maxErrorOutput]
+			}
+			return fmt.Errorf("command exited with code %d: %s", exitErr.ExitCode(), strings.TrimSpace(output))
+		}
+		return fmt.Errorf("error running command: %v", err)
+	}
+
+	return nil
+}
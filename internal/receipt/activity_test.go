@@ -0,0 +1,201 @@
+package receipt_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+
+	"github.com/artefactual-labs/enduro/internal/collection"
+	collectionfake "github.com/artefactual-labs/enduro/internal/collection/fake"
+	"github.com/artefactual-labs/enduro/internal/receipt"
+)
+
+var transfer = receipt.TransferInfo{
+	CollectionID: 12,
+	SIPID:        "d6d5cd1f-3a3c-4c52-96d8-8e0e2a9b6bd3",
+	PipelineName: "am",
+	Key:          "transfer.zip",
+	StoredAt:     time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC),
+}
+
+func newActivity(t *testing.T, hooks ...receipt.HookConfig) *receipt.SendActivity {
+	t.Helper()
+
+	colsvc := collectionfake.NewMockService(gomock.NewController(t))
+	colsvc.EXPECT().Read(gomock.Any(), transfer.CollectionID).Return(&collection.Collection{
+		ID:     transfer.CollectionID,
+		Name:   "Foobar",
+		Status: collection.StatusDone,
+	}, nil).AnyTimes()
+
+	return receipt.NewSendActivity(receipt.Config{Hook: hooks}, colsvc, http.DefaultClient)
+}
+
+const payload = `{"sip": {{ json .Transfer.SIPID }}, "name": {{ json .Collection.Name }}, "status": "{{ .Collection.Status }}", "stored_at": "{{ rfc3339 .Transfer.StoredAt }}"}`
+
+func TestSendActivityHTTPJSON(t *testing.T) {
+	t.Parallel()
+
+	var (
+		body    map[string]string
+		headers http.Header
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	act := newActivity(t, receipt.HookConfig{
+		Name:     "catalog",
+		Type:     receipt.TypeHTTPJSON,
+		URL:      srv.URL,
+		Headers:  map[string]string{"Authorization": "Bearer token"},
+		Template: payload,
+	})
+
+	err := act.Execute(context.Background(), &receipt.SendActivityParams{Hook: "catalog", Transfer: transfer})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, body, map[string]string{
+		"sip":       transfer.SIPID,
+		"name":      "Foobar",
+		"status":    "done",
+		"stored_at": "2024-03-01T10:00:00Z",
+	})
+	assert.Equal(t, headers.Get("Content-Type"), "application/json")
+	assert.Equal(t, headers.Get("Authorization"), "Bearer token")
+}
+
+func TestSendActivityHTTPJSONFailure(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = io.WriteString(w, "upstream unavailable")
+	}))
+	t.Cleanup(srv.Close)
+
+	act := newActivity(t, receipt.HookConfig{
+		Name:     "catalog",
+		Type:     receipt.TypeHTTPJSON,
+		URL:      srv.URL,
+		Template: payload,
+	})
+
+	err := act.Execute(context.Background(), &receipt.SendActivityParams{Hook: "catalog", Transfer: transfer})
+	assert.ErrorContains(t, err, "unexpected response status: 502 Bad Gateway: upstream unavailable")
+}
+
+func TestSendActivityFilesystemJSON(t *testing.T) {
+	t.Parallel()
+
+	dir := fs.NewDir(t, "enduro-receipt")
+	act := newActivity(t, receipt.HookConfig{
+		Name:     "drop",
+		Type:     receipt.TypeFilesystemJSON,
+		Path:     "Receipt_{{ .Collection.ID }}.json",
+		BaseDir:  dir.Path(),
+		Template: payload,
+	})
+
+	err := act.Execute(context.Background(), &receipt.SendActivityParams{Hook: "drop", Transfer: transfer})
+	assert.NilError(t, err)
+
+	blob, err := os.ReadFile(dir.Join("Receipt_12.json"))
+	assert.NilError(t, err)
+	assert.Assert(t, json.Valid(blob))
+
+	entries, err := os.ReadDir(dir.Path())
+	assert.NilError(t, err)
+	assert.Equal(t, len(entries), 1)
+}
+
+func TestSendActivityFilesystemJSONOutsideBaseDir(t *testing.T) {
+	t.Parallel()
+
+	dir := fs.NewDir(t, "enduro-receipt", fs.WithDir("receipts"))
+	act := newActivity(t, receipt.HookConfig{
+		Name:     "drop",
+		Type:     receipt.TypeFilesystemJSON,
+		Path:     "../Receipt_{{ .Collection.Name }}.json",
+		BaseDir:  dir.Join("receipts"),
+		Template: payload,
+	})
+
+	err := act.Execute(context.Background(), &receipt.SendActivityParams{Hook: "drop", Transfer: transfer})
+	assert.ErrorContains(t, err, "is outside of")
+
+	entries, err := os.ReadDir(dir.Path())
+	assert.NilError(t, err)
+	assert.Equal(t, len(entries), 1)
+}
+
+func TestSendActivityRejectsInvalidJSON(t *testing.T) {
+	t.Parallel()
+
+	dir := fs.NewDir(t, "enduro-receipt")
+	act := newActivity(t, receipt.HookConfig{
+		Name:     "drop",
+		Type:     receipt.TypeFilesystemJSON,
+		Path:     dir.Join("receipt.json"),
+		BaseDir:  dir.Path(),
+		Template: `{"name": {{ .Collection.Name }}}`,
+	})
+
+	err := act.Execute(context.Background(), &receipt.SendActivityParams{Hook: "drop", Transfer: transfer})
+	assert.ErrorContains(t, err, `payload of hook "drop" is not valid JSON`)
+}
+
+func TestSendActivityCommand(t *testing.T) {
+	t.Parallel()
+
+	dir := fs.NewDir(t, "enduro-receipt")
+	out := filepath.Join(dir.Path(), "out.txt")
+	act := newActivity(t, receipt.HookConfig{
+		Name:     "script",
+		Type:     receipt.TypeCommand,
+		Command:  []string{"sh", "-c", `cat > "$1"`, "sh", out},
+		Template: "{{ .Transfer.Key }} {{ .Collection.Status }}",
+	})
+
+	err := act.Execute(context.Background(), &receipt.SendActivityParams{Hook: "script", Transfer: transfer})
+	assert.NilError(t, err)
+
+	blob, err := os.ReadFile(out)
+	assert.NilError(t, err)
+	assert.Equal(t, string(blob), "transfer.zip done")
+}
+
+func TestSendActivityCommandFailure(t *testing.T) {
+	t.Parallel()
+
+	act := newActivity(t, receipt.HookConfig{
+		Name:     "script",
+		Type:     receipt.TypeCommand,
+		Command:  []string{"sh", "-c", "echo rejected >&2; exit 3"},
+		Template: "{{ .Transfer.Key }}",
+	})
+
+	err := act.Execute(context.Background(), &receipt.SendActivityParams{Hook: "script", Transfer: transfer})
+	assert.ErrorContains(t, err, "command exited with code 3: rejected")
+}
+
+func TestSendActivityUnknownHook(t *testing.T) {
+	t.Parallel()
+
+	act := newActivity(t)
+
+	err := act.Execute(context.Background(), &receipt.SendActivityParams{Hook: "missing", Transfer: transfer})
+	assert.ErrorContains(t, err, `receipt hook "missing" is not configured`)
+}
@@ -0,0 +1,207 @@
+package receipt
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/artefactual-labs/enduro/internal/decision"
+)
+
+const (
+	TypeHTTPJSON       = "http-json"
+	TypeFilesystemJSON = "filesystem-json"
+	TypeCommand        = "command"
+)
+
+const (
+	// OnFailureDecide asks the operator to retry or abandon the delivery.
+	OnFailureDecide = "decide"
+
+	// OnFailureFail fails the processing workflow.
+	OnFailureFail = "fail"
+
+	// OnFailureIgnore logs the error and continues with the next hook.
+	OnFailureIgnore = "ignore"
+)
+
+const defaultTimeout = 30 * time.Second
+
+type Config struct {
+	Hook []HookConfig
+}
+
+// HookConfig describes a receipt delivered once processing has completed.
+type HookConfig struct {
+	// Name identifies the hook in logs and errors.
+	Name string
+
+	// Type of delivery: "http-json", "filesystem-json" or "command".
+	Type string
+
+	// Template is the Go template used to render the payload.
+	Template string
+
+	// TemplateFile is the path of a file containing the payload template. It
+	// is used when Template is empty.
+	TemplateFile string
+
+	// URL where the payload is sent (http-json).
+	URL string
+
+	// Method of the HTTP request (http-json). Defaults to POST.
+	Method string
+
+	// Headers added to the HTTP request (http-json).
+	Headers map[string]string
+
+	// Path is a Go template rendering the location of the receipt file
+	// (filesystem-json). Relative paths are resolved against BaseDir.
+	Path string
+
+	// BaseDir is the directory receipt files are confined to
+	// (filesystem-json). Rendered paths that fall outside of it, e.g.
+	// because of a collection name with "../", are rejected.
+	BaseDir string
+
+	// Command and its arguments (command). Each argument is rendered as a Go
+	// template and the payload is written to the standard input.
+	Command []string
+
+	// Timeout of the delivery. Defaults to 30 seconds.
+	Timeout time.Duration
+
+	// OnFailure is the action taken when the delivery fails: "decide"
+	// (default), "fail" or "ignore".
+	OnFailure string
+
+	// Decision configures the wait for the operator decision when the
+	// delivery fails and OnFailure is "decide". Unset attributes are taken
+	// from the pipeline and the workflow configuration.
+	Decision decision.Config
+}
+
+func (c Config) Validate() error {
+	names := map[string]struct{}{}
+	for i, hook := range c.Hook {
+		if err := hook.validate(); err != nil {
+			return fmt.Errorf("invalid receipts configuration (hook[%d]): %v", i, err)
+		}
+		if _, ok := names[hook.Name]; ok {
+			return fmt.Errorf("invalid receipts configuration (hook[%d]): duplicate name %q", i, hook.Name)
+		}
+		names[hook.Name] = struct{}{}
+	}
+
+	return nil
+}
+
+// ByName returns the configuration of the hook with the given name.
+func (c Config) ByName(name string) (HookConfig, bool) {
+	for _, hook := range c.Hook {
+		if hook.Name == name {
+			return hook, true
+		}
+	}
+
+	return HookConfig{}, false
+}
+
+func (c HookConfig) validate() error {
+	if strings.TrimSpace(c.Name) == "" {
+		return errors.New("name is required")
+	}
+
+	switch c.Type {
+	case TypeHTTPJSON:
+		u, err := url.Parse(c.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid url %q", c.URL)
+		}
+	case TypeFilesystemJSON:
+		if c.Path == "" {
+			return errors.New("path is required")
+		}
+		if !filepath.IsAbs(c.BaseDir) {
+			return fmt.Errorf("baseDir %q must be an absolute path", c.BaseDir)
+		}
+		if _, err := parseTemplate("path", c.Path); err != nil {
+			return err
+		}
+	case TypeCommand:
+		if len(c.Command) == 0 {
+			return errors.New("command is required")
+		}
+		for _, arg := range c.Command {
+			if _, err := parseTemplate("command", arg); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unknown type %q", c.Type)
+	}
+
+	if _, err := c.payloadTemplate(); err != nil {
+		return err
+	}
+
+	switch c.OnFailure {
+	case "", OnFailureDecide, OnFailureFail, OnFailureIgnore:
+	default:
+		return fmt.Errorf("unknown onFailure action %q", c.OnFailure)
+	}
+
+	if c.Timeout < 0 {
+		return errors.New("timeout must not be negative")
+	}
+
+	if err := c.Decision.Validate(); err != nil {
+		return fmt.Errorf("invalid decision configuration: %v", err)
+	}
+
+	return nil
+}
+
+// FailureAction returns the action taken when the delivery fails.
+func (c HookConfig) FailureAction() string {
+	if c.OnFailure == "" {
+		return OnFailureDecide
+	}
+	return c.OnFailure
+}
+
+// DeliveryTimeout returns the maximum duration of a delivery.
+func (c HookConfig) DeliveryTimeout() time.Duration {
+	if c.Timeout == 0 {
+		return defaultTimeout
+	}
+	return c.Timeout
+}
+
+func (c HookConfig) method() string {
+	if c.Method == "" {
+		return "POST"
+	}
+	return strings.ToUpper(c.Method)
+}
+
+func (c HookConfig) payloadTemplate() (*template.Template, error) {
+	text := c.Template
+	if text == "" {
+		if c.TemplateFile == "" {
+			return nil, errors.New("template or templateFile is required")
+		}
+		blob, err := os.ReadFile(c.TemplateFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading templateFile: %v", err)
+		}
+		text = string(blob)
+	}
+
+	return parseTemplate("payload", text)
+}
@@ -0,0 +1,91 @@
+package receipt_test
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/enduro/internal/decision"
+	"github.com/artefactual-labs/enduro/internal/receipt"
+)
+
+func TestConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	valid := receipt.HookConfig{
+		Name:     "catalog",
+		Type:     receipt.TypeHTTPJSON,
+		URL:      "https://catalog.example.com/receipts",
+		Template: `{"id": {{ .Collection.ID }}}`,
+	}
+
+	tests := map[string]struct {
+		hooks   []receipt.HookConfig
+		wantErr string
+	}{
+		"Accepts an empty configuration": {},
+		"Accepts valid hooks": {
+			hooks: []receipt.HookConfig{
+				valid,
+				{
+					Name:      "drop",
+					Type:      receipt.TypeFilesystemJSON,
+					Path:      "{{ .Transfer.SIPID }}.json",
+					BaseDir:   "/receipts",
+					Template:  "{}",
+					OnFailure: receipt.OnFailureIgnore,
+				},
+				{
+					Name:     "script",
+					Type:     receipt.TypeCommand,
+					Command:  []string{"/usr/local/bin/notify", "{{ .Collection.ID }}"},
+					Template: "{{ .Collection.Name }}",
+				},
+			},
+		},
+		"Rejects duplicate names": {
+			hooks:   []receipt.HookConfig{valid, valid},
+			wantErr: `invalid receipts configuration (hook[1]): duplicate name "catalog"`,
+		},
+		"Rejects unknown types": {
+			hooks:   []receipt.HookConfig{{Name: "x", Type: "smtp", Template: "{}"}},
+			wantErr: `invalid receipts configuration (hook[0]): unknown type "smtp"`,
+		},
+		"Rejects invalid URLs": {
+			hooks:   []receipt.HookConfig{{Name: "x", Type: receipt.TypeHTTPJSON, URL: "ftp://host", Template: "{}"}},
+			wantErr: `invalid receipts configuration (hook[0]): invalid url "ftp://host"`,
+		},
+		"Rejects relative base directories": {
+			hooks:   []receipt.HookConfig{{Name: "x", Type: receipt.TypeFilesystemJSON, Path: "r.json", BaseDir: "receipts", Template: "{}"}},
+			wantErr: `invalid receipts configuration (hook[0]): baseDir "receipts" must be an absolute path`,
+		},
+		"Rejects hooks without template": {
+			hooks:   []receipt.HookConfig{{Name: "x", Type: receipt.TypeHTTPJSON, URL: "http://host"}},
+			wantErr: "invalid receipts configuration (hook[0]): template or templateFile is required",
+		},
+		"Rejects invalid templates": {
+			hooks:   []receipt.HookConfig{{Name: "x", Type: receipt.TypeHTTPJSON, URL: "http://host", Template: "{{ .Foo"}},
+			wantErr: "invalid receipts configuration (hook[0]): invalid payload template",
+		},
+		"Rejects unknown failure actions": {
+			hooks:   []receipt.HookConfig{{Name: "x", Type: receipt.TypeCommand, Command: []string{"true"}, Template: "{}", OnFailure: "retry"}},
+			wantErr: `invalid receipts configuration (hook[0]): unknown onFailure action "retry"`,
+		},
+		"Rejects invalid decision configurations": {
+			hooks:   []receipt.HookConfig{{Name: "x", Type: receipt.TypeCommand, Command: []string{"true"}, Template: "{}", Decision: decision.Config{OnTimeout: "skip"}}},
+			wantErr: `invalid receipts configuration (hook[0]): invalid decision configuration: unknown onTimeout action "skip"`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := receipt.Config{Hook: tc.hooks}.Validate()
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+		})
+	}
+}
@@ -0,0 +1,6 @@
+// Package receipt delivers processing receipts to external systems.
+//
+// Each configured hook declares how the receipt is delivered (an HTTP request
+// with a JSON body, a JSON file written to a directory or a local command) and
+// a Go template used to build the payload from the transfer and the collection.
+package receipt
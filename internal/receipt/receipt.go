@@ -0,0 +1,93 @@
+package receipt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"text/template"
+	"time"
+
+	"github.com/artefactual-labs/enduro/internal/collection"
+)
+
+// TransferInfo is the processing state available to receipt templates. It is
+// a subset of the workflow state that excludes pipeline credentials.
+type TransferInfo struct {
+	CollectionID uint
+	TransferID   string
+	SIPID        string
+	WatcherName  string
+	PipelineName string
+	PipelineID   string
+	Key          string
+	IsDir        bool
+	BatchDir     string
+	TransferType string
+	StoredAt     time.Time
+
+	// FullPath and RelPath describe the bundle submitted to Archivematica.
+	FullPath string
+	RelPath  string
+}
+
+// Data is the value passed to the hook templates.
+type Data struct {
+	Hook       string
+	Transfer   TransferInfo
+	Collection collection.Collection
+}
+
+var funcs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		blob, err := json.Marshal(v)
+		return string(blob), err
+	},
+	"rfc3339": func(t time.Time) string {
+		return t.UTC().Format(time.RFC3339)
+	},
+}
+
+func parseTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Funcs(funcs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s template: %v", name, err)
+	}
+	return tmpl, nil
+}
+
+func render(tmpl *template.Template, data *Data) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("error rendering %s template: %v", tmpl.Name(), err)
+	}
+	return buf.Bytes(), nil
+}
+
+func renderString(name, text string, data *Data) (string, error) {
+	tmpl, err := parseTemplate(name, text)
+	if err != nil {
+		return "", err
+	}
+	blob, err := render(tmpl, data)
+	if err != nil {
+		return "", err
+	}
+	return string(blob), nil
+}
+
+// Payload renders the payload of the hook. JSON hook types are required to
+// produce a valid JSON document.
+func (c HookConfig) Payload(data *Data) ([]byte, error) {
+	tmpl, err := c.payloadTemplate()
+	if err != nil {
+		return nil, err
+	}
+	payload, err := render(tmpl, data)
+	if err != nil {
+		return nil, err
+	}
+	if c.Type != TypeCommand && !json.Valid(payload) {
+		return nil, fmt.Errorf("payload of hook %q is not valid JSON", c.Name)
+	}
+	return payload, nil
+}
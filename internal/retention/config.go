@@ -0,0 +1,60 @@
+package retention
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	defaultInterval    = 5 * time.Minute
+	defaultBatchSize   = 100
+	defaultMaxAttempts = 5
+)
+
+type Config struct {
+	// Interval between runs of the retention workflow. Defaults to 5 minutes.
+	Interval time.Duration
+
+	// BatchSize is the maximum number of deletions executed in a single run.
+	// Defaults to 100.
+	BatchSize int
+
+	// MaxAttempts is the number of failed attempts after which a deletion is
+	// not retried anymore. Defaults to 5.
+	MaxAttempts int
+}
+
+func (c Config) Validate() error {
+	if c.Interval < 0 {
+		return errors.New("invalid retention configuration: interval must be positive")
+	}
+	if c.BatchSize < 0 {
+		return errors.New("invalid retention configuration: batchSize must be positive")
+	}
+	if c.MaxAttempts < 0 {
+		return errors.New("invalid retention configuration: maxAttempts must be positive")
+	}
+
+	return nil
+}
+
+func (c Config) interval() time.Duration {
+	if c.Interval == 0 {
+		return defaultInterval
+	}
+	return c.Interval
+}
+
+func (c Config) batchSize() int {
+	if c.BatchSize == 0 {
+		return defaultBatchSize
+	}
+	return c.BatchSize
+}
+
+func (c Config) maxAttempts() int {
+	if c.MaxAttempts == 0 {
+		return defaultMaxAttempts
+	}
+	return c.MaxAttempts
+}
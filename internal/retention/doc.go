@@ -0,0 +1,13 @@
+// Package retention schedules the deletion of the originals of the
+// collections processed by watchers configured with a retention period.
+//
+// When a processing workflow completes successfully it records a deletion in
+// the retention_deletion table instead of waiting for the retention period to
+// elapse. A Temporal schedule starts a workflow periodically that deletes the
+// originals whose deletions are due, so pending deletions can be listed,
+// postponed or canceled while they wait.
+//
+// Processing workflows started before this package was introduced still wait
+// on their own retention timers. They hand their deletion over to this package
+// when they receive the retention handoff signal.
+package retention
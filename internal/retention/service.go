@@ -0,0 +1,210 @@
+package retention
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/jmoiron/sqlx"
+	temporalsdk_client "go.temporal.io/sdk/client"
+	temporalsdk_temporal "go.temporal.io/sdk/temporal"
+)
+
+// Deletion statuses.
+const (
+	StatusPending  = "pending"
+	StatusDone     = "done"
+	StatusFailed   = "failed"
+	StatusCanceled = "canceled"
+)
+
+// ErrNotFound is returned when a collection has no pending deletion.
+var ErrNotFound = errors.New("pending deletion not found")
+
+// Deletion represents an entry of the retention_deletion table.
+type Deletion struct {
+	ID           uint64         `db:"id"`
+	CollectionID uint           `db:"collection_id"`
+	WatcherName  string         `db:"watcher_name"`
+	BatchDir     string         `db:"batch_dir"`
+	Key          string         `db:"object_key"`
+	Status       string         `db:"status"`
+	DueAt        time.Time      `db:"due_at"`
+	Attempts     int            `db:"attempts"`
+	Error        sql.NullString `db:"error"`
+	CreatedAt    time.Time      `db:"created_at"`
+	UpdatedAt    time.Time      `db:"updated_at"`
+}
+
+type Service interface {
+	// Schedule records the deletion of the original of a collection. An
+	// existing deletion of the same collection is replaced.
+	Schedule(ctx context.Context, deletion *Deletion) error
+	// List returns the deletions in the given status, or all of them when
+	// the status is empty, sorted by due date.
+	List(ctx context.Context, status string) ([]Deletion, error)
+	// Postpone changes the due date of the pending deletion of a collection.
+	Postpone(ctx context.Context, collectionID uint, dueAt time.Time) error
+	// Cancel cancels the pending deletion of a collection.
+	Cancel(ctx context.Context, collectionID uint) error
+	// Due returns up to limit pending deletions that are due at the given time,
+	// skipping collections under legal hold.
+	Due(ctx context.Context, now time.Time, limit int) ([]Deletion, error)
+	// Complete records the outcome of a deletion attempt.
+	Complete(ctx context.Context, ID uint64, errMsg string) error
+	// RegisterSchedule creates or updates the Temporal schedule that runs the
+	// retention workflow.
+	RegisterSchedule(ctx context.Context) error
+}
+
+type serviceImpl struct {
+	logger    logr.Logger
+	db        *sqlx.DB
+	cc        temporalsdk_client.Client
+	taskQueue string
+	cfg       Config
+}
+
+var _ Service = (*serviceImpl)(nil)
+
+func NewService(logger logr.Logger, db *sql.DB, cc temporalsdk_client.Client, taskQueue string, cfg Config) *serviceImpl {
+	return &serviceImpl{
+		logger:    logger,
+		db:        sqlx.NewDb(db, "mysql"),
+		cc:        cc,
+		taskQueue: taskQueue,
+		cfg:       cfg,
+	}
+}
+
+const deletionColumns = "id, collection_id, watcher_name, batch_dir, object_key, status, CONVERT_TZ(due_at, @@session.time_zone, '+00:00') AS due_at, attempts, error, CONVERT_TZ(created_at, @@session.time_zone, '+00:00') AS created_at, CONVERT_TZ(updated_at, @@session.time_zone, '+00:00') AS updated_at"
+
+func (svc *serviceImpl) Schedule(ctx context.Context, deletion *Deletion) error {
+	query := `INSERT INTO retention_deletion (collection_id, watcher_name, batch_dir, object_key, status, due_at) VALUES ((?), (?), (?), (?), (?), (?)) ON DUPLICATE KEY UPDATE watcher_name = VALUES(watcher_name), batch_dir = VALUES(batch_dir), object_key = VALUES(object_key), status = VALUES(status), due_at = VALUES(due_at), attempts = 0, error = NULL`
+	args := []any{
+		deletion.CollectionID,
+		deletion.WatcherName,
+		deletion.BatchDir,
+		deletion.Key,
+		StatusPending,
+		deletion.DueAt.UTC(),
+	}
+	if _, err := svc.db.ExecContext(ctx, svc.db.Rebind(query), args...); err != nil {
+		return fmt.Errorf("error inserting retention deletion: %w", err)
+	}
+
+	return nil
+}
+
+func (svc *serviceImpl) List(ctx context.Context, status string) ([]Deletion, error) {
+	query := "SELECT " + deletionColumns + " FROM retention_deletion"
+	args := []any{}
+	if status != "" {
+		query += " WHERE status = (?)"
+		args = append(args, status)
+	}
+	query += " ORDER BY due_at ASC, id ASC"
+
+	deletions := []Deletion{}
+	if err := svc.db.SelectContext(ctx, &deletions, svc.db.Rebind(query), args...); err != nil {
+		return nil, fmt.Errorf("error reading retention deletions: %w", err)
+	}
+
+	return deletions, nil
+}
+
+func (svc *serviceImpl) Postpone(ctx context.Context, collectionID uint, dueAt time.Time) error {
+	query := `UPDATE retention_deletion SET due_at = (?) WHERE collection_id = (?) AND status = (?)`
+
+	return svc.updatePending(ctx, query, dueAt.UTC(), collectionID, StatusPending)
+}
+
+func (svc *serviceImpl) Cancel(ctx context.Context, collectionID uint) error {
+	query := `UPDATE retention_deletion SET status = (?) WHERE collection_id = (?) AND status = (?)`
+
+	return svc.updatePending(ctx, query, StatusCanceled, collectionID, StatusPending)
+}
+
+func (svc *serviceImpl) updatePending(ctx context.Context, query string, args ...any) error {
+	res, err := svc.db.ExecContext(ctx, svc.db.Rebind(query), args...)
+	if err != nil {
+		return fmt.Errorf("error updating retention deletion: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error retrieving affected rows: %w", err)
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (svc *serviceImpl) Due(ctx context.Context, now time.Time, limit int) ([]Deletion, error) {
+	// Deletions of collections under legal hold stay pending until released.
+	query := "SELECT " + deletionColumns + " FROM retention_deletion WHERE status = (?) AND due_at <= (?) AND collection_id NOT IN (SELECT id FROM collection WHERE legal_hold = TRUE) ORDER BY due_at ASC, id ASC LIMIT ?"
+
+	deletions := []Deletion{}
+	if err := svc.db.SelectContext(ctx, &deletions, svc.db.Rebind(query), StatusPending, now.UTC(), limit); err != nil {
+		return nil, fmt.Errorf("error reading due retention deletions: %w", err)
+	}
+
+	return deletions, nil
+}
+
+func (svc *serviceImpl) Complete(ctx context.Context, ID uint64, errMsg string) error {
+	var (
+		query string
+		args  []any
+	)
+	if errMsg == "" {
+		query = `UPDATE retention_deletion SET status = (?), attempts = attempts + 1, error = NULL WHERE id = (?)`
+		args = []any{StatusDone, ID}
+	} else {
+		// MySQL evaluates the assignments from left to right, so the status
+		// is based on the updated number of attempts.
+		query = `UPDATE retention_deletion SET attempts = attempts + 1, error = (?), status = IF(attempts >= (?), (?), (?)) WHERE id = (?)`
+		args = []any{errMsg, svc.cfg.maxAttempts(), StatusFailed, StatusPending, ID}
+	}
+	if _, err := svc.db.ExecContext(ctx, svc.db.Rebind(query), args...); err != nil {
+		return fmt.Errorf("error updating retention deletion: %w", err)
+	}
+
+	return nil
+}
+
+func (svc *serviceImpl) RegisterSchedule(ctx context.Context) error {
+	spec := temporalsdk_client.ScheduleSpec{
+		Intervals: []temporalsdk_client.ScheduleIntervalSpec{{Every: svc.cfg.interval()}},
+	}
+	action := &temporalsdk_client.ScheduleWorkflowAction{
+		ID:        WorkflowName,
+		Workflow:  WorkflowName,
+		Args:      []any{WorkflowInput{BatchSize: svc.cfg.batchSize()}},
+		TaskQueue: svc.taskQueue,
+	}
+
+	_, err := svc.cc.ScheduleClient().Create(ctx, temporalsdk_client.ScheduleOptions{
+		ID:     ScheduleID,
+		Spec:   spec,
+		Action: action,
+	})
+	if !errors.Is(err, temporalsdk_temporal.ErrScheduleAlreadyRunning) {
+		return err
+	}
+
+	// Keep the existing schedule in sync with the configuration.
+	handle := svc.cc.ScheduleClient().GetHandle(ctx, ScheduleID)
+	return handle.Update(ctx, temporalsdk_client.ScheduleUpdateOptions{
+		DoUpdate: func(input temporalsdk_client.ScheduleUpdateInput) (*temporalsdk_client.ScheduleUpdate, error) {
+			schedule := input.Description.Schedule
+			schedule.Spec = &spec
+			schedule.Action = action
+			return &temporalsdk_client.ScheduleUpdate{Schedule: &schedule}, nil
+		},
+	})
+}
@@ -0,0 +1,85 @@
+package retention
+
+import (
+	"context"
+	"time"
+
+	temporalsdk_temporal "go.temporal.io/sdk/temporal"
+	temporalsdk_workflow "go.temporal.io/sdk/workflow"
+
+	"github.com/artefactual-labs/enduro/internal/workflow/activities"
+)
+
+const (
+	ScheduleID           = "retention-schedule"
+	WorkflowName         = "retention-workflow"
+	DueActivityName      = "retention-due-activity"
+	CompleteActivityName = "retention-complete-activity"
+)
+
+type WorkflowInput struct {
+	// Maximum number of deletions executed by the workflow.
+	BatchSize int
+}
+
+// Workflow is a Temporal workflow that deletes the originals whose deletions
+// are due. It is started periodically by the retention schedule.
+func Workflow(ctx temporalsdk_workflow.Context, input WorkflowInput) error {
+	opts := temporalsdk_workflow.WithActivityOptions(ctx, temporalsdk_workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporalsdk_temporal.RetryPolicy{
+			InitialInterval:    time.Second * 5,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	})
+
+	var deletions []Deletion
+	if err := temporalsdk_workflow.ExecuteActivity(opts, DueActivityName, input.BatchSize).Get(opts, &deletions); err != nil {
+		return err
+	}
+
+	// Failed deletions are retried in the next runs of the workflow, up to
+	// the maximum number of attempts configured. Deletions of collections put
+	// under legal hold in the meantime are left pending.
+	for _, deletion := range deletions {
+		var errMsg string
+		err := temporalsdk_workflow.ExecuteActivity(opts, activities.DeleteOriginalActivityName, deletion.WatcherName, deletion.BatchDir, deletion.Key, deletion.CollectionID).Get(opts, nil)
+		if activities.IsLegalHoldError(err) {
+			continue
+		}
+		if err != nil {
+			errMsg = err.Error()
+		}
+		if err := temporalsdk_workflow.ExecuteActivity(opts, CompleteActivityName, deletion.ID, errMsg).Get(opts, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type DueActivity struct {
+	svc Service
+}
+
+func NewDueActivity(svc Service) *DueActivity {
+	return &DueActivity{svc: svc}
+}
+
+func (a *DueActivity) Execute(ctx context.Context, limit int) ([]Deletion, error) {
+	return a.svc.Due(ctx, time.Now(), limit)
+}
+
+type CompleteActivity struct {
+	svc Service
+}
+
+func NewCompleteActivity(svc Service) *CompleteActivity {
+	return &CompleteActivity{svc: svc}
+}
+
+func (a *CompleteActivity) Execute(ctx context.Context, ID uint64, errMsg string) error {
+	return a.svc.Complete(ctx, ID, errMsg)
+}
@@ -0,0 +1,92 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	temporalsdk_activity "go.temporal.io/sdk/activity"
+	temporalsdk_temporal "go.temporal.io/sdk/temporal"
+	temporalsdk_testsuite "go.temporal.io/sdk/testsuite"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+
+	"github.com/artefactual-labs/enduro/internal/workflow/activities"
+)
+
+type completion struct {
+	ID     uint64
+	ErrMsg string
+}
+
+type deletionRecorder struct {
+	Service
+	due         []Deletion
+	limit       int
+	completions []completion
+}
+
+func (r *deletionRecorder) Due(_ context.Context, _ time.Time, limit int) ([]Deletion, error) {
+	r.limit = limit
+	return r.due, nil
+}
+
+func (r *deletionRecorder) Complete(_ context.Context, ID uint64, errMsg string) error {
+	r.completions = append(r.completions, completion{ID, errMsg})
+	return nil
+}
+
+func TestWorkflow(t *testing.T) {
+	t.Parallel()
+
+	svc := &deletionRecorder{
+		due: []Deletion{
+			{ID: 1, CollectionID: 10, WatcherName: "minio", Key: "a.zip"},
+			{ID: 2, CollectionID: 20, WatcherName: "minio", Key: "b.zip"},
+			{ID: 3, CollectionID: 30, WatcherName: "minio", Key: "c.zip"},
+		},
+	}
+	deleted := []string{}
+
+	ts := &temporalsdk_testsuite.WorkflowTestSuite{}
+	env := ts.NewTestWorkflowEnvironment()
+	env.RegisterActivityWithOptions(NewDueActivity(svc).Execute, temporalsdk_activity.RegisterOptions{Name: DueActivityName})
+	env.RegisterActivityWithOptions(NewCompleteActivity(svc).Execute, temporalsdk_activity.RegisterOptions{Name: CompleteActivityName})
+	env.RegisterActivityWithOptions(
+		func(_ context.Context, watcherName, batchDir, key string, collectionID uint) error {
+			if key == "b.zip" {
+				return errors.New("access denied")
+			}
+			if collectionID == 30 {
+				return temporalsdk_temporal.NewNonRetryableApplicationError("held", activities.LegalHoldErrorType, nil)
+			}
+			deleted = append(deleted, watcherName+"/"+key)
+			return nil
+		},
+		temporalsdk_activity.RegisterOptions{Name: activities.DeleteOriginalActivityName},
+	)
+
+	env.ExecuteWorkflow(Workflow, WorkflowInput{BatchSize: 50})
+
+	assert.Assert(t, env.IsWorkflowCompleted())
+	assert.NilError(t, env.GetWorkflowError())
+	assert.Equal(t, svc.limit, 50)
+	assert.DeepEqual(t, deleted, []string{"minio/a.zip"})
+	assert.Equal(t, len(svc.completions), 2)
+	assert.Equal(t, svc.completions[0], completion{ID: 1})
+	assert.Equal(t, svc.completions[1].ID, uint64(2))
+	assert.Assert(t, cmp.Contains(svc.completions[1].ErrMsg, "access denied"))
+}
+
+func TestConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	assert.NilError(t, Config{}.Validate())
+	assert.Error(t, Config{Interval: -time.Second}.Validate(), "invalid retention configuration: interval must be positive")
+
+	cfg := Config{}
+	assert.Equal(t, cfg.interval(), defaultInterval)
+	assert.Equal(t, cfg.batchSize(), defaultBatchSize)
+	assert.Equal(t, cfg.maxAttempts(), defaultMaxAttempts)
+}
@@ -0,0 +1,202 @@
+package validation
+
+import (
+	"bufio"
+	"crypto/md5"  // #nosec G501 -- used to verify user-provided checksums.
+	"crypto/sha1" // #nosec G505 -- used to verify user-provided checksums.
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	ChecksumExistsValidatorName   = "checksum-exists"
+	ChecksumManifestValidatorName = "checksum-manifest"
+)
+
+var checksumFiles = [4]string{
+	"checksum.md5",
+	"checksum.sha1",
+	"checksum.sha256",
+	"checksum.sha512",
+}
+
+var checksumAlgorithms = map[string]func() hash.Hash{
+	"checksum.md5":    md5.New,
+	"checksum.sha1":   sha1.New,
+	"checksum.sha256": sha256.New,
+	"checksum.sha512": sha512.New,
+}
+
+// ChecksumExistsValidator is a Validator that checks that the transfer
+// includes at least one checksum file in its metadata directory.
+type ChecksumExistsValidator struct {
+	path string
+}
+
+func newChecksumExistsValidator(_ ValidatorConfig, path string) (Validator, error) {
+	return ChecksumExistsValidator{path: path}, nil
+}
+
+func (v ChecksumExistsValidator) Valid() error {
+	for _, checksum := range checksumFiles {
+		if fileExists(path.Join(v.path, "metadata", checksum)) {
+			return nil
+		}
+	}
+	return fmt.Errorf("transfer does not contain checksums (path=%s)", v.path)
+}
+
+// ChecksumManifestValidator is a Validator that recomputes the digests of the
+// files listed in the checksum files of the metadata directory and compares
+// them with the expected values.
+//
+// Entries can be relative to the metadata directory (e.g. "../objects/a.jpg")
+// or to the root of the transfer (e.g. "objects/a.jpg").
+type ChecksumManifestValidator struct {
+	path string
+}
+
+func newChecksumManifestValidator(_ ValidatorConfig, path string) (Validator, error) {
+	return ChecksumManifestValidator{path: path}, nil
+}
+
+func (v ChecksumManifestValidator) Valid() error {
+	var (
+		found bool
+		errs  []error
+	)
+
+	for _, checksum := range checksumFiles {
+		manifest := filepath.Join(v.path, "metadata", checksum)
+		if !fileExists(manifest) {
+			continue
+		}
+		found = true
+
+		if err := v.verify(manifest, checksumAlgorithms[checksum]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("transfer does not contain checksums (path=%s)", v.path)
+	}
+
+	return joinErrors(errs)
+}
+
+func (v ChecksumManifestValidator) verify(manifest string, newHash func() hash.Hash) error {
+	f, err := os.Open(manifest) // #nosec G304 -- manifest is a path of the transfer being validated.
+	if err != nil {
+		return fmt.Errorf("%s: %v", filepath.Base(manifest), err)
+	}
+	defer f.Close()
+
+	var errs []error
+	name := filepath.Base(manifest)
+	scanner := bufio.NewScanner(f)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		expected, entry, ok := parseChecksumLine(line)
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: line %d is malformed", name, lineNumber))
+			continue
+		}
+
+		filePath, err := v.resolve(entry)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %s: %v", name, entry, err))
+			continue
+		}
+
+		actual, err := fileDigest(filePath, newHash())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %s: %v", name, entry, err))
+			continue
+		}
+		if !strings.EqualFold(actual, expected) {
+			errs = append(errs, fmt.Errorf("%s: %s: checksum mismatch", name, entry))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		errs = append(errs, fmt.Errorf("%s: %v", name, err))
+	}
+
+	return joinErrors(errs)
+}
+
+// resolve returns the absolute path of a manifest entry, making sure that it
+// does not point outside of the transfer.
+func (v ChecksumManifestValidator) resolve(entry string) (string, error) {
+	entry = filepath.FromSlash(entry)
+	if filepath.IsAbs(entry) {
+		return "", fmt.Errorf("absolute paths are not allowed")
+	}
+
+	base := v.path
+	if strings.HasPrefix(entry, ".."+string(filepath.Separator)) {
+		base = filepath.Join(v.path, "metadata")
+	}
+
+	resolved := filepath.Join(base, entry)
+	rel, err := filepath.Rel(v.path, resolved)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("path is outside of the transfer")
+	}
+
+	return resolved, nil
+}
+
+// parseChecksumLine parses lines written by the coreutils checksum tools,
+// i.e. "<digest>  <path>" or "<digest> *<path>".
+func parseChecksumLine(line string) (digest, entry string, ok bool) {
+	digest, entry, ok = strings.Cut(line, " ")
+	if !ok {
+		return "", "", false
+	}
+	entry = strings.TrimPrefix(strings.TrimLeft(entry, " "), "*")
+	if digest == "" || entry == "" {
+		return "", "", false
+	}
+	if _, err := hex.DecodeString(digest); err != nil {
+		return "", "", false
+	}
+
+	return digest, entry, true
+}
+
+func fileDigest(name string, h hash.Hash) (string, error) {
+	f, err := os.Open(name) // #nosec G304 -- name is resolved within the transfer.
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func fileExists(name string) bool {
+	stat, err := os.Stat(name)
+	if err != nil {
+		return false
+	}
+	return !stat.IsDir()
+}
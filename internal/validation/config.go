@@ -1,9 +1,135 @@
 package validation
 
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
 type Config struct {
+	// ChecksumsCheckEnabled is the legacy switch for the checksum-exists
+	// validator. It is kept so existing configuration files keep working.
 	ChecksumsCheckEnabled bool
+
+	// Validators is the ordered list of validators run against a transfer.
+	Validators []ValidatorConfig
+}
+
+// ValidatorConfig configures one entry of the validator chain. Only the
+// attributes relevant to the named validator are used.
+type ValidatorConfig struct {
+	// Name of the validator in the registry, e.g. "checksum-manifest".
+	Name string
+
+	// Severity determines whether a failure stops the workflow ("fail") or
+	// is only recorded ("warn"). Defaults to "fail".
+	Severity Severity
+
+	// Directories that must exist in the transfer (required-directories).
+	Directories []string
+
+	// Pattern that every file and directory name must match
+	// (filename-policy).
+	Pattern string
+
+	// MaxFileSize is the largest file size allowed in bytes (max-file-size).
+	MaxFileSize int64
+
+	// MaxFileCount is the largest number of files allowed (max-file-count).
+	MaxFileCount int
+
+	// Extensions that are not allowed in the transfer (forbidden-extensions).
+	Extensions []string
 }
 
 func (c Config) IsEnabled() bool {
-	return c.ChecksumsCheckEnabled
+	return c.ChecksumsCheckEnabled || len(c.Validators) > 0
+}
+
+// Chain returns the ordered list of validators to run, including the legacy
+// checksum-exists validator when it is enabled but not listed explicitly.
+func (c Config) Chain() []ValidatorConfig {
+	chain := make([]ValidatorConfig, 0, len(c.Validators)+1)
+	if c.ChecksumsCheckEnabled && !c.hasValidator(ChecksumExistsValidatorName) {
+		chain = append(chain, ValidatorConfig{Name: ChecksumExistsValidatorName, Severity: SeverityFail})
+	}
+	for _, vc := range c.Validators {
+		if vc.Severity == "" {
+			vc.Severity = SeverityFail
+		}
+		chain = append(chain, vc)
+	}
+
+	return chain
 }
+
+func (c Config) hasValidator(name string) bool {
+	for _, vc := range c.Validators {
+		if vc.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (c Config) Validate() error {
+	for i, vc := range c.Validators {
+		if err := vc.Validate(); err != nil {
+			return fmt.Errorf("invalid validation configuration (validators[%d]): %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (c ValidatorConfig) Validate() error {
+	if _, ok := registry[c.Name]; !ok {
+		return fmt.Errorf("unknown validator %q", c.Name)
+	}
+
+	switch c.Severity {
+	case "", SeverityFail, SeverityWarn:
+	default:
+		return fmt.Errorf("invalid severity %q", c.Severity)
+	}
+
+	switch c.Name {
+	case RequiredDirectoriesValidatorName:
+		if len(c.Directories) == 0 {
+			return fmt.Errorf("%s: directories is required", c.Name)
+		}
+	case FilenamePolicyValidatorName:
+		if c.Pattern != "" {
+			if _, err := regexp.Compile(c.Pattern); err != nil {
+				return fmt.Errorf("%s: invalid pattern: %v", c.Name, err)
+			}
+		}
+	case MaxFileSizeValidatorName:
+		if c.MaxFileSize <= 0 {
+			return fmt.Errorf("%s: maxFileSize must be greater than zero", c.Name)
+		}
+	case MaxFileCountValidatorName:
+		if c.MaxFileCount <= 0 {
+			return fmt.Errorf("%s: maxFileCount must be greater than zero", c.Name)
+		}
+	case ForbiddenExtensionsValidatorName:
+		if len(c.Extensions) == 0 {
+			return fmt.Errorf("%s: extensions is required", c.Name)
+		}
+		for _, ext := range c.Extensions {
+			if strings.TrimSpace(ext) == "" {
+				return fmt.Errorf("%s: extensions must not be empty", c.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Severity indicates how a validator failure is handled.
+type Severity string
+
+const (
+	SeverityFail Severity = "fail"
+	SeverityWarn Severity = "warn"
+)
@@ -0,0 +1,200 @@
+package validation
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const (
+	RequiredDirectoriesValidatorName = "required-directories"
+	FilenamePolicyValidatorName      = "filename-policy"
+	MaxFileSizeValidatorName         = "max-file-size"
+	MaxFileCountValidatorName        = "max-file-count"
+	ForbiddenExtensionsValidatorName = "forbidden-extensions"
+)
+
+// defaultFilenamePattern is used by the filename-policy validator when no
+// pattern is configured. It rejects control characters and the characters
+// that are reserved in common filesystems.
+var defaultFilenamePattern = regexp.MustCompile(`^[^\x00-\x1f\x7f<>:"/\\|?*]+$`)
+
+// RequiredDirectoriesValidator is a Validator that checks that the transfer
+// contains a set of directories, e.g. "objects" and "metadata".
+type RequiredDirectoriesValidator struct {
+	path        string
+	directories []string
+}
+
+func newRequiredDirectoriesValidator(cfg ValidatorConfig, path string) (Validator, error) {
+	return RequiredDirectoriesValidator{path: path, directories: cfg.Directories}, nil
+}
+
+func (v RequiredDirectoriesValidator) Valid() error {
+	var errs []error
+	for _, dir := range v.directories {
+		stat, err := os.Stat(filepath.Join(v.path, filepath.FromSlash(dir)))
+		if err != nil || !stat.IsDir() {
+			errs = append(errs, fmt.Errorf("required directory %q is missing", dir))
+		}
+	}
+
+	return joinErrors(errs)
+}
+
+// FilenamePolicyValidator is a Validator that checks that the names of all
+// files and directories in the transfer match a regular expression.
+type FilenamePolicyValidator struct {
+	path    string
+	pattern *regexp.Regexp
+}
+
+func newFilenamePolicyValidator(cfg ValidatorConfig, path string) (Validator, error) {
+	pattern := defaultFilenamePattern
+	if cfg.Pattern != "" {
+		var err error
+		if pattern, err = regexp.Compile(cfg.Pattern); err != nil {
+			return nil, err
+		}
+	}
+
+	return FilenamePolicyValidator{path: path, pattern: pattern}, nil
+}
+
+func (v FilenamePolicyValidator) Valid() error {
+	var errs []error
+	err := walkTransfer(v.path, func(rel string, d fs.DirEntry) error {
+		if !v.pattern.MatchString(d.Name()) {
+			errs = append(errs, fmt.Errorf("name not allowed: %q", rel))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return joinErrors(errs)
+}
+
+// MaxFileSizeValidator is a Validator that checks that no file in the
+// transfer is larger than a given size.
+type MaxFileSizeValidator struct {
+	path    string
+	maxSize int64
+}
+
+func newMaxFileSizeValidator(cfg ValidatorConfig, path string) (Validator, error) {
+	return MaxFileSizeValidator{path: path, maxSize: cfg.MaxFileSize}, nil
+}
+
+func (v MaxFileSizeValidator) Valid() error {
+	var errs []error
+	err := walkTransfer(v.path, func(rel string, d fs.DirEntry) error {
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if info.Size() > v.maxSize {
+			errs = append(errs, fmt.Errorf("file %q is too large (%d bytes, limit is %d bytes)", rel, info.Size(), v.maxSize))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return joinErrors(errs)
+}
+
+// MaxFileCountValidator is a Validator that checks that the transfer does not
+// contain more than a given number of files.
+type MaxFileCountValidator struct {
+	path     string
+	maxCount int
+}
+
+func newMaxFileCountValidator(cfg ValidatorConfig, path string) (Validator, error) {
+	return MaxFileCountValidator{path: path, maxCount: cfg.MaxFileCount}, nil
+}
+
+func (v MaxFileCountValidator) Valid() error {
+	count := 0
+	err := walkTransfer(v.path, func(rel string, d fs.DirEntry) error {
+		if !d.IsDir() {
+			count++
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if count > v.maxCount {
+		return fmt.Errorf("transfer contains too many files (%d files, limit is %d)", count, v.maxCount)
+	}
+
+	return nil
+}
+
+// ForbiddenExtensionsValidator is a Validator that checks that no file in the
+// transfer uses one of the given extensions. The comparison is not case
+// sensitive.
+type ForbiddenExtensionsValidator struct {
+	path       string
+	extensions map[string]struct{}
+}
+
+func newForbiddenExtensionsValidator(cfg ValidatorConfig, path string) (Validator, error) {
+	extensions := make(map[string]struct{}, len(cfg.Extensions))
+	for _, ext := range cfg.Extensions {
+		ext = strings.ToLower(strings.TrimSpace(ext))
+		if !strings.HasPrefix(ext, ".") {
+			ext = "." + ext
+		}
+		extensions[ext] = struct{}{}
+	}
+
+	return ForbiddenExtensionsValidator{path: path, extensions: extensions}, nil
+}
+
+func (v ForbiddenExtensionsValidator) Valid() error {
+	var errs []error
+	err := walkTransfer(v.path, func(rel string, d fs.DirEntry) error {
+		if d.IsDir() {
+			return nil
+		}
+		if _, ok := v.extensions[strings.ToLower(filepath.Ext(d.Name()))]; ok {
+			errs = append(errs, fmt.Errorf("file %q uses a forbidden extension", rel))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return joinErrors(errs)
+}
+
+// walkTransfer calls fn for every file and directory found in the transfer,
+// excluding the root directory. The path passed to fn is relative to root.
+func walkTransfer(root string, fn func(rel string, d fs.DirEntry) error) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == root {
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		return fn(filepath.ToSlash(rel), d)
+	})
+}
@@ -1,56 +1,144 @@
 package validation
 
 import (
+	"errors"
 	"fmt"
-	"os"
-	"path"
+	"strings"
+)
+
+// Validator is the interface that all validators must implement.
+type Validator interface {
+	Valid() error
+}
+
+// factory builds a validator for the transfer found in path.
+type factory func(cfg ValidatorConfig, path string) (Validator, error)
+
+// registry maps validator names to their factories. The names are the values
+// accepted by the name attribute of the validator configuration.
+var registry = map[string]factory{
+	ChecksumExistsValidatorName:      newChecksumExistsValidator,
+	ChecksumManifestValidatorName:    newChecksumManifestValidator,
+	RequiredDirectoriesValidatorName: newRequiredDirectoriesValidator,
+	FilenamePolicyValidatorName:      newFilenamePolicyValidator,
+	MaxFileSizeValidatorName:         newMaxFileSizeValidator,
+	MaxFileCountValidatorName:        newMaxFileCountValidator,
+	ForbiddenExtensionsValidatorName: newForbiddenExtensionsValidator,
+}
 
-	"github.com/hashicorp/go-multierror"
+// Status is the outcome of a single validator.
+type Status string
+
+const (
+	StatusPassed Status = "passed"
+	StatusFailed Status = "failed"
+	StatusWarned Status = "warned"
 )
 
-var checksumFiles = [4]string{
-	"checksum.md5",
-	"checksum.sha1",
-	"checksum.sha256",
-	"checksum.sha512",
+// Result describes the outcome of a single validator of the chain.
+type Result struct {
+	Validator string
+	Severity  Severity
+	Status    Status
+	Message   string
 }
 
-func ValidateTransfer(c Config, path string) error {
-	var result error
+// Report contains the results of the validator chain, in order.
+type Report struct {
+	Results []Result
+}
 
-	if c.ChecksumsCheckEnabled {
-		v := ChecksumExistsValidator{path: path}
-		if err := v.Valid(); err != nil {
-			result = multierror.Append(result, err)
+// Failed returns the results of validators that failed with fail severity.
+func (r Report) Failed() []Result {
+	var failed []Result
+	for _, result := range r.Results {
+		if result.Status == StatusFailed {
+			failed = append(failed, result)
 		}
 	}
+	return failed
+}
 
-	return result
+// Warnings returns the results of validators that failed with warn severity.
+func (r Report) Warnings() []Result {
+	var warned []Result
+	for _, result := range r.Results {
+		if result.Status == StatusWarned {
+			warned = append(warned, result)
+		}
+	}
+	return warned
 }
 
-// Validator is the interface that all validators must implement.
-type Validator interface {
-	Valid() error
+// Err returns a non-nil error when one or more validators with fail severity
+// did not pass.
+func (r Report) Err() error {
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(failed))
+	for _, result := range failed {
+		names = append(names, result.Validator)
+	}
+
+	return &FailedError{Validators: names}
 }
 
-// ChecksumExistsValidator is a Validator that checks...
-type ChecksumExistsValidator struct {
-	path string
+// FailedError is returned when the transfer does not pass validation.
+type FailedError struct {
+	Validators []string
 }
 
-func (v ChecksumExistsValidator) Valid() error {
-	for _, checksum := range checksumFiles {
-		if fileExists(path.Join(v.path, "metadata", checksum)) {
-			return nil
+func (e *FailedError) Error() string {
+	return fmt.Sprintf("transfer validation failed (validators: %s)", strings.Join(e.Validators, ", "))
+}
+
+// ValidateTransfer runs the configured validator chain against the transfer
+// found in path. The returned error is only used for configuration problems,
+// validation failures are reported via Report.
+func ValidateTransfer(c Config, path string) (*Report, error) {
+	report := &Report{}
+
+	for _, vc := range c.Chain() {
+		if err := vc.Validate(); err != nil {
+			return nil, err
+		}
+
+		v, err := registry[vc.Name](vc, path)
+		if err != nil {
+			return nil, fmt.Errorf("error creating validator %q: %w", vc.Name, err)
 		}
+
+		result := Result{
+			Validator: vc.Name,
+			Severity:  vc.Severity,
+			Status:    StatusPassed,
+		}
+		if err := v.Valid(); err != nil {
+			result.Status = StatusFailed
+			if vc.Severity == SeverityWarn {
+				result.Status = StatusWarned
+			}
+			result.Message = err.Error()
+		}
+
+		report.Results = append(report.Results, result)
 	}
-	return fmt.Errorf("transfer does not contain checksums (path=%s)", v.path)
+
+	return report, nil
 }
 
-func fileExists(name string) bool {
-	stat, err := os.Stat(name)
-	if err != nil {
-		return false
+// joinErrors combines a list of violations into a single error, capping the
+// number of entries so the message stays readable.
+func joinErrors(errs []error) error {
+	const limit = 20
+
+	if len(errs) <= limit {
+		return errors.Join(errs...)
 	}
-	return !stat.IsDir()
+
+	capped := append(errs[:limit:limit], fmt.Errorf("... and %d more", len(errs)-limit))
+	return errors.Join(capped...)
 }
@@ -65,3 +65,225 @@ func TestChecksumExistsValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestChecksumManifestValidator(t *testing.T) {
+	tests := map[string]struct {
+		dirOpts      []fs.PathOp
+		errorMessage string
+	}{
+		"Validates entries relative to the metadata directory": {
+			dirOpts: []fs.PathOp{
+				fs.WithDir("objects", fs.WithFile("a.txt", "hello\n")),
+				fs.WithDir("metadata",
+					fs.WithFile("checksum.md5", "b1946ac92492d2347c6235b4d2611184  ../objects/a.txt\n"),
+				),
+			},
+		},
+		"Validates entries relative to the transfer": {
+			dirOpts: []fs.PathOp{
+				fs.WithDir("objects", fs.WithFile("a.txt", "hello\n")),
+				fs.WithDir("metadata",
+					fs.WithFile("checksum.sha256", "5891b5b522d5df086d0ff0b110fbd9d21bb4fc7163af34d08286a2e846f6be03 *objects/a.txt\n"),
+				),
+			},
+		},
+		"Fails when a digest does not match": {
+			dirOpts: []fs.PathOp{
+				fs.WithDir("objects", fs.WithFile("a.txt", "bye\n")),
+				fs.WithDir("metadata",
+					fs.WithFile("checksum.md5", "b1946ac92492d2347c6235b4d2611184  ../objects/a.txt\n"),
+				),
+			},
+			errorMessage: "checksum.md5: ../objects/a.txt: checksum mismatch",
+		},
+		"Fails when a file is missing": {
+			dirOpts: []fs.PathOp{
+				fs.WithDir("metadata",
+					fs.WithFile("checksum.md5", "b1946ac92492d2347c6235b4d2611184  objects/a.txt\n"),
+				),
+			},
+			errorMessage: "checksum.md5: objects/a.txt: open",
+		},
+		"Fails when an entry points outside of the transfer": {
+			dirOpts: []fs.PathOp{
+				fs.WithDir("metadata",
+					fs.WithFile("checksum.md5", "b1946ac92492d2347c6235b4d2611184  ../../a.txt\n"),
+				),
+			},
+			errorMessage: "path is outside of the transfer",
+		},
+		"Fails when a line is malformed": {
+			dirOpts: []fs.PathOp{
+				fs.WithDir("metadata",
+					fs.WithFile("checksum.md5", "foobar\n"),
+				),
+			},
+			errorMessage: "checksum.md5: line 1 is malformed",
+		},
+		"Fails when there are no checksums": {
+			dirOpts:      []fs.PathOp{fs.WithDir("metadata")},
+			errorMessage: "transfer does not contain checksums",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tmpDir := fs.NewDir(t, "transfer", tc.dirOpts...)
+			defer tmpDir.Remove()
+
+			validator := ChecksumManifestValidator{path: tmpDir.Path()}
+			err := validator.Valid()
+
+			if tc.errorMessage == "" {
+				assert.NilError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.errorMessage)
+			}
+		})
+	}
+}
+
+func TestFileValidators(t *testing.T) {
+	dirOpts := []fs.PathOp{
+		fs.WithDir("objects",
+			fs.WithFile("image.JPG", "12345"),
+			fs.WithFile("run.exe", "1"),
+			fs.WithFile("bad:name.txt", ""),
+		),
+		fs.WithDir("metadata"),
+	}
+
+	tests := map[string]struct {
+		cfg          ValidatorConfig
+		errorMessage string
+	}{
+		"required-directories passes": {
+			cfg: ValidatorConfig{Name: RequiredDirectoriesValidatorName, Directories: []string{"objects", "metadata"}},
+		},
+		"required-directories fails": {
+			cfg:          ValidatorConfig{Name: RequiredDirectoriesValidatorName, Directories: []string{"objects", "logs"}},
+			errorMessage: `required directory "logs" is missing`,
+		},
+		"filename-policy fails with default pattern": {
+			cfg:          ValidatorConfig{Name: FilenamePolicyValidatorName},
+			errorMessage: `name not allowed: "objects/bad:name.txt"`,
+		},
+		"filename-policy passes with custom pattern": {
+			cfg: ValidatorConfig{Name: FilenamePolicyValidatorName, Pattern: `^[\w.:]+$`},
+		},
+		"max-file-size passes": {
+			cfg: ValidatorConfig{Name: MaxFileSizeValidatorName, MaxFileSize: 5},
+		},
+		"max-file-size fails": {
+			cfg:          ValidatorConfig{Name: MaxFileSizeValidatorName, MaxFileSize: 4},
+			errorMessage: `file "objects/image.JPG" is too large (5 bytes, limit is 4 bytes)`,
+		},
+		"max-file-count passes": {
+			cfg: ValidatorConfig{Name: MaxFileCountValidatorName, MaxFileCount: 3},
+		},
+		"max-file-count fails": {
+			cfg:          ValidatorConfig{Name: MaxFileCountValidatorName, MaxFileCount: 2},
+			errorMessage: "transfer contains too many files (3 files, limit is 2)",
+		},
+		"forbidden-extensions passes": {
+			cfg: ValidatorConfig{Name: ForbiddenExtensionsValidatorName, Extensions: []string{"bat"}},
+		},
+		"forbidden-extensions fails": {
+			cfg:          ValidatorConfig{Name: ForbiddenExtensionsValidatorName, Extensions: []string{"EXE", ".jpg"}},
+			errorMessage: `file "objects/image.JPG" uses a forbidden extension`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tmpDir := fs.NewDir(t, "transfer", dirOpts...)
+			defer tmpDir.Remove()
+
+			validator, err := registry[tc.cfg.Name](tc.cfg, tmpDir.Path())
+			assert.NilError(t, err)
+			err = validator.Valid()
+
+			if tc.errorMessage == "" {
+				assert.NilError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.errorMessage)
+			}
+		})
+	}
+}
+
+func TestValidateTransfer(t *testing.T) {
+	t.Run("Runs the chain in order", func(t *testing.T) {
+		tmpDir := fs.NewDir(t, "transfer",
+			fs.WithDir("objects", fs.WithFile("run.exe", "")),
+		)
+		defer tmpDir.Remove()
+
+		report, err := ValidateTransfer(Config{
+			ChecksumsCheckEnabled: true,
+			Validators: []ValidatorConfig{
+				{Name: RequiredDirectoriesValidatorName, Directories: []string{"objects"}},
+				{Name: ForbiddenExtensionsValidatorName, Severity: SeverityWarn, Extensions: []string{"exe"}},
+			},
+		}, tmpDir.Path())
+		assert.NilError(t, err)
+
+		assert.DeepEqual(t, report.Results, []Result{
+			{
+				Validator: ChecksumExistsValidatorName,
+				Severity:  SeverityFail,
+				Status:    StatusFailed,
+				Message:   "transfer does not contain checksums (path=" + tmpDir.Path() + ")",
+			},
+			{
+				Validator: RequiredDirectoriesValidatorName,
+				Severity:  SeverityFail,
+				Status:    StatusPassed,
+			},
+			{
+				Validator: ForbiddenExtensionsValidatorName,
+				Severity:  SeverityWarn,
+				Status:    StatusWarned,
+				Message:   `file "objects/run.exe" uses a forbidden extension`,
+			},
+		})
+		assert.Equal(t, len(report.Warnings()), 1)
+		assert.Error(t, report.Err(), "transfer validation failed (validators: checksum-exists)")
+	})
+
+	t.Run("Rejects unknown validators", func(t *testing.T) {
+		_, err := ValidateTransfer(Config{
+			Validators: []ValidatorConfig{{Name: "foobar"}},
+		}, t.TempDir())
+		assert.Error(t, err, `unknown validator "foobar"`)
+	})
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := map[string]struct {
+		cfg          Config
+		errorMessage string
+	}{
+		"Accepts an empty configuration": {},
+		"Rejects invalid severities": {
+			cfg:          Config{Validators: []ValidatorConfig{{Name: ChecksumManifestValidatorName, Severity: "ignore"}}},
+			errorMessage: `invalid validation configuration (validators[0]): invalid severity "ignore"`,
+		},
+		"Rejects invalid patterns": {
+			cfg:          Config{Validators: []ValidatorConfig{{Name: FilenamePolicyValidatorName, Pattern: "("}}},
+			errorMessage: "invalid validation configuration (validators[0]): filename-policy: invalid pattern",
+		},
+		"Rejects missing limits": {
+			cfg:          Config{Validators: []ValidatorConfig{{Name: MaxFileSizeValidatorName}}},
+			errorMessage: "invalid validation configuration (validators[0]): max-file-size: maxFileSize must be greater than zero",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			if tc.errorMessage == "" {
+				assert.NilError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.errorMessage)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package watcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// amqpEventSource consumes messages from an AMQP 0-9-1 queue, e.g. RabbitMQ.
+// Deliveries are acknowledged manually, so the broker requeues the ones that
+// are still unacknowledged when the connection is lost. After a rejection the
+// source waits before taking the next delivery, since AMQP cannot requeue a
+// message with a delay.
+type amqpEventSource struct {
+	url        string
+	queue      string
+	mu         sync.Mutex
+	conn       *amqp.Connection
+	deliveries <-chan amqp.Delivery
+	backoff    redeliveryBackoff
+}
+
+var _ eventSource = (*amqpEventSource)(nil)
+
+func newAMQPEventSource(config *S3Config) (*amqpEventSource, error) {
+	if config.AMQPURL == "" {
+		return nil, errors.New("amqpURL is required")
+	}
+	if config.AMQPQueue == "" {
+		return nil, errors.New("amqpQueue is required")
+	}
+
+	return &amqpEventSource{
+		url:   config.AMQPURL,
+		queue: config.AMQPQueue,
+	}, nil
+}
+
+// consume connects to the broker unless there is a working connection already.
+func (s *amqpEventSource) consume() (<-chan amqp.Delivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn != nil && !s.conn.IsClosed() {
+		return s.deliveries, nil
+	}
+
+	conn, err := amqp.Dial(s.url)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := conn.Channel()
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	// Only one unacknowledged delivery at a time: the watcher does not fetch
+	// the next event until the previous one has been settled.
+	if err := channel.Qos(1, 0, false); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	deliveries, err := channel.Consume(s.queue, "", false, false, false, false, nil)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	s.conn, s.deliveries = conn, deliveries
+
+	return deliveries, nil
+}
+
+func (s *amqpEventSource) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn != nil {
+		_ = s.conn.Close()
+	}
+	s.conn, s.deliveries = nil, nil
+}
+
+func (s *amqpEventSource) receive(ctx context.Context) (*sourceMessage, error) {
+	if err := s.backoff.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	deliveries, err := s.consume()
+	if err != nil {
+		return nil, fmt.Errorf("error consuming from AMQP queue: %w", err)
+	}
+
+	timer := time.NewTimer(eventSourceTimeout)
+	defer timer.Stop()
+
+	select {
+	case delivery, ok := <-deliveries:
+		if !ok {
+			s.reset()
+			return nil, errors.New("error consuming from AMQP queue: channel closed")
+		}
+		return &sourceMessage{
+			body:    delivery.Body,
+			ack:     func() error { s.backoff.succeeded(); return delivery.Ack(false) },
+			nack:    func() error { s.backoff.failed(); return delivery.Nack(false, true) },
+			discard: func() error { return delivery.Reject(false) },
+		}, nil
+	case <-timer.C:
+		return nil, ErrWatchTimeout
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -41,21 +41,23 @@ type FilesystemConfig struct {
 	RejectDuplicates   bool
 	ExcludeHiddenFiles bool
 	TransferType       string
+	RescanInterval     time.Duration
 }
 
 // See minio.go for more.
 type MinioConfig struct {
-	Name         string
-	RedisAddress string
-	RedisList    string
-	Region       string
-	Endpoint     string
-	PathStyle    bool
-	Profile      string
-	Key          string
-	Secret       string
-	Token        string
-	Bucket       string
+	Name                string
+	RedisAddress        string
+	RedisList           string
+	RedisProcessingList string
+	Region              string
+	Endpoint            string
+	PathStyle           bool
+	Profile             string
+	Key                 string
+	Secret              string
+	Token               string
+	Bucket              string
 
 	Pipeline           []string
 	RetentionPeriod    *time.Duration
@@ -63,6 +65,7 @@ type MinioConfig struct {
 	RejectDuplicates   bool
 	ExcludeHiddenFiles bool
 	TransferType       string
+	RescanInterval     time.Duration
 }
 
 // See minio.go for more.
@@ -77,10 +80,17 @@ type S3Config struct {
 	Token     string
 	Bucket    string
 
-	EventSource  string
-	EventFormat  string
-	RedisAddress string
-	RedisList    string
+	EventSource         string
+	EventFormat         string
+	RedisAddress        string
+	RedisList           string
+	RedisProcessingList string
+	NATSURL             string
+	NATSStream          string
+	NATSConsumer        string
+	NATSSubject         string
+	AMQPURL             string
+	AMQPQueue           string
 
 	Pipeline           []string
 	RetentionPeriod    *time.Duration
@@ -88,4 +98,5 @@ type S3Config struct {
 	RejectDuplicates   bool
 	ExcludeHiddenFiles bool
 	TransferType       string
+	RescanInterval     time.Duration
 }
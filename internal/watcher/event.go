@@ -46,6 +46,10 @@ type BlobEvent struct {
 
 	// Bucket where the blob lives.
 	Bucket string `json:"Bucket,omitempty"`
+
+	// ack and nack settle the message that delivered the event. They are nil
+	// when the event source does not support acknowledgements.
+	ack, nack func() error
 }
 
 func NewBlobEvent(w Watcher, key string, isDir bool) *BlobEvent {
@@ -69,6 +73,24 @@ func NewBlobEventWithBucket(w Watcher, bucket, key string) *BlobEvent {
 	return e
 }
 
+// Ack acknowledges the message that delivered the event. It should be called
+// once the processing workflow has been started.
+func (e *BlobEvent) Ack() error {
+	if e.ack == nil {
+		return nil
+	}
+	return e.ack()
+}
+
+// Nack returns the message that delivered the event to the event source so it
+// can be delivered again.
+func (e *BlobEvent) Nack() error {
+	if e.nack == nil {
+		return nil
+	}
+	return e.nack()
+}
+
 func (e BlobEvent) String() string {
 	key := e.Key
 
@@ -0,0 +1,261 @@
+package watcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	redisInFlightMessages = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "enduro",
+			Subsystem: "watcher",
+			Name:      "redis_inflight_messages",
+			Help:      "Number of Redis messages received by the watcher that are not settled yet.",
+		},
+		[]string{"watcher"},
+	)
+	redisRedeliveredMessages = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "enduro",
+			Subsystem: "watcher",
+			Name:      "redis_redelivered_messages_total",
+			Help:      "Number of in-flight Redis messages re-driven after a restart.",
+		},
+		[]string{"watcher"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(redisInFlightMessages, redisRedeliveredMessages)
+}
+
+// eventSource delivers the bucket notifications consumed by s3Watcher.
+type eventSource interface {
+	// receive waits for the next message. It returns ErrWatchTimeout when no
+	// message arrives before the poll timeout of the source.
+	receive(ctx context.Context) (*sourceMessage, error)
+}
+
+// sourceMessage is a message received from an event source. The settlement
+// functions are nil when the source does not support acknowledgements.
+type sourceMessage struct {
+	body []byte
+
+	// ack confirms that the message has been processed.
+	ack func() error
+
+	// nack requests the message to be delivered again.
+	nack func() error
+
+	// discard drops a message that cannot be processed.
+	discard func() error
+}
+
+func (m *sourceMessage) drop() {
+	if m.discard != nil {
+		_ = m.discard()
+	}
+}
+
+const (
+	// redeliveryMinDelay is the delay before a message that could not be
+	// dispatched is delivered again. It doubles after every consecutive
+	// failure up to redeliveryMaxDelay.
+	redeliveryMinDelay = time.Second
+	redeliveryMaxDelay = time.Minute
+)
+
+// redeliveryBackoff delays the delivery of messages after failed dispatches,
+// so an event that keeps failing is not redelivered in a tight loop. It is
+// reset when a message is acknowledged.
+type redeliveryBackoff struct {
+	mu       sync.Mutex
+	failures int
+	pending  time.Duration
+}
+
+// failed records a failed dispatch and returns the delay before the next
+// delivery.
+func (b *redeliveryBackoff) failed() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delay := redeliveryMinDelay
+	for i := 0; i < b.failures && delay < redeliveryMaxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, redeliveryMaxDelay)
+	b.failures++
+	b.pending = delay
+
+	return delay
+}
+
+func (b *redeliveryBackoff) succeeded() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures = 0
+	b.pending = 0
+}
+
+// wait blocks for the delay of the last failed dispatch, once.
+func (b *redeliveryBackoff) wait(ctx context.Context) error {
+	b.mu.Lock()
+	delay := b.pending
+	b.pending = 0
+	b.mu.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// redisEventSource implements the reliable queue pattern on top of Redis
+// lists. Messages are atomically moved into a processing list owned by the
+// watcher instance and they're only removed from it once they have been
+// settled, so the events in flight when Enduro stops are delivered again on
+// startup. Rejected messages are returned to the tail of the list after a
+// delay.
+type redisEventSource struct {
+	client         redis.UniversalClient
+	listName       string
+	processingList string
+	watcherName    string
+	swept          bool
+	backoff        redeliveryBackoff
+}
+
+var _ eventSource = (*redisEventSource)(nil)
+
+// redisSettleTimeout bounds the Redis commands used to settle messages.
+const redisSettleTimeout = time.Second * 5
+
+func newRedisEventSource(config *S3Config) (*redisEventSource, error) {
+	opts, err := redis.ParseURL(config.RedisAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// The processing list is scoped to the host so the startup sweep does not
+	// re-drive the messages in flight in other instances sharing the watcher.
+	processingList := config.RedisProcessingList
+	if processingList == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, fmt.Errorf("error looking up hostname: %w", err)
+		}
+		processingList = fmt.Sprintf("%s:processing:%s:%s", config.RedisList, config.Name, hostname)
+	}
+
+	return &redisEventSource{
+		client:         redis.NewClient(opts),
+		listName:       config.RedisList,
+		processingList: processingList,
+		watcherName:    config.Name,
+	}, nil
+}
+
+func (s *redisEventSource) receive(ctx context.Context) (*sourceMessage, error) {
+	if !s.swept {
+		if err := s.sweep(ctx); err != nil {
+			return nil, fmt.Errorf("error re-driving in-flight Redis messages: %w", err)
+		}
+		s.swept = true
+	}
+	if err := s.backoff.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	val, err := s.client.BLMove(ctx, s.listName, s.processingList, "LEFT", "RIGHT", eventSourceTimeout).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrWatchTimeout
+		}
+		return nil, fmt.Errorf("error retrieving from Redis list: %w", err)
+	}
+	s.observe(ctx)
+
+	return &sourceMessage{
+		body:    []byte(val),
+		ack:     func() error { s.backoff.succeeded(); return s.remove(val) },
+		nack:    func() error { s.backoff.failed(); return s.requeue(val) },
+		discard: func() error { return s.remove(val) },
+	}, nil
+}
+
+// sweep moves the messages left in the processing list by a previous run back
+// to the head of the list so they're delivered before newer messages.
+func (s *redisEventSource) sweep(ctx context.Context) error {
+	for {
+		err := s.client.LMove(ctx, s.processingList, s.listName, "RIGHT", "LEFT").Err()
+		if errors.Is(err, redis.Nil) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		redisRedeliveredMessages.WithLabelValues(s.watcherName).Inc()
+	}
+	s.observe(ctx)
+
+	return nil
+}
+
+func (s *redisEventSource) remove(val string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), redisSettleTimeout)
+	defer cancel()
+
+	if err := s.client.LRem(ctx, s.processingList, 1, val).Err(); err != nil {
+		return fmt.Errorf("error removing message from Redis processing list: %w", err)
+	}
+	s.observe(ctx)
+
+	return nil
+}
+
+// requeue moves a message from the processing list to the tail of the list, so
+// the messages that are waiting are delivered first.
+func (s *redisEventSource) requeue(val string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), redisSettleTimeout)
+	defer cancel()
+
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.LRem(ctx, s.processingList, 1, val)
+		pipe.RPush(ctx, s.listName, val)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error returning message to Redis list: %w", err)
+	}
+	s.observe(ctx)
+
+	return nil
+}
+
+// observe updates the in-flight gauge with the length of the processing list.
+func (s *redisEventSource) observe(ctx context.Context) {
+	n, err := s.client.LLen(ctx, s.processingList).Result()
+	if err != nil {
+		return
+	}
+	redisInFlightMessages.WithLabelValues(s.watcherName).Set(float64(n))
+}
@@ -0,0 +1,141 @@
+package watcher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+type fakeEventSource struct {
+	msgs []*sourceMessage
+}
+
+func (s *fakeEventSource) receive(ctx context.Context) (*sourceMessage, error) {
+	if len(s.msgs) == 0 {
+		return nil, ErrWatchTimeout
+	}
+	msg := s.msgs[0]
+	s.msgs = s.msgs[1:]
+	return msg, nil
+}
+
+type settlements struct {
+	acked, nacked, discarded int
+}
+
+func (s *settlements) message(body string) *sourceMessage {
+	return &sourceMessage{
+		body:    []byte(body),
+		ack:     func() error { s.acked++; return nil },
+		nack:    func() error { s.nacked++; return nil },
+		discard: func() error { s.discarded++; return nil },
+	}
+}
+
+func newTestS3Watcher(source eventSource) *s3Watcher {
+	return &s3Watcher{
+		source:            source,
+		bucketName:        "bucket",
+		eventFormat:       S3EventFormatEnduro,
+		commonWatcherImpl: &commonWatcherImpl{name: "s3-watcher"},
+	}
+}
+
+func TestS3WatcherSettlesMessages(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Event is settled by the caller", func(t *testing.T) {
+		t.Parallel()
+
+		var s settlements
+		w := newTestS3Watcher(&fakeEventSource{msgs: []*sourceMessage{
+			s.message(`{"version": "1", "type": "object.created", "bucket": "bucket", "key": "a.zip"}`),
+			s.message(`{"version": "1", "type": "object.created", "bucket": "bucket", "key": "b.zip"}`),
+		}})
+
+		event, err := w.Watch(context.Background())
+		assert.NilError(t, err)
+		assert.Equal(t, event.Key, "a.zip")
+		assert.Equal(t, s, settlements{})
+
+		assert.NilError(t, event.Ack())
+		assert.Equal(t, s, settlements{acked: 1})
+
+		event, err = w.Watch(context.Background())
+		assert.NilError(t, err)
+		assert.Equal(t, event.Key, "b.zip")
+
+		assert.NilError(t, event.Nack())
+		assert.Equal(t, s, settlements{acked: 1, nacked: 1})
+	})
+
+	t.Run("Invalid messages are discarded", func(t *testing.T) {
+		t.Parallel()
+
+		var s settlements
+		w := newTestS3Watcher(&fakeEventSource{msgs: []*sourceMessage{
+			s.message(`{"version": "2"}`),
+		}})
+
+		_, err := w.Watch(context.Background())
+		assert.ErrorContains(t, err, `unsupported Enduro event version "2"`)
+		assert.Equal(t, s, settlements{discarded: 1})
+	})
+
+	t.Run("Messages from other buckets are discarded", func(t *testing.T) {
+		t.Parallel()
+
+		var s settlements
+		w := newTestS3Watcher(&fakeEventSource{msgs: []*sourceMessage{
+			s.message(`{"version": "1", "type": "object.created", "bucket": "other", "key": "a.zip"}`),
+		}})
+
+		_, err := w.Watch(context.Background())
+		assert.ErrorIs(t, err, ErrBucketMismatch)
+		assert.Equal(t, s, settlements{discarded: 1})
+	})
+
+	t.Run("Events without acknowledgements are settled as no-ops", func(t *testing.T) {
+		t.Parallel()
+
+		w := newTestS3Watcher(&fakeEventSource{msgs: []*sourceMessage{
+			{body: []byte(`{"version": "1", "type": "object.created", "bucket": "bucket", "key": "a.zip"}`)},
+		}})
+
+		event, err := w.Watch(context.Background())
+		assert.NilError(t, err)
+		assert.NilError(t, event.Ack())
+		assert.NilError(t, event.Nack())
+	})
+}
+
+func TestRedeliveryBackoff(t *testing.T) {
+	t.Parallel()
+
+	var b redeliveryBackoff
+	var delays []time.Duration
+	for range 8 {
+		delays = append(delays, b.failed())
+	}
+	assert.DeepEqual(t, delays, []time.Duration{
+		time.Second,
+		time.Second * 2,
+		time.Second * 4,
+		time.Second * 8,
+		time.Second * 16,
+		time.Second * 32,
+		time.Minute,
+		time.Minute,
+	})
+
+	// The delay is only waited once.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, b.wait(ctx), context.Canceled)
+	assert.NilError(t, b.wait(ctx))
+
+	b.succeeded()
+	assert.Equal(t, b.failed(), time.Second)
+}
@@ -195,6 +195,84 @@ func (c *MockServiceDownloadCall) DoAndReturn(f func(context.Context, io.Writer,
 	return c
 }
 
+// Scan mocks base method.
+func (m *MockService) Scan(ctx context.Context, watcherName string) ([]*watcher.BlobEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scan", ctx, watcherName)
+	ret0, _ := ret[0].([]*watcher.BlobEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockServiceMockRecorder) Scan(ctx, watcherName any) *MockServiceScanCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockService)(nil).Scan), ctx, watcherName)
+	return &MockServiceScanCall{Call: call}
+}
+
+// MockServiceScanCall wrap *gomock.Call
+type MockServiceScanCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceScanCall) Return(arg0 []*watcher.BlobEvent, arg1 error) *MockServiceScanCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceScanCall) Do(f func(context.Context, string) ([]*watcher.BlobEvent, error)) *MockServiceScanCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceScanCall) DoAndReturn(f func(context.Context, string) ([]*watcher.BlobEvent, error)) *MockServiceScanCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Size mocks base method.
+func (m *MockService) Size(ctx context.Context, watcherName, key string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size", ctx, watcherName, key)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockServiceMockRecorder) Size(ctx, watcherName, key any) *MockServiceSizeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockService)(nil).Size), ctx, watcherName, key)
+	return &MockServiceSizeCall{Call: call}
+}
+
+// MockServiceSizeCall wrap *gomock.Call
+type MockServiceSizeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceSizeCall) Return(arg0 int64, arg1 error) *MockServiceSizeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceSizeCall) Do(f func(context.Context, string, string) (int64, error)) *MockServiceSizeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceSizeCall) DoAndReturn(f func(context.Context, string, string) (int64, error)) *MockServiceSizeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Watchers mocks base method.
 func (m *MockService) Watchers() []watcher.Watcher {
 	m.ctrl.T.Helper()
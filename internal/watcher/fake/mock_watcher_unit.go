@@ -272,6 +272,44 @@ func (c *MockWatcherRejectDuplicatesCall) DoAndReturn(f func() bool) *MockWatche
 	return c
 }
 
+// RescanInterval mocks base method.
+func (m *MockWatcher) RescanInterval() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RescanInterval")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// RescanInterval indicates an expected call of RescanInterval.
+func (mr *MockWatcherMockRecorder) RescanInterval() *MockWatcherRescanIntervalCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RescanInterval", reflect.TypeOf((*MockWatcher)(nil).RescanInterval))
+	return &MockWatcherRescanIntervalCall{Call: call}
+}
+
+// MockWatcherRescanIntervalCall wrap *gomock.Call
+type MockWatcherRescanIntervalCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockWatcherRescanIntervalCall) Return(arg0 time.Duration) *MockWatcherRescanIntervalCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockWatcherRescanIntervalCall) Do(f func() time.Duration) *MockWatcherRescanIntervalCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockWatcherRescanIntervalCall) DoAndReturn(f func() time.Duration) *MockWatcherRescanIntervalCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RetentionPeriod mocks base method.
 func (m *MockWatcher) RetentionPeriod() *time.Duration {
 	m.ctrl.T.Helper()
@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"regexp"
 	"runtime"
+	"time"
 
 	"github.com/fsnotify/fsnotify"
 	"gocloud.dev/blob"
@@ -79,6 +81,7 @@ func NewFilesystemWatcher(ctx context.Context, config *FilesystemConfig) (*files
 			rejectDuplicates:   config.RejectDuplicates,
 			excludeHiddenFiles: config.ExcludeHiddenFiles,
 			transferType:       config.TransferType,
+			rescanInterval:     config.RescanInterval,
 		},
 	}
 
@@ -158,3 +161,38 @@ func (w *filesystemWatcher) Dispose(key string) error {
 
 	return fsutil.Move(src, dst)
 }
+
+func (w *filesystemWatcher) scan(ctx context.Context, before time.Time) ([]*BlobEvent, error) {
+	entries, err := os.ReadDir(w.path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading directory: %w", err)
+	}
+
+	var completedDir string
+	if w.completedDir != "" {
+		completedDir, _ = filepath.Abs(w.completedDir)
+	}
+
+	events := []*BlobEvent{}
+	for _, entry := range entries {
+		if w.regex != nil && w.regex.MatchString(entry.Name()) {
+			continue
+		}
+		if completedDir != "" && filepath.Join(w.path, entry.Name()) == completedDir {
+			continue
+		}
+		info, err := entry.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error in file stat check: %w", err)
+		}
+		if info.ModTime().After(before) {
+			continue
+		}
+		events = append(events, NewBlobEvent(w, entry.Name(), info.IsDir()))
+	}
+
+	return events, nil
+}
@@ -0,0 +1,85 @@
+package watcher_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+
+	"github.com/artefactual-labs/enduro/internal/watcher"
+)
+
+func TestFilesystemWatcherScan(t *testing.T) {
+	t.Parallel()
+
+	dir := fs.NewDir(t, "enduro",
+		fs.WithFile("old.zip", ""),
+		fs.WithDir("old-dir"),
+		fs.WithFile("new.zip", ""),
+		fs.WithFile("old.part", ""),
+		fs.WithDir("completed"),
+	)
+	old := time.Now().Add(-time.Hour)
+	for _, name := range []string{"old.zip", "old-dir", "old.part", "completed"} {
+		assert.NilError(t, os.Chtimes(dir.Join(name), old, old))
+	}
+
+	svc, err := watcher.New(context.Background(), &watcher.Config{
+		Filesystem: []*watcher.FilesystemConfig{
+			{
+				Name:           "fs",
+				Path:           dir.Path(),
+				Ignore:         `\.part$`,
+				CompletedDir:   dir.Join("completed"),
+				RescanInterval: time.Minute,
+			},
+		},
+	})
+	assert.NilError(t, err)
+
+	events, err := svc.Scan(context.Background(), "fs")
+	assert.NilError(t, err)
+
+	found := map[string]bool{}
+	for _, event := range events {
+		assert.Equal(t, event.WatcherName, "fs")
+		found[event.Key] = event.IsDir
+	}
+	assert.DeepEqual(t, found, map[string]bool{
+		"old.zip": false,
+		"old-dir": true,
+	})
+
+	_, err = svc.Scan(context.Background(), "unknown")
+	assert.ErrorContains(t, err, "unknown watcher unknown")
+}
+
+func TestFilesystemWatcherSize(t *testing.T) {
+	t.Parallel()
+
+	dir := fs.NewDir(t, "enduro",
+		fs.WithFile("transfer.zip", "zipped"),
+		fs.WithDir("transfer", fs.WithFile("a.txt", "abc"), fs.WithDir("sub", fs.WithFile("b.txt", "de"))),
+	)
+
+	svc, err := watcher.New(context.Background(), &watcher.Config{
+		Filesystem: []*watcher.FilesystemConfig{
+			{Name: "fs", Path: dir.Path()},
+		},
+	})
+	assert.NilError(t, err)
+
+	size, err := svc.Size(context.Background(), "fs", "transfer.zip")
+	assert.NilError(t, err)
+	assert.Equal(t, size, int64(6))
+
+	size, err = svc.Size(context.Background(), "fs", "transfer")
+	assert.NilError(t, err)
+	assert.Equal(t, size, int64(5))
+
+	_, err = svc.Size(context.Background(), "unknown", "transfer")
+	assert.ErrorContains(t, err, "unknown watcher unknown")
+}
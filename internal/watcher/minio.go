@@ -5,29 +5,31 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
-	"github.com/redis/go-redis/v9"
 	"gocloud.dev/blob"
 	"gocloud.dev/blob/s3blob"
 )
 
 const (
 	S3EventSourceRedis  = "redis"
+	S3EventSourceNATS   = "nats"
+	S3EventSourceAMQP   = "amqp"
 	S3EventFormatMinio  = "minio"
 	S3EventFormatEnduro = "enduro"
 )
 
 // s3Watcher implements a Watcher for S3-compatible object storage.
 type s3Watcher struct {
-	redisClient redis.UniversalClient
+	source      eventSource
 	s3Client    *s3.Client
-	listName    string
 	bucketName  string
 	eventFormat string
 	*commonWatcherImpl
@@ -40,7 +42,9 @@ type MinioEventSet struct {
 
 var _ Watcher = (*s3Watcher)(nil)
 
-const redisPopTimeout = time.Second * 2
+// eventSourceTimeout is how long Watch waits for a message before it returns
+// ErrWatchTimeout.
+const eventSourceTimeout = time.Second * 2
 
 func NewMinioWatcher(ctx context.Context, config *MinioConfig) (*s3Watcher, error) {
 	return NewS3Watcher(ctx, s3ConfigFromMinio(config))
@@ -52,25 +56,26 @@ func s3ConfigFromMinio(config *MinioConfig) *S3Config {
 	}
 
 	return &S3Config{
-		Name:               config.Name,
-		Region:             config.Region,
-		Endpoint:           config.Endpoint,
-		PathStyle:          config.PathStyle,
-		Profile:            config.Profile,
-		Key:                config.Key,
-		Secret:             config.Secret,
-		Token:              config.Token,
-		Bucket:             config.Bucket,
-		EventSource:        S3EventSourceRedis,
-		EventFormat:        S3EventFormatMinio,
-		RedisAddress:       config.RedisAddress,
-		RedisList:          config.RedisList,
-		Pipeline:           config.Pipeline,
-		RetentionPeriod:    config.RetentionPeriod,
-		StripTopLevelDir:   config.StripTopLevelDir,
-		RejectDuplicates:   config.RejectDuplicates,
-		ExcludeHiddenFiles: config.ExcludeHiddenFiles,
-		TransferType:       config.TransferType,
+		Name:                config.Name,
+		Region:              config.Region,
+		Endpoint:            config.Endpoint,
+		PathStyle:           config.PathStyle,
+		Profile:             config.Profile,
+		Key:                 config.Key,
+		Secret:              config.Secret,
+		Token:               config.Token,
+		Bucket:              config.Bucket,
+		EventSource:         S3EventSourceRedis,
+		EventFormat:         S3EventFormatMinio,
+		RedisAddress:        config.RedisAddress,
+		RedisList:           config.RedisList,
+		RedisProcessingList: config.RedisProcessingList,
+		Pipeline:            config.Pipeline,
+		RetentionPeriod:     config.RetentionPeriod,
+		StripTopLevelDir:    config.StripTopLevelDir,
+		RejectDuplicates:    config.RejectDuplicates,
+		ExcludeHiddenFiles:  config.ExcludeHiddenFiles,
+		TransferType:        config.TransferType,
 	}
 }
 
@@ -78,20 +83,28 @@ func NewS3Watcher(ctx context.Context, config *S3Config) (*s3Watcher, error) {
 	if config == nil {
 		return nil, errors.New("missing S3 watcher config")
 	}
-	if config.EventSource != S3EventSourceRedis {
-		return nil, fmt.Errorf("unsupported S3 watcher event source %q", config.EventSource)
-	}
 	if config.EventFormat != S3EventFormatMinio && config.EventFormat != S3EventFormatEnduro {
 		return nil, fmt.Errorf("unsupported S3 watcher event format %q", config.EventFormat)
 	}
 
-	opts, err := redis.ParseURL(config.RedisAddress)
+	var (
+		source eventSource
+		err    error
+	)
+	switch config.EventSource {
+	case S3EventSourceRedis:
+		source, err = newRedisEventSource(config)
+	case S3EventSourceNATS:
+		source, err = newNATSEventSource(config)
+	case S3EventSourceAMQP:
+		source, err = newAMQPEventSource(config)
+	default:
+		return nil, fmt.Errorf("unsupported S3 watcher event source %q", config.EventSource)
+	}
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error configuring %s event source: %w", config.EventSource, err)
 	}
 
-	client := redis.NewClient(opts)
-
 	awsConfig, err := awsconfig.LoadDefaultConfig(ctx,
 		awsconfig.WithSharedConfigProfile(config.Profile),
 		awsconfig.WithRegion(config.Region),
@@ -121,8 +134,7 @@ func NewS3Watcher(ctx context.Context, config *S3Config) (*s3Watcher, error) {
 	})
 
 	return &s3Watcher{
-		redisClient: client,
-		listName:    config.RedisList,
+		source:      source,
 		s3Client:    s3Client,
 		bucketName:  config.Bucket,
 		eventFormat: config.EventFormat,
@@ -134,21 +146,31 @@ func NewS3Watcher(ctx context.Context, config *S3Config) (*s3Watcher, error) {
 			rejectDuplicates:   config.RejectDuplicates,
 			excludeHiddenFiles: config.ExcludeHiddenFiles,
 			transferType:       config.TransferType,
+			rescanInterval:     config.RescanInterval,
 		},
 	}, nil
 }
 
+// Watch returns the next event delivered by the event source. Messages that
+// cannot be processed are discarded, otherwise they're settled by the caller
+// via BlobEvent.Ack or BlobEvent.Nack.
 func (w *s3Watcher) Watch(ctx context.Context) (*BlobEvent, error) {
-	event, err := w.blpop(ctx)
+	msg, err := w.source.receive(ctx)
 	if err != nil {
-		if errors.Is(err, redis.Nil) {
-			err = ErrWatchTimeout
-		}
 		return nil, err
 	}
+
+	event, err := w.event(msg.body)
+	if err != nil {
+		msg.drop()
+		return nil, fmt.Errorf("error processing item received: %w", err)
+	}
 	if event.Bucket != w.bucketName {
+		msg.drop()
 		return nil, ErrBucketMismatch
 	}
+	event.ack, event.nack = msg.ack, msg.nack
+
 	return event, nil
 }
 
@@ -156,21 +178,7 @@ func (w *s3Watcher) Path() string {
 	return ""
 }
 
-func (w *s3Watcher) blpop(ctx context.Context) (*BlobEvent, error) {
-	val, err := w.redisClient.BLPop(ctx, redisPopTimeout, w.listName).Result()
-	if err != nil {
-		return nil, fmt.Errorf("error retrieving from Redis list: %w", err)
-	}
-
-	event, err := w.event(val[1])
-	if err != nil {
-		return nil, fmt.Errorf("ereror processing item received: %w", err)
-	}
-
-	return event, nil
-}
-
-func (w *s3Watcher) event(blob string) (*BlobEvent, error) {
+func (w *s3Watcher) event(blob []byte) (*BlobEvent, error) {
 	switch w.eventFormat {
 	case S3EventFormatMinio:
 		return w.minioEvent(blob)
@@ -181,46 +189,46 @@ func (w *s3Watcher) event(blob string) (*BlobEvent, error) {
 	}
 }
 
-// minioEvent processes MinIO-specific events delivered via Redis. We expect a
-// single item array containing a map of {Event: ..., EvenTime: ...}
-func (w *s3Watcher) minioEvent(blob string) (*BlobEvent, error) {
+// minioEvent processes MinIO-specific events. We expect a single item array
+// containing a map of {Event: ..., EvenTime: ...}
+func (w *s3Watcher) minioEvent(blob []byte) (*BlobEvent, error) {
 	container := []json.RawMessage{}
-	if err := json.Unmarshal([]byte(blob), &container); err != nil {
+	if err := json.Unmarshal(blob, &container); err != nil {
 		return nil, err
 	}
 
 	var set MinioEventSet
 	if err := json.Unmarshal(container[0], &set); err != nil {
-		return nil, fmt.Errorf("error procesing item received from Redis list: %w", err)
+		return nil, fmt.Errorf("error procesing item: %w", err)
 	}
 	if len(set.Event) == 0 {
-		return nil, fmt.Errorf("error processing item received from Redis list: empty event list")
+		return nil, fmt.Errorf("error processing item: empty event list")
 	}
 
 	key, err := url.QueryUnescape(set.Event[0].S3.Object.Key)
 	if err != nil {
-		return nil, fmt.Errorf("error processing item received from Redis list: %w", err)
+		return nil, fmt.Errorf("error processing item: %w", err)
 	}
 
 	return NewBlobEventWithBucket(w, set.Event[0].S3.Bucket.Name, key), nil
 }
 
-func (w *s3Watcher) enduroEvent(blob string) (*BlobEvent, error) {
+func (w *s3Watcher) enduroEvent(blob []byte) (*BlobEvent, error) {
 	var event EnduroEvent
-	if err := json.Unmarshal([]byte(blob), &event); err != nil {
+	if err := json.Unmarshal(blob, &event); err != nil {
 		return nil, err
 	}
 	if event.Version != "1" {
-		return nil, fmt.Errorf("error processing item received from Redis list: unsupported Enduro event version %q", event.Version)
+		return nil, fmt.Errorf("error processing item: unsupported Enduro event version %q", event.Version)
 	}
 	if event.Type != EnduroEventTypeObjectCreated {
-		return nil, fmt.Errorf("error processing item received from Redis list: unsupported Enduro event type %q", event.Type)
+		return nil, fmt.Errorf("error processing item: unsupported Enduro event type %q", event.Type)
 	}
 	if event.Bucket == "" {
-		return nil, fmt.Errorf("error processing item received from Redis list: empty bucket")
+		return nil, fmt.Errorf("error processing item: empty bucket")
 	}
 	if event.Key == "" {
-		return nil, fmt.Errorf("error processing item received from Redis list: empty key")
+		return nil, fmt.Errorf("error processing item: empty key")
 	}
 
 	return NewBlobEventWithBucket(w, event.Bucket, event.Key), nil
@@ -229,3 +237,29 @@ func (w *s3Watcher) enduroEvent(blob string) (*BlobEvent, error) {
 func (w *s3Watcher) OpenBucket(ctx context.Context) (*blob.Bucket, error) {
 	return s3blob.OpenBucketV2(ctx, w.s3Client, w.bucketName, nil)
 }
+
+func (w *s3Watcher) scan(ctx context.Context, before time.Time) ([]*BlobEvent, error) {
+	bucket, err := w.OpenBucket(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error opening bucket: %w", err)
+	}
+	defer bucket.Close()
+
+	events := []*BlobEvent{}
+	iter := bucket.List(nil)
+	for {
+		obj, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error listing bucket: %w", err)
+		}
+		if obj.IsDir || strings.HasSuffix(obj.Key, "/") || obj.ModTime.After(before) {
+			continue
+		}
+		events = append(events, NewBlobEventWithBucket(w, w.bucketName, obj.Key))
+	}
+
+	return events, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"strings"
 	"testing"
 	"time"
@@ -104,6 +105,41 @@ func TestS3WatcherRejectsUnsupportedEventSource(t *testing.T) {
 	assert.ErrorContains(t, err, `unsupported S3 watcher event source "webhook"`)
 }
 
+func TestS3WatcherRequiresEventSourceSettings(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		config watcher.S3Config
+		err    string
+	}{
+		{
+			name:   "NATSStream",
+			config: watcher.S3Config{EventSource: watcher.S3EventSourceNATS, NATSConsumer: "enduro"},
+			err:    "error configuring nats event source: natsStream is required",
+		},
+		{
+			name:   "NATSConsumer",
+			config: watcher.S3Config{EventSource: watcher.S3EventSourceNATS, NATSStream: "minio"},
+			err:    "error configuring nats event source: natsConsumer is required",
+		},
+		{
+			name:   "AMQPURL",
+			config: watcher.S3Config{EventSource: watcher.S3EventSourceAMQP, AMQPQueue: "minio"},
+			err:    "error configuring amqp event source: amqpURL is required",
+		},
+		{
+			name:   "AMQPQueue",
+			config: watcher.S3Config{EventSource: watcher.S3EventSourceAMQP, AMQPURL: "amqp://localhost"},
+			err:    "error configuring amqp event source: amqpQueue is required",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.config.EventFormat = watcher.S3EventFormatMinio
+			_, err := watcher.NewS3Watcher(context.Background(), &tc.config)
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
 func TestS3WatcherRejectsUnsupportedEventFormat(t *testing.T) {
 	_, err := watcher.NewS3Watcher(context.Background(), &watcher.S3Config{
 		EventSource: watcher.S3EventSourceRedis,
@@ -543,3 +579,97 @@ func TestS3WatcherReturnsErrOnInvalidEnduroMessages(t *testing.T) {
 		})
 	}
 }
+
+func TestS3WatcherReliableRedisQueue(t *testing.T) {
+	hostname, err := os.Hostname()
+	assert.NilError(t, err)
+
+	const list = "minio-events"
+	processing := "minio-events:processing:s3-watcher:" + hostname
+	message := func(key string) string {
+		return fmt.Sprintf(`{"version": "1", "type": "object.created", "bucket": "bucket", "key": %q}`, key)
+	}
+	listed := func(t *testing.T, m *miniredis.Miniredis, key string) []string {
+		t.Helper()
+		if !m.Exists(key) {
+			return nil
+		}
+		items, err := m.List(key)
+		assert.NilError(t, err)
+		return items
+	}
+
+	t.Run("Acknowledged events are removed from the processing list", func(t *testing.T) {
+		m, w := newS3WatcherWithEventFormat(t, watcher.S3EventFormatEnduro)
+		defer cleanup(t, m)
+
+		m.Lpush(list, message("transfer.zip"))
+
+		event, err := w.Watch(context.Background())
+		assert.NilError(t, err)
+		assert.Equal(t, event.Key, "transfer.zip")
+		assert.DeepEqual(t, listed(t, m, processing), []string{message("transfer.zip")})
+
+		assert.NilError(t, event.Ack())
+		assert.Equal(t, len(listed(t, m, processing)), 0)
+		assert.Equal(t, len(listed(t, m, list)), 0)
+	})
+
+	t.Run("Rejected events are returned to the list", func(t *testing.T) {
+		m, w := newS3WatcherWithEventFormat(t, watcher.S3EventFormatEnduro)
+		defer cleanup(t, m)
+
+		m.Lpush(list, message("transfer.zip"))
+
+		event, err := w.Watch(context.Background())
+		assert.NilError(t, err)
+
+		assert.NilError(t, event.Nack())
+		assert.Equal(t, len(listed(t, m, processing)), 0)
+		assert.DeepEqual(t, listed(t, m, list), []string{message("transfer.zip")})
+	})
+
+	t.Run("Rejected events are delivered after the waiting events", func(t *testing.T) {
+		m, w := newS3WatcherWithEventFormat(t, watcher.S3EventFormatEnduro)
+		defer cleanup(t, m)
+
+		m.RPush(list, message("a.zip"))
+		m.RPush(list, message("b.zip"))
+
+		event, err := w.Watch(context.Background())
+		assert.NilError(t, err)
+		assert.Equal(t, event.Key, "a.zip")
+
+		assert.NilError(t, event.Nack())
+		assert.DeepEqual(t, listed(t, m, list), []string{message("b.zip"), message("a.zip")})
+	})
+
+	t.Run("Invalid events are dropped", func(t *testing.T) {
+		m, w := newS3WatcherWithEventFormat(t, watcher.S3EventFormatEnduro)
+		defer cleanup(t, m)
+
+		m.Lpush(list, "{}")
+
+		_, err := w.Watch(context.Background())
+		assert.ErrorContains(t, err, "unsupported Enduro event version")
+		assert.Equal(t, len(listed(t, m, processing)), 0)
+		assert.Equal(t, len(listed(t, m, list)), 0)
+	})
+
+	t.Run("Orphaned events are delivered first on startup", func(t *testing.T) {
+		m, w := newS3WatcherWithEventFormat(t, watcher.S3EventFormatEnduro)
+		defer cleanup(t, m)
+
+		m.Lpush(list, message("new.zip"))
+		m.RPush(processing, message("orphan-1.zip"))
+		m.RPush(processing, message("orphan-2.zip"))
+
+		for _, key := range []string{"orphan-1.zip", "orphan-2.zip", "new.zip"} {
+			event, err := w.Watch(context.Background())
+			assert.NilError(t, err)
+			assert.Equal(t, event.Key, key)
+			assert.NilError(t, event.Ack())
+		}
+		assert.Equal(t, len(listed(t, m, processing)), 0)
+	})
+}
@@ -0,0 +1,106 @@
+package watcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// natsEventSource consumes messages from a NATS JetStream durable consumer.
+// Messages are acknowledged explicitly, so JetStream redelivers the ones that
+// are not acknowledged before the consumer's AckWait expires, e.g. when Enduro
+// stops before the processing workflow is started. Rejected messages are
+// redelivered by JetStream after a delay.
+type natsEventSource struct {
+	conn     *nats.Conn
+	stream   string
+	config   jetstream.ConsumerConfig
+	mu       sync.Mutex
+	consumer jetstream.Consumer
+	backoff  redeliveryBackoff
+}
+
+var _ eventSource = (*natsEventSource)(nil)
+
+func newNATSEventSource(config *S3Config) (*natsEventSource, error) {
+	if config.NATSStream == "" {
+		return nil, errors.New("natsStream is required")
+	}
+	if config.NATSConsumer == "" {
+		return nil, errors.New("natsConsumer is required")
+	}
+
+	// The connection is established in the background so the watcher can be
+	// created while the server is unavailable, similar to the Redis client.
+	conn, err := nats.Connect(
+		config.NATSURL,
+		nats.Name("enduro-"+config.Name),
+		nats.RetryOnFailedConnect(true),
+		nats.MaxReconnects(-1),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to NATS: %w", err)
+	}
+
+	return &natsEventSource{
+		conn:   conn,
+		stream: config.NATSStream,
+		config: jetstream.ConsumerConfig{
+			Durable:       config.NATSConsumer,
+			FilterSubject: config.NATSSubject,
+			AckPolicy:     jetstream.AckExplicitPolicy,
+		},
+	}, nil
+}
+
+func (s *natsEventSource) durableConsumer(ctx context.Context) (jetstream.Consumer, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.consumer != nil {
+		return s.consumer, nil
+	}
+
+	js, err := jetstream.New(s.conn)
+	if err != nil {
+		return nil, err
+	}
+
+	consumer, err := js.CreateOrUpdateConsumer(ctx, s.stream, s.config)
+	if err != nil {
+		return nil, err
+	}
+	s.consumer = consumer
+
+	return consumer, nil
+}
+
+func (s *natsEventSource) receive(ctx context.Context) (*sourceMessage, error) {
+	consumer, err := s.durableConsumer(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error looking up JetStream consumer: %w", err)
+	}
+
+	batch, err := consumer.Fetch(1, jetstream.FetchMaxWait(eventSourceTimeout))
+	if err != nil {
+		return nil, fmt.Errorf("error fetching from JetStream: %w", err)
+	}
+
+	for msg := range batch.Messages() {
+		return &sourceMessage{
+			body:    msg.Data(),
+			ack:     func() error { s.backoff.succeeded(); return msg.Ack() },
+			nack:    func() error { return msg.NakWithDelay(s.backoff.failed()) },
+			discard: msg.Term,
+		}, nil
+	}
+	if err := batch.Error(); err != nil && !errors.Is(err, context.DeadlineExceeded) {
+		return nil, fmt.Errorf("error fetching from JetStream: %w", err)
+	}
+
+	return nil, ErrWatchTimeout
+}
@@ -6,10 +6,13 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
 	"gocloud.dev/blob"
+
+	"github.com/artefactual-labs/enduro/internal/fsutil"
 )
 
 var (
@@ -33,12 +36,21 @@ type Watcher interface {
 	ExcludeHiddenFiles() bool
 	TransferType() string
 
+	// RescanInterval is how often the bucket is listed to find blobs missed
+	// by the event source. Zero disables rescans.
+	RescanInterval() time.Duration
+
 	// Full path of the watched bucket when available, empty string otherwise.
 	Path() string
 
 	fmt.Stringer // It should return the name of the watcher.
 }
 
+// scanner is implemented by watchers that can list the blobs in their bucket.
+type scanner interface {
+	scan(ctx context.Context, before time.Time) ([]*BlobEvent, error)
+}
+
 type commonWatcherImpl struct {
 	name               string
 	pipeline           []string
@@ -48,6 +60,7 @@ type commonWatcherImpl struct {
 	rejectDuplicates   bool
 	excludeHiddenFiles bool
 	transferType       string
+	rescanInterval     time.Duration
 }
 
 func (w *commonWatcherImpl) String() string {
@@ -82,6 +95,10 @@ func (w *commonWatcherImpl) TransferType() string {
 	return w.transferType
 }
 
+func (w *commonWatcherImpl) RescanInterval() time.Duration {
+	return w.rescanInterval
+}
+
 type Service interface {
 	// Watchers return all known watchers.
 	Watchers() []Watcher
@@ -92,11 +109,19 @@ type Service interface {
 	// Download blob given an event.
 	Download(ctx context.Context, w io.Writer, watcherName, key string) error
 
+	// Size returns the size of a blob in bytes. The size of a directory is
+	// the total size of the files that it contains.
+	Size(ctx context.Context, watcherName, key string) (int64, error)
+
 	// Delete blob given an event.
 	Delete(ctx context.Context, watcherName, key string) error
 
 	// Dipose blob into the completedDir directory.
 	Dispose(ctx context.Context, watcherName, key string) error
+
+	// Scan lists the blobs found in the bucket of the watcher that were last
+	// modified at least RescanInterval ago.
+	Scan(ctx context.Context, watcherName string) ([]*BlobEvent, error)
 }
 
 type serviceImpl struct {
@@ -196,6 +221,31 @@ func (svc *serviceImpl) Download(ctx context.Context, writer io.Writer, watcherN
 	return nil
 }
 
+func (svc *serviceImpl) Size(ctx context.Context, watcherName, key string) (int64, error) {
+	w, err := svc.watcher(watcherName)
+	if err != nil {
+		return 0, err
+	}
+
+	// Filesystem-based watchers may be dealing with directories.
+	if fw, ok := w.(*filesystemWatcher); ok {
+		return fsutil.Size(filepath.Join(fw.path, key))
+	}
+
+	bucket, err := w.OpenBucket(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("error opening bucket: %w", err)
+	}
+	defer bucket.Close()
+
+	attrs, err := bucket.Attributes(ctx, key)
+	if err != nil {
+		return 0, fmt.Errorf("error reading blob attributes: %w", err)
+	}
+
+	return attrs.Size, nil
+}
+
 func (svc *serviceImpl) Delete(ctx context.Context, watcherName, key string) error {
 	w, err := svc.watcher(watcherName)
 	if err != nil {
@@ -235,3 +285,19 @@ func (svc *serviceImpl) Dispose(ctx context.Context, watcherName, key string) er
 
 	return fw.Dispose(key)
 }
+
+func (svc *serviceImpl) Scan(ctx context.Context, watcherName string) ([]*BlobEvent, error) {
+	w, err := svc.watcher(watcherName)
+	if err != nil {
+		return nil, err
+	}
+
+	s, ok := w.(scanner)
+	if !ok {
+		return nil, fmt.Errorf("not available in this type of watcher: %s", w)
+	}
+
+	// Recent blobs are left to the event source, otherwise they could be
+	// dispatched twice while their events are still being delivered.
+	return s.scan(ctx, time.Now().Add(-w.RescanInterval()))
+}
@@ -2,18 +2,29 @@ package activities
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
 	temporalsdk_activity "go.temporal.io/sdk/activity"
+	temporalsdk_temporal "go.temporal.io/sdk/temporal"
 
 	"github.com/artefactual-labs/enduro/internal/pipeline"
 	"github.com/artefactual-labs/enduro/internal/temporal"
 )
 
-// AcquirePipelineActivity acquires a lock in the weighted semaphore associated
-// to a particular pipeline.
+// PipelineDrainingErrorType is the type of the application error returned by
+// AcquirePipelineActivity when the pipeline is draining and the transfer can
+// be sent to a different pipeline.
+const PipelineDrainingErrorType = "PipelineDraining"
+
+// AcquirePipelineActivity acquires a slot of a particular pipeline on behalf
+// of the workflow, using the pipeline leases or its weighted semaphore.
+//
+// New work is held while the pipeline is paused or draining. When reroute is
+// set, a draining pipeline is not waited for and the activity fails so the
+// workflow can choose a different one.
 type AcquirePipelineActivity struct {
 	pipelineRegistry *pipeline.Registry
 }
@@ -22,22 +33,41 @@ func NewAcquirePipelineActivity(pipelineRegistry *pipeline.Registry) *AcquirePip
 	return &AcquirePipelineActivity{pipelineRegistry: pipelineRegistry}
 }
 
-func (a *AcquirePipelineActivity) Execute(ctx context.Context, pipelineName string) error {
+func (a *AcquirePipelineActivity) Execute(ctx context.Context, pipelineName string, reroute bool) error {
 	p, err := a.pipelineRegistry.ByName(pipelineName)
 	if err != nil {
 		return temporal.NewNonRetryableError(err)
 	}
 
 	errAcquirePipeline := fmt.Errorf("error acquring semaphore: busy")
+	errPipelineHeld := fmt.Errorf("error acquring semaphore: pipeline is not running")
+	holder := temporalsdk_activity.GetInfo(ctx).WorkflowExecution.ID
 
 	err = backoff.RetryNotify(
-		func() (err error) {
-			ok := p.TryAcquire()
+		func() error {
+			switch p.State(ctx) {
+			case pipeline.StateDraining:
+				if reroute {
+					return backoff.Permanent(temporalsdk_temporal.NewNonRetryableApplicationError(
+						fmt.Sprintf("pipeline %s is draining", pipelineName),
+						PipelineDrainingErrorType,
+						nil,
+					))
+				}
+				return errPipelineHeld
+			case pipeline.StatePaused:
+				return errPipelineHeld
+			}
+
+			ok, err := p.Acquire(ctx, holder)
+			if err != nil {
+				return err
+			}
 			if !ok {
-				err = errAcquirePipeline
+				return errAcquirePipeline
 			}
 
-			return err
+			return nil
 		},
 		backoff.WithContext(backoff.NewConstantBackOff(time.Second*5), ctx),
 		func(err error, duration time.Duration) {
@@ -47,3 +77,10 @@ func (a *AcquirePipelineActivity) Execute(ctx context.Context, pipelineName stri
 
 	return err
 }
+
+// IsPipelineDrainingError reports whether err was returned because the
+// pipeline is draining.
+func IsPipelineDrainingError(err error) bool {
+	var appErr *temporalsdk_temporal.ApplicationError
+	return errors.As(err, &appErr) && appErr.Type() == PipelineDrainingErrorType
+}
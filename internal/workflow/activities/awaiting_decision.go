@@ -0,0 +1,35 @@
+package activities
+
+import (
+	"errors"
+
+	temporalsdk_temporal "go.temporal.io/sdk/temporal"
+)
+
+// AwaitingDecisionErrorType is the type of the application error returned by
+// the polling activities when Archivematica is waiting for a user decision.
+const AwaitingDecisionErrorType = "AwaitingDecision"
+
+// newAwaitingDecisionError returns a non-retryable error with the description
+// of the decision awaited by Archivematica.
+func newAwaitingDecisionError(err error) error {
+	return temporalsdk_temporal.NewNonRetryableApplicationError(err.Error(), AwaitingDecisionErrorType, nil)
+}
+
+// IsAwaitingDecisionError reports whether err was returned because
+// Archivematica is waiting for a user decision.
+func IsAwaitingDecisionError(err error) bool {
+	var appErr *temporalsdk_temporal.ApplicationError
+	return errors.As(err, &appErr) && appErr.Type() == AwaitingDecisionErrorType
+}
+
+// AwaitingDecision returns the description of the decision awaited by
+// Archivematica carried by err.
+func AwaitingDecision(err error) string {
+	var appErr *temporalsdk_temporal.ApplicationError
+	if errors.As(err, &appErr) {
+		return appErr.Message()
+	}
+
+	return err.Error()
+}
@@ -9,15 +9,20 @@ import (
 )
 
 // DeleteOriginalActivity removes the original package from its source watcher.
+// Originals of collections under legal hold are kept.
 type DeleteOriginalActivity struct {
-	wsvc watcher.Service
+	wsvc  watcher.Service
+	holds LegalHoldChecker
 }
 
-func NewDeleteOriginalActivity(wsvc watcher.Service) *DeleteOriginalActivity {
-	return &DeleteOriginalActivity{wsvc: wsvc}
+func NewDeleteOriginalActivity(wsvc watcher.Service, holds LegalHoldChecker) *DeleteOriginalActivity {
+	return &DeleteOriginalActivity{wsvc: wsvc, holds: holds}
 }
 
-func (a *DeleteOriginalActivity) Execute(ctx context.Context, watcherName, batchDir, key string) error {
+func (a *DeleteOriginalActivity) Execute(ctx context.Context, watcherName, batchDir, key string, collectionID uint) error {
+	if err := checkLegalHold(ctx, a.holds, collectionID); err != nil {
+		return err
+	}
 	if batchDir != "" {
 		return deleteOriginalFromBatch(batchDir, key)
 	}
@@ -9,15 +9,20 @@ import (
 )
 
 // DisposeOriginalActivity moves the original package to completed storage.
+// Originals of collections under legal hold are left in place.
 type DisposeOriginalActivity struct {
-	wsvc watcher.Service
+	wsvc  watcher.Service
+	holds LegalHoldChecker
 }
 
-func NewDisposeOriginalActivity(wsvc watcher.Service) *DisposeOriginalActivity {
-	return &DisposeOriginalActivity{wsvc: wsvc}
+func NewDisposeOriginalActivity(wsvc watcher.Service, holds LegalHoldChecker) *DisposeOriginalActivity {
+	return &DisposeOriginalActivity{wsvc: wsvc, holds: holds}
 }
 
-func (a *DisposeOriginalActivity) Execute(ctx context.Context, watcherName, completedDir, batchDir, key string) error {
+func (a *DisposeOriginalActivity) Execute(ctx context.Context, watcherName, completedDir, batchDir, key string, collectionID uint) error {
+	if err := checkLegalHold(ctx, a.holds, collectionID); err != nil {
+		return err
+	}
 	if batchDir != "" {
 		return disposeOriginalFromBatch(completedDir, batchDir, key)
 	}
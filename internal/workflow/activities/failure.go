@@ -0,0 +1,32 @@
+package activities
+
+import (
+	"context"
+
+	"go.artefactual.dev/amclient"
+	temporalsdk_log "go.temporal.io/sdk/log"
+
+	"github.com/artefactual-labs/enduro/internal/pipeline"
+)
+
+// FailureRecorder records why Archivematica could not process a collection.
+type FailureRecorder interface {
+	SetFailure(ctx context.Context, ID uint, failure pipeline.Failure) error
+}
+
+// recordFailure describes the error that ended the processing of an
+// Archivematica unit and records it. Failure details are informative, errors
+// are logged and do not change the result of the activity.
+func recordFailure(ctx context.Context, logger temporalsdk_log.Logger, recorder FailureRecorder, amc *amclient.Client, collectionID uint, stage, unitID string, err error) {
+	if recorder == nil || collectionID == 0 {
+		return
+	}
+
+	failure, lerr := pipeline.NewFailure(ctx, amc.Jobs, amc.Task, stage, unitID, err)
+	if lerr != nil {
+		logger.Info("Failed to look up failure details.", "error", lerr)
+	}
+	if err := recorder.SetFailure(ctx, collectionID, *failure); err != nil {
+		logger.Warn("Failed to record failure.", "error", err)
+	}
+}
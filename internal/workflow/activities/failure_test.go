@@ -0,0 +1,92 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"go.artefactual.dev/amclient"
+	"go.artefactual.dev/amclient/amclienttest"
+	temporalsdk_log "go.temporal.io/sdk/log"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/enduro/internal/pipeline"
+)
+
+type failureRecorder struct {
+	ids      []uint
+	failures []pipeline.Failure
+	err      error
+}
+
+func (r *failureRecorder) SetFailure(ctx context.Context, ID uint, failure pipeline.Failure) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.ids = append(r.ids, ID)
+	r.failures = append(r.failures, failure)
+	return nil
+}
+
+func TestRecordFailure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	logger := temporalsdk_log.NewStructuredLogger(slog.New(slog.DiscardHandler))
+	const unitID = "cbc4b312-b076-4ff7-b67b-b6850f2b4486"
+	statusErr := fmt.Errorf("error checking transfer status (%w): transfer is in a state that we can't handle: %w", pipeline.ErrStatusNonRetryable, &pipeline.StateError{State: "FAILED"})
+
+	t.Run("Records the failed job", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		jobsService := amclienttest.NewMockJobsService(ctrl)
+		jobsService.EXPECT().
+			List(ctx, unitID, gomock.Any()).
+			Return([]amclient.Job{{ID: "job", Name: "Scan for viruses", Microservice: "Scan for viruses", Status: amclient.JobStatusFailed}}, &amclient.Response{}, nil)
+		recorder := &failureRecorder{}
+
+		recordFailure(ctx, logger, recorder, &amclient.Client{Jobs: jobsService}, 42, pipeline.ProgressStageTransfer, unitID, statusErr)
+
+		assert.DeepEqual(t, recorder.ids, []uint{42})
+		assert.DeepEqual(t, recorder.failures, []pipeline.Failure{
+			{
+				Category:     pipeline.FailureCategoryFailed,
+				Stage:        pipeline.ProgressStageTransfer,
+				UnitID:       unitID,
+				Job:          "Scan for viruses",
+				Microservice: "Scan for viruses",
+				Errors:       []string{statusErr.Error()},
+			},
+		})
+	})
+
+	t.Run("Records the failure when the jobs can't be listed", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		jobsService := amclienttest.NewMockJobsService(ctrl)
+		jobsService.EXPECT().
+			List(ctx, unitID, gomock.Any()).
+			Return(nil, nil, errors.New("unavailable"))
+		recorder := &failureRecorder{}
+
+		recordFailure(ctx, logger, recorder, &amclient.Client{Jobs: jobsService}, 42, pipeline.ProgressStageTransfer, unitID, statusErr)
+
+		assert.Equal(t, len(recorder.failures), 1)
+		assert.Equal(t, recorder.failures[0].Category, pipeline.FailureCategoryFailed)
+	})
+
+	t.Run("Skips unknown collections", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &failureRecorder{}
+
+		recordFailure(ctx, logger, recorder, &amclient.Client{}, 0, pipeline.ProgressStageTransfer, unitID, statusErr)
+
+		assert.Equal(t, len(recorder.failures), 0)
+	})
+}
@@ -0,0 +1,47 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	temporalsdk_temporal "go.temporal.io/sdk/temporal"
+)
+
+// LegalHoldErrorType is the type of the application error returned by the
+// activities that refuse to act on collections under legal hold.
+const LegalHoldErrorType = "LegalHold"
+
+// LegalHoldChecker reports whether a collection is under legal hold.
+type LegalHoldChecker interface {
+	LegalHold(ctx context.Context, ID uint) (bool, error)
+}
+
+// checkLegalHold returns a non-retryable error when the collection is under
+// legal hold. Callers that don't know the collection pass the zero value.
+func checkLegalHold(ctx context.Context, holds LegalHoldChecker, collectionID uint) error {
+	if holds == nil || collectionID == 0 {
+		return nil
+	}
+
+	held, err := holds.LegalHold(ctx, collectionID)
+	if err != nil {
+		return fmt.Errorf("error checking legal hold: %v", err)
+	}
+	if held {
+		return temporalsdk_temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("collection %d is under legal hold", collectionID),
+			LegalHoldErrorType,
+			nil,
+		)
+	}
+
+	return nil
+}
+
+// IsLegalHoldError reports whether err was returned because the collection is
+// under legal hold.
+func IsLegalHoldError(err error) bool {
+	var appErr *temporalsdk_temporal.ApplicationError
+	return errors.As(err, &appErr) && appErr.Type() == LegalHoldErrorType
+}
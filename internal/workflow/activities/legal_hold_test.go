@@ -0,0 +1,82 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+)
+
+type legalHolds map[uint]bool
+
+func (h legalHolds) LegalHold(_ context.Context, ID uint) (bool, error) {
+	held, ok := h[ID]
+	if !ok {
+		return false, errors.New("not found")
+	}
+	return held, nil
+}
+
+func TestDeleteOriginalActivityLegalHold(t *testing.T) {
+	t.Parallel()
+
+	holds := legalHolds{1: false, 2: true}
+
+	t.Run("Deletes original of collection without hold", func(t *testing.T) {
+		t.Parallel()
+
+		dir := fs.NewDir(t, "enduro", fs.WithFile("a.zip", ""))
+		activity := NewDeleteOriginalActivity(nil, holds)
+
+		err := activity.Execute(context.Background(), "", dir.Path(), "a.zip", 1)
+
+		assert.NilError(t, err)
+		_, err = os.Stat(dir.Join("a.zip"))
+		assert.Assert(t, errors.Is(err, os.ErrNotExist))
+	})
+
+	t.Run("Keeps original of collection under legal hold", func(t *testing.T) {
+		t.Parallel()
+
+		dir := fs.NewDir(t, "enduro", fs.WithFile("a.zip", ""))
+		activity := NewDeleteOriginalActivity(nil, holds)
+
+		err := activity.Execute(context.Background(), "", dir.Path(), "a.zip", 2)
+
+		assert.Assert(t, IsLegalHoldError(err))
+		_, err = os.Stat(dir.Join("a.zip"))
+		assert.NilError(t, err)
+	})
+
+	t.Run("Fails when the hold cannot be checked", func(t *testing.T) {
+		t.Parallel()
+
+		dir := fs.NewDir(t, "enduro", fs.WithFile("a.zip", ""))
+		activity := NewDeleteOriginalActivity(nil, holds)
+
+		err := activity.Execute(context.Background(), "", dir.Path(), "a.zip", 3)
+
+		assert.ErrorContains(t, err, "error checking legal hold")
+		assert.Assert(t, !IsLegalHoldError(err))
+	})
+}
+
+func TestDisposeOriginalActivityLegalHold(t *testing.T) {
+	t.Parallel()
+
+	batchDir := fs.NewDir(t, "enduro", fs.WithFile("a.zip", ""))
+	completedDir := fs.NewDir(t, "enduro")
+	activity := NewDisposeOriginalActivity(nil, legalHolds{2: true})
+
+	err := activity.Execute(context.Background(), "", completedDir.Path(), batchDir.Path(), "a.zip", 2)
+
+	assert.Assert(t, IsLegalHoldError(err))
+	_, err = os.Stat(filepath.Join(batchDir.Path(), "a.zip"))
+	assert.NilError(t, err)
+	_, err = os.Stat(completedDir.Join("a.zip"))
+	assert.Assert(t, errors.Is(err, os.ErrNotExist))
+}
@@ -15,15 +15,32 @@ import (
 // PollIngestActivity waits until Archivematica finishes ingest processing.
 type PollIngestActivity struct {
 	pipelineRegistry *pipeline.Registry
+	progress         ProgressRecorder
+	failures         FailureRecorder
 }
 
-func NewPollIngestActivity(pipelineRegistry *pipeline.Registry) *PollIngestActivity {
-	return &PollIngestActivity{pipelineRegistry: pipelineRegistry}
+func NewPollIngestActivity(pipelineRegistry *pipeline.Registry, progress ProgressRecorder, failures FailureRecorder) *PollIngestActivity {
+	return &PollIngestActivity{
+		pipelineRegistry: pipelineRegistry,
+		progress:         progress,
+		failures:         failures,
+	}
 }
 
 type PollIngestActivityParams struct {
 	PipelineName string
 	SIPID        string
+
+	// Keep polling while Archivematica waits for a user decision instead of
+	// returning an error that can be checked with IsAwaitingDecisionError.
+	// The activity returns a zero result as soon as Archivematica resumes
+	// processing, so the workflow can update the collection before it polls
+	// again without this option.
+	WaitForDecision bool
+
+	// Collection that the progress and the failures reported by
+	// Archivematica are recorded for, they are not recorded when zero.
+	CollectionID uint
 }
 
 func (a *PollIngestActivity) Execute(ctx context.Context, params *PollIngestActivityParams) (time.Time, error) {
@@ -45,8 +62,12 @@ func (a *PollIngestActivity) Execute(ctx context.Context, params *PollIngestActi
 	}
 
 	backoffStrategy := backoff.WithContext(backoffStrategy, ctx)
+	var resumed bool // Archivematica resumed processing after a decision.
 	lastRetryableError := time.Time{}
 
+	var failed error // Error that ended processing in Archivematica.
+	reporter := newProgressReporter(logger, a.progress, amc.Jobs, params.CollectionID, pipeline.ProgressStageIngest, params.SIPID)
+
 	err = backoff.RetryNotify(
 		func() (err error) {
 			ctx, cancel := context.WithTimeout(ctx, requestTimeout)
@@ -54,13 +75,34 @@ func (a *PollIngestActivity) Execute(ctx context.Context, params *PollIngestActi
 
 			err = pipeline.IngestStatus(ctx, amc.Ingest, params.SIPID)
 
+			// Record the jobs run so far unless Archivematica is unreachable.
+			if !errors.Is(err, pipeline.ErrStatusRetryable) {
+				reporter.report(ctx)
+			}
+
 			// Abandon when we see a non-retryable error.
 			if errors.Is(err, pipeline.ErrStatusNonRetryable) {
+				failed = err
 				return backoff.Permanent(temporal.NewNonRetryableError(err))
 			}
 
-			// Looking good, keep polling.
+			// Archivematica is waiting for a user decision. The workflow is
+			// told unless it is waiting for the decision already.
+			if errors.Is(err, pipeline.ErrStatusAwaitingDecision) {
+				if !params.WaitForDecision {
+					return backoff.Permanent(newAwaitingDecisionError(err))
+				}
+				lastRetryableError = time.Time{} // Reset.
+				return err
+			}
+
+			// Looking good, keep polling unless the workflow is waiting for
+			// Archivematica to resume.
 			if errors.Is(err, pipeline.ErrStatusInProgress) {
+				if params.WaitForDecision {
+					resumed = true
+					return nil
+				}
 				lastRetryableError = time.Time{} // Reset.
 				return err
 			}
@@ -73,6 +115,7 @@ func (a *PollIngestActivity) Execute(ctx context.Context, params *PollIngestActi
 			if lastRetryableError.IsZero() {
 				lastRetryableError = clock.Now()
 			} else if clock.Since(lastRetryableError) > deadline {
+				failed = err
 				return backoff.Permanent(temporal.NewNonRetryableError(err))
 			}
 
@@ -83,7 +126,13 @@ func (a *PollIngestActivity) Execute(ctx context.Context, params *PollIngestActi
 			temporalsdk_activity.RecordHeartbeat(ctx, err.Error())
 		},
 	)
-	if err != nil {
+
+	if failed != nil {
+		ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+		defer cancel()
+		recordFailure(ctx, logger, a.failures, amc, params.CollectionID, pipeline.ProgressStageIngest, params.SIPID, failed)
+	}
+	if err != nil || resumed {
 		return time.Time{}, err
 	}
 
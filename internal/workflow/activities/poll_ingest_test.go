@@ -19,7 +19,7 @@ import (
 func TestPollIngestActivity(t *testing.T) {
 	t.Run("Fails when the pipeline isn't found", func(t *testing.T) {
 		pipelineRegistry, _ := pipeline.NewPipelineRegistry(logr.Discard(), []pipeline.Config{}, nil, nil)
-		activity := NewPollIngestActivity(pipelineRegistry)
+		activity := NewPollIngestActivity(pipelineRegistry, nil, nil)
 
 		s := temporalsdk_testsuite.WorkflowTestSuite{}
 		env := s.NewTestActivityEnvironment()
@@ -43,7 +43,7 @@ func TestPollIngestActivity(t *testing.T) {
 				"status": "COMPLETE"
 			}`))
 		})
-		activity := NewPollIngestActivity(pipelineRegistry)
+		activity := NewPollIngestActivity(pipelineRegistry, nil, nil)
 
 		s := temporalsdk_testsuite.WorkflowTestSuite{}
 		env := s.NewTestActivityEnvironment()
@@ -66,7 +66,7 @@ func TestPollIngestActivity(t *testing.T) {
 		pipelineRegistry := newPipelineRegistry(t, func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusUnauthorized)
 		})
-		activity := NewPollIngestActivity(pipelineRegistry)
+		activity := NewPollIngestActivity(pipelineRegistry, nil, nil)
 
 		s := temporalsdk_testsuite.WorkflowTestSuite{}
 		env := s.NewTestActivityEnvironment()
@@ -103,7 +103,7 @@ func TestPollIngestActivity(t *testing.T) {
 				"status": "PROCESSING"
 			}`))
 		})
-		activity := NewPollIngestActivity(pipelineRegistry)
+		activity := NewPollIngestActivity(pipelineRegistry, nil, nil)
 
 		s := temporalsdk_testsuite.WorkflowTestSuite{}
 		env := s.NewTestActivityEnvironment()
@@ -130,7 +130,7 @@ func TestPollIngestActivity(t *testing.T) {
 			fakeClock.Advance(time.Minute)
 			w.WriteHeader(http.StatusBadGateway)
 		})
-		activity := NewPollIngestActivity(pipelineRegistry)
+		activity := NewPollIngestActivity(pipelineRegistry, nil, nil)
 
 		s := temporalsdk_testsuite.WorkflowTestSuite{}
 		env := s.NewTestActivityEnvironment()
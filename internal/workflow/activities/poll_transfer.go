@@ -18,15 +18,32 @@ import (
 // It is expected to deliver at least on heartbeat per minute.
 type PollTransferActivity struct {
 	pipelineRegistry *pipeline.Registry
+	progress         ProgressRecorder
+	failures         FailureRecorder
 }
 
-func NewPollTransferActivity(pipelineRegistry *pipeline.Registry) *PollTransferActivity {
-	return &PollTransferActivity{pipelineRegistry: pipelineRegistry}
+func NewPollTransferActivity(pipelineRegistry *pipeline.Registry, progress ProgressRecorder, failures FailureRecorder) *PollTransferActivity {
+	return &PollTransferActivity{
+		pipelineRegistry: pipelineRegistry,
+		progress:         progress,
+		failures:         failures,
+	}
 }
 
 type PollTransferActivityParams struct {
 	PipelineName string
 	TransferID   string
+
+	// Keep polling while Archivematica waits for a user decision instead of
+	// returning an error that can be checked with IsAwaitingDecisionError.
+	// The activity returns a zero result as soon as Archivematica resumes
+	// processing, so the workflow can update the collection before it polls
+	// again without this option.
+	WaitForDecision bool
+
+	// Collection that the progress and the failures reported by
+	// Archivematica are recorded for, they are not recorded when zero.
+	CollectionID uint
 }
 
 func (a *PollTransferActivity) Execute(ctx context.Context, params *PollTransferActivityParams) (string, error) {
@@ -48,9 +65,13 @@ func (a *PollTransferActivity) Execute(ctx context.Context, params *PollTransfer
 	}
 
 	var sipID string
+	var resumed bool // Archivematica resumed processing after a decision.
 	lastRetryableError := time.Time{}
 	backoffStrategy := backoff.WithContext(backoffStrategy, ctx)
 
+	var failed error // Error that ended processing in Archivematica.
+	reporter := newProgressReporter(logger, a.progress, amc.Jobs, params.CollectionID, pipeline.ProgressStageTransfer, params.TransferID)
+
 	err = backoff.RetryNotify(
 		func() (err error) {
 			ctx, cancel := context.WithTimeout(ctx, requestTimeout)
@@ -58,13 +79,34 @@ func (a *PollTransferActivity) Execute(ctx context.Context, params *PollTransfer
 
 			sipID, err = pipeline.TransferStatus(ctx, amc.Transfer, params.TransferID)
 
+			// Record the jobs run so far unless Archivematica is unreachable.
+			if !errors.Is(err, pipeline.ErrStatusRetryable) {
+				reporter.report(ctx)
+			}
+
 			// Abandon when we see a non-retryable error.
 			if errors.Is(err, pipeline.ErrStatusNonRetryable) {
+				failed = err
 				return backoff.Permanent(temporal.NewNonRetryableError(err))
 			}
 
-			// Looking good, keep polling.
+			// Archivematica is waiting for a user decision. The workflow is
+			// told unless it is waiting for the decision already.
+			if errors.Is(err, pipeline.ErrStatusAwaitingDecision) {
+				if !params.WaitForDecision {
+					return backoff.Permanent(newAwaitingDecisionError(err))
+				}
+				lastRetryableError = time.Time{} // Reset.
+				return err
+			}
+
+			// Looking good, keep polling unless the workflow is waiting for
+			// Archivematica to resume.
 			if errors.Is(err, pipeline.ErrStatusInProgress) {
+				if params.WaitForDecision {
+					resumed = true
+					return nil
+				}
 				lastRetryableError = time.Time{} // Reset.
 				return err
 			}
@@ -77,6 +119,7 @@ func (a *PollTransferActivity) Execute(ctx context.Context, params *PollTransfer
 			if lastRetryableError.IsZero() {
 				lastRetryableError = clock.Now()
 			} else if clock.Since(lastRetryableError) > deadline {
+				failed = err
 				return backoff.Permanent(temporal.NewNonRetryableError(err))
 			}
 
@@ -88,5 +131,15 @@ func (a *PollTransferActivity) Execute(ctx context.Context, params *PollTransfer
 		},
 	)
 
+	if failed != nil {
+		ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+		defer cancel()
+		recordFailure(ctx, logger, a.failures, amc, params.CollectionID, pipeline.ProgressStageTransfer, params.TransferID, failed)
+	}
+
+	if resumed {
+		return "", err
+	}
+
 	return sipID, err
 }
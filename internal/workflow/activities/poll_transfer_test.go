@@ -19,7 +19,7 @@ import (
 func TestPollTransferActivity(t *testing.T) {
 	t.Run("Fails when the pipeline isn't found", func(t *testing.T) {
 		pipelineRegistry, _ := pipeline.NewPipelineRegistry(logr.Discard(), []pipeline.Config{}, nil, nil)
-		activity := NewPollTransferActivity(pipelineRegistry)
+		activity := NewPollTransferActivity(pipelineRegistry, nil, nil)
 
 		s := temporalsdk_testsuite.WorkflowTestSuite{}
 		env := s.NewTestActivityEnvironment()
@@ -41,7 +41,7 @@ func TestPollTransferActivity(t *testing.T) {
 				"status": "COMPLETE"
 			}`))
 		})
-		activity := NewPollTransferActivity(pipelineRegistry)
+		activity := NewPollTransferActivity(pipelineRegistry, nil, nil)
 
 		s := temporalsdk_testsuite.WorkflowTestSuite{}
 		env := s.NewTestActivityEnvironment()
@@ -64,7 +64,7 @@ func TestPollTransferActivity(t *testing.T) {
 		pipelineRegistry := newPipelineRegistry(t, func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusUnauthorized)
 		})
-		activity := NewPollTransferActivity(pipelineRegistry)
+		activity := NewPollTransferActivity(pipelineRegistry, nil, nil)
 
 		s := temporalsdk_testsuite.WorkflowTestSuite{}
 		env := s.NewTestActivityEnvironment()
@@ -101,7 +101,7 @@ func TestPollTransferActivity(t *testing.T) {
 				"status": "PROCESSING"
 			}`))
 		})
-		activity := NewPollTransferActivity(pipelineRegistry)
+		activity := NewPollTransferActivity(pipelineRegistry, nil, nil)
 
 		s := temporalsdk_testsuite.WorkflowTestSuite{}
 		env := s.NewTestActivityEnvironment()
@@ -120,6 +120,104 @@ func TestPollTransferActivity(t *testing.T) {
 		assert.Equal(t, backoffStrategy.(*ZeroBackOff).hits, 3)
 	})
 
+	t.Run("Reports when Archivematica awaits a user decision", func(t *testing.T) {
+		ctx := context.Background()
+		pipelineRegistry := newPipelineRegistry(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{
+				"status": "USER_INPUT",
+				"microservice": "Create SIP from Transfer"
+			}`))
+		})
+		activity := NewPollTransferActivity(pipelineRegistry, nil, nil)
+
+		s := temporalsdk_testsuite.WorkflowTestSuite{}
+		env := s.NewTestActivityEnvironment()
+		env.RegisterActivity(activity.Execute)
+		env.SetWorkerOptions(temporalsdk_worker.Options{BackgroundActivityContext: ctx})
+
+		future, err := env.ExecuteActivity(activity.Execute, &PollTransferActivityParams{
+			PipelineName: "am",
+			TransferID:   "cbc4b312-b076-4ff7-b67b-b6850f2b4486",
+		})
+
+		assert.Assert(t, future == nil)
+		assert.Assert(t, IsAwaitingDecisionError(err))
+		assert.ErrorContains(t, err, "transfer is awaiting a decision in Archivematica: Create SIP from Transfer")
+	})
+
+	t.Run("Polls until Archivematica proceeds after a user decision", func(t *testing.T) {
+		ctx := context.Background()
+		backoffStrategy = &ZeroBackOff{}
+		attempts := 0
+		pipelineRegistry := newPipelineRegistry(t, func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			w.WriteHeader(http.StatusOK)
+			if attempts > 2 {
+				w.Write([]byte(`{
+					"sip_uuid": "734abbaf-4e2f-4a68-938c-c8ff6420e525",
+					"status": "COMPLETE"
+				}`))
+				return
+			}
+			w.Write([]byte(`{"status": "USER_INPUT"}`))
+		})
+		activity := NewPollTransferActivity(pipelineRegistry, nil, nil)
+
+		s := temporalsdk_testsuite.WorkflowTestSuite{}
+		env := s.NewTestActivityEnvironment()
+		env.RegisterActivity(activity.Execute)
+		env.SetWorkerOptions(temporalsdk_worker.Options{BackgroundActivityContext: ctx})
+
+		var sipID string
+		future, err := env.ExecuteActivity(activity.Execute, &PollTransferActivityParams{
+			PipelineName:    "am",
+			TransferID:      "cbc4b312-b076-4ff7-b67b-b6850f2b4486",
+			WaitForDecision: true,
+		})
+		future.Get(&sipID)
+
+		assert.NilError(t, err)
+		assert.Equal(t, sipID, "734abbaf-4e2f-4a68-938c-c8ff6420e525")
+		assert.Equal(t, backoffStrategy.(*ZeroBackOff).hits, 2)
+	})
+
+	t.Run("Returns once Archivematica resumes processing after a user decision", func(t *testing.T) {
+		ctx := context.Background()
+		backoffStrategy = &ZeroBackOff{}
+		attempts := 0
+		pipelineRegistry := newPipelineRegistry(t, func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			w.WriteHeader(http.StatusOK)
+			if attempts > 2 {
+				w.Write([]byte(`{
+					"sip_uuid": "734abbaf-4e2f-4a68-938c-c8ff6420e525",
+					"status": "PROCESSING"
+				}`))
+				return
+			}
+			w.Write([]byte(`{"status": "USER_INPUT"}`))
+		})
+		activity := NewPollTransferActivity(pipelineRegistry, nil, nil)
+
+		s := temporalsdk_testsuite.WorkflowTestSuite{}
+		env := s.NewTestActivityEnvironment()
+		env.RegisterActivity(activity.Execute)
+		env.SetWorkerOptions(temporalsdk_worker.Options{BackgroundActivityContext: ctx})
+
+		var sipID string
+		future, err := env.ExecuteActivity(activity.Execute, &PollTransferActivityParams{
+			PipelineName:    "am",
+			TransferID:      "cbc4b312-b076-4ff7-b67b-b6850f2b4486",
+			WaitForDecision: true,
+		})
+		future.Get(&sipID)
+
+		assert.NilError(t, err)
+		assert.Equal(t, sipID, "")
+		assert.Equal(t, attempts, 3)
+	})
+
 	t.Run("Retries on retry-able errors until the deadline is exceeded", func(t *testing.T) {
 		ctx := context.Background()
 		backoffStrategy = &ZeroBackOff{}
@@ -131,7 +229,7 @@ func TestPollTransferActivity(t *testing.T) {
 			fakeClock.Advance(time.Minute)
 			w.WriteHeader(http.StatusBadGateway)
 		})
-		activity := NewPollTransferActivity(pipelineRegistry)
+		activity := NewPollTransferActivity(pipelineRegistry, nil, nil)
 
 		s := temporalsdk_testsuite.WorkflowTestSuite{}
 		env := s.NewTestActivityEnvironment()
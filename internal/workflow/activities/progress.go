@@ -0,0 +1,62 @@
+package activities
+
+import (
+	"context"
+	"reflect"
+
+	"go.artefactual.dev/amclient"
+	temporalsdk_log "go.temporal.io/sdk/log"
+
+	"github.com/artefactual-labs/enduro/internal/pipeline"
+)
+
+// ProgressRecorder records the progress reported by Archivematica.
+type ProgressRecorder interface {
+	SetProgress(ctx context.Context, ID uint, progress pipeline.Progress) error
+}
+
+// progressReporter records the progress of an Archivematica unit while it is
+// polled. Snapshots are only recorded when they change.
+type progressReporter struct {
+	logger       temporalsdk_log.Logger
+	recorder     ProgressRecorder
+	jobs         amclient.JobsService
+	collectionID uint
+	stage        string
+	unitID       string
+	last         *pipeline.Progress
+}
+
+func newProgressReporter(logger temporalsdk_log.Logger, recorder ProgressRecorder, jobs amclient.JobsService, collectionID uint, stage, unitID string) *progressReporter {
+	return &progressReporter{
+		logger:       logger,
+		recorder:     recorder,
+		jobs:         jobs,
+		collectionID: collectionID,
+		stage:        stage,
+		unitID:       unitID,
+	}
+}
+
+// report records the current progress. Progress is informative, errors are
+// logged and do not interrupt polling.
+func (r *progressReporter) report(ctx context.Context) {
+	if r.recorder == nil || r.jobs == nil || r.collectionID == 0 {
+		return
+	}
+
+	progress, err := pipeline.JobsProgress(ctx, r.jobs, r.stage, r.unitID)
+	if err != nil {
+		r.logger.Info("Failed to look up progress.", "error", err)
+		return
+	}
+	if r.last != nil && reflect.DeepEqual(r.last, progress) {
+		return
+	}
+	if err := r.recorder.SetProgress(ctx, r.collectionID, *progress); err != nil {
+		r.logger.Warn("Failed to record progress.", "error", err)
+		return
+	}
+
+	r.last = progress
+}
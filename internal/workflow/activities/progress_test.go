@@ -0,0 +1,96 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"go.artefactual.dev/amclient"
+	"go.artefactual.dev/amclient/amclienttest"
+	temporalsdk_log "go.temporal.io/sdk/log"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/enduro/internal/pipeline"
+)
+
+type progressRecorder struct {
+	ids      []uint
+	progress []pipeline.Progress
+	err      error
+}
+
+func (r *progressRecorder) SetProgress(ctx context.Context, ID uint, progress pipeline.Progress) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.ids = append(r.ids, ID)
+	r.progress = append(r.progress, progress)
+	return nil
+}
+
+func TestProgressReporter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	logger := temporalsdk_log.NewStructuredLogger(slog.New(slog.DiscardHandler))
+	const unitID = "cbc4b312-b076-4ff7-b67b-b6850f2b4486"
+	jobs := func(statuses ...amclient.JobStatus) []amclient.Job {
+		res := []amclient.Job{}
+		for _, status := range statuses {
+			res = append(res, amclient.Job{ID: "job", Name: "Scan for viruses", Microservice: "Scan for viruses", Status: status})
+		}
+		return res
+	}
+
+	t.Run("Records the progress when it changes", func(t *testing.T) {
+		t.Parallel()
+
+		jobsService := amclienttest.NewMockJobsService(gomock.NewController(t))
+		gomock.InOrder(
+			jobsService.EXPECT().List(ctx, unitID, gomock.Any()).Return(jobs(amclient.JobStatusProcessing), &amclient.Response{}, nil),
+			jobsService.EXPECT().List(ctx, unitID, gomock.Any()).Return(jobs(amclient.JobStatusProcessing), &amclient.Response{}, nil),
+			jobsService.EXPECT().List(ctx, unitID, gomock.Any()).Return(nil, nil, errors.New("unavailable")),
+			jobsService.EXPECT().List(ctx, unitID, gomock.Any()).Return(jobs(amclient.JobStatusComplete, amclient.JobStatusProcessing), &amclient.Response{}, nil),
+		)
+		recorder := &progressRecorder{}
+		reporter := newProgressReporter(logger, recorder, jobsService, 42, pipeline.ProgressStageTransfer, unitID)
+
+		for range 4 {
+			reporter.report(ctx)
+		}
+
+		assert.DeepEqual(t, recorder.ids, []uint{42, 42})
+		assert.Equal(t, recorder.progress[0].JobsTotal, uint(1))
+		assert.Equal(t, recorder.progress[1].JobsCompleted, uint(1))
+		assert.Equal(t, recorder.progress[1].JobsTotal, uint(2))
+	})
+
+	t.Run("Retries recording after a failure", func(t *testing.T) {
+		t.Parallel()
+
+		jobsService := amclienttest.NewMockJobsService(gomock.NewController(t))
+		jobsService.EXPECT().List(ctx, unitID, gomock.Any()).Return(jobs(amclient.JobStatusProcessing), &amclient.Response{}, nil).Times(2)
+		recorder := &progressRecorder{err: errors.New("database unavailable")}
+		reporter := newProgressReporter(logger, recorder, jobsService, 42, pipeline.ProgressStageTransfer, unitID)
+
+		reporter.report(ctx)
+		recorder.err = nil
+		reporter.report(ctx)
+
+		assert.DeepEqual(t, recorder.ids, []uint{42})
+	})
+
+	t.Run("Does nothing without a collection", func(t *testing.T) {
+		t.Parallel()
+
+		jobsService := amclienttest.NewMockJobsService(gomock.NewController(t))
+		recorder := &progressRecorder{}
+		reporter := newProgressReporter(logger, recorder, jobsService, 0, pipeline.ProgressStageTransfer, unitID)
+
+		reporter.report(ctx)
+
+		assert.Equal(t, len(recorder.ids), 0)
+	})
+}
@@ -3,7 +3,6 @@ package activities
 import (
 	"context"
 	"errors"
-	"fmt"
 
 	temporalsdk_activity "go.temporal.io/sdk/activity"
 	temporalsdk_temporal "go.temporal.io/sdk/temporal"
@@ -30,6 +29,16 @@ type PublishTransferActivityParams struct {
 
 type PublishTransferActivityResult = publisher.PublishedTransfer
 
+// PublishTransferProgress is recorded as the heartbeat details of the
+// activity. Publishers able to resume an interrupted upload report how much of
+// the transfer was reused from previous attempts.
+type PublishTransferProgress struct {
+	LocalPath   string
+	Bytes       int64
+	Files       int
+	ReusedBytes int64
+}
+
 const PublishTransferLocalPathMissingErrorType = "PublishTransferLocalPathMissing"
 
 func (a *PublishTransferActivity) Execute(ctx context.Context, params *PublishTransferActivityParams) (*PublishTransferActivityResult, error) {
@@ -37,7 +46,29 @@ func (a *PublishTransferActivity) Execute(ctx context.Context, params *PublishTr
 		return nil, temporal.NewNonRetryableError(errors.New("error processing parameters: missing"))
 	}
 
-	pub, err := a.publisher(ctx, params.PipelineName)
+	// The progress recorded by the previous attempt tells the publisher which
+	// of the files it left behind can be reused.
+	var opts []publisher.Option
+	if temporalsdk_activity.HasHeartbeatDetails(ctx) {
+		var previous PublishTransferProgress
+		if err := temporalsdk_activity.GetHeartbeatDetails(ctx, &previous); err == nil {
+			temporalsdk_activity.GetLogger(ctx).Info(
+				"Resuming transfer publication.",
+				"attempt", temporalsdk_activity.GetInfo(ctx).Attempt,
+				"files", previous.Files,
+				"path", previous.LocalPath,
+				"bytes", previous.Bytes,
+			)
+			opts = append(opts, publisher.WithResume(publisher.Progress{
+				LocalPath:   previous.LocalPath,
+				Bytes:       previous.Bytes,
+				Files:       previous.Files,
+				ReusedBytes: previous.ReusedBytes,
+			}))
+		}
+	}
+
+	pub, err := a.publisher(ctx, params.PipelineName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -50,18 +81,21 @@ func (a *PublishTransferActivity) Execute(ctx context.Context, params *PublishTr
 	return res, nil
 }
 
-func (a *PublishTransferActivity) publisher(ctx context.Context, pipelineName string) (publisher.Publisher, error) {
+func (a *PublishTransferActivity) publisher(ctx context.Context, pipelineName string, opts ...publisher.Option) (publisher.Publisher, error) {
 	p, err := a.pipelineRegistry.ByName(pipelineName)
 	if err != nil {
 		return nil, temporal.NewNonRetryableError(err)
 	}
 
-	pub, err := publisher.New(
-		p.Config().TransferPublisher,
-		publisher.WithProgress(func(progress publisher.Progress) {
-			temporalsdk_activity.RecordHeartbeat(ctx, fmt.Sprintf("Uploaded %d bytes from %s.", progress.Bytes, progress.LocalPath))
-		}),
-	)
+	opts = append(opts, publisher.WithProgress(func(progress publisher.Progress) {
+		temporalsdk_activity.RecordHeartbeat(ctx, PublishTransferProgress{
+			LocalPath:   progress.LocalPath,
+			Bytes:       progress.Bytes,
+			Files:       progress.Files,
+			ReusedBytes: progress.ReusedBytes,
+		})
+	}))
+	pub, err := publisher.New(p.Config().TransferPublisher, opts...)
 	if err != nil {
 		return nil, publisherError(err)
 	}
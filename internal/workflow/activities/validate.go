@@ -3,6 +3,7 @@ package activities
 import (
 	"context"
 
+	"github.com/artefactual-labs/enduro/internal/temporal"
 	"github.com/artefactual-labs/enduro/internal/validation"
 )
 
@@ -18,6 +19,14 @@ type ValidateTransferActivityParams struct {
 	Path   string
 }
 
-func (a *ValidateTransferActivity) Execute(ctx context.Context, params *ValidateTransferActivityParams) error {
-	return validation.ValidateTransfer(params.Config, params.Path)
+// Execute runs the validator chain and returns its report. Validation
+// failures are not returned as errors so the workflow can persist the results
+// before deciding how to proceed.
+func (a *ValidateTransferActivity) Execute(ctx context.Context, params *ValidateTransferActivityParams) (*validation.Report, error) {
+	report, err := validation.ValidateTransfer(params.Config, params.Path)
+	if err != nil {
+		return nil, temporal.NewNonRetryableError(err)
+	}
+
+	return report, nil
 }
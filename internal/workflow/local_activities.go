@@ -2,29 +2,43 @@ package workflow
 
 import (
 	"context"
+	"errors"
+	"path/filepath"
 	"time"
 
 	"github.com/go-logr/logr"
 	temporalsdk_activity "go.temporal.io/sdk/activity"
 
 	"github.com/artefactual-labs/enduro/internal/collection"
+	"github.com/artefactual-labs/enduro/internal/fsutil"
 	"github.com/artefactual-labs/enduro/internal/pipeline"
+	"github.com/artefactual-labs/enduro/internal/retention"
+	"github.com/artefactual-labs/enduro/internal/validation"
+	"github.com/artefactual-labs/enduro/internal/watcher"
 	"github.com/artefactual-labs/enduro/internal/workflow/hooks"
 )
 
 type createPackageLocalActivityParams struct {
-	Key    string
-	Status collection.Status
+	Key         string
+	WatcherName string
+	Status      collection.Status
+
+	// Actor that started the workflow, the watcher when empty.
+	Actor string
 }
 
 func createPackageLocalActivity(ctx context.Context, logger logr.Logger, colsvc collection.Service, params *createPackageLocalActivityParams) (uint, error) {
 	info := temporalsdk_activity.GetInfo(ctx)
+	if params.Actor != "" {
+		ctx = collection.WithActor(ctx, params.Actor)
+	}
 
 	col := &collection.Collection{
-		Name:       params.Key,
-		WorkflowID: info.WorkflowExecution.ID,
-		RunID:      info.WorkflowExecution.RunID,
-		Status:     params.Status,
+		Name:        params.Key,
+		WatcherName: params.WatcherName,
+		WorkflowID:  info.WorkflowExecution.ID,
+		RunID:       info.WorkflowExecution.RunID,
+		Status:      params.Status,
 	}
 
 	if err := colsvc.Create(ctx, col); err != nil {
@@ -43,10 +57,14 @@ type updatePackageLocalActivityParams struct {
 	SIPID        string
 	StoredAt     time.Time
 	Status       collection.Status
+
+	// Cause of the status transition, inferred from the statuses when empty.
+	Cause collection.StatusTransitionCause
 }
 
 func updatePackageLocalActivity(ctx context.Context, logger logr.Logger, colsvc collection.Service, params *updatePackageLocalActivityParams) error {
 	info := temporalsdk_activity.GetInfo(ctx)
+	ctx = collection.WithStatusTransitionCause(ctx, params.Cause)
 
 	err := colsvc.UpdateWorkflowStatus(
 		ctx, params.CollectionID, params.Key, info.WorkflowExecution.ID,
@@ -79,12 +97,37 @@ func updateReconciliationLocalActivity(ctx context.Context, logger logr.Logger,
 	return nil
 }
 
+func setValidationResultsLocalActivity(ctx context.Context, logger logr.Logger, colsvc collection.Service, colID uint, results []validation.Result) error {
+	if err := colsvc.SetValidationResults(ctx, colID, results); err != nil {
+		logger.Error(err, "Error persisting validation results")
+		return err
+	}
+
+	return nil
+}
+
 func checkDuplicatePackageLocalActivity(ctx context.Context, logger logr.Logger, colsvc collection.Service, id uint) (bool, error) {
 	return colsvc.CheckDuplicate(ctx, id)
 }
 
-func loadConfigLocalActivity(ctx context.Context, h *hooks.Hooks, pipelineRegistry *pipeline.Registry, logger logr.Logger, pipeline string, tinfo *TransferInfo) (*TransferInfo, error) {
-	p, err := pipelineRegistry.ByName(pipeline)
+// loadConfigLocalActivity loads the configuration of the pipeline. When route
+// is set, the routing rules can select different candidate pipelines and the
+// processing configuration. The scheduler chooses among the candidates when
+// the pipeline is not given.
+func loadConfigLocalActivity(ctx context.Context, h *hooks.Hooks, pipelineRegistry *pipeline.Registry, wsvc watcher.Service, logger logr.Logger, pipelineName string, route bool, tinfo *TransferInfo) (*TransferInfo, error) {
+	if route {
+		routeTransfer(ctx, pipelineRegistry, wsvc, logger, tinfo)
+		pipelineName = tinfo.PipelineName
+	}
+
+	if pipelineName == "" {
+		tinfo.PipelineSelection = pipelineRegistry.Select(ctx, tinfo.PipelineNames)
+		tinfo.PipelineName = tinfo.PipelineSelection.Pipeline
+		pipelineName = tinfo.PipelineName
+		logger.Info("Pipeline selected.", "key", tinfo.Key, "selection", tinfo.PipelineSelection.String())
+	}
+
+	p, err := pipelineRegistry.ByName(pipelineName)
 	if err != nil {
 		logger.Error(err, "Error loading local configuration")
 		return nil, err
@@ -96,7 +139,55 @@ func loadConfigLocalActivity(ctx context.Context, h *hooks.Hooks, pipelineRegist
 	return tinfo, nil
 }
 
-func setStatusInProgressLocalActivity(ctx context.Context, colsvc collection.Service, colID uint, startedAt time.Time) error {
+// routeTransfer updates the candidate pipelines and the processing
+// configuration of the transfer when a routing rule matches.
+func routeTransfer(ctx context.Context, pipelineRegistry *pipeline.Registry, wsvc watcher.Service, logger logr.Logger, tinfo *TransferInfo) {
+	attrs := pipeline.RouteAttributes{
+		Key:          tinfo.Key,
+		Watcher:      tinfo.WatcherName,
+		TransferType: tinfo.TransferType,
+	}
+	if pipelineRegistry.RoutingNeedsSize() {
+		size, err := transferSize(ctx, wsvc, tinfo)
+		if err != nil {
+			logger.Info("Transfer size not available for routing.", "key", tinfo.Key, "err", err)
+		} else {
+			attrs.Size = &size
+		}
+	}
+
+	result := pipelineRegistry.Route(attrs)
+	if result.Rule == "" || len(result.Pipelines) == 0 {
+		return
+	}
+
+	tinfo.PipelineName = ""
+	tinfo.PipelineNames = result.Pipelines
+	if tinfo.ProcessingConfig == "" {
+		tinfo.ProcessingConfig = result.ProcessingConfig
+	}
+
+	logger.Info("Transfer routed.", "key", tinfo.Key, "rule", result.Rule, "pipelines", result.Pipelines)
+}
+
+// transferSize returns the size of the transfer in its watcher or in the batch
+// directory.
+func transferSize(ctx context.Context, wsvc watcher.Service, tinfo *TransferInfo) (int64, error) {
+	if tinfo.WatcherName == "" {
+		if tinfo.BatchDir == "" {
+			return 0, errors.New("transfer location is unknown")
+		}
+		return fsutil.Size(filepath.Join(tinfo.BatchDir, tinfo.Key))
+	}
+	if wsvc == nil {
+		return 0, errors.New("watcher service is unavailable")
+	}
+
+	return wsvc.Size(ctx, tinfo.WatcherName, tinfo.Key)
+}
+
+func setStatusInProgressLocalActivity(ctx context.Context, colsvc collection.Service, colID uint, startedAt time.Time, cause collection.StatusTransitionCause) error {
+	ctx = collection.WithStatusTransitionCause(ctx, cause)
 	return colsvc.SetStatusInProgress(ctx, colID, startedAt)
 }
 
@@ -104,6 +195,41 @@ func setStatusLocalActivity(ctx context.Context, colsvc collection.Service, colI
 	return colsvc.SetStatus(ctx, colID, status)
 }
 
+func setPendingDecisionLocalActivity(ctx context.Context, colsvc collection.Service, colID uint, decision collection.PendingDecision) error {
+	return colsvc.SetPendingDecision(ctx, colID, decision)
+}
+
+func remindPendingDecisionLocalActivity(ctx context.Context, colsvc collection.Service, colID uint, pendingFor time.Duration) error {
+	return colsvc.RemindPendingDecision(ctx, colID, pendingFor)
+}
+
+func setPipelineSelectionLocalActivity(ctx context.Context, colsvc collection.Service, colID uint, selection string) error {
+	return colsvc.SetPipelineSelection(ctx, colID, selection)
+}
+
 func setOriginalIDLocalActivity(ctx context.Context, colsvc collection.Service, colID uint, originalID string) error {
 	return colsvc.SetOriginalID(ctx, colID, originalID)
 }
+
+type scheduleRetentionLocalActivityParams struct {
+	CollectionID uint
+	WatcherName  string
+	BatchDir     string
+	Key          string
+	DueAt        time.Time
+}
+
+func scheduleRetentionLocalActivity(ctx context.Context, logger logr.Logger, retsvc retention.Service, params *scheduleRetentionLocalActivityParams) error {
+	err := retsvc.Schedule(ctx, &retention.Deletion{
+		CollectionID: params.CollectionID,
+		WatcherName:  params.WatcherName,
+		BatchDir:     params.BatchDir,
+		Key:          params.Key,
+		DueAt:        params.DueAt,
+	})
+	if err != nil {
+		logger.Error(err, "Error scheduling retention deletion")
+	}
+
+	return err
+}
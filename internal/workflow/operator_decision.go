@@ -3,23 +3,44 @@ package workflow
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	temporalsdk_temporal "go.temporal.io/sdk/temporal"
 	temporalsdk_workflow "go.temporal.io/sdk/workflow"
 
 	"github.com/artefactual-labs/enduro/internal/collection"
+	"github.com/artefactual-labs/enduro/internal/decision"
 )
 
+// operatorDecisionTimeout is the fixed timeout of the workflows started before
+// the timeout became configurable.
 const operatorDecisionTimeout = 7 * 24 * time.Hour
 
 // ErrOperatorDecisionAbandoned indicates that an operator abandoned processing.
 var ErrOperatorDecisionAbandoned = errors.New("user abandoned")
 
+// ErrOperatorDecisionTimedOut indicates that no operator decided in time and
+// the configured action is to fail processing.
+var ErrOperatorDecisionTimedOut = errors.New("operator decision timed out")
+
+// operatorDecisionContextChangeID versions the recording of the failed
+// activity and the accepted options when the workflow awaits a decision.
+const operatorDecisionContextChangeID = "operator-decision-context"
+
+// operatorDecisionTimeoutChangeID versions the configurable timeout, the
+// reminders and the timeout action of the operator decisions.
+const operatorDecisionTimeoutChangeID = "operator-decision-timeout"
+
 type operatorDecisionHandler struct {
 	ctx      temporalsdk_workflow.Context
 	awaiting bool
+	options  []collection.ProcessingWorkflowDecision
 	decision collection.ProcessingWorkflowDecision
+
+	// Actor that made the last decision, empty when the decision was not
+	// made by an operator, e.g. after a timeout.
+	actor string
 }
 
 func newOperatorDecisionHandler(ctx temporalsdk_workflow.Context) (*operatorDecisionHandler, error) {
@@ -27,19 +48,23 @@ func newOperatorDecisionHandler(ctx temporalsdk_workflow.Context) (*operatorDeci
 	err := temporalsdk_workflow.SetUpdateHandlerWithOptions(
 		ctx,
 		collection.ProcessingWorkflowDecisionUpdateName,
-		func(_ temporalsdk_workflow.Context, decision collection.ProcessingWorkflowDecision) error {
+		func(_ temporalsdk_workflow.Context, decision collection.ProcessingWorkflowDecision, actor string) error {
 			h.decision = decision
+			h.actor = actor
 			h.awaiting = false
 			return nil
 		},
 		temporalsdk_workflow.UpdateHandlerOptions{
-			Validator: func(decision collection.ProcessingWorkflowDecision) error {
+			Validator: func(decision collection.ProcessingWorkflowDecision, actor string) error {
 				if _, err := collection.ParseProcessingWorkflowDecision(string(decision)); err != nil {
 					return err
 				}
 				if !h.awaiting {
 					return errors.New("workflow is not awaiting an operator decision")
 				}
+				if !slices.Contains(h.options, decision) {
+					return fmt.Errorf("decision option %q is not accepted by the workflow", decision)
+				}
 				return nil
 			},
 		},
@@ -51,58 +76,188 @@ func newOperatorDecisionHandler(ctx temporalsdk_workflow.Context) (*operatorDeci
 	return h, nil
 }
 
+// await sets the collection status to pending and blocks until the operator
+// decides about the failure. Reminders are sent while the decision is pending and
+// ErrOperatorDecisionTimedOut is returned when the timeout is reached.
 func (h *operatorDecisionHandler) await(
 	ctx temporalsdk_workflow.Context,
 	colsvc collection.Service,
 	colID uint,
+	pending collection.PendingDecision,
+	config decision.Config,
 ) (collection.ProcessingWorkflowDecision, error) {
 	h.awaiting = true
+	h.options = pending.Options
 	h.decision = ""
+	h.actor = ""
 	defer func() { h.awaiting = false }()
 
 	activityOpts := withLocalActivityOpts(h.ctx)
-	err := temporalsdk_workflow.ExecuteLocalActivity(
-		activityOpts,
-		setStatusLocalActivity,
-		colsvc,
-		colID,
-		collection.StatusPending,
-	).Get(activityOpts, nil)
+	var err error
+	version := temporalsdk_workflow.GetVersion(h.ctx, operatorDecisionContextChangeID, temporalsdk_workflow.DefaultVersion, 1)
+	if version == temporalsdk_workflow.DefaultVersion {
+		err = temporalsdk_workflow.ExecuteLocalActivity(
+			activityOpts,
+			setStatusLocalActivity,
+			colsvc,
+			colID,
+			collection.StatusPending,
+		).Get(activityOpts, nil)
+	} else {
+		err = temporalsdk_workflow.ExecuteLocalActivity(
+			activityOpts,
+			setPendingDecisionLocalActivity,
+			colsvc,
+			colID,
+			pending,
+		).Get(activityOpts, nil)
+	}
 	if err != nil {
 		return "", fmt.Errorf("error setting collection status to pending: %w", err)
 	}
 
-	ok, err := temporalsdk_workflow.AwaitWithTimeout(ctx, operatorDecisionTimeout, func() bool {
+	decided := func() bool {
 		return h.decision != ""
-	})
+	}
+
+	version = temporalsdk_workflow.GetVersion(h.ctx, operatorDecisionTimeoutChangeID, temporalsdk_workflow.DefaultVersion, 1)
+	if version == temporalsdk_workflow.DefaultVersion {
+		ok, err := temporalsdk_workflow.AwaitWithTimeout(ctx, operatorDecisionTimeout, decided)
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			return collection.ProcessingWorkflowDecisionAbandon, nil
+		}
+		return h.decision, nil
+	}
+
+	logger := temporalsdk_workflow.GetLogger(ctx)
+	startedAt := temporalsdk_workflow.Now(ctx)
+	awaitUntil := func(elapsed time.Duration) (bool, error) {
+		wait := elapsed - temporalsdk_workflow.Now(ctx).Sub(startedAt)
+		if wait <= 0 {
+			return decided(), nil
+		}
+		return temporalsdk_workflow.AwaitWithTimeout(ctx, wait, decided)
+	}
+
+	for _, reminder := range config.ReminderSchedule() {
+		ok, err := awaitUntil(reminder)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return h.decision, nil
+		}
+
+		logger.Warn("Operator decision pending.", "collectionID", colID, "activity", pending.Activity, "pendingFor", reminder.String())
+		remindOpts := withLocalActivityOpts(h.ctx)
+		if err := temporalsdk_workflow.ExecuteLocalActivity(
+			remindOpts,
+			remindPendingDecisionLocalActivity,
+			colsvc,
+			colID,
+			reminder,
+		).Get(remindOpts, nil); err != nil {
+			logger.Warn("Error sending operator decision reminder.", "collectionID", colID, "err", err.Error())
+		}
+	}
+
+	ok, err := awaitUntil(config.WaitTimeout())
 	if err != nil {
 		return "", err
 	}
 	if !ok {
-		return collection.ProcessingWorkflowDecisionAbandon, nil
+		logger.Warn("Operator decision timed out.", "collectionID", colID, "activity", pending.Activity, "action", config.TimeoutAction())
+		return "", ErrOperatorDecisionTimedOut
+	}
+
+	return h.decision, nil
+}
+
+// awaitPipeline sets the collection status to pending while Archivematica
+// waits for a user decision and blocks until done reports that Archivematica
+// proceeded or an operator decides. The decision can only be made in
+// Archivematica, so abandoning processing is the only option accepted.
+func (h *operatorDecisionHandler) awaitPipeline(
+	ctx temporalsdk_workflow.Context,
+	colsvc collection.Service,
+	colID uint,
+	pending collection.PendingDecision,
+	done func() bool,
+) (collection.ProcessingWorkflowDecision, error) {
+	h.awaiting = true
+	h.options = pending.Options
+	h.decision = ""
+	h.actor = ""
+	defer func() { h.awaiting = false }()
+
+	activityOpts := withLocalActivityOpts(h.ctx)
+	if err := temporalsdk_workflow.ExecuteLocalActivity(
+		activityOpts,
+		setPendingDecisionLocalActivity,
+		colsvc,
+		colID,
+		pending,
+	).Get(activityOpts, nil); err != nil {
+		return "", fmt.Errorf("error setting collection status to pending: %w", err)
+	}
+
+	if err := temporalsdk_workflow.Await(ctx, func() bool {
+		return h.decision != "" || done()
+	}); err != nil {
+		return "", err
 	}
 
 	return h.decision, nil
 }
 
+// operatorDecisionStep is an activity whose failures are handed over to an
+// operator.
+type operatorDecisionStep struct {
+	opts     temporalsdk_workflow.ActivityOptions
+	activity string
+	args     []any
+
+	// Whether the workflow can continue without the activity.
+	skippable bool
+
+	// decision configures the wait for the operator decision.
+	decision decision.Config
+}
+
+// options returns the decision options accepted by the step.
+func (s operatorDecisionStep) options() []collection.ProcessingWorkflowDecision {
+	options := []collection.ProcessingWorkflowDecision{
+		collection.ProcessingWorkflowDecisionRetry,
+		collection.ProcessingWorkflowDecisionRetryOnce,
+		collection.ProcessingWorkflowDecisionAbandon,
+	}
+	if s.skippable {
+		options = append(options, collection.ProcessingWorkflowDecisionSkip)
+	}
+
+	return options
+}
+
 func executeActivityWithOperatorDecision(
 	ctx temporalsdk_workflow.Context,
 	decisions *operatorDecisionHandler,
 	colsvc collection.Service,
 	colID uint,
-	opts temporalsdk_workflow.ActivityOptions,
-	act any,
-	args ...any,
+	step operatorDecisionStep,
 ) error {
 	decision := collection.ProcessingWorkflowDecisionRetry
+	retriedOnTimeout := false
 
 	for {
-		activityOptions := opts
+		activityOptions := step.opts
 		if decision == collection.ProcessingWorkflowDecisionRetryOnce {
 			activityOptions.RetryPolicy = &temporalsdk_temporal.RetryPolicy{MaximumAttempts: 1}
 		}
 		activityCtx := temporalsdk_workflow.WithActivityOptions(ctx, activityOptions)
-		err := temporalsdk_workflow.ExecuteActivity(activityCtx, act, args...).Get(activityCtx, nil)
+		err := temporalsdk_workflow.ExecuteActivity(activityCtx, step.activity, step.args...).Get(activityCtx, nil)
 		if err == nil {
 			return nil
 		}
@@ -110,14 +265,23 @@ func executeActivityWithOperatorDecision(
 			return err
 		}
 
-		decision, err = decisions.await(ctx, colsvc, colID)
+		decision, err = decisions.await(ctx, colsvc, colID, collection.PendingDecision{
+			Activity: step.activity,
+			Error:    err.Error(),
+			Options:  step.options(),
+		}, step.decision)
+		if errors.Is(err, ErrOperatorDecisionTimedOut) {
+			decision, err = timeoutDecision(step.decision, retriedOnTimeout)
+			retriedOnTimeout = true
+		}
 		if err != nil {
 			return err
 		}
 
 		switch decision {
 		case collection.ProcessingWorkflowDecisionRetry,
-			collection.ProcessingWorkflowDecisionRetryOnce:
+			collection.ProcessingWorkflowDecisionRetryOnce,
+			collection.ProcessingWorkflowDecisionSkip:
 			statusOpts := withLocalActivityOpts(decisions.ctx)
 			if err := temporalsdk_workflow.ExecuteLocalActivity(
 				statusOpts,
@@ -125,9 +289,14 @@ func executeActivityWithOperatorDecision(
 				colsvc,
 				colID,
 				time.Time{},
+				collection.StatusTransitionCause{Actor: decisions.actor},
 			).Get(statusOpts, nil); err != nil {
 				return fmt.Errorf("error setting collection status to in progress: %w", err)
 			}
+			if decision == collection.ProcessingWorkflowDecisionSkip {
+				temporalsdk_workflow.GetLogger(ctx).Warn("Activity skipped by operator decision.", "activity", step.activity, "collectionID", colID)
+				return nil
+			}
 			continue
 		case collection.ProcessingWorkflowDecisionAbandon:
 			return ErrOperatorDecisionAbandoned
@@ -137,6 +306,21 @@ func executeActivityWithOperatorDecision(
 	}
 }
 
+// timeoutDecision returns the decision taken when no operator decides in time.
+// The activity is retried once at most, later timeouts abandon processing.
+func timeoutDecision(config decision.Config, retried bool) (collection.ProcessingWorkflowDecision, error) {
+	switch config.TimeoutAction() {
+	case decision.OnTimeoutError:
+		return "", ErrOperatorDecisionTimedOut
+	case decision.OnTimeoutRetryOnce:
+		if !retried {
+			return collection.ProcessingWorkflowDecisionRetryOnce, nil
+		}
+	}
+
+	return collection.ProcessingWorkflowDecisionAbandon, nil
+}
+
 func requiresOperatorDecision(err error) bool {
 	return !errors.Is(err, temporalsdk_workflow.ErrSessionFailed) &&
 		!temporalsdk_temporal.IsCanceledError(err)
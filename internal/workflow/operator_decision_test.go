@@ -1,7 +1,9 @@
 package workflow
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -17,6 +19,8 @@ import (
 
 	"github.com/artefactual-labs/enduro/internal/collection"
 	collectionfake "github.com/artefactual-labs/enduro/internal/collection/fake"
+	"github.com/artefactual-labs/enduro/internal/decision"
+	"github.com/artefactual-labs/enduro/internal/workflow/activities"
 )
 
 func TestRetryOnceOverridesActivityRetryPolicy(t *testing.T) {
@@ -30,11 +34,11 @@ func TestRetryOnceOverridesActivityRetryPolicy(t *testing.T) {
 		return errors.New("failed")
 	}, temporalsdk_activity.RegisterOptions{Name: activityName})
 	env.OnActivity(
-		setStatusLocalActivity,
+		setPendingDecisionLocalActivity,
 		mock.Anything,
 		mock.Anything,
 		uint(42),
-		collection.StatusPending,
+		mock.Anything,
 	).Return(nil).Twice()
 	env.OnActivity(
 		setStatusInProgressLocalActivity,
@@ -42,6 +46,7 @@ func TestRetryOnceOverridesActivityRetryPolicy(t *testing.T) {
 		mock.Anything,
 		uint(42),
 		time.Time{},
+		collection.StatusTransitionCause{Actor: "api:alice"},
 	).Return(nil).Once()
 
 	env.RegisterDelayedCallback(func() {
@@ -50,6 +55,7 @@ func TestRetryOnceOverridesActivityRetryPolicy(t *testing.T) {
 			"retry-once",
 			t,
 			collection.ProcessingWorkflowDecisionRetryOnce,
+			"api:alice",
 		)
 	}, 10*time.Second)
 	env.RegisterDelayedCallback(func() {
@@ -71,14 +77,16 @@ func TestRetryOnceOverridesActivityRetryPolicy(t *testing.T) {
 			decisions,
 			colsvc,
 			42,
-			temporalsdk_workflow.ActivityOptions{
-				StartToCloseTimeout: time.Minute,
-				RetryPolicy: &temporalsdk_temporal.RetryPolicy{
-					InitialInterval: time.Second,
-					MaximumAttempts: 3,
+			operatorDecisionStep{
+				opts: temporalsdk_workflow.ActivityOptions{
+					StartToCloseTimeout: time.Minute,
+					RetryPolicy: &temporalsdk_temporal.RetryPolicy{
+						InitialInterval: time.Second,
+						MaximumAttempts: 3,
+					},
 				},
+				activity: activityName,
 			},
-			activityName,
 		)
 	})
 
@@ -87,6 +95,212 @@ func TestRetryOnceOverridesActivityRetryPolicy(t *testing.T) {
 	env.AssertExpectations(t)
 }
 
+func TestOperatorDecisionKeepsLegacyPendingStatus(t *testing.T) {
+	env := new(temporalsdk_testsuite.WorkflowTestSuite).NewTestWorkflowEnvironment()
+	colsvc := collectionfake.NewMockService(gomock.NewController(t))
+	activityName := uuid.NewString()
+
+	env.RegisterActivityWithOptions(
+		func() error { return errors.New("failed") },
+		temporalsdk_activity.RegisterOptions{Name: activityName},
+	)
+	env.OnGetVersion(operatorDecisionContextChangeID, temporalsdk_workflow.DefaultVersion, 1).Return(temporalsdk_workflow.DefaultVersion)
+	env.OnActivity(
+		setStatusLocalActivity,
+		mock.Anything,
+		mock.Anything,
+		uint(42),
+		collection.StatusPending,
+	).Return(nil).Once()
+
+	env.RegisterDelayedCallback(func() {
+		env.UpdateWorkflowNoRejection(
+			collection.ProcessingWorkflowDecisionUpdateName,
+			"abandon",
+			t,
+			collection.ProcessingWorkflowDecisionAbandon,
+		)
+	}, time.Second)
+
+	env.ExecuteWorkflow(func(ctx temporalsdk_workflow.Context) error {
+		decisions, err := newOperatorDecisionHandler(ctx)
+		if err != nil {
+			return err
+		}
+		return executeActivityWithOperatorDecision(
+			ctx,
+			decisions,
+			colsvc,
+			42,
+			operatorDecisionStep{
+				opts: temporalsdk_workflow.ActivityOptions{
+					StartToCloseTimeout: time.Minute,
+					RetryPolicy: &temporalsdk_temporal.RetryPolicy{
+						MaximumAttempts: 1,
+					},
+				},
+				activity: activityName,
+			},
+		)
+	})
+
+	assert.ErrorContains(t, env.GetWorkflowError(), "user abandoned")
+	env.AssertExpectations(t)
+}
+
+func TestOperatorDecisionTimeout(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		onTimeout    string
+		wantErr      string
+		wantAttempts int32
+		wantAwaits   int
+	}{
+		"Abandons processing by default": {
+			wantErr:      "user abandoned",
+			wantAttempts: 1,
+			wantAwaits:   1,
+		},
+		"Fails processing": {
+			onTimeout:    decision.OnTimeoutError,
+			wantErr:      "operator decision timed out",
+			wantAttempts: 1,
+			wantAwaits:   1,
+		},
+		"Retries once before abandoning": {
+			onTimeout:    decision.OnTimeoutRetryOnce,
+			wantErr:      "user abandoned",
+			wantAttempts: 2,
+			wantAwaits:   2,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			env := new(temporalsdk_testsuite.WorkflowTestSuite).NewTestWorkflowEnvironment()
+			colsvc := collectionfake.NewMockService(gomock.NewController(t))
+			activityName := uuid.NewString()
+			var attempts atomic.Int32
+
+			env.RegisterActivityWithOptions(func() error {
+				attempts.Add(1)
+				return errors.New("failed")
+			}, temporalsdk_activity.RegisterOptions{Name: activityName})
+			env.OnActivity(
+				setPendingDecisionLocalActivity,
+				mock.Anything,
+				mock.Anything,
+				uint(42),
+				mock.Anything,
+			).Return(nil).Times(tc.wantAwaits)
+			env.OnActivity(
+				remindPendingDecisionLocalActivity,
+				mock.Anything,
+				mock.Anything,
+				uint(42),
+				time.Hour,
+			).Return(nil).Times(tc.wantAwaits)
+			if tc.wantAwaits > 1 {
+				env.OnActivity(
+					setStatusInProgressLocalActivity,
+					mock.Anything,
+					mock.Anything,
+					uint(42),
+					time.Time{},
+					collection.StatusTransitionCause{},
+				).Return(nil).Once()
+			}
+
+			env.ExecuteWorkflow(func(ctx temporalsdk_workflow.Context) error {
+				decisions, err := newOperatorDecisionHandler(ctx)
+				if err != nil {
+					return err
+				}
+				return executeActivityWithOperatorDecision(
+					ctx,
+					decisions,
+					colsvc,
+					42,
+					operatorDecisionStep{
+						opts: temporalsdk_workflow.ActivityOptions{
+							StartToCloseTimeout: time.Minute,
+							RetryPolicy: &temporalsdk_temporal.RetryPolicy{
+								MaximumAttempts: 1,
+							},
+						},
+						activity: activityName,
+						decision: decision.Config{
+							Timeout:   2 * time.Hour,
+							Reminders: []time.Duration{time.Hour},
+							OnTimeout: tc.onTimeout,
+						},
+					},
+				)
+			})
+
+			assert.ErrorContains(t, env.GetWorkflowError(), tc.wantErr)
+			assert.Equal(t, attempts.Load(), tc.wantAttempts)
+			env.AssertExpectations(t)
+		})
+	}
+}
+
+func TestOperatorDecisionKeepsLegacyTimeout(t *testing.T) {
+	env := new(temporalsdk_testsuite.WorkflowTestSuite).NewTestWorkflowEnvironment()
+	colsvc := collectionfake.NewMockService(gomock.NewController(t))
+	activityName := uuid.NewString()
+
+	env.RegisterActivityWithOptions(
+		func() error { return errors.New("failed") },
+		temporalsdk_activity.RegisterOptions{Name: activityName},
+	)
+	env.OnGetVersion(operatorDecisionTimeoutChangeID, temporalsdk_workflow.DefaultVersion, 1).Return(temporalsdk_workflow.DefaultVersion)
+	env.OnActivity(
+		setPendingDecisionLocalActivity,
+		mock.Anything,
+		mock.Anything,
+		uint(42),
+		mock.Anything,
+	).Return(nil).Once()
+
+	env.ExecuteWorkflow(func(ctx temporalsdk_workflow.Context) error {
+		decisions, err := newOperatorDecisionHandler(ctx)
+		if err != nil {
+			return err
+		}
+		startedAt := temporalsdk_workflow.Now(ctx)
+		err = executeActivityWithOperatorDecision(
+			ctx,
+			decisions,
+			colsvc,
+			42,
+			operatorDecisionStep{
+				opts: temporalsdk_workflow.ActivityOptions{
+					StartToCloseTimeout: time.Minute,
+					RetryPolicy: &temporalsdk_temporal.RetryPolicy{
+						MaximumAttempts: 1,
+					},
+				},
+				activity: activityName,
+				decision: decision.Config{
+					Timeout:   time.Hour,
+					Reminders: []time.Duration{time.Minute},
+					OnTimeout: decision.OnTimeoutError,
+				},
+			},
+		)
+		if elapsed := temporalsdk_workflow.Now(ctx).Sub(startedAt); elapsed < operatorDecisionTimeout {
+			return fmt.Errorf("decision timed out after %s", elapsed)
+		}
+		return err
+	})
+
+	assert.ErrorContains(t, env.GetWorkflowError(), "user abandoned")
+	env.AssertExpectations(t)
+}
+
 func TestOperatorDecisionPropagatesCancellation(t *testing.T) {
 	env := new(temporalsdk_testsuite.WorkflowTestSuite).NewTestWorkflowEnvironment()
 	colsvc := collectionfake.NewMockService(gomock.NewController(t))
@@ -111,10 +325,12 @@ func TestOperatorDecisionPropagatesCancellation(t *testing.T) {
 			decisions,
 			colsvc,
 			42,
-			temporalsdk_workflow.ActivityOptions{
-				StartToCloseTimeout: time.Minute,
+			operatorDecisionStep{
+				opts: temporalsdk_workflow.ActivityOptions{
+					StartToCloseTimeout: time.Minute,
+				},
+				activity: activityName,
 			},
-			activityName,
 		)
 	})
 
@@ -132,11 +348,11 @@ func TestOperatorDecisionWaitPropagatesCancellation(t *testing.T) {
 		temporalsdk_activity.RegisterOptions{Name: activityName},
 	)
 	env.OnActivity(
-		setStatusLocalActivity,
+		setPendingDecisionLocalActivity,
 		mock.Anything,
 		mock.Anything,
 		uint(42),
-		collection.StatusPending,
+		mock.Anything,
 	).Return(nil).Once()
 
 	env.ExecuteWorkflow(func(ctx temporalsdk_workflow.Context) error {
@@ -156,13 +372,15 @@ func TestOperatorDecisionWaitPropagatesCancellation(t *testing.T) {
 			decisions,
 			colsvc,
 			42,
-			temporalsdk_workflow.ActivityOptions{
-				StartToCloseTimeout: time.Minute,
-				RetryPolicy: &temporalsdk_temporal.RetryPolicy{
-					MaximumAttempts: 1,
+			operatorDecisionStep{
+				opts: temporalsdk_workflow.ActivityOptions{
+					StartToCloseTimeout: time.Minute,
+					RetryPolicy: &temporalsdk_temporal.RetryPolicy{
+						MaximumAttempts: 1,
+					},
 				},
+				activity: activityName,
 			},
-			activityName,
 		)
 	})
 
@@ -196,3 +414,163 @@ func TestRequiresOperatorDecision(t *testing.T) {
 		})
 	}
 }
+
+func TestPollAwaitingDecision(t *testing.T) {
+	pending := collection.PendingDecision{
+		Activity: "poll",
+		Error:    "transfer is awaiting a decision in Archivematica: Create SIP from Transfer",
+		Options:  []collection.ProcessingWorkflowDecision{collection.ProcessingWorkflowDecisionAbandon},
+	}
+	awaiting := temporalsdk_temporal.NewNonRetryableApplicationError(pending.Error, activities.AwaitingDecisionErrorType, nil)
+	resumed := collection.StatusTransitionCause{Reason: collection.ReasonProcessingResumed}
+
+	// The polling activity takes whether it only waits for the decision.
+	step := func(sipID *string) pollStep {
+		return pollStep{
+			activity:   "poll",
+			params:     false,
+			waitParams: true,
+			result:     sipID,
+			completed:  func() bool { return *sipID != "" },
+		}
+	}
+
+	t.Run("Resumes polling when Archivematica proceeds", func(t *testing.T) {
+		env := new(temporalsdk_testsuite.WorkflowTestSuite).NewTestWorkflowEnvironment()
+		colsvc := collectionfake.NewMockService(gomock.NewController(t))
+		w := &ProcessingWorkflow{colsvc: colsvc}
+
+		var polled []bool
+		env.RegisterActivityWithOptions(func(ctx context.Context, wait bool) (string, error) {
+			polled = append(polled, wait)
+			if wait {
+				return "", nil
+			}
+			return "sip", nil
+		}, temporalsdk_activity.RegisterOptions{Name: "poll"})
+		env.OnActivity(setPendingDecisionLocalActivity, mock.Anything, mock.Anything, uint(42), pending).Return(nil).Once()
+		env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(42), time.Time{}, resumed).Return(nil).Once()
+
+		env.ExecuteWorkflow(func(ctx temporalsdk_workflow.Context) (string, error) {
+			decisions, err := newOperatorDecisionHandler(ctx)
+			if err != nil {
+				return "", err
+			}
+			var sipID string
+			err = w.pollAwaitingDecision(ctx, decisions, &TransferInfo{CollectionID: 42}, step(&sipID), awaiting)
+			return sipID, err
+		})
+
+		assert.NilError(t, env.GetWorkflowError())
+		var sipID string
+		assert.NilError(t, env.GetWorkflowResult(&sipID))
+		assert.Equal(t, sipID, "sip")
+		assert.DeepEqual(t, polled, []bool{true, false})
+		env.AssertExpectations(t)
+	})
+
+	t.Run("Waits for each decision requested by Archivematica", func(t *testing.T) {
+		env := new(temporalsdk_testsuite.WorkflowTestSuite).NewTestWorkflowEnvironment()
+		colsvc := collectionfake.NewMockService(gomock.NewController(t))
+		w := &ProcessingWorkflow{colsvc: colsvc}
+
+		var polled []bool
+		env.RegisterActivityWithOptions(func(ctx context.Context, wait bool) (string, error) {
+			polled = append(polled, wait)
+			switch {
+			case wait:
+				return "", nil
+			case len(polled) == 2:
+				return "", awaiting
+			default:
+				return "sip", nil
+			}
+		}, temporalsdk_activity.RegisterOptions{Name: "poll"})
+		env.OnActivity(setPendingDecisionLocalActivity, mock.Anything, mock.Anything, uint(42), pending).Return(nil).Twice()
+		env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(42), time.Time{}, resumed).Return(nil).Twice()
+
+		env.ExecuteWorkflow(func(ctx temporalsdk_workflow.Context) (string, error) {
+			decisions, err := newOperatorDecisionHandler(ctx)
+			if err != nil {
+				return "", err
+			}
+			var sipID string
+			err = w.pollAwaitingDecision(ctx, decisions, &TransferInfo{CollectionID: 42}, step(&sipID), awaiting)
+			return sipID, err
+		})
+
+		assert.NilError(t, env.GetWorkflowError())
+		var sipID string
+		assert.NilError(t, env.GetWorkflowResult(&sipID))
+		assert.Equal(t, sipID, "sip")
+		assert.DeepEqual(t, polled, []bool{true, false, true, false})
+		env.AssertExpectations(t)
+	})
+
+	t.Run("Returns the result when processing completes during the wait", func(t *testing.T) {
+		env := new(temporalsdk_testsuite.WorkflowTestSuite).NewTestWorkflowEnvironment()
+		colsvc := collectionfake.NewMockService(gomock.NewController(t))
+		w := &ProcessingWorkflow{colsvc: colsvc}
+
+		var polled []bool
+		env.RegisterActivityWithOptions(func(ctx context.Context, wait bool) (string, error) {
+			polled = append(polled, wait)
+			return "sip", nil
+		}, temporalsdk_activity.RegisterOptions{Name: "poll"})
+		env.OnActivity(setPendingDecisionLocalActivity, mock.Anything, mock.Anything, uint(42), pending).Return(nil).Once()
+		env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(42), time.Time{}, resumed).Return(nil).Once()
+
+		env.ExecuteWorkflow(func(ctx temporalsdk_workflow.Context) (string, error) {
+			decisions, err := newOperatorDecisionHandler(ctx)
+			if err != nil {
+				return "", err
+			}
+			var sipID string
+			err = w.pollAwaitingDecision(ctx, decisions, &TransferInfo{CollectionID: 42}, step(&sipID), awaiting)
+			return sipID, err
+		})
+
+		assert.NilError(t, env.GetWorkflowError())
+		var sipID string
+		assert.NilError(t, env.GetWorkflowResult(&sipID))
+		assert.Equal(t, sipID, "sip")
+		assert.DeepEqual(t, polled, []bool{true})
+		env.AssertExpectations(t)
+	})
+
+	t.Run("Operators can abandon processing", func(t *testing.T) {
+		env := new(temporalsdk_testsuite.WorkflowTestSuite).NewTestWorkflowEnvironment()
+		colsvc := collectionfake.NewMockService(gomock.NewController(t))
+		w := &ProcessingWorkflow{colsvc: colsvc}
+
+		env.RegisterActivityWithOptions(func(ctx context.Context, wait bool) (string, error) {
+			select {
+			case <-ctx.Done():
+				return "", ctx.Err()
+			case <-time.After(time.Second):
+				return "", errors.New("still awaiting decision")
+			}
+		}, temporalsdk_activity.RegisterOptions{Name: "poll"})
+		env.OnActivity(setPendingDecisionLocalActivity, mock.Anything, mock.Anything, uint(42), pending).Return(nil).Once()
+		env.RegisterDelayedCallback(func() {
+			env.UpdateWorkflowNoRejection(
+				collection.ProcessingWorkflowDecisionUpdateName,
+				"abandon",
+				t,
+				collection.ProcessingWorkflowDecisionAbandon,
+			)
+		}, time.Millisecond)
+
+		env.ExecuteWorkflow(func(ctx temporalsdk_workflow.Context) error {
+			decisions, err := newOperatorDecisionHandler(ctx)
+			if err != nil {
+				return err
+			}
+			var sipID string
+			return w.pollAwaitingDecision(ctx, decisions, &TransferInfo{CollectionID: 42}, step(&sipID), awaiting)
+		})
+
+		assert.ErrorContains(t, env.GetWorkflowError(), "user abandoned")
+		env.AssertExpectations(t)
+	})
+}
@@ -8,7 +8,6 @@ package workflow
 import (
 	"errors"
 	"fmt"
-	"math/rand"
 	"os"
 	"time"
 
@@ -18,13 +17,17 @@ import (
 	temporalsdk_workflow "go.temporal.io/sdk/workflow"
 
 	"github.com/artefactual-labs/enduro/internal/collection"
+	"github.com/artefactual-labs/enduro/internal/decision"
 	"github.com/artefactual-labs/enduro/internal/metadata"
 	"github.com/artefactual-labs/enduro/internal/nha"
 	nha_activities "github.com/artefactual-labs/enduro/internal/nha/activities"
 	"github.com/artefactual-labs/enduro/internal/pipeline"
+	"github.com/artefactual-labs/enduro/internal/receipt"
 	"github.com/artefactual-labs/enduro/internal/reconciliation"
+	"github.com/artefactual-labs/enduro/internal/retention"
 	"github.com/artefactual-labs/enduro/internal/temporal"
 	"github.com/artefactual-labs/enduro/internal/validation"
+	"github.com/artefactual-labs/enduro/internal/watcher"
 	"github.com/artefactual-labs/enduro/internal/workflow/activities"
 	"github.com/artefactual-labs/enduro/internal/workflow/hooks"
 )
@@ -32,6 +35,8 @@ import (
 type ProcessingWorkflow struct {
 	hooks            *hooks.Hooks
 	colsvc           collection.Service
+	retsvc           retention.Service
+	wsvc             watcher.Service
 	pipelineRegistry *pipeline.Registry
 	logger           logr.Logger
 	config           Config
@@ -40,15 +45,35 @@ type ProcessingWorkflow struct {
 type Config struct {
 	ActivityHeartbeatTimeout time.Duration
 	InitProcessingTimeout    time.Duration
+
+	// Receipts configures the receipt hooks delivered after processing.
+	Receipts receipt.Config
+
+	// Decision configures the wait for operator decisions. It can be
+	// overridden per pipeline and per receipt hook.
+	Decision decision.Config
 }
 
+// pipelineSelectionChangeID versions the recording of the decision of the
+// pipeline scheduler and the rerouting of transfers away from draining
+// pipelines.
+const pipelineSelectionChangeID = "pipeline-selection"
+
+// validationResultsChangeID versions the recording of the results of the
+// transfer validators.
+const validationResultsChangeID = "validation-results"
+
+// awaitingDecisionChangeID versions the wait for decisions requested by
+// Archivematica, e.g. during manual appraisal.
+const awaitingDecisionChangeID = "awaiting-decision"
+
 const (
 	postIngestReconciliationRetryWindow   = 5 * time.Minute
 	postIngestReconciliationRetryInterval = 15 * time.Second
 )
 
-func NewProcessingWorkflow(h *hooks.Hooks, colsvc collection.Service, pipelineRegistry *pipeline.Registry, l logr.Logger, c Config) *ProcessingWorkflow {
-	return &ProcessingWorkflow{hooks: h, colsvc: colsvc, pipelineRegistry: pipelineRegistry, logger: l, config: c}
+func NewProcessingWorkflow(h *hooks.Hooks, colsvc collection.Service, retsvc retention.Service, wsvc watcher.Service, pipelineRegistry *pipeline.Registry, l logr.Logger, c Config) *ProcessingWorkflow {
+	return &ProcessingWorkflow{hooks: h, colsvc: colsvc, retsvc: retsvc, wsvc: wsvc, pipelineRegistry: pipelineRegistry, logger: l, config: c}
 }
 
 // TransferInfo is shared state that is passed down to activities. It can be
@@ -85,10 +110,21 @@ type TransferInfo struct {
 
 	// Name of the pipeline to be used for processing.
 	//
-	// It is populated by this workflow after the list provided by the user or
-	// the list of configured pipelines in the system.
+	// It is populated via the workflow request or, when the request does not
+	// choose one, by the scheduler in loadConfigLocalActivity.
 	PipelineName string
 
+	// Candidate pipelines of the scheduler. Routing rules can replace them.
+	//
+	// It is populated via the workflow request.
+	PipelineNames []string
+
+	// PipelineSelection explains the decision of the scheduler, nil when the
+	// pipeline was chosen by the request.
+	//
+	// It is populated by loadConfigLocalActivity.
+	PipelineSelection *pipeline.Selection
+
 	// Retention period.
 	// Period of time to schedule the deletion of the original blob from the
 	// watched data source. nil means no deletion.
@@ -174,6 +210,26 @@ type TransferInfo struct {
 	MetadataConfig metadata.Config
 }
 
+// receiptInfo returns the subset of the transfer state that is made available
+// to receipt templates.
+func (tinfo TransferInfo) receiptInfo() receipt.TransferInfo {
+	return receipt.TransferInfo{
+		CollectionID: tinfo.CollectionID,
+		TransferID:   tinfo.TransferID,
+		SIPID:        tinfo.SIPID,
+		WatcherName:  tinfo.WatcherName,
+		PipelineName: tinfo.PipelineName,
+		PipelineID:   tinfo.PipelineID,
+		Key:          tinfo.Key,
+		IsDir:        tinfo.IsDir,
+		BatchDir:     tinfo.BatchDir,
+		TransferType: tinfo.TransferType,
+		StoredAt:     tinfo.StoredAt,
+		FullPath:     tinfo.Bundle.FullPath,
+		RelPath:      tinfo.Bundle.RelPath,
+	}
+}
+
 func (tinfo TransferInfo) ProcessingConfiguration() string {
 	if tinfo.ProcessingConfig != "" {
 		return tinfo.ProcessingConfig
@@ -218,6 +274,13 @@ func (w *ProcessingWorkflow) Execute(ctx temporalsdk_workflow.Context, req *coll
 
 		// Collection status. All collections start in queued status.
 		status = collection.StatusQueued
+
+		// Cause of the final status transition, inferred from the statuses
+		// unless the workflow knows better.
+		finalCause collection.StatusTransitionCause
+
+		// Tells who canceled the workflow.
+		cancelCh = temporalsdk_workflow.GetSignalChannel(ctx, collection.ProcessingWorkflowCancelSignalName)
 	)
 
 	// Persist collection as early as possible.
@@ -227,8 +290,10 @@ func (w *ProcessingWorkflow) Execute(ctx temporalsdk_workflow.Context, req *coll
 
 		if req.CollectionID == 0 {
 			err = temporalsdk_workflow.ExecuteLocalActivity(activityOpts, createPackageLocalActivity, w.logger, w.colsvc, &createPackageLocalActivityParams{
-				Key:    req.Key,
-				Status: status,
+				Key:         req.Key,
+				WatcherName: req.WatcherName,
+				Status:      status,
+				Actor:       req.Actor,
 			}).Get(activityOpts, &tinfo.CollectionID)
 		} else {
 			// A retry starts from the existing collection row, but the stored
@@ -238,6 +303,7 @@ func (w *ProcessingWorkflow) Execute(ctx temporalsdk_workflow.Context, req *coll
 				Key:          req.Key,
 				StoredAt:     time.Time{},
 				Status:       status,
+				Cause:        collection.StatusTransitionCause{Actor: req.Actor},
 			}
 			if req.RetryMode == collection.RetryModeReconcileExistingAIP {
 				params.PipelineID = req.ExistingPipelineID
@@ -267,7 +333,15 @@ func (w *ProcessingWorkflow) Execute(ctx temporalsdk_workflow.Context, req *coll
 	// Ensure that the status of the collection is always updated when this
 	// workflow function returns.
 	defer func() {
-		status = finalCollectionStatus(status, ctx.Err() == temporalsdk_workflow.ErrCanceled)
+		canceled := ctx.Err() == temporalsdk_workflow.ErrCanceled
+		if canceled && status != collection.StatusDone && status != collection.StatusAbandoned {
+			finalCause = collection.StatusTransitionCause{Reason: collection.ReasonWorkflowCanceled}
+			var signal collection.ProcessingWorkflowCancelSignal
+			if cancelCh.ReceiveAsync(&signal) {
+				finalCause.Actor = signal.Actor
+			}
+		}
+		status = finalCollectionStatus(status, canceled)
 
 		// Use disconnected context so it also runs after cancellation.
 		dctx, _ := temporalsdk_workflow.NewDisconnectedContext(ctx)
@@ -280,6 +354,7 @@ func (w *ProcessingWorkflow) Execute(ctx temporalsdk_workflow.Context, req *coll
 			SIPID:        tinfo.SIPID,
 			StoredAt:     tinfo.StoredAt,
 			Status:       status,
+			Cause:        finalCause,
 		}).Get(activityOpts, nil)
 		if err != nil {
 			logger.Error("Failed to persist final workflow status",
@@ -305,20 +380,25 @@ func (w *ProcessingWorkflow) Execute(ctx temporalsdk_workflow.Context, req *coll
 				return fmt.Errorf("error checking duplicate: %v", err)
 			}
 			if exists {
+				finalCause.Reason = collection.ReasonDuplicateRejected
 				return fmt.Errorf("duplicate detected: key: %s", tinfo.Key)
 			}
 		}
 	}
 
-	// Extract details from transfer name.
-	{
+	// Extract details from transfer name, only needed by the NHA receipts.
+	if hari, prod, version := w.nhaHooks(ctx); version == temporalsdk_workflow.DefaultVersion || hari || prod {
 		activityOpts := withLocalActivityWithoutRetriesOpts(ctx)
 		err := temporalsdk_workflow.ExecuteLocalActivity(activityOpts, nha_activities.ParseNameLocalActivity, tinfo.Key).Get(activityOpts, &nameInfo)
 
-		// An error should only stop the workflow if hari/prod activities are enabled.
-		hariDisabled, _ := hooks.HookAttrBool(w.hooks.Hooks, "hari", "disabled")
-		prodDisabled, _ := hooks.HookAttrBool(w.hooks.Hooks, "prod", "disabled")
-		if err != nil && !hariDisabled && !prodDisabled {
+		// An error should only stop the workflow if hari/prod activities are
+		// enabled. Executions that did not record the NHA hooks version only
+		// stopped when both were.
+		failed := hari || prod
+		if version == temporalsdk_workflow.DefaultVersion {
+			failed = hari && prod
+		}
+		if err != nil && failed {
 			return fmt.Errorf("error parsing transfer name: %v", err)
 		}
 
@@ -329,16 +409,25 @@ func (w *ProcessingWorkflow) Execute(ctx temporalsdk_workflow.Context, req *coll
 	}
 
 	tinfo.PipelineName = req.PipelineName
+	tinfo.PipelineNames = req.PipelineNames
 
 	// Load pipeline configuration and hooks.
 	{
 		activityOpts := withLocalActivityWithoutRetriesOpts(ctx)
-		err := temporalsdk_workflow.ExecuteLocalActivity(activityOpts, loadConfigLocalActivity, w.hooks, w.pipelineRegistry, w.logger, tinfo.PipelineName, tinfo).Get(activityOpts, &tinfo)
+		route := req.Route && req.RetryMode != collection.RetryModeReconcileExistingAIP
+		err := temporalsdk_workflow.ExecuteLocalActivity(activityOpts, loadConfigLocalActivity, w.hooks, w.pipelineRegistry, w.wsvc, w.logger, tinfo.PipelineName, route, tinfo).Get(activityOpts, &tinfo)
 		if err != nil {
 			return fmt.Errorf("error loading configuration: %v", err)
 		}
 	}
 
+	// Record the decision of the scheduler in the collection.
+	version := temporalsdk_workflow.GetVersion(ctx, pipelineSelectionChangeID, temporalsdk_workflow.DefaultVersion, 1)
+	if version != temporalsdk_workflow.DefaultVersion && tinfo.PipelineSelection != nil {
+		activityOpts := withLocalActivityOpts(ctx)
+		_ = temporalsdk_workflow.ExecuteLocalActivity(activityOpts, setPipelineSelectionLocalActivity, w.colsvc, tinfo.CollectionID, tinfo.PipelineSelection.String()).Get(activityOpts, nil)
+	}
+
 	// Activities running within a session.
 	{
 		var sessErr error
@@ -379,6 +468,7 @@ func (w *ProcessingWorkflow) Execute(ctx temporalsdk_workflow.Context, req *coll
 
 				// We're done if the transfer deadline was exceeded.
 				if temporalsdk_temporal.IsCanceledError(sessErr) && timer.Exceeded() {
+					finalCause.Reason = collection.ReasonDeadlineExceeded
 					return fmt.Errorf("transfer deadline (%s) exceeded", tinfo.PipelineConfig.TransferDeadline)
 				}
 
@@ -397,8 +487,14 @@ func (w *ProcessingWorkflow) Execute(ctx temporalsdk_workflow.Context, req *coll
 		if sessErr != nil {
 			status = collection.StatusError
 
+			// Every attempt lost its worker.
+			if errors.Is(sessErr, temporalsdk_workflow.ErrSessionFailed) || temporalsdk_temporal.IsCanceledError(sessErr) {
+				finalCause.Reason = collection.ReasonWorkerLost
+			}
+
 			if errors.Is(sessErr, ErrOperatorDecisionAbandoned) {
 				status = collection.StatusAbandoned
+				finalCause.Actor = decisions.actor
 			}
 
 			return sessErr
@@ -429,17 +525,15 @@ func (w *ProcessingWorkflow) Execute(ctx temporalsdk_workflow.Context, req *coll
 	{
 		if status == collection.StatusDone {
 			if tinfo.RetentionPeriod != nil {
-				err := temporalsdk_workflow.NewTimer(ctx, *tinfo.RetentionPeriod).Get(ctx, nil)
-				if err != nil {
-					logger.Warn("Retention policy timer failed", "error", err)
-				} else {
-					activityOpts := withActivityOptsForRequest(ctx)
-					_ = temporalsdk_workflow.ExecuteActivity(activityOpts, activities.DeleteOriginalActivityName, tinfo.WatcherName, tinfo.BatchDir, tinfo.Key).Get(activityOpts, nil)
+				if err := w.scheduleRetention(ctx, tinfo); err != nil {
+					return err
 				}
 			} else if tinfo.CompletedDir != "" {
 				activityOpts := withActivityOptsForLocalAction(ctx)
-				err := temporalsdk_workflow.ExecuteActivity(activityOpts, activities.DisposeOriginalActivityName, tinfo.WatcherName, tinfo.CompletedDir, tinfo.BatchDir, tinfo.Key).Get(activityOpts, nil)
-				if err != nil {
+				err := temporalsdk_workflow.ExecuteActivity(activityOpts, activities.DisposeOriginalActivityName, tinfo.WatcherName, tinfo.CompletedDir, tinfo.BatchDir, tinfo.Key, tinfo.CollectionID).Get(activityOpts, nil)
+				if activities.IsLegalHoldError(err) {
+					logger.Info("Original not disposed, collection is under legal hold.", "collectionID", tinfo.CollectionID)
+				} else if err != nil {
 					return err
 				}
 			}
@@ -469,10 +563,51 @@ func finalCollectionStatus(status collection.Status, canceled bool) collection.S
 	return collection.StatusError
 }
 
+// acquireSelectedPipeline acquires the pipeline of the transfer. When the
+// pipeline was chosen by the scheduler and it is draining, the scheduler is
+// asked again for a different pipeline. The transfer waits for the pipeline
+// when it was requested explicitly or there are no alternatives.
+func (w *ProcessingWorkflow) acquireSelectedPipeline(ctx temporalsdk_workflow.Context, tinfo *TransferInfo, onLeaseLost func()) (bool, releaser, error) {
+	version := temporalsdk_workflow.GetVersion(ctx, pipelineSelectionChangeID, temporalsdk_workflow.DefaultVersion, 1)
+	reroute := version != temporalsdk_workflow.DefaultVersion && tinfo.PipelineSelection != nil
+	for {
+		acquired, release, err := acquirePipeline(ctx, w.colsvc, w.pipelineRegistry, tinfo.PipelineName, reroute, tinfo.CollectionID, w.config.ActivityHeartbeatTimeout, onLeaseLost)
+		if !reroute || !activities.IsPipelineDrainingError(err) {
+			return acquired, release, err
+		}
+
+		draining := tinfo.PipelineName
+		{
+			activityOpts := withLocalActivityWithoutRetriesOpts(ctx)
+			var updated *TransferInfo
+			err := temporalsdk_workflow.ExecuteLocalActivity(activityOpts, loadConfigLocalActivity, w.hooks, w.pipelineRegistry, w.wsvc, w.logger, "", false, tinfo).Get(activityOpts, &updated)
+			if err != nil {
+				return false, noopReleaser, fmt.Errorf("error loading configuration: %v", err)
+			}
+			*tinfo = *updated
+		}
+		{
+			activityOpts := withLocalActivityOpts(ctx)
+			_ = temporalsdk_workflow.ExecuteLocalActivity(activityOpts, setPipelineSelectionLocalActivity, w.colsvc, tinfo.CollectionID, tinfo.PipelineSelection.String()).Get(activityOpts, nil)
+		}
+
+		// Hold the transfer instead when the scheduler could only choose
+		// among pipelines that are not running.
+		chosen, ok := tinfo.PipelineSelection.Chosen()
+		reroute = ok && chosen.State == pipeline.StateRunning
+		temporalsdk_workflow.GetLogger(ctx).Info("Pipeline draining, transfer rerouted.", "from", draining, "to", tinfo.PipelineName)
+	}
+}
+
 // SessionHandler runs activities that belong to the same session.
 func (w *ProcessingWorkflow) SessionHandler(sessCtx temporalsdk_workflow.Context, attempt int, tinfo *TransferInfo, nameInfo nha.NameInfo, validationConfig validation.Config, timer *Timer, decisions *operatorDecisionHandler, req *collection.ProcessingWorkflowRequest) error {
 	defer temporalsdk_workflow.CompleteSession(sessCtx)
 
+	// The session is canceled when the lease of the pipeline is lost, so it is
+	// retried and the pipeline is acquired again.
+	sessCtx, cancelSession := temporalsdk_workflow.WithCancel(sessCtx)
+	defer cancelSession()
+
 	var release releaser
 
 	// Block until pipeline semaphore is acquired. The collection status is set
@@ -480,7 +615,7 @@ func (w *ProcessingWorkflow) SessionHandler(sessCtx temporalsdk_workflow.Context
 	{
 		var acquired bool
 		var err error
-		acquired, release, err = acquirePipeline(sessCtx, w.colsvc, w.pipelineRegistry, tinfo.PipelineName, tinfo.CollectionID, w.config.ActivityHeartbeatTimeout)
+		acquired, release, err = w.acquireSelectedPipeline(sessCtx, tinfo, cancelSession)
 		if acquired {
 			defer func() {
 				_ = release(sessCtx)
@@ -525,6 +660,8 @@ func (w *ProcessingWorkflow) SessionHandler(sessCtx temporalsdk_workflow.Context
 				PipelineName: tinfo.PipelineName,
 				NameInfo:     nameInfo,
 				CollectionID: tinfo.CollectionID,
+				Transfer:     tinfo.receiptInfo(),
+				Decision:     w.decisionConfig(tinfo),
 			})
 			if err != nil {
 				return fmt.Errorf("error delivering receipt(s): %w", err)
@@ -559,13 +696,33 @@ func (w *ProcessingWorkflow) SessionHandler(sessCtx temporalsdk_workflow.Context
 				ScheduleToStartTimeout: forever,
 				StartToCloseTimeout:    time.Minute * 5,
 			})
+			version := temporalsdk_workflow.GetVersion(sessCtx, validationResultsChangeID, temporalsdk_workflow.DefaultVersion, 1)
+			var report validation.Report
 			err := temporalsdk_workflow.ExecuteActivity(activityOpts, activities.ValidateTransferActivityName, &activities.ValidateTransferActivityParams{
 				Config: validationConfig,
 				Path:   tinfo.Bundle.FullPath,
-			}).Get(activityOpts, nil)
+			}).Get(activityOpts, &report)
 			if err != nil {
 				return err
 			}
+
+			// Executions started before the validation results were reported
+			// only fail when the activity does.
+			if version != temporalsdk_workflow.DefaultVersion {
+				activityOpts := withLocalActivityOpts(sessCtx)
+				err := temporalsdk_workflow.ExecuteLocalActivity(activityOpts, setValidationResultsLocalActivity, w.logger, w.colsvc, tinfo.CollectionID, report.Results).Get(activityOpts, nil)
+				if err != nil {
+					return err
+				}
+
+				for _, result := range report.Warnings() {
+					temporalsdk_workflow.GetLogger(sessCtx).Warn("Transfer validation warning", "validator", result.Validator, "message", result.Message)
+				}
+
+				if err := report.Err(); err != nil {
+					return err
+				}
+			}
 		}
 	}
 
@@ -610,6 +767,9 @@ func (w *ProcessingWorkflow) SessionHandler(sessCtx temporalsdk_workflow.Context
 			if err != nil {
 				return err
 			}
+			if manifest := tinfo.PublishedTransfer.Manifest; manifest != nil {
+				temporalsdk_workflow.GetLogger(sessCtx).Info("Published transfer verified", "path", tinfo.PublishedTransfer.RemotePath, "files", len(manifest.Files), "algorithm", manifest.Algorithm, "verification", manifest.Verification)
+			}
 		}
 		if tinfo.PublishedTransfer.RelPath != "" {
 			// From this point on, the workflow talks to Archivematica using the
@@ -628,7 +788,7 @@ func (w *ProcessingWorkflow) SessionHandler(sessCtx temporalsdk_workflow.Context
 			defer cancel()
 		}
 
-		err := w.transfer(sessCtx, tinfo, nameMetadata)
+		err := w.transfer(sessCtx, decisions, tinfo, nameMetadata)
 		if err != nil {
 			return err
 		}
@@ -647,6 +807,8 @@ func (w *ProcessingWorkflow) SessionHandler(sessCtx temporalsdk_workflow.Context
 			PipelineName: tinfo.PipelineName,
 			NameInfo:     nameInfo,
 			CollectionID: tinfo.CollectionID,
+			Transfer:     tinfo.receiptInfo(),
+			Decision:     w.decisionConfig(tinfo),
 		})
 		if err != nil {
 			return fmt.Errorf("error delivering receipt(s): %w", err)
@@ -815,7 +977,7 @@ func localTransferPathExistsLocalActivity(path string) (bool, error) {
 	return false, err
 }
 
-func (w *ProcessingWorkflow) transfer(sessCtx temporalsdk_workflow.Context, tinfo *TransferInfo, nameMetadata metadata.TransferName) error {
+func (w *ProcessingWorkflow) transfer(sessCtx temporalsdk_workflow.Context, decisions *operatorDecisionHandler, tinfo *TransferInfo, nameMetadata metadata.TransferName) error {
 	// Transfer.
 	{
 		if tinfo.TransferID == "" {
@@ -854,11 +1016,24 @@ func (w *ProcessingWorkflow) transfer(sessCtx temporalsdk_workflow.Context, tinf
 	// Poll transfer.
 	{
 		if tinfo.SIPID == "" {
-			activityOpts := withActivityOptsForHeartbeatedRequest(sessCtx, w.config.ActivityHeartbeatTimeout)
-			err := temporalsdk_workflow.ExecuteActivity(activityOpts, activities.PollTransferActivityName, &activities.PollTransferActivityParams{
+			params := &activities.PollTransferActivityParams{
 				PipelineName: tinfo.PipelineName,
 				TransferID:   tinfo.TransferID,
-			}).Get(activityOpts, &tinfo.SIPID)
+				CollectionID: tinfo.CollectionID,
+			}
+			activityOpts := withActivityOptsForHeartbeatedRequest(sessCtx, w.config.ActivityHeartbeatTimeout)
+			err := temporalsdk_workflow.ExecuteActivity(activityOpts, activities.PollTransferActivityName, params).Get(activityOpts, &tinfo.SIPID)
+			if activities.IsAwaitingDecisionError(err) {
+				waitParams := *params
+				waitParams.WaitForDecision = true
+				err = w.pollAwaitingDecision(sessCtx, decisions, tinfo, pollStep{
+					activity:   activities.PollTransferActivityName,
+					params:     params,
+					waitParams: &waitParams,
+					result:     &tinfo.SIPID,
+					completed:  func() bool { return tinfo.SIPID != "" },
+				}, err)
+			}
 			if err != nil {
 				return err
 			}
@@ -882,11 +1057,27 @@ func (w *ProcessingWorkflow) transfer(sessCtx temporalsdk_workflow.Context, tinf
 	{
 		var ingestErr error
 		if tinfo.StoredAt.IsZero() {
-			activityOpts := withActivityOptsForHeartbeatedRequest(sessCtx, w.config.ActivityHeartbeatTimeout)
-			ingestErr = temporalsdk_workflow.ExecuteActivity(activityOpts, activities.PollIngestActivityName, &activities.PollIngestActivityParams{
+			params := &activities.PollIngestActivityParams{
 				PipelineName: tinfo.PipelineName,
 				SIPID:        tinfo.SIPID,
-			}).Get(activityOpts, &tinfo.StoredAt)
+				CollectionID: tinfo.CollectionID,
+			}
+			activityOpts := withActivityOptsForHeartbeatedRequest(sessCtx, w.config.ActivityHeartbeatTimeout)
+			ingestErr = temporalsdk_workflow.ExecuteActivity(activityOpts, activities.PollIngestActivityName, params).Get(activityOpts, &tinfo.StoredAt)
+			if activities.IsAwaitingDecisionError(ingestErr) {
+				waitParams := *params
+				waitParams.WaitForDecision = true
+				ingestErr = w.pollAwaitingDecision(sessCtx, decisions, tinfo, pollStep{
+					activity:   activities.PollIngestActivityName,
+					params:     params,
+					waitParams: &waitParams,
+					result:     &tinfo.StoredAt,
+					completed:  func() bool { return !tinfo.StoredAt.IsZero() },
+				}, ingestErr)
+			}
+			if errors.Is(ingestErr, ErrOperatorDecisionAbandoned) {
+				return ingestErr
+			}
 		}
 
 		if tinfo.PipelineConfig != nil && tinfo.PipelineConfig.Recovery.ReconcileExistingAIP && tinfo.SIPID != "" {
@@ -901,6 +1092,82 @@ func (w *ProcessingWorkflow) transfer(sessCtx temporalsdk_workflow.Context, tinf
 	return nil
 }
 
+// pollStep is a polling activity that reports when Archivematica waits for a
+// user decision.
+type pollStep struct {
+	activity string
+
+	// params poll until processing completes, waitParams only while
+	// Archivematica waits for a decision.
+	params     any
+	waitParams any
+
+	// result receives the result of the activity, completed reports whether
+	// it marks the end of processing.
+	result    any
+	completed func() bool
+}
+
+// pollAwaitingDecision keeps polling Archivematica while it waits for a user
+// decision, e.g. during manual appraisal. The collection is pending with the
+// description of the decision until Archivematica resumes processing, then it
+// is in progress again and polling continues as usual. Operators can abandon
+// processing meanwhile. Executions started before the wait was introduced
+// fail with the original error.
+func (w *ProcessingWorkflow) pollAwaitingDecision(sessCtx temporalsdk_workflow.Context, decisions *operatorDecisionHandler, tinfo *TransferInfo, step pollStep, err error) error {
+	version := temporalsdk_workflow.GetVersion(sessCtx, awaitingDecisionChangeID, temporalsdk_workflow.DefaultVersion, 1)
+	if version == temporalsdk_workflow.DefaultVersion {
+		return err
+	}
+
+	for activities.IsAwaitingDecisionError(err) {
+		if err := w.awaitPipelineDecision(sessCtx, decisions, tinfo, step, activities.AwaitingDecision(err)); err != nil {
+			return err
+		}
+		if step.completed() {
+			return nil
+		}
+
+		activityOpts := withActivityOptsForHeartbeatedRequest(sessCtx, w.config.ActivityHeartbeatTimeout)
+		err = temporalsdk_workflow.ExecuteActivity(activityOpts, step.activity, step.params).Get(activityOpts, step.result)
+	}
+
+	return err
+}
+
+// awaitPipelineDecision polls Archivematica until it stops waiting for the
+// decision described and sets the collection in progress again.
+func (w *ProcessingWorkflow) awaitPipelineDecision(sessCtx temporalsdk_workflow.Context, decisions *operatorDecisionHandler, tinfo *TransferInfo, step pollStep, description string) error {
+	ctx, cancel := temporalsdk_workflow.WithCancel(sessCtx)
+	defer cancel()
+
+	activityOpts := withActivityOptsForHeartbeatedRequest(ctx, w.config.ActivityHeartbeatTimeout)
+	future := temporalsdk_workflow.ExecuteActivity(activityOpts, step.activity, step.waitParams)
+
+	decision, err := decisions.awaitPipeline(sessCtx, w.colsvc, tinfo.CollectionID, collection.PendingDecision{
+		Activity: step.activity,
+		Error:    description,
+		Options:  []collection.ProcessingWorkflowDecision{collection.ProcessingWorkflowDecisionAbandon},
+	}, future.IsReady)
+	if err != nil {
+		return err
+	}
+	if decision == collection.ProcessingWorkflowDecisionAbandon {
+		return ErrOperatorDecisionAbandoned
+	}
+	if err := future.Get(activityOpts, step.result); err != nil {
+		return err
+	}
+
+	statusOpts := withLocalActivityOpts(sessCtx)
+	cause := collection.StatusTransitionCause{Reason: collection.ReasonProcessingResumed}
+	if err := temporalsdk_workflow.ExecuteLocalActivity(statusOpts, setStatusInProgressLocalActivity, w.colsvc, tinfo.CollectionID, time.Time{}, cause).Get(statusOpts, nil); err != nil {
+		return fmt.Errorf("error setting collection status to in progress: %w", err)
+	}
+
+	return nil
+}
+
 func (w *ProcessingWorkflow) resetForFullReprocess(sessCtx temporalsdk_workflow.Context, tinfo *TransferInfo) error {
 	tinfo.TempFile = ""
 	tinfo.TransferID = ""
@@ -1086,7 +1353,7 @@ func (w *ProcessingWorkflow) receiptsEnabled() bool {
 	hariDisabled, _ := hooks.HookAttrBool(w.hooks.Hooks, "hari", "disabled")
 	prodDisabled, _ := hooks.HookAttrBool(w.hooks.Hooks, "prod", "disabled")
 
-	return !hariDisabled || !prodDisabled
+	return !hariDisabled || !prodDisabled || len(w.config.Receipts.Hook) > 0
 }
 
 func setStoredAtFromReconciliation(tinfo *TransferInfo, response *activities.ReconcileStorageActivityResponse) error {
@@ -1115,18 +1382,3 @@ func parseOptionalRFC3339Time(value *string) (*time.Time, error) {
 
 	return &parsed, nil
 }
-
-// RandomPipeline will randomly choose the pipeline from the list of names provided. If the
-// list is empty then choose one from the list of all configured pipelines.
-func RandomPipeline(pipelineNames []string, registry *pipeline.Registry) string {
-	names := pipelineNames
-	if len(names) < 1 {
-		names = registry.Names()
-		if len(names) < 1 {
-			return ""
-		}
-	}
-	src := rand.NewSource(time.Now().UnixNano())
-	rnd := rand.New(src) // #nosec G404 -- not security sensitive.
-	return names[rnd.Intn(len(names))]
-}
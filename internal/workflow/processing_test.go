@@ -26,6 +26,8 @@ import (
 	"github.com/artefactual-labs/enduro/internal/publisher"
 	"github.com/artefactual-labs/enduro/internal/reconciliation"
 	"github.com/artefactual-labs/enduro/internal/temporal"
+	"github.com/artefactual-labs/enduro/internal/watcher"
+	watcherfake "github.com/artefactual-labs/enduro/internal/watcher/fake"
 	"github.com/artefactual-labs/enduro/internal/workflow/activities"
 	"github.com/artefactual-labs/enduro/internal/workflow/hooks"
 )
@@ -48,26 +50,23 @@ func (s *ProcessingWorkflowTestSuite) SetupTest() {
 	s.env.SetWorkerOptions(temporalsdk_worker.Options{EnableSessionWorker: true})
 	s.hooks = buildHooks(s.T(), ctrl)
 	pipelineRegistry, _ := pipeline.NewPipelineRegistry(logr.Discard(), []pipeline.Config{}, nil, nil)
-	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
+	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), nil, watcherfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
 }
 
 func (s *ProcessingWorkflowTestSuite) AfterTest(suiteName, testName string) {
 	s.env.AssertExpectations(s.T())
 }
 
-// Workflow ignores an error in parseName when NHA hooks are disabled.
-func (s *ProcessingWorkflowTestSuite) TestParseErrorIsIgnored() {
+// Workflow does not parse the name when NHA hooks are disabled.
+func (s *ProcessingWorkflowTestSuite) TestParseNameIsSkipped() {
 	s.hooks.Hooks["hari"]["disabled"] = true
 	s.hooks.Hooks["prod"]["disabled"] = true
 
 	// Collection is persisted.
 	s.env.OnActivity(createPackageLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(uint(12345), nil).Once()
 
-	// parseName is executed with errors.
-	s.env.OnActivity(nha_activities.ParseNameLocalActivity, mock.Anything, "key").Return(nil, errors.New("parse error")).Once()
-
 	// loadConfig is executed (workflow continued), returning an error.
-	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("pipeline is unavailable")).Once()
+	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("pipeline is unavailable")).Once()
 
 	// Defer updates the package with the error status before returning.
 	s.env.OnActivity(updatePackageLocalActivity, mock.Anything, mock.Anything, mock.Anything, &updatePackageLocalActivityParams{
@@ -130,6 +129,64 @@ func (s *ProcessingWorkflowTestSuite) TestParseError() {
 	s.ErrorContains(s.env.GetWorkflowError(), "parse error")
 }
 
+// Workflow does not ignore an error in parseName when one of the NHA hooks is
+// enabled.
+func (s *ProcessingWorkflowTestSuite) TestParseErrorWithOneHookEnabled() {
+	s.hooks.Hooks["hari"]["disabled"] = true
+	s.hooks.Hooks["prod"]["disabled"] = false
+
+	// Collection is persisted.
+	s.env.OnActivity(createPackageLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(uint(12345), nil).Once()
+
+	// parseName is executed, inject error.
+	s.env.OnActivity(nha_activities.ParseNameLocalActivity, mock.Anything, "key").Return(nil, errors.New("parse error")).Once()
+
+	// Defer updates the package with the error status before returning.
+	s.env.OnActivity(updatePackageLocalActivity, mock.Anything, mock.Anything, mock.Anything, &updatePackageLocalActivityParams{
+		CollectionID: uint(12345),
+		Key:          "key",
+		Status:       collection.StatusError,
+	}).Return(nil).Once()
+
+	retentionPeriod := time.Second
+	s.env.ExecuteWorkflow(s.workflow.Execute, &collection.ProcessingWorkflowRequest{
+		WatcherName:      "watcher",
+		PipelineName:     "pipeline",
+		RetentionPeriod:  &retentionPeriod,
+		StripTopLevelDir: true,
+		Key:              "key",
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.ErrorContains(s.env.GetWorkflowError(), "parse error")
+}
+
+// Workflow records why a duplicate collection was rejected and who started it.
+func (s *ProcessingWorkflowTestSuite) TestDuplicateRejected() {
+	s.env.OnActivity(createPackageLocalActivity, mock.Anything, mock.Anything, mock.Anything, &createPackageLocalActivityParams{
+		Key:    "key",
+		Status: collection.StatusQueued,
+		Actor:  "api:alice",
+	}).Return(uint(12345), nil).Once()
+	s.env.OnActivity(checkDuplicatePackageLocalActivity, mock.Anything, mock.Anything, mock.Anything, uint(12345)).Return(true, nil).Once()
+	s.env.OnActivity(updatePackageLocalActivity, mock.Anything, mock.Anything, mock.Anything, &updatePackageLocalActivityParams{
+		CollectionID: uint(12345),
+		Key:          "key",
+		Status:       collection.StatusError,
+		Cause:        collection.StatusTransitionCause{Reason: collection.ReasonDuplicateRejected},
+	}).Return(nil).Once()
+
+	s.env.ExecuteWorkflow(s.workflow.Execute, &collection.ProcessingWorkflowRequest{
+		PipelineName:     "pipeline",
+		Key:              "key",
+		RejectDuplicates: true,
+		Actor:            "api:alice",
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.ErrorContains(s.env.GetWorkflowError(), "duplicate detected")
+}
+
 func TestFinalCollectionStatus(t *testing.T) {
 	t.Parallel()
 
@@ -184,7 +241,7 @@ func (s *ProcessingWorkflowTestSuite) TestReconciliationRetryPreservesExistingSt
 		},
 	}, nil, nil)
 	s.Require().NoError(err)
-	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
+	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), nil, watcherfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
 
 	s.env.OnActivity(updatePackageLocalActivity, mock.Anything, mock.Anything, mock.Anything, &updatePackageLocalActivityParams{
 		CollectionID: uint(12345),
@@ -197,7 +254,7 @@ func (s *ProcessingWorkflowTestSuite) TestReconciliationRetryPreservesExistingSt
 	}).Return(nil).Once()
 
 	s.env.OnActivity(nha_activities.ParseNameLocalActivity, mock.Anything, "key").Return(&nha.NameInfo{}, nil).Once()
-	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("pipeline is unavailable")).Once()
+	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("pipeline is unavailable")).Once()
 	s.env.OnActivity(updatePackageLocalActivity, mock.Anything, mock.Anything, mock.Anything, &updatePackageLocalActivityParams{
 		CollectionID: uint(12345),
 		Key:          "key",
@@ -228,7 +285,7 @@ func (s *ProcessingWorkflowTestSuite) TestFullReprocessRetryClearsExistingState(
 		{Name: "pipeline", ID: "pipeline-id"},
 	}, nil, nil)
 	s.Require().NoError(err)
-	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
+	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), nil, watcherfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
 
 	s.env.OnActivity(updatePackageLocalActivity, mock.Anything, mock.Anything, mock.Anything, &updatePackageLocalActivityParams{
 		CollectionID: uint(12345),
@@ -277,7 +334,7 @@ func (s *ProcessingWorkflowTestSuite) TestReconciliationRetryNotFoundFallsBackTo
 		{Name: "pipeline", ID: "pipeline-id"},
 	}, nil, nil)
 	s.Require().NoError(err)
-	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
+	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), nil, watcherfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
 	registerWorkflowActivityStubs(s.env)
 	s.env.RegisterActivityWithOptions(func(*activities.ReconcileStorageActivityParams) (*activities.ReconcileStorageActivityResponse, error) {
 		return nil, nil
@@ -309,9 +366,8 @@ func (s *ProcessingWorkflowTestSuite) TestReconciliationRetryNotFoundFallsBackTo
 		Status:       collection.StatusQueued,
 	}).Return(nil).Once()
 
-	s.env.OnActivity(nha_activities.ParseNameLocalActivity, mock.Anything, "key").Return(&nha.NameInfo{}, nil).Once()
-	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(func(_ context.Context, _ *hooks.Hooks, _ *pipeline.Registry, _ logr.Logger, _ string, tinfo *TransferInfo) (*TransferInfo, error) {
+	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, _ *hooks.Hooks, _ *pipeline.Registry, _ watcher.Service, _ logr.Logger, _ string, _ bool, tinfo *TransferInfo) (*TransferInfo, error) {
 			out := *tinfo
 			out.PipelineConfig = &pipeline.Config{
 				Name:               "pipeline",
@@ -322,8 +378,8 @@ func (s *ProcessingWorkflowTestSuite) TestReconciliationRetryNotFoundFallsBackTo
 			return &out, nil
 		}).Once()
 
-	s.env.OnActivity(activities.AcquirePipelineActivityName, "pipeline").Return(nil).Once()
-	s.env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(12345), mock.Anything).Return(nil).Once()
+	s.env.OnActivity(activities.AcquirePipelineActivityName, "pipeline", false).Return(nil).Once()
+	s.env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(12345), mock.Anything, collection.StatusTransitionCause{}).Return(nil).Once()
 	s.env.OnActivity(activities.ReconcileStorageActivityName, &activities.ReconcileStorageActivityParams{
 		PipelineName: "pipeline",
 		AIPID:        "aip-id",
@@ -387,6 +443,7 @@ func (s *ProcessingWorkflowTestSuite) TestReconciliationRetryNotFoundFallsBackTo
 	s.env.OnActivity(activities.PollTransferActivityName, &activities.PollTransferActivityParams{
 		PipelineName: "pipeline",
 		TransferID:   "new-transfer-id",
+		CollectionID: uint(12345),
 	}).Return("new-aip-id", nil).Once()
 	s.env.OnActivity(updatePackageLocalActivity, mock.Anything, mock.Anything, mock.Anything, &updatePackageLocalActivityParams{
 		CollectionID: uint(12345),
@@ -400,6 +457,7 @@ func (s *ProcessingWorkflowTestSuite) TestReconciliationRetryNotFoundFallsBackTo
 	s.env.OnActivity(activities.PollIngestActivityName, &activities.PollIngestActivityParams{
 		PipelineName: "pipeline",
 		SIPID:        "new-aip-id",
+		CollectionID: uint(12345),
 	}).Return(storedAt, nil).Once()
 	s.env.OnActivity(releasePipelineLocalActivity, mock.Anything, mock.Anything, "pipeline").Return(nil).Once()
 	s.env.OnActivity(activities.HidePackageActivityName, "new-transfer-id", "transfer", "pipeline", true).Return(nil).Once()
@@ -445,7 +503,7 @@ func (s *ProcessingWorkflowTestSuite) TestReconciliationRetryCompleteDeliversRec
 		},
 	}, nil, nil)
 	s.Require().NoError(err)
-	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
+	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), nil, watcherfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
 	registerWorkflowActivityStubs(s.env)
 
 	completedAt := "2026-03-17T08:00:00Z"
@@ -461,8 +519,8 @@ func (s *ProcessingWorkflowTestSuite) TestReconciliationRetryCompleteDeliversRec
 		Status:       collection.StatusQueued,
 	}).Return(nil).Once()
 	s.env.OnActivity(nha_activities.ParseNameLocalActivity, mock.Anything, "key").Return(&nha.NameInfo{}, nil).Once()
-	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(func(_ context.Context, _ *hooks.Hooks, _ *pipeline.Registry, _ logr.Logger, _ string, tinfo *TransferInfo) (*TransferInfo, error) {
+	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, _ *hooks.Hooks, _ *pipeline.Registry, _ watcher.Service, _ logr.Logger, _ string, _ bool, tinfo *TransferInfo) (*TransferInfo, error) {
 			out := *tinfo
 			out.PipelineConfig = &pipeline.Config{
 				Name:        "pipeline",
@@ -474,8 +532,8 @@ func (s *ProcessingWorkflowTestSuite) TestReconciliationRetryCompleteDeliversRec
 			}
 			return &out, nil
 		}).Once()
-	s.env.OnActivity(activities.AcquirePipelineActivityName, "pipeline").Return(nil).Once()
-	s.env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(12345), mock.Anything).Return(nil).Once()
+	s.env.OnActivity(activities.AcquirePipelineActivityName, "pipeline", false).Return(nil).Once()
+	s.env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(12345), mock.Anything, collection.StatusTransitionCause{}).Return(nil).Once()
 	s.env.OnActivity(activities.ReconcileStorageActivityName, &activities.ReconcileStorageActivityParams{
 		PipelineName: "pipeline",
 		AIPID:        "aip-id",
@@ -564,7 +622,7 @@ func (s *ProcessingWorkflowTestSuite) TestReconciliationRetryRequiresExistingAIP
 		},
 	}, nil, nil)
 	s.Require().NoError(err)
-	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
+	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), nil, watcherfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
 	registerWorkflowActivityStubs(s.env)
 
 	s.env.OnActivity(updatePackageLocalActivity, mock.Anything, mock.Anything, mock.Anything, &updatePackageLocalActivityParams{
@@ -576,9 +634,8 @@ func (s *ProcessingWorkflowTestSuite) TestReconciliationRetryRequiresExistingAIP
 		StoredAt:     time.Time{},
 		Status:       collection.StatusQueued,
 	}).Return(nil).Once()
-	s.env.OnActivity(nha_activities.ParseNameLocalActivity, mock.Anything, "key").Return(&nha.NameInfo{}, nil).Once()
-	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(func(_ context.Context, _ *hooks.Hooks, _ *pipeline.Registry, _ logr.Logger, _ string, tinfo *TransferInfo) (*TransferInfo, error) {
+	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, _ *hooks.Hooks, _ *pipeline.Registry, _ watcher.Service, _ logr.Logger, _ string, _ bool, tinfo *TransferInfo) (*TransferInfo, error) {
 			out := *tinfo
 			out.PipelineConfig = &pipeline.Config{
 				Name: "pipeline",
@@ -589,8 +646,8 @@ func (s *ProcessingWorkflowTestSuite) TestReconciliationRetryRequiresExistingAIP
 			}
 			return &out, nil
 		}).Once()
-	s.env.OnActivity(activities.AcquirePipelineActivityName, "pipeline").Return(nil).Once()
-	s.env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(12345), mock.Anything).Return(nil).Once()
+	s.env.OnActivity(activities.AcquirePipelineActivityName, "pipeline", false).Return(nil).Once()
+	s.env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(12345), mock.Anything, collection.StatusTransitionCause{}).Return(nil).Once()
 	s.env.OnActivity(releasePipelineLocalActivity, mock.Anything, mock.Anything, "pipeline").Return(nil).Once()
 	s.env.OnActivity(updatePackageLocalActivity, mock.Anything, mock.Anything, mock.Anything, &updatePackageLocalActivityParams{
 		CollectionID: uint(12345),
@@ -633,7 +690,7 @@ func (s *ProcessingWorkflowTestSuite) TestReconciliationRetryPartialStopsWithout
 		},
 	}, nil, nil)
 	s.Require().NoError(err)
-	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
+	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), nil, watcherfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
 	registerWorkflowActivityStubs(s.env)
 
 	s.env.OnActivity(updatePackageLocalActivity, mock.Anything, mock.Anything, mock.Anything, &updatePackageLocalActivityParams{
@@ -645,9 +702,8 @@ func (s *ProcessingWorkflowTestSuite) TestReconciliationRetryPartialStopsWithout
 		StoredAt:     time.Time{},
 		Status:       collection.StatusQueued,
 	}).Return(nil).Once()
-	s.env.OnActivity(nha_activities.ParseNameLocalActivity, mock.Anything, "key").Return(&nha.NameInfo{}, nil).Once()
-	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(func(_ context.Context, _ *hooks.Hooks, _ *pipeline.Registry, _ logr.Logger, _ string, tinfo *TransferInfo) (*TransferInfo, error) {
+	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, _ *hooks.Hooks, _ *pipeline.Registry, _ watcher.Service, _ logr.Logger, _ string, _ bool, tinfo *TransferInfo) (*TransferInfo, error) {
 			out := *tinfo
 			out.PipelineConfig = &pipeline.Config{
 				Name: "pipeline",
@@ -659,8 +715,8 @@ func (s *ProcessingWorkflowTestSuite) TestReconciliationRetryPartialStopsWithout
 			}
 			return &out, nil
 		}).Once()
-	s.env.OnActivity(activities.AcquirePipelineActivityName, "pipeline").Return(nil).Once()
-	s.env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(12345), mock.Anything).Return(nil).Once()
+	s.env.OnActivity(activities.AcquirePipelineActivityName, "pipeline", false).Return(nil).Once()
+	s.env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(12345), mock.Anything, collection.StatusTransitionCause{}).Return(nil).Once()
 	s.env.OnActivity(activities.ReconcileStorageActivityName, &activities.ReconcileStorageActivityParams{
 		PipelineName: "pipeline",
 		AIPID:        "aip-id",
@@ -718,7 +774,7 @@ func (s *ProcessingWorkflowTestSuite) TestReconciliationRetryStorageFailurePersi
 		},
 	}, nil, nil)
 	s.Require().NoError(err)
-	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
+	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), nil, watcherfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
 	registerWorkflowActivityStubs(s.env)
 	reconcileErr := temporal.NewNonRetryableError(errors.New("storage service unavailable"))
 
@@ -731,9 +787,8 @@ func (s *ProcessingWorkflowTestSuite) TestReconciliationRetryStorageFailurePersi
 		StoredAt:     time.Time{},
 		Status:       collection.StatusQueued,
 	}).Return(nil).Once()
-	s.env.OnActivity(nha_activities.ParseNameLocalActivity, mock.Anything, "key").Return(&nha.NameInfo{}, nil).Once()
-	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(func(_ context.Context, _ *hooks.Hooks, _ *pipeline.Registry, _ logr.Logger, _ string, tinfo *TransferInfo) (*TransferInfo, error) {
+	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, _ *hooks.Hooks, _ *pipeline.Registry, _ watcher.Service, _ logr.Logger, _ string, _ bool, tinfo *TransferInfo) (*TransferInfo, error) {
 			out := *tinfo
 			out.PipelineConfig = &pipeline.Config{
 				Name: "pipeline",
@@ -744,8 +799,8 @@ func (s *ProcessingWorkflowTestSuite) TestReconciliationRetryStorageFailurePersi
 			}
 			return &out, nil
 		}).Once()
-	s.env.OnActivity(activities.AcquirePipelineActivityName, "pipeline").Return(nil).Once()
-	s.env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(12345), mock.Anything).Return(nil).Once()
+	s.env.OnActivity(activities.AcquirePipelineActivityName, "pipeline", false).Return(nil).Once()
+	s.env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(12345), mock.Anything, collection.StatusTransitionCause{}).Return(nil).Once()
 	s.env.OnActivity(activities.ReconcileStorageActivityName, &activities.ReconcileStorageActivityParams{
 		PipelineName: "pipeline",
 		AIPID:        "aip-id",
@@ -799,7 +854,7 @@ func (s *ProcessingWorkflowTestSuite) TestReconciliationRetryIndeterminateStopsW
 		},
 	}, nil, nil)
 	s.Require().NoError(err)
-	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
+	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), nil, watcherfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
 	registerWorkflowActivityStubs(s.env)
 
 	s.env.OnActivity(updatePackageLocalActivity, mock.Anything, mock.Anything, mock.Anything, &updatePackageLocalActivityParams{
@@ -811,9 +866,8 @@ func (s *ProcessingWorkflowTestSuite) TestReconciliationRetryIndeterminateStopsW
 		StoredAt:     time.Time{},
 		Status:       collection.StatusQueued,
 	}).Return(nil).Once()
-	s.env.OnActivity(nha_activities.ParseNameLocalActivity, mock.Anything, "key").Return(&nha.NameInfo{}, nil).Once()
-	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(func(_ context.Context, _ *hooks.Hooks, _ *pipeline.Registry, _ logr.Logger, _ string, tinfo *TransferInfo) (*TransferInfo, error) {
+	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, _ *hooks.Hooks, _ *pipeline.Registry, _ watcher.Service, _ logr.Logger, _ string, _ bool, tinfo *TransferInfo) (*TransferInfo, error) {
 			out := *tinfo
 			out.PipelineConfig = &pipeline.Config{
 				Name: "pipeline",
@@ -824,8 +878,8 @@ func (s *ProcessingWorkflowTestSuite) TestReconciliationRetryIndeterminateStopsW
 			}
 			return &out, nil
 		}).Once()
-	s.env.OnActivity(activities.AcquirePipelineActivityName, "pipeline").Return(nil).Once()
-	s.env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(12345), mock.Anything).Return(nil).Once()
+	s.env.OnActivity(activities.AcquirePipelineActivityName, "pipeline", false).Return(nil).Once()
+	s.env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(12345), mock.Anything, collection.StatusTransitionCause{}).Return(nil).Once()
 	s.env.OnActivity(activities.ReconcileStorageActivityName, &activities.ReconcileStorageActivityParams{
 		PipelineName: "pipeline",
 		AIPID:        "aip-id",
@@ -876,7 +930,7 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledReconcilesAfterSuccessf
 		{Name: "pipeline", ID: "pipeline-id"},
 	}, nil, nil)
 	s.Require().NoError(err)
-	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
+	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), nil, watcherfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
 	registerWorkflowActivityStubs(s.env)
 
 	pollStoredAt := time.Date(2026, time.March, 17, 7, 0, 0, 0, time.UTC)
@@ -893,9 +947,8 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledReconcilesAfterSuccessf
 		StoredAt:     time.Time{},
 		Status:       collection.StatusQueued,
 	}).Return(nil).Once()
-	s.env.OnActivity(nha_activities.ParseNameLocalActivity, mock.Anything, "key").Return(&nha.NameInfo{}, nil).Once()
-	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(func(_ context.Context, _ *hooks.Hooks, _ *pipeline.Registry, _ logr.Logger, _ string, tinfo *TransferInfo) (*TransferInfo, error) {
+	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, _ *hooks.Hooks, _ *pipeline.Registry, _ watcher.Service, _ logr.Logger, _ string, _ bool, tinfo *TransferInfo) (*TransferInfo, error) {
 			out := *tinfo
 			out.PipelineConfig = &pipeline.Config{
 				Name:               "pipeline",
@@ -911,8 +964,8 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledReconcilesAfterSuccessf
 			}
 			return &out, nil
 		}).Once()
-	s.env.OnActivity(activities.AcquirePipelineActivityName, "pipeline").Return(nil).Once()
-	s.env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(12345), mock.Anything).Return(nil).Once()
+	s.env.OnActivity(activities.AcquirePipelineActivityName, "pipeline", false).Return(nil).Once()
+	s.env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(12345), mock.Anything, collection.StatusTransitionCause{}).Return(nil).Once()
 	s.env.OnActivity(activities.BundleActivityName, &activities.BundleActivityParams{
 		TransferDir:        "/transfer-dir",
 		Key:                "key",
@@ -959,6 +1012,7 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledReconcilesAfterSuccessf
 	s.env.OnActivity(activities.PollTransferActivityName, &activities.PollTransferActivityParams{
 		PipelineName: "pipeline",
 		TransferID:   "transfer-id",
+		CollectionID: uint(12345),
 	}).Return("aip-id", nil).Once()
 	s.env.OnActivity(updatePackageLocalActivity, mock.Anything, mock.Anything, mock.Anything, &updatePackageLocalActivityParams{
 		CollectionID: uint(12345),
@@ -972,6 +1026,7 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledReconcilesAfterSuccessf
 	s.env.OnActivity(activities.PollIngestActivityName, &activities.PollIngestActivityParams{
 		PipelineName: "pipeline",
 		SIPID:        "aip-id",
+		CollectionID: uint(12345),
 	}).Return(pollStoredAt, nil).Once()
 	s.env.OnActivity(activities.ReconcileStorageActivityName, &activities.ReconcileStorageActivityParams{
 		PipelineName: "pipeline",
@@ -1081,7 +1136,7 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledRetriesIndeterminateAft
 		{Name: "pipeline", ID: "pipeline-id"},
 	}, nil, nil)
 	s.Require().NoError(err)
-	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
+	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), nil, watcherfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
 	registerWorkflowActivityStubs(s.env)
 
 	pollStoredAt := time.Date(2026, time.March, 17, 7, 0, 0, 0, time.UTC)
@@ -1098,9 +1153,8 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledRetriesIndeterminateAft
 		StoredAt:     time.Time{},
 		Status:       collection.StatusQueued,
 	}).Return(nil).Once()
-	s.env.OnActivity(nha_activities.ParseNameLocalActivity, mock.Anything, "key").Return(&nha.NameInfo{}, nil).Once()
-	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(func(_ context.Context, _ *hooks.Hooks, _ *pipeline.Registry, _ logr.Logger, _ string, tinfo *TransferInfo) (*TransferInfo, error) {
+	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, _ *hooks.Hooks, _ *pipeline.Registry, _ watcher.Service, _ logr.Logger, _ string, _ bool, tinfo *TransferInfo) (*TransferInfo, error) {
 			out := *tinfo
 			out.PipelineConfig = &pipeline.Config{
 				Name:               "pipeline",
@@ -1113,8 +1167,8 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledRetriesIndeterminateAft
 			}
 			return &out, nil
 		}).Once()
-	s.env.OnActivity(activities.AcquirePipelineActivityName, "pipeline").Return(nil).Once()
-	s.env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(12345), mock.Anything).Return(nil).Once()
+	s.env.OnActivity(activities.AcquirePipelineActivityName, "pipeline", false).Return(nil).Once()
+	s.env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(12345), mock.Anything, collection.StatusTransitionCause{}).Return(nil).Once()
 	s.env.OnActivity(activities.BundleActivityName, &activities.BundleActivityParams{
 		TransferDir:        "/transfer-dir",
 		Key:                "key",
@@ -1152,6 +1206,7 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledRetriesIndeterminateAft
 	s.env.OnActivity(activities.PollTransferActivityName, &activities.PollTransferActivityParams{
 		PipelineName: "pipeline",
 		TransferID:   "transfer-id",
+		CollectionID: uint(12345),
 	}).Return("aip-id", nil).Once()
 	s.env.OnActivity(updatePackageLocalActivity, mock.Anything, mock.Anything, mock.Anything, &updatePackageLocalActivityParams{
 		CollectionID: uint(12345),
@@ -1165,6 +1220,7 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledRetriesIndeterminateAft
 	s.env.OnActivity(activities.PollIngestActivityName, &activities.PollIngestActivityParams{
 		PipelineName: "pipeline",
 		SIPID:        "aip-id",
+		CollectionID: uint(12345),
 	}).Return(pollStoredAt, nil).Once()
 
 	// First reconciliation returns indeterminate (SS hasn't finished storing yet).
@@ -1238,7 +1294,7 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledRetriesNotFoundAfterSuc
 		{Name: "pipeline", ID: "pipeline-id"},
 	}, nil, nil)
 	s.Require().NoError(err)
-	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
+	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), nil, watcherfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
 	registerWorkflowActivityStubs(s.env)
 
 	pollStoredAt := time.Date(2026, time.March, 17, 7, 0, 0, 0, time.UTC)
@@ -1252,9 +1308,8 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledRetriesNotFoundAfterSuc
 		StoredAt:     time.Time{},
 		Status:       collection.StatusQueued,
 	}).Return(nil).Once()
-	s.env.OnActivity(nha_activities.ParseNameLocalActivity, mock.Anything, "key").Return(&nha.NameInfo{}, nil).Once()
-	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(func(_ context.Context, _ *hooks.Hooks, _ *pipeline.Registry, _ logr.Logger, _ string, tinfo *TransferInfo) (*TransferInfo, error) {
+	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, _ *hooks.Hooks, _ *pipeline.Registry, _ watcher.Service, _ logr.Logger, _ string, _ bool, tinfo *TransferInfo) (*TransferInfo, error) {
 			out := *tinfo
 			out.PipelineConfig = &pipeline.Config{
 				Name:               "pipeline",
@@ -1267,8 +1322,8 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledRetriesNotFoundAfterSuc
 			}
 			return &out, nil
 		}).Once()
-	s.env.OnActivity(activities.AcquirePipelineActivityName, "pipeline").Return(nil).Once()
-	s.env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(12345), mock.Anything).Return(nil).Once()
+	s.env.OnActivity(activities.AcquirePipelineActivityName, "pipeline", false).Return(nil).Once()
+	s.env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(12345), mock.Anything, collection.StatusTransitionCause{}).Return(nil).Once()
 	s.env.OnActivity(activities.BundleActivityName, &activities.BundleActivityParams{
 		TransferDir:        "/transfer-dir",
 		Key:                "key",
@@ -1306,6 +1361,7 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledRetriesNotFoundAfterSuc
 	s.env.OnActivity(activities.PollTransferActivityName, &activities.PollTransferActivityParams{
 		PipelineName: "pipeline",
 		TransferID:   "transfer-id",
+		CollectionID: uint(12345),
 	}).Return("aip-id", nil).Once()
 	s.env.OnActivity(updatePackageLocalActivity, mock.Anything, mock.Anything, mock.Anything, &updatePackageLocalActivityParams{
 		CollectionID: uint(12345),
@@ -1319,6 +1375,7 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledRetriesNotFoundAfterSuc
 	s.env.OnActivity(activities.PollIngestActivityName, &activities.PollIngestActivityParams{
 		PipelineName: "pipeline",
 		SIPID:        "aip-id",
+		CollectionID: uint(12345),
 	}).Return(pollStoredAt, nil).Once()
 	s.env.OnActivity(activities.ReconcileStorageActivityName, &activities.ReconcileStorageActivityParams{
 		PipelineName: "pipeline",
@@ -1390,7 +1447,7 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledFailsWhenNotFoundPersis
 		{Name: "pipeline", ID: "pipeline-id"},
 	}, nil, nil)
 	s.Require().NoError(err)
-	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
+	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), nil, watcherfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
 	registerWorkflowActivityStubs(s.env)
 
 	pollStoredAt := time.Date(2026, time.March, 17, 7, 0, 0, 0, time.UTC)
@@ -1404,9 +1461,8 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledFailsWhenNotFoundPersis
 		StoredAt:     time.Time{},
 		Status:       collection.StatusQueued,
 	}).Return(nil).Once()
-	s.env.OnActivity(nha_activities.ParseNameLocalActivity, mock.Anything, "key").Return(&nha.NameInfo{}, nil).Once()
-	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(func(_ context.Context, _ *hooks.Hooks, _ *pipeline.Registry, _ logr.Logger, _ string, tinfo *TransferInfo) (*TransferInfo, error) {
+	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, _ *hooks.Hooks, _ *pipeline.Registry, _ watcher.Service, _ logr.Logger, _ string, _ bool, tinfo *TransferInfo) (*TransferInfo, error) {
 			out := *tinfo
 			out.PipelineConfig = &pipeline.Config{
 				Name:               "pipeline",
@@ -1419,8 +1475,8 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledFailsWhenNotFoundPersis
 			}
 			return &out, nil
 		}).Once()
-	s.env.OnActivity(activities.AcquirePipelineActivityName, "pipeline").Return(nil).Once()
-	s.env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(12345), mock.Anything).Return(nil).Once()
+	s.env.OnActivity(activities.AcquirePipelineActivityName, "pipeline", false).Return(nil).Once()
+	s.env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(12345), mock.Anything, collection.StatusTransitionCause{}).Return(nil).Once()
 	s.env.OnActivity(activities.BundleActivityName, &activities.BundleActivityParams{
 		TransferDir:        "/transfer-dir",
 		Key:                "key",
@@ -1458,6 +1514,7 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledFailsWhenNotFoundPersis
 	s.env.OnActivity(activities.PollTransferActivityName, &activities.PollTransferActivityParams{
 		PipelineName: "pipeline",
 		TransferID:   "transfer-id",
+		CollectionID: uint(12345),
 	}).Return("aip-id", nil).Once()
 	s.env.OnActivity(updatePackageLocalActivity, mock.Anything, mock.Anything, mock.Anything, &updatePackageLocalActivityParams{
 		CollectionID: uint(12345),
@@ -1471,6 +1528,7 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledFailsWhenNotFoundPersis
 	s.env.OnActivity(activities.PollIngestActivityName, &activities.PollIngestActivityParams{
 		PipelineName: "pipeline",
 		SIPID:        "aip-id",
+		CollectionID: uint(12345),
 	}).Return(pollStoredAt, nil).Once()
 
 	attempts := int(postIngestReconciliationRetryWindow/postIngestReconciliationRetryInterval) + 1
@@ -1525,7 +1583,7 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledPromotesIngestFailureWh
 		{Name: "pipeline", ID: "pipeline-id"},
 	}, nil, nil)
 	s.Require().NoError(err)
-	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
+	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), nil, watcherfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
 	registerWorkflowActivityStubs(s.env)
 
 	pollErr := errors.New("ingest poll failed")
@@ -1542,9 +1600,8 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledPromotesIngestFailureWh
 		StoredAt:     time.Time{},
 		Status:       collection.StatusQueued,
 	}).Return(nil).Once()
-	s.env.OnActivity(nha_activities.ParseNameLocalActivity, mock.Anything, "key").Return(&nha.NameInfo{}, nil).Once()
-	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(func(_ context.Context, _ *hooks.Hooks, _ *pipeline.Registry, _ logr.Logger, _ string, tinfo *TransferInfo) (*TransferInfo, error) {
+	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, _ *hooks.Hooks, _ *pipeline.Registry, _ watcher.Service, _ logr.Logger, _ string, _ bool, tinfo *TransferInfo) (*TransferInfo, error) {
 			out := *tinfo
 			out.PipelineConfig = &pipeline.Config{
 				Name:               "pipeline",
@@ -1557,8 +1614,8 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledPromotesIngestFailureWh
 			}
 			return &out, nil
 		}).Once()
-	s.env.OnActivity(activities.AcquirePipelineActivityName, "pipeline").Return(nil).Once()
-	s.env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(12345), mock.Anything).Return(nil).Once()
+	s.env.OnActivity(activities.AcquirePipelineActivityName, "pipeline", false).Return(nil).Once()
+	s.env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(12345), mock.Anything, collection.StatusTransitionCause{}).Return(nil).Once()
 	s.env.OnActivity(activities.BundleActivityName, &activities.BundleActivityParams{
 		TransferDir:        "/transfer-dir",
 		Key:                "key",
@@ -1596,6 +1653,7 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledPromotesIngestFailureWh
 	s.env.OnActivity(activities.PollTransferActivityName, &activities.PollTransferActivityParams{
 		PipelineName: "pipeline",
 		TransferID:   "transfer-id",
+		CollectionID: uint(12345),
 	}).Return("aip-id", nil).Once()
 	s.env.OnActivity(updatePackageLocalActivity, mock.Anything, mock.Anything, mock.Anything, &updatePackageLocalActivityParams{
 		CollectionID: uint(12345),
@@ -1609,6 +1667,7 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledPromotesIngestFailureWh
 	s.env.OnActivity(activities.PollIngestActivityName, &activities.PollIngestActivityParams{
 		PipelineName: "pipeline",
 		SIPID:        "aip-id",
+		CollectionID: uint(12345),
 	}).Return(time.Time{}, temporal.NewNonRetryableError(pollErr)).Once()
 	s.env.OnActivity(activities.ReconcileStorageActivityName, &activities.ReconcileStorageActivityParams{
 		PipelineName: "pipeline",
@@ -1661,7 +1720,7 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledFailsFastOnNotFoundAfte
 		{Name: "pipeline", ID: "pipeline-id"},
 	}, nil, nil)
 	s.Require().NoError(err)
-	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
+	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), nil, watcherfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
 	registerWorkflowActivityStubs(s.env)
 
 	pollErr := errors.New("ingest poll failed")
@@ -1674,9 +1733,8 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledFailsFastOnNotFoundAfte
 		StoredAt:     time.Time{},
 		Status:       collection.StatusQueued,
 	}).Return(nil).Once()
-	s.env.OnActivity(nha_activities.ParseNameLocalActivity, mock.Anything, "key").Return(&nha.NameInfo{}, nil).Once()
-	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(func(_ context.Context, _ *hooks.Hooks, _ *pipeline.Registry, _ logr.Logger, _ string, tinfo *TransferInfo) (*TransferInfo, error) {
+	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, _ *hooks.Hooks, _ *pipeline.Registry, _ watcher.Service, _ logr.Logger, _ string, _ bool, tinfo *TransferInfo) (*TransferInfo, error) {
 			out := *tinfo
 			out.PipelineConfig = &pipeline.Config{
 				Name:               "pipeline",
@@ -1689,8 +1747,8 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledFailsFastOnNotFoundAfte
 			}
 			return &out, nil
 		}).Once()
-	s.env.OnActivity(activities.AcquirePipelineActivityName, "pipeline").Return(nil).Once()
-	s.env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(12345), mock.Anything).Return(nil).Once()
+	s.env.OnActivity(activities.AcquirePipelineActivityName, "pipeline", false).Return(nil).Once()
+	s.env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(12345), mock.Anything, collection.StatusTransitionCause{}).Return(nil).Once()
 	s.env.OnActivity(activities.BundleActivityName, &activities.BundleActivityParams{
 		TransferDir:        "/transfer-dir",
 		Key:                "key",
@@ -1728,6 +1786,7 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledFailsFastOnNotFoundAfte
 	s.env.OnActivity(activities.PollTransferActivityName, &activities.PollTransferActivityParams{
 		PipelineName: "pipeline",
 		TransferID:   "transfer-id",
+		CollectionID: uint(12345),
 	}).Return("aip-id", nil).Once()
 	s.env.OnActivity(updatePackageLocalActivity, mock.Anything, mock.Anything, mock.Anything, &updatePackageLocalActivityParams{
 		CollectionID: uint(12345),
@@ -1741,6 +1800,7 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledFailsFastOnNotFoundAfte
 	s.env.OnActivity(activities.PollIngestActivityName, &activities.PollIngestActivityParams{
 		PipelineName: "pipeline",
 		SIPID:        "aip-id",
+		CollectionID: uint(12345),
 	}).Return(time.Time{}, temporal.NewNonRetryableError(pollErr)).Once()
 	s.env.OnActivity(activities.ReconcileStorageActivityName, &activities.ReconcileStorageActivityParams{
 		PipelineName: "pipeline",
@@ -1791,7 +1851,7 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledFailsFastOnReplicatedPa
 		{Name: "pipeline", ID: "pipeline-id"},
 	}, nil, nil)
 	s.Require().NoError(err)
-	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
+	s.workflow = NewProcessingWorkflow(s.hooks, collectionfake.NewMockService(ctrl), nil, watcherfake.NewMockService(ctrl), pipelineRegistry, logr.Discard(), Config{})
 	registerWorkflowActivityStubs(s.env)
 
 	pollErr := errors.New("ingest poll failed")
@@ -1805,9 +1865,8 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledFailsFastOnReplicatedPa
 		StoredAt:     time.Time{},
 		Status:       collection.StatusQueued,
 	}).Return(nil).Once()
-	s.env.OnActivity(nha_activities.ParseNameLocalActivity, mock.Anything, "key").Return(&nha.NameInfo{}, nil).Once()
-	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(func(_ context.Context, _ *hooks.Hooks, _ *pipeline.Registry, _ logr.Logger, _ string, tinfo *TransferInfo) (*TransferInfo, error) {
+	s.env.OnActivity(loadConfigLocalActivity, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, _ *hooks.Hooks, _ *pipeline.Registry, _ watcher.Service, _ logr.Logger, _ string, _ bool, tinfo *TransferInfo) (*TransferInfo, error) {
 			out := *tinfo
 			out.PipelineConfig = &pipeline.Config{
 				Name:               "pipeline",
@@ -1820,8 +1879,8 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledFailsFastOnReplicatedPa
 			}
 			return &out, nil
 		}).Once()
-	s.env.OnActivity(activities.AcquirePipelineActivityName, "pipeline").Return(nil).Once()
-	s.env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(12345), mock.Anything).Return(nil).Once()
+	s.env.OnActivity(activities.AcquirePipelineActivityName, "pipeline", false).Return(nil).Once()
+	s.env.OnActivity(setStatusInProgressLocalActivity, mock.Anything, mock.Anything, uint(12345), mock.Anything, collection.StatusTransitionCause{}).Return(nil).Once()
 	s.env.OnActivity(activities.BundleActivityName, &activities.BundleActivityParams{
 		TransferDir:        "/transfer-dir",
 		Key:                "key",
@@ -1859,6 +1918,7 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledFailsFastOnReplicatedPa
 	s.env.OnActivity(activities.PollTransferActivityName, &activities.PollTransferActivityParams{
 		PipelineName: "pipeline",
 		TransferID:   "transfer-id",
+		CollectionID: uint(12345),
 	}).Return("aip-id", nil).Once()
 	s.env.OnActivity(updatePackageLocalActivity, mock.Anything, mock.Anything, mock.Anything, &updatePackageLocalActivityParams{
 		CollectionID: uint(12345),
@@ -1872,6 +1932,7 @@ func (s *ProcessingWorkflowTestSuite) TestRecoveryEnabledFailsFastOnReplicatedPa
 	s.env.OnActivity(activities.PollIngestActivityName, &activities.PollIngestActivityParams{
 		PipelineName: "pipeline",
 		SIPID:        "aip-id",
+		CollectionID: uint(12345),
 	}).Return(time.Time{}, pollErr).Once()
 	s.env.OnActivity(activities.ReconcileStorageActivityName, &activities.ReconcileStorageActivityParams{
 		PipelineName: "pipeline",
@@ -1925,7 +1986,7 @@ func buildHooks(t *testing.T, ctrl *gomock.Controller) *hooks.Hooks {
 }
 
 func registerWorkflowActivityStubs(env *temporalsdk_testsuite.TestWorkflowEnvironment) {
-	env.RegisterActivityWithOptions(func(string) error { return nil }, temporalsdk_activity.RegisterOptions{Name: activities.AcquirePipelineActivityName})
+	env.RegisterActivityWithOptions(func(string, bool) error { return nil }, temporalsdk_activity.RegisterOptions{Name: activities.AcquirePipelineActivityName})
 	env.RegisterActivityWithOptions(func(*activities.ReconcileStorageActivityParams) (*activities.ReconcileStorageActivityResponse, error) {
 		return nil, nil
 	}, temporalsdk_activity.RegisterOptions{Name: activities.ReconcileStorageActivityName})
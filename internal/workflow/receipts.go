@@ -7,11 +7,23 @@ import (
 	temporalsdk_temporal "go.temporal.io/sdk/temporal"
 	temporalsdk_workflow "go.temporal.io/sdk/workflow"
 
+	"github.com/artefactual-labs/enduro/internal/decision"
 	"github.com/artefactual-labs/enduro/internal/nha"
 	nha_activities "github.com/artefactual-labs/enduro/internal/nha/activities"
+	"github.com/artefactual-labs/enduro/internal/receipt"
 	"github.com/artefactual-labs/enduro/internal/workflow/hooks"
 )
 
+// nhaHooksChangeID versions the defaults of the NHA receipts so executions
+// started before can be replayed: the hari and prod hooks are only enabled when
+// they are configured, and the transfer name is only parsed when one of them
+// is enabled.
+const nhaHooksChangeID = "nha-hooks"
+
+// receiptHooksChangeID versions the delivery of the receipt hooks listed in
+// the workflow configuration so executions started before can be replayed.
+const receiptHooksChangeID = "receipt-hooks"
+
 type sendReceiptsParams struct {
 	SIPID        string
 	StoredAt     time.Time
@@ -19,45 +31,138 @@ type sendReceiptsParams struct {
 	PipelineName string
 	NameInfo     nha.NameInfo
 	CollectionID uint
+	Transfer     receipt.TransferInfo
+
+	// Decision configures the wait for operator decisions when a delivery
+	// fails, receipt hooks can override it.
+	Decision decision.Config
 }
 
+// decisionConfig returns the operator decision configuration of the pipeline
+// merged with the one of the workflow.
+func (w *ProcessingWorkflow) decisionConfig(tinfo *TransferInfo) decision.Config {
+	if tinfo.PipelineConfig == nil {
+		return w.config.Decision
+	}
+	return tinfo.PipelineConfig.Decision.Merge(w.config.Decision)
+}
+
+// nhaHooks reports whether the legacy NHA receipts (hari and prod hooks) are
+// enabled, along with the version of their defaults recorded by the execution.
+// Hooks are enabled when configured and not disabled, executions that did not
+// record the version enabled them unless disabled.
+func (w *ProcessingWorkflow) nhaHooks(ctx temporalsdk_workflow.Context) (hari, prod bool, version temporalsdk_workflow.Version) {
+	version = temporalsdk_workflow.GetVersion(ctx, nhaHooksChangeID, temporalsdk_workflow.DefaultVersion, 1)
+	enabled := func(name string) bool {
+		disabled, _ := hooks.HookAttrBool(w.hooks.Hooks, name, "disabled")
+		if version == temporalsdk_workflow.DefaultVersion {
+			return !disabled
+		}
+		_, configured := w.hooks.Hooks[name]
+		return configured && !disabled
+	}
+
+	return enabled("hari"), enabled("prod"), version
+}
+
+// sendReceipts delivers the legacy NHA receipts (hari and prod hooks) followed
+// by the receipt hooks listed in the workflow configuration.
 func (w *ProcessingWorkflow) sendReceipts(ctx temporalsdk_workflow.Context, decisions *operatorDecisionHandler, params *sendReceiptsParams) error {
-	if disabled, _ := hooks.HookAttrBool(w.hooks.Hooks, "hari", "disabled"); !disabled {
+	hari, prod, _ := w.nhaHooks(ctx)
+
+	if hari {
 		opts := temporalsdk_workflow.ActivityOptions{
 			StartToCloseTimeout: time.Minute * 20,
 			RetryPolicy: &temporalsdk_temporal.RetryPolicy{
 				MaximumAttempts: 1,
 			},
 		}
-		err := executeActivityWithOperatorDecision(ctx, decisions, w.colsvc, params.CollectionID, opts, nha_activities.UpdateHARIActivityName, &nha_activities.UpdateHARIActivityParams{
-			SIPID:        params.SIPID,
-			StoredAt:     params.StoredAt,
-			FullPath:     params.FullPath,
-			PipelineName: params.PipelineName,
-			NameInfo:     params.NameInfo,
+		err := executeActivityWithOperatorDecision(ctx, decisions, w.colsvc, params.CollectionID, operatorDecisionStep{
+			opts:     opts,
+			activity: nha_activities.UpdateHARIActivityName,
+			args: []any{&nha_activities.UpdateHARIActivityParams{
+				SIPID:        params.SIPID,
+				StoredAt:     params.StoredAt,
+				FullPath:     params.FullPath,
+				PipelineName: params.PipelineName,
+				NameInfo:     params.NameInfo,
+			}},
+			skippable: true,
+			decision:  params.Decision,
 		})
 		if err != nil {
 			return fmt.Errorf("error sending hari receipt: %w", err)
 		}
 	}
 
-	if disabled, _ := hooks.HookAttrBool(w.hooks.Hooks, "prod", "disabled"); !disabled {
+	if prod {
 		opts := temporalsdk_workflow.ActivityOptions{
 			StartToCloseTimeout: time.Second * 10,
 			RetryPolicy: &temporalsdk_temporal.RetryPolicy{
 				MaximumAttempts: 1,
 			},
 		}
-		err := executeActivityWithOperatorDecision(ctx, decisions, w.colsvc, params.CollectionID, opts, nha_activities.UpdateProductionSystemActivityName, &nha_activities.UpdateProductionSystemActivityParams{
-			StoredAt:     params.StoredAt,
-			PipelineName: params.PipelineName,
-			NameInfo:     params.NameInfo,
-			FullPath:     params.FullPath,
+		err := executeActivityWithOperatorDecision(ctx, decisions, w.colsvc, params.CollectionID, operatorDecisionStep{
+			opts:     opts,
+			activity: nha_activities.UpdateProductionSystemActivityName,
+			args: []any{&nha_activities.UpdateProductionSystemActivityParams{
+				StoredAt:     params.StoredAt,
+				PipelineName: params.PipelineName,
+				NameInfo:     params.NameInfo,
+				FullPath:     params.FullPath,
+			}},
+			skippable: true,
+			decision:  params.Decision,
 		})
 		if err != nil {
 			return fmt.Errorf("error sending prod receipt: %w", err)
 		}
 	}
 
+	version := temporalsdk_workflow.GetVersion(ctx, receiptHooksChangeID, temporalsdk_workflow.DefaultVersion, 1)
+	if version == temporalsdk_workflow.DefaultVersion {
+		return nil
+	}
+
+	for _, hook := range w.config.Receipts.Hook {
+		if err := w.sendReceipt(ctx, decisions, params, hook); err != nil {
+			return fmt.Errorf("error sending %s receipt: %w", hook.Name, err)
+		}
+	}
+
 	return nil
 }
+
+func (w *ProcessingWorkflow) sendReceipt(ctx temporalsdk_workflow.Context, decisions *operatorDecisionHandler, params *sendReceiptsParams, hook receipt.HookConfig) error {
+	opts := temporalsdk_workflow.ActivityOptions{
+		StartToCloseTimeout: hook.DeliveryTimeout() + time.Minute,
+		RetryPolicy: &temporalsdk_temporal.RetryPolicy{
+			MaximumAttempts: 1,
+		},
+	}
+	actParams := &receipt.SendActivityParams{
+		Hook:     hook.Name,
+		Transfer: params.Transfer,
+	}
+
+	switch hook.FailureAction() {
+	case receipt.OnFailureFail:
+		activityCtx := temporalsdk_workflow.WithActivityOptions(ctx, opts)
+		return temporalsdk_workflow.ExecuteActivity(activityCtx, receipt.SendActivityName, actParams).Get(activityCtx, nil)
+	case receipt.OnFailureIgnore:
+		activityCtx := temporalsdk_workflow.WithActivityOptions(ctx, opts)
+		err := temporalsdk_workflow.ExecuteActivity(activityCtx, receipt.SendActivityName, actParams).Get(activityCtx, nil)
+		if err != nil {
+			temporalsdk_workflow.GetLogger(ctx).Warn("Receipt delivery failed, continuing.", "hook", hook.Name, "err", err.Error())
+		}
+		return nil
+	default:
+		return executeActivityWithOperatorDecision(ctx, decisions, w.colsvc, params.CollectionID, operatorDecisionStep{
+			opts:      opts,
+			activity:  receipt.SendActivityName,
+			args:      []any{actParams},
+			skippable: true,
+			decision:  hook.Decision.Merge(params.Decision),
+		})
+	}
+}
@@ -1,7 +1,10 @@
 package workflow
 
 import (
+	"context"
 	"errors"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -19,6 +22,7 @@ import (
 	"github.com/artefactual-labs/enduro/internal/nha"
 	nha_activities "github.com/artefactual-labs/enduro/internal/nha/activities"
 	"github.com/artefactual-labs/enduro/internal/pipeline"
+	"github.com/artefactual-labs/enduro/internal/receipt"
 )
 
 func TestSendReceiptsStopsAfterAbandonDecision(t *testing.T) {
@@ -30,11 +34,20 @@ func TestSendReceiptsStopsAfterAbandonDecision(t *testing.T) {
 		hariParams(params),
 	).Return(errors.New("failed")).Once()
 	env.OnActivity(
-		setStatusLocalActivity,
+		setPendingDecisionLocalActivity,
 		mock.Anything,
 		mock.Anything,
 		params.CollectionID,
-		collection.StatusPending,
+		mock.MatchedBy(func(decision collection.PendingDecision) bool {
+			return decision.Activity == nha_activities.UpdateHARIActivityName &&
+				strings.Contains(decision.Error, "failed") &&
+				slices.Equal(decision.Options, []collection.ProcessingWorkflowDecision{
+					collection.ProcessingWorkflowDecisionRetry,
+					collection.ProcessingWorkflowDecisionRetryOnce,
+					collection.ProcessingWorkflowDecisionAbandon,
+					collection.ProcessingWorkflowDecisionSkip,
+				})
+		}),
 	).Return(nil).Once()
 
 	env.RegisterDelayedCallback(func() {
@@ -63,6 +76,7 @@ func TestSendReceiptsRetriesAfterDecision(t *testing.T) {
 		mock.Anything,
 		params.CollectionID,
 		time.Time{},
+		collection.StatusTransitionCause{},
 	).Return(nil).Once()
 	env.OnActivity(
 		nha_activities.UpdateHARIActivityName,
@@ -75,11 +89,11 @@ func TestSendReceiptsRetriesAfterDecision(t *testing.T) {
 		hariParams(params),
 	).Return(nil).Once()
 	env.OnActivity(
-		setStatusLocalActivity,
+		setPendingDecisionLocalActivity,
 		mock.Anything,
 		mock.Anything,
 		params.CollectionID,
-		collection.StatusPending,
+		mock.Anything,
 	).Return(nil).Once()
 
 	env.RegisterDelayedCallback(func() {
@@ -117,11 +131,11 @@ func TestSendReceiptsRetriesOnlyFailedProductionReceipt(t *testing.T) {
 		prodParams(params),
 	).Return(nil).Once()
 	env.OnActivity(
-		setStatusLocalActivity,
+		setPendingDecisionLocalActivity,
 		mock.Anything,
 		mock.Anything,
 		params.CollectionID,
-		collection.StatusPending,
+		mock.Anything,
 	).Return(nil).Once()
 	env.OnActivity(
 		setStatusInProgressLocalActivity,
@@ -129,6 +143,7 @@ func TestSendReceiptsRetriesOnlyFailedProductionReceipt(t *testing.T) {
 		mock.Anything,
 		params.CollectionID,
 		time.Time{},
+		collection.StatusTransitionCause{},
 	).Return(nil).Once()
 
 	env.RegisterDelayedCallback(func() {
@@ -147,6 +162,52 @@ func TestSendReceiptsRetriesOnlyFailedProductionReceipt(t *testing.T) {
 	env.AssertExpectations(t)
 }
 
+func TestSendReceiptsSkipsFailedReceipt(t *testing.T) {
+	env, w, params := newSendReceiptsTest(t)
+
+	env.OnActivity(
+		nha_activities.UpdateHARIActivityName,
+		mock.Anything,
+		hariParams(params),
+	).Return(errors.New("failed")).Once()
+	env.OnActivity(
+		nha_activities.UpdateProductionSystemActivityName,
+		mock.Anything,
+		prodParams(params),
+	).Return(nil).Once()
+	env.OnActivity(
+		setPendingDecisionLocalActivity,
+		mock.Anything,
+		mock.Anything,
+		params.CollectionID,
+		mock.Anything,
+	).Return(nil).Once()
+	env.OnActivity(
+		setStatusInProgressLocalActivity,
+		mock.Anything,
+		mock.Anything,
+		params.CollectionID,
+		time.Time{},
+		collection.StatusTransitionCause{},
+	).Return(nil).Once()
+
+	env.RegisterDelayedCallback(func() {
+		env.UpdateWorkflowNoRejection(
+			collection.ProcessingWorkflowDecisionUpdateName,
+			"skip-decision",
+			t,
+			collection.ProcessingWorkflowDecisionSkip,
+			"",
+		)
+	}, time.Second)
+
+	executeSendReceiptsWorkflow(env, w, params)
+
+	assert.Equal(t, env.IsWorkflowCompleted(), true)
+	assert.NilError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
+
 func TestSendReceiptsAbandonsAfterDecisionTimeout(t *testing.T) {
 	env, w, params := newSendReceiptsTest(t)
 	w.hooks.Hooks["prod"]["disabled"] = true
@@ -158,11 +219,11 @@ func TestSendReceiptsAbandonsAfterDecisionTimeout(t *testing.T) {
 		hariParams(params),
 	).Return(errors.New("failed")).Once()
 	env.OnActivity(
-		setStatusLocalActivity,
+		setPendingDecisionLocalActivity,
 		mock.Anything,
 		mock.Anything,
 		params.CollectionID,
-		collection.StatusPending,
+		mock.Anything,
 	).Return(nil).Once()
 
 	executeSendReceiptsWorkflow(env, w, params)
@@ -173,6 +234,104 @@ func TestSendReceiptsAbandonsAfterDecisionTimeout(t *testing.T) {
 	env.AssertExpectations(t)
 }
 
+func TestSendReceiptsConfiguredHooks(t *testing.T) {
+	env, w, params := newSendReceiptsTest(t)
+	w.hooks.Hooks["hari"]["disabled"] = true
+	w.hooks.Hooks["prod"]["disabled"] = true
+	w.config.Receipts.Hook = []receipt.HookConfig{
+		{Name: "optional", Type: receipt.TypeCommand, OnFailure: receipt.OnFailureIgnore},
+		{Name: "catalog", Type: receipt.TypeHTTPJSON},
+	}
+
+	env.OnActivity(
+		receipt.SendActivityName,
+		mock.Anything,
+		&receipt.SendActivityParams{Hook: "optional", Transfer: params.Transfer},
+	).Return(errors.New("failed")).Once()
+	env.OnActivity(
+		receipt.SendActivityName,
+		mock.Anything,
+		&receipt.SendActivityParams{Hook: "catalog", Transfer: params.Transfer},
+	).Return(errors.New("failed")).Once()
+	env.OnActivity(
+		receipt.SendActivityName,
+		mock.Anything,
+		&receipt.SendActivityParams{Hook: "catalog", Transfer: params.Transfer},
+	).Return(nil).Once()
+	env.OnActivity(
+		setPendingDecisionLocalActivity,
+		mock.Anything,
+		mock.Anything,
+		params.CollectionID,
+		mock.Anything,
+	).Return(nil).Once()
+	env.OnActivity(
+		setStatusInProgressLocalActivity,
+		mock.Anything,
+		mock.Anything,
+		params.CollectionID,
+		time.Time{},
+		collection.StatusTransitionCause{},
+	).Return(nil).Once()
+
+	env.RegisterDelayedCallback(func() {
+		env.UpdateWorkflowNoRejection(
+			collection.ProcessingWorkflowDecisionUpdateName,
+			"retry-catalog-decision",
+			t,
+			collection.ProcessingWorkflowDecisionRetryOnce,
+		)
+	}, time.Second)
+
+	executeSendReceiptsWorkflow(env, w, params)
+
+	assert.Equal(t, env.IsWorkflowCompleted(), true)
+	assert.NilError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
+
+func TestSendReceiptsSkipsUnconfiguredNHAHooks(t *testing.T) {
+	env, w, params := newSendReceiptsTest(t)
+	delete(w.hooks.Hooks, "hari")
+	delete(w.hooks.Hooks, "prod")
+	w.config.Receipts.Hook = []receipt.HookConfig{
+		{Name: "catalog", Type: receipt.TypeHTTPJSON},
+	}
+
+	env.OnActivity(
+		receipt.SendActivityName,
+		mock.Anything,
+		&receipt.SendActivityParams{Hook: "catalog", Transfer: params.Transfer},
+	).Return(nil).Once()
+
+	executeSendReceiptsWorkflow(env, w, params)
+
+	assert.Equal(t, env.IsWorkflowCompleted(), true)
+	assert.NilError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
+
+func TestSendReceiptsConfiguredHookFailsWorkflow(t *testing.T) {
+	env, w, params := newSendReceiptsTest(t)
+	w.hooks.Hooks["hari"]["disabled"] = true
+	w.hooks.Hooks["prod"]["disabled"] = true
+	w.config.Receipts.Hook = []receipt.HookConfig{
+		{Name: "catalog", Type: receipt.TypeHTTPJSON, OnFailure: receipt.OnFailureFail},
+	}
+
+	env.OnActivity(
+		receipt.SendActivityName,
+		mock.Anything,
+		&receipt.SendActivityParams{Hook: "catalog", Transfer: params.Transfer},
+	).Return(errors.New("failed")).Once()
+
+	executeSendReceiptsWorkflow(env, w, params)
+
+	assert.Equal(t, env.IsWorkflowCompleted(), true)
+	assert.ErrorContains(t, env.GetWorkflowError(), "error sending catalog receipt")
+	env.AssertExpectations(t)
+}
+
 func TestOperatorDecisionValidation(t *testing.T) {
 	t.Run("Rejects decision when workflow is not awaiting one", func(t *testing.T) {
 		env := new(temporalsdk_testsuite.WorkflowTestSuite).NewTestWorkflowEnvironment()
@@ -211,11 +370,11 @@ func TestOperatorDecisionValidation(t *testing.T) {
 			hariParams(params),
 		).Return(errors.New("failed")).Once()
 		env.OnActivity(
-			setStatusLocalActivity,
+			setPendingDecisionLocalActivity,
 			mock.Anything,
 			mock.Anything,
 			params.CollectionID,
-			collection.StatusPending,
+			mock.Anything,
 		).Return(nil).Once()
 
 		env.RegisterDelayedCallback(func() {
@@ -292,6 +451,11 @@ func newSendReceiptsTest(t *testing.T) (*temporalsdk_testsuite.TestWorkflowEnvir
 		temporalsdk_activity.RegisterOptions{Name: nha_activities.UpdateProductionSystemActivityName},
 	)
 
+	env.RegisterActivityWithOptions(
+		func(context.Context, *receipt.SendActivityParams) error { return nil },
+		temporalsdk_activity.RegisterOptions{Name: receipt.SendActivityName},
+	)
+
 	params := &sendReceiptsParams{
 		SIPID:        "91e3ed2f-b798-4f4e-9133-74193f0d6a4f",
 		StoredAt:     time.Now().UTC(),
@@ -299,9 +463,13 @@ func newSendReceiptsTest(t *testing.T) (*temporalsdk_testsuite.TestWorkflowEnvir
 		PipelineName: "pipeline",
 		NameInfo:     nha.NameInfo{},
 		CollectionID: uint(12345),
+		Transfer: receipt.TransferInfo{
+			CollectionID: uint(12345),
+			SIPID:        "91e3ed2f-b798-4f4e-9133-74193f0d6a4f",
+		},
 	}
 
-	return env, NewProcessingWorkflow(h, colsvc, pipelineRegistry, logr.Discard(), Config{}), params
+	return env, NewProcessingWorkflow(h, colsvc, nil, nil, pipelineRegistry, logr.Discard(), Config{}), params
 }
 
 func executeSendReceiptsWorkflow(
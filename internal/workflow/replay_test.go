@@ -0,0 +1,42 @@
+package workflow
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	temporalsdk_worker "go.temporal.io/sdk/worker"
+	temporalsdk_workflow "go.temporal.io/sdk/workflow"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/enduro/internal/collection"
+	"github.com/artefactual-labs/enduro/internal/pipeline"
+	"github.com/artefactual-labs/enduro/internal/receipt"
+	"github.com/artefactual-labs/enduro/internal/workflow/hooks"
+)
+
+// TestProcessingWorkflowReplay replays the history of an execution started
+// before the workflow changes were versioned. The transfer is validated, the
+// NHA receipts are disabled and the execution is handed over to the retention
+// scheduler while it waits on the retention timer. The receipt hook of the
+// worker configuration must not be delivered to it.
+func TestProcessingWorkflowReplay(t *testing.T) {
+	t.Parallel()
+
+	h := hooks.NewHooks(map[string]map[string]any{
+		"hari": {"disabled": true},
+		"prod": {"disabled": true},
+	})
+	registry, err := pipeline.NewPipelineRegistry(logr.Discard(), []pipeline.Config{}, nil, nil)
+	assert.NilError(t, err)
+	w := NewProcessingWorkflow(h, nil, nil, nil, registry, logr.Discard(), Config{
+		Receipts: receipt.Config{
+			Hook: []receipt.HookConfig{{Name: "catalog", Type: receipt.TypeHTTPJSON, URL: "http://127.0.0.1/receipts"}},
+		},
+	})
+
+	replayer := temporalsdk_worker.NewWorkflowReplayer()
+	replayer.RegisterWorkflowWithOptions(w.Execute, temporalsdk_workflow.RegisterOptions{Name: collection.ProcessingWorkflowName})
+
+	err = replayer.ReplayWorkflowHistoryFromJSONFile(nil, "testdata/processing_retention_handoff.json")
+	assert.NilError(t, err)
+}
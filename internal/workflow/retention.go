@@ -0,0 +1,63 @@
+package workflow
+
+import (
+	"fmt"
+
+	temporalsdk_workflow "go.temporal.io/sdk/workflow"
+
+	"github.com/artefactual-labs/enduro/internal/collection"
+	"github.com/artefactual-labs/enduro/internal/workflow/activities"
+)
+
+// retentionSchedulerChangeID versions the move of the retention timer to the
+// retention scheduler so executions waiting on the timer can be replayed.
+const retentionSchedulerChangeID = "retention-scheduler"
+
+// scheduleRetention records the deletion of the original in the retention
+// scheduler. Executions started before the scheduler was introduced keep
+// waiting on their own timer unless they're handed over to the scheduler.
+func (w *ProcessingWorkflow) scheduleRetention(ctx temporalsdk_workflow.Context, tinfo *TransferInfo) error {
+	logger := temporalsdk_workflow.GetLogger(ctx)
+	dueAt := temporalsdk_workflow.Now(ctx).Add(*tinfo.RetentionPeriod)
+
+	version := temporalsdk_workflow.GetVersion(ctx, retentionSchedulerChangeID, temporalsdk_workflow.DefaultVersion, 1)
+	if version == temporalsdk_workflow.DefaultVersion {
+		var (
+			handoff bool
+			err     error
+		)
+		selector := temporalsdk_workflow.NewSelector(ctx)
+		selector.AddFuture(temporalsdk_workflow.NewTimer(ctx, *tinfo.RetentionPeriod), func(f temporalsdk_workflow.Future) {
+			err = f.Get(ctx, nil)
+		})
+		selector.AddReceive(temporalsdk_workflow.GetSignalChannel(ctx, collection.ProcessingWorkflowRetentionHandoffSignalName), func(c temporalsdk_workflow.ReceiveChannel, more bool) {
+			c.Receive(ctx, nil)
+			handoff = true
+		})
+		selector.Select(ctx)
+
+		if !handoff {
+			if err != nil {
+				logger.Warn("Retention policy timer failed", "error", err)
+			} else {
+				activityOpts := withActivityOptsForRequest(ctx)
+				_ = temporalsdk_workflow.ExecuteActivity(activityOpts, activities.DeleteOriginalActivityName, tinfo.WatcherName, tinfo.BatchDir, tinfo.Key, tinfo.CollectionID).Get(activityOpts, nil)
+			}
+			return nil
+		}
+	}
+
+	activityOpts := withLocalActivityOpts(ctx)
+	err := temporalsdk_workflow.ExecuteLocalActivity(activityOpts, scheduleRetentionLocalActivity, w.logger, w.retsvc, &scheduleRetentionLocalActivityParams{
+		CollectionID: tinfo.CollectionID,
+		WatcherName:  tinfo.WatcherName,
+		BatchDir:     tinfo.BatchDir,
+		Key:          tinfo.Key,
+		DueAt:        dueAt,
+	}).Get(activityOpts, nil)
+	if err != nil {
+		return fmt.Errorf("error scheduling deletion of the original: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package workflow
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	temporalsdk_activity "go.temporal.io/sdk/activity"
+	temporalsdk_testsuite "go.temporal.io/sdk/testsuite"
+	temporalsdk_workflow "go.temporal.io/sdk/workflow"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/enduro/internal/collection"
+	"github.com/artefactual-labs/enduro/internal/retention"
+	"github.com/artefactual-labs/enduro/internal/workflow/activities"
+)
+
+type retentionRecorder struct {
+	retention.Service
+	scheduled []*retention.Deletion
+}
+
+func (r *retentionRecorder) Schedule(_ context.Context, deletion *retention.Deletion) error {
+	r.scheduled = append(r.scheduled, deletion)
+	return nil
+}
+
+func newRetentionTest(t *testing.T) (*temporalsdk_testsuite.TestWorkflowEnvironment, *retentionRecorder, *[]string) {
+	t.Helper()
+
+	ts := &temporalsdk_testsuite.WorkflowTestSuite{}
+	env := ts.NewTestWorkflowEnvironment()
+	retsvc := &retentionRecorder{}
+	deleted := &[]string{}
+	env.RegisterActivityWithOptions(
+		func(_ context.Context, watcherName, batchDir, key string, collectionID uint) error {
+			*deleted = append(*deleted, key)
+			return nil
+		},
+		temporalsdk_activity.RegisterOptions{Name: activities.DeleteOriginalActivityName},
+	)
+
+	w := NewProcessingWorkflow(nil, nil, retsvc, nil, nil, logr.Discard(), Config{})
+	env.RegisterWorkflowWithOptions(
+		func(ctx temporalsdk_workflow.Context, tinfo *TransferInfo) error {
+			return w.scheduleRetention(ctx, tinfo)
+		},
+		temporalsdk_workflow.RegisterOptions{Name: "retention-test"},
+	)
+
+	return env, retsvc, deleted
+}
+
+func TestScheduleRetention(t *testing.T) {
+	t.Parallel()
+
+	retentionPeriod := time.Hour
+	tinfo := &TransferInfo{
+		CollectionID:    12,
+		WatcherName:     "minio",
+		Key:             "a.zip",
+		RetentionPeriod: &retentionPeriod,
+	}
+
+	t.Run("Schedules the deletion of the original", func(t *testing.T) {
+		t.Parallel()
+
+		env, retsvc, deleted := newRetentionTest(t)
+		start := env.Now()
+		env.ExecuteWorkflow("retention-test", tinfo)
+
+		assert.Assert(t, env.IsWorkflowCompleted())
+		assert.NilError(t, env.GetWorkflowError())
+		assert.Equal(t, len(*deleted), 0)
+		assert.Equal(t, len(retsvc.scheduled), 1)
+		assert.Equal(t, retsvc.scheduled[0].CollectionID, uint(12))
+		assert.Equal(t, retsvc.scheduled[0].Key, "a.zip")
+		assert.Equal(t, retsvc.scheduled[0].DueAt.Sub(start), time.Hour)
+	})
+
+	t.Run("Legacy executions delete the original when the timer fires", func(t *testing.T) {
+		t.Parallel()
+
+		env, retsvc, deleted := newRetentionTest(t)
+		env.OnGetVersion(retentionSchedulerChangeID, temporalsdk_workflow.DefaultVersion, 1).Return(temporalsdk_workflow.DefaultVersion)
+		env.ExecuteWorkflow("retention-test", tinfo)
+
+		assert.Assert(t, env.IsWorkflowCompleted())
+		assert.NilError(t, env.GetWorkflowError())
+		assert.DeepEqual(t, *deleted, []string{"a.zip"})
+		assert.Equal(t, len(retsvc.scheduled), 0)
+	})
+
+	t.Run("Legacy executions hand the deletion over when signaled", func(t *testing.T) {
+		t.Parallel()
+
+		env, retsvc, deleted := newRetentionTest(t)
+		start := env.Now()
+		env.OnGetVersion(retentionSchedulerChangeID, temporalsdk_workflow.DefaultVersion, 1).Return(temporalsdk_workflow.DefaultVersion)
+		env.RegisterDelayedCallback(func() {
+			env.SignalWorkflow(collection.ProcessingWorkflowRetentionHandoffSignalName, nil)
+		}, time.Minute)
+		env.ExecuteWorkflow("retention-test", tinfo)
+
+		assert.Assert(t, env.IsWorkflowCompleted())
+		assert.NilError(t, env.GetWorkflowError())
+		assert.Equal(t, len(*deleted), 0)
+		assert.Equal(t, len(retsvc.scheduled), 1)
+		assert.Equal(t, retsvc.scheduled[0].DueAt.Sub(start), time.Hour)
+	})
+}
@@ -2,10 +2,12 @@ package workflow
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
 
+	temporalsdk_activity "go.temporal.io/sdk/activity"
 	temporalsdk_temporal "go.temporal.io/sdk/temporal"
 	temporalsdk_workflow "go.temporal.io/sdk/workflow"
 
@@ -15,6 +17,10 @@ import (
 	"github.com/artefactual-labs/enduro/internal/workflow/activities"
 )
 
+// pipelineLeaseChangeID versions the renewal of the pipeline leases so
+// executions that acquired the pipeline before can be replayed.
+const pipelineLeaseChangeID = "pipeline-lease"
+
 type releaser func(ctx temporalsdk_workflow.Context) error
 
 var noopReleaser = releaser(func(ctx temporalsdk_workflow.Context) error {
@@ -23,8 +29,11 @@ var noopReleaser = releaser(func(ctx temporalsdk_workflow.Context) error {
 
 // acquirePipeline acquires the pipeline semaphore. It returns a releaser that
 // users should execute. It's safe to execute more than once or when the acquire
-// operation failed (no-op).
-func acquirePipeline(ctx temporalsdk_workflow.Context, colsvc collection.Service, pipelineRegistry *pipeline.Registry, pipelineName string, colID uint, heartBeatTimeout time.Duration) (bool, releaser, error) {
+// operation failed (no-op). When reroute is set, a draining pipeline is not
+// waited for and the error can be checked with activities.IsPipelineDrainingError.
+// onLeaseLost is called when the lease of the pipeline is lost and it can't be
+// acquired again, the caller must stop using the pipeline.
+func acquirePipeline(ctx temporalsdk_workflow.Context, colsvc collection.Service, pipelineRegistry *pipeline.Registry, pipelineName string, reroute bool, colID uint, heartBeatTimeout time.Duration, onLeaseLost func()) (bool, releaser, error) {
 	var acquired bool
 
 	// The releaser defaults to a no-op operation, a nil value would panic.
@@ -41,13 +50,16 @@ func acquirePipeline(ctx temporalsdk_workflow.Context, colsvc collection.Service
 				MaximumAttempts: 1,
 			},
 		})
-		if err := temporalsdk_workflow.ExecuteActivity(ctx, activities.AcquirePipelineActivityName, pipelineName).Get(ctx, nil); err != nil {
+		if err := temporalsdk_workflow.ExecuteActivity(ctx, activities.AcquirePipelineActivityName, pipelineName, reroute).Get(ctx, nil); err != nil {
 			return acquired, relfn, fmt.Errorf("error acquiring pipeline: %w", err)
 		}
 
 		acquired = true
 	}
 
+	// Keep the lease of the pipeline while it is held.
+	stopRenewal := renewPipelineLease(ctx, pipelineRegistry, pipelineName, onLeaseLost)
+
 	// Create the function that releases the pipeline that we've just acquired.
 	var once sync.Once
 	relfn = func(ctx temporalsdk_workflow.Context) error {
@@ -56,6 +68,7 @@ func acquirePipeline(ctx temporalsdk_workflow.Context, colsvc collection.Service
 		}
 		var err error
 		once.Do(func() {
+			stopRenewal()
 			ctx = withLocalActivityWithoutRetriesOpts(ctx)
 			ctx, _ = temporalsdk_workflow.NewDisconnectedContext(ctx)
 			err = temporalsdk_workflow.ExecuteLocalActivity(ctx, releasePipelineLocalActivity, pipelineRegistry, pipelineName).Get(ctx, nil)
@@ -69,7 +82,7 @@ func acquirePipeline(ctx temporalsdk_workflow.Context, colsvc collection.Service
 	// Set in-progress status.
 	{
 		ctx := withLocalActivityOpts(ctx)
-		err := temporalsdk_workflow.ExecuteLocalActivity(ctx, setStatusInProgressLocalActivity, colsvc, colID, time.Now().UTC()).Get(ctx, nil)
+		err := temporalsdk_workflow.ExecuteLocalActivity(ctx, setStatusInProgressLocalActivity, colsvc, colID, time.Now().UTC(), collection.StatusTransitionCause{}).Get(ctx, nil)
 		if err != nil {
 			return acquired, relfn, fmt.Errorf("error updating collection status: %w", err)
 		}
@@ -78,13 +91,90 @@ func acquirePipeline(ctx temporalsdk_workflow.Context, colsvc collection.Service
 	return acquired, relfn, nil
 }
 
+// renewPipelineLease renews the lease of the pipeline periodically until the
+// returned function is called. A lease that expired is acquired again when the
+// pipeline has a free slot, onLost is called otherwise because another
+// workflow may be using the slot. It's a no-op when the pipelines do not use
+// leases.
+//
+// Every renewal adds a timer and a local activity marker to the history of the
+// workflow, i.e. about 430 renewals a day with the default TTL of ten minutes.
+func renewPipelineLease(ctx temporalsdk_workflow.Context, pipelineRegistry *pipeline.Registry, pipelineName string, onLost func()) temporalsdk_workflow.CancelFunc {
+	version := temporalsdk_workflow.GetVersion(ctx, pipelineLeaseChangeID, temporalsdk_workflow.DefaultVersion, 3)
+	if version == temporalsdk_workflow.DefaultVersion {
+		return func() {}
+	}
+
+	// The TTL is recorded in the history so the renewals are replayed even
+	// when the configuration of the pipelines changed since. Executions that
+	// recorded an earlier version read it from the configuration.
+	ttl := pipelineRegistry.LeaseTTL()
+	if version > 2 {
+		encoded := temporalsdk_workflow.SideEffect(ctx, func(ctx temporalsdk_workflow.Context) any {
+			return pipelineRegistry.LeaseTTL()
+		})
+		if err := encoded.Get(&ttl); err != nil {
+			temporalsdk_workflow.GetLogger(ctx).Warn("Error reading pipeline lease TTL.", "pipeline", pipelineName, "err", err)
+			return func() {}
+		}
+	}
+	if ttl <= 0 {
+		return func() {}
+	}
+
+	ctx, cancel := temporalsdk_workflow.WithCancel(ctx)
+	temporalsdk_workflow.Go(ctx, func(ctx temporalsdk_workflow.Context) {
+		logger := temporalsdk_workflow.GetLogger(ctx)
+		for {
+			if err := temporalsdk_workflow.Sleep(ctx, ttl/3); err != nil {
+				return
+			}
+			activityOpts := withLocalActivityWithoutRetriesOpts(ctx)
+			var held bool
+			if err := temporalsdk_workflow.ExecuteLocalActivity(activityOpts, renewPipelineLocalActivity, pipelineRegistry, pipelineName).Get(activityOpts, &held); err != nil {
+				logger.Warn("Error renewing pipeline lease.", "pipeline", pipelineName, "err", err)
+				continue
+			}
+			// Executions that recorded the first version only logged lost
+			// leases.
+			if !held && version > 1 {
+				logger.Error("Pipeline lease lost.", "pipeline", pipelineName)
+				if onLost != nil {
+					onLost()
+				}
+				return
+			}
+		}
+	})
+
+	return cancel
+}
+
 func releasePipelineLocalActivity(ctx context.Context, registry *pipeline.Registry, pipelineName string) error {
 	p, err := registry.ByName(pipelineName)
 	if err != nil {
 		return temporal.NewNonRetryableError(err)
 	}
 
-	p.Release()
+	return p.ReleaseLease(ctx, temporalsdk_activity.GetInfo(ctx).WorkflowExecution.ID)
+}
 
-	return nil
+// renewPipelineLocalActivity renews the lease of the workflow, or acquires it
+// again when it expired. It reports whether the workflow holds the lease.
+func renewPipelineLocalActivity(ctx context.Context, registry *pipeline.Registry, pipelineName string) (bool, error) {
+	p, err := registry.ByName(pipelineName)
+	if err != nil {
+		return false, temporal.NewNonRetryableError(err)
+	}
+
+	holder := temporalsdk_activity.GetInfo(ctx).WorkflowExecution.ID
+	err = p.RenewLease(ctx, holder)
+	if errors.Is(err, pipeline.ErrLeaseNotFound) {
+		return p.Acquire(ctx, holder)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
 }
@@ -1,6 +1,7 @@
 package workflow
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -44,7 +45,7 @@ func TestSemaphoreAcquireRelease(t *testing.T) {
 
 	env.RegisterWorkflowWithOptions(
 		func(ctx temporalsdk_workflow.Context) error {
-			acquired, release, err := acquirePipeline(ctx, colsvc, registry, "am1", 12345, time.Minute*1)
+			acquired, release, err := acquirePipeline(ctx, colsvc, registry, "am1", false, 12345, time.Minute*1, nil)
 			assert.Nil(t, err)
 			assert.Equal(t, acquired, true)
 
@@ -70,3 +71,193 @@ func TestSemaphoreAcquireRelease(t *testing.T) {
 	assert.True(t, env.IsWorkflowCompleted())
 	assert.Nil(t, env.GetWorkflowError())
 }
+
+type countingLeaseStore struct {
+	acquired, renewed, released []string
+
+	// expired makes renewals fail as if the lease expired, and taken makes
+	// the acquisitions that follow the first one fail.
+	expired, taken bool
+}
+
+func (s *countingLeaseStore) Acquire(ctx context.Context, pipeline, holder string, capacity int64, ttl time.Duration) (bool, error) {
+	s.acquired = append(s.acquired, holder)
+	return !s.taken || len(s.acquired) == 1, nil
+}
+
+func (s *countingLeaseStore) Renew(ctx context.Context, name, holder string, ttl time.Duration) error {
+	s.renewed = append(s.renewed, holder)
+	if s.expired {
+		return pipeline.ErrLeaseNotFound
+	}
+	return nil
+}
+
+func (s *countingLeaseStore) Release(ctx context.Context, pipeline, holder string) error {
+	s.released = append(s.released, holder)
+	return nil
+}
+
+func (s *countingLeaseStore) Holders(ctx context.Context, pipeline string) ([]pipeline.Lease, error) {
+	return nil, nil
+}
+
+func TestSemaphoreLeaseRenewal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	wts := temporalsdk_testsuite.WorkflowTestSuite{}
+	env := wts.NewTestWorkflowEnvironment()
+
+	colsvc := collectionfake.NewMockService(ctrl)
+	colsvc.
+		EXPECT().
+		SetStatusInProgress(mockutil.Context(), gomock.Eq(uint(12345)), mockutil.Recent()).
+		Return(nil)
+
+	store := &countingLeaseStore{}
+	registry, _ := pipeline.NewPipelineRegistry(logr.Discard(), []pipeline.Config{{Name: "am1", Capacity: 1}}, nil, nil)
+	registry.SetLeases(store, time.Minute*3)
+	env.RegisterActivityWithOptions(
+		activities.NewAcquirePipelineActivity(registry).Execute,
+		temporalsdk_activity.RegisterOptions{
+			Name: activities.AcquirePipelineActivityName,
+		},
+	)
+
+	env.RegisterWorkflowWithOptions(
+		func(ctx temporalsdk_workflow.Context) error {
+			_, release, err := acquirePipeline(ctx, colsvc, registry, "am1", false, 12345, time.Minute*1, nil)
+			if err != nil {
+				return err
+			}
+
+			// The lease is renewed every minute while the pipeline is held.
+			if err := temporalsdk_workflow.Sleep(ctx, time.Minute*3+time.Second*30); err != nil {
+				return err
+			}
+
+			return release(ctx)
+		},
+		temporalsdk_workflow.RegisterOptions{
+			Name: "workflow",
+		},
+	)
+
+	env.ExecuteWorkflow("workflow")
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.Nil(t, env.GetWorkflowError())
+	assert.Equal(t, store.acquired, []string{"default-test-workflow-id"})
+	assert.Equal(t, store.renewed, []string{"default-test-workflow-id", "default-test-workflow-id", "default-test-workflow-id"})
+	assert.Equal(t, store.released, []string{"default-test-workflow-id"})
+}
+
+func TestSemaphoreLeaseLost(t *testing.T) {
+	tests := map[string]struct {
+		taken    bool
+		wantLost bool
+	}{
+		"Acquires the expired lease again": {
+			taken:    false,
+			wantLost: false,
+		},
+		"Reports the lease lost when the slot was taken": {
+			taken:    true,
+			wantLost: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			wts := temporalsdk_testsuite.WorkflowTestSuite{}
+			env := wts.NewTestWorkflowEnvironment()
+
+			colsvc := collectionfake.NewMockService(ctrl)
+			colsvc.
+				EXPECT().
+				SetStatusInProgress(mockutil.Context(), gomock.Eq(uint(12345)), mockutil.Recent()).
+				Return(nil)
+
+			store := &countingLeaseStore{expired: true, taken: tc.taken}
+			registry, _ := pipeline.NewPipelineRegistry(logr.Discard(), []pipeline.Config{{Name: "am1", Capacity: 1}}, nil, nil)
+			registry.SetLeases(store, time.Minute*3)
+			env.RegisterActivityWithOptions(
+				activities.NewAcquirePipelineActivity(registry).Execute,
+				temporalsdk_activity.RegisterOptions{
+					Name: activities.AcquirePipelineActivityName,
+				},
+			)
+
+			var lost bool
+			env.RegisterWorkflowWithOptions(
+				func(ctx temporalsdk_workflow.Context) error {
+					_, release, err := acquirePipeline(ctx, colsvc, registry, "am1", false, 12345, time.Minute*1, func() { lost = true })
+					if err != nil {
+						return err
+					}
+
+					if err := temporalsdk_workflow.Sleep(ctx, time.Minute*1+time.Second*30); err != nil {
+						return err
+					}
+
+					return release(ctx)
+				},
+				temporalsdk_workflow.RegisterOptions{
+					Name: "workflow",
+				},
+			)
+
+			env.ExecuteWorkflow("workflow")
+
+			assert.True(t, env.IsWorkflowCompleted())
+			assert.Nil(t, env.GetWorkflowError())
+			assert.Equal(t, lost, tc.wantLost)
+			assert.Equal(t, len(store.acquired), 2)
+		})
+	}
+}
+
+func TestSemaphoreDrainingPipeline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	wts := temporalsdk_testsuite.WorkflowTestSuite{}
+	env := wts.NewTestWorkflowEnvironment()
+
+	colsvc := collectionfake.NewMockService(ctrl)
+
+	registry, _ := pipeline.NewPipelineRegistry(logr.Discard(), []pipeline.Config{{Name: "am1", Capacity: 1}}, nil, nil)
+	p, _ := registry.ByName("am1")
+	assert.NoError(t, p.SetState(context.Background(), pipeline.StateDraining))
+	env.RegisterActivityWithOptions(
+		activities.NewAcquirePipelineActivity(registry).Execute,
+		temporalsdk_activity.RegisterOptions{
+			Name: activities.AcquirePipelineActivityName,
+		},
+	)
+
+	env.RegisterWorkflowWithOptions(
+		func(ctx temporalsdk_workflow.Context) error {
+			acquired, release, err := acquirePipeline(ctx, colsvc, registry, "am1", true, 12345, time.Minute*1, nil)
+			assert.True(t, activities.IsPipelineDrainingError(err))
+			assert.Equal(t, acquired, false)
+			assert.NoError(t, release(ctx))
+
+			_, cur := p.Capacity()
+			assert.Equal(t, cur, int64(0))
+
+			return nil
+		},
+		temporalsdk_workflow.RegisterOptions{
+			Name: "workflow",
+		},
+	)
+
+	env.ExecuteWorkflow("workflow")
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.Nil(t, env.GetWorkflowError())
+}
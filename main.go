@@ -46,8 +46,11 @@ import (
 	"github.com/artefactual-labs/enduro/internal/db"
 	"github.com/artefactual-labs/enduro/internal/metadata"
 	nha_activities "github.com/artefactual-labs/enduro/internal/nha/activities"
+	"github.com/artefactual-labs/enduro/internal/notification"
 	"github.com/artefactual-labs/enduro/internal/objectevent"
 	"github.com/artefactual-labs/enduro/internal/pipeline"
+	"github.com/artefactual-labs/enduro/internal/receipt"
+	"github.com/artefactual-labs/enduro/internal/retention"
 	"github.com/artefactual-labs/enduro/internal/temporal"
 	"github.com/artefactual-labs/enduro/internal/validation"
 	"github.com/artefactual-labs/enduro/internal/watcher"
@@ -172,6 +175,14 @@ func main() {
 		logger.Error(err, "Pipeline registry cannot be initialized.")
 		os.Exit(1)
 	}
+	if err := pipelineRegistry.SetRouting(config.Routing); err != nil {
+		logger.Error(err, "Pipeline routing cannot be initialized.")
+		os.Exit(1)
+	}
+	if config.Worker.SharedPipelines {
+		pipelineRegistry.SetLeases(pipeline.NewLeaseStore(database, workerIdentity()), config.Worker.PipelineLeaseTTL)
+		pipelineRegistry.SetStateStore(pipeline.NewStateStore(database))
+	}
 
 	// Set up the pipeline service.
 	var pipesvc pipeline.Service
@@ -185,10 +196,10 @@ func main() {
 		batchsvc = batch.NewService(logger.WithName("batch"), temporalClient, config.Temporal.TaskQueue, config.Watcher.CompletedDirs(), config.Batch)
 	}
 
-	// Set up the collection service.
-	var colsvc collection.Service
+	// Set up the notification service.
+	var notificationsvc notification.Service
 	{
-		colsvc = collection.NewService(logger.WithName("collection"), database, temporalClient, config.Temporal.TaskQueue, pipelineRegistry)
+		notificationsvc = notification.NewService(logger.WithName("notification"), database, temporalClient, config.Temporal.TaskQueue, config.Notifications)
 	}
 
 	// Set up the watcher service.
@@ -201,6 +212,21 @@ func main() {
 		}
 	}
 
+	// Set up the retention service.
+	var retentionsvc retention.Service
+	{
+		retentionsvc = retention.NewService(logger.WithName("retention"), database, temporalClient, config.Temporal.TaskQueue, config.Retention)
+		if err := retentionsvc.RegisterSchedule(ctx); err != nil {
+			logger.Error(err, "Error registering retention schedule.")
+		}
+	}
+
+	// Set up the collection service.
+	var colsvc collection.Service
+	{
+		colsvc = collection.NewService(logger.WithName("collection"), database, temporalClient, config.Temporal.TaskQueue, pipelineRegistry, notificationsvc, wsvc, retentionsvc)
+	}
+
 	var g run.Group
 
 	// API server.
@@ -256,6 +282,56 @@ func main() {
 		)
 	}
 
+	// Starts the processing workflow of a blob received by a watcher.
+	dispatch := func(ctx context.Context, event *watcher.BlobEvent) error {
+		ctx, span := tracer.Start(ctx, "Watcher")
+		defer span.End()
+		span.SetAttributes(
+			attribute.String("watcher", event.WatcherName),
+			attribute.String("bucket", event.Bucket),
+			attribute.String("key", event.Key),
+			attribute.Bool("dir", event.IsDir),
+		)
+		logger.V(1).Info(
+			"Starting new workflow",
+			"watcher", event.WatcherName,
+			"bucket", event.Bucket,
+			"key", event.Key,
+			"dir", event.IsDir,
+			"pipelines", event.PipelineName,
+		)
+		req := collection.ProcessingWorkflowRequest{
+			WatcherName:        event.WatcherName,
+			PipelineNames:      event.PipelineName,
+			Route:              true,
+			RetentionPeriod:    event.RetentionPeriod,
+			CompletedDir:       event.CompletedDir,
+			StripTopLevelDir:   event.StripTopLevelDir,
+			RejectDuplicates:   event.RejectDuplicates,
+			ExcludeHiddenFiles: event.ExcludeHiddenFiles,
+			TransferType:       event.TransferType,
+			Key:                event.Key,
+			IsDir:              event.IsDir,
+			ValidationConfig:   config.Validation,
+			MetadataConfig:     config.Metadata,
+		}
+
+		timeout := config.Workflow.InitProcessingTimeout
+		if timeout == 0 {
+			timeout = collection.DefaultInitProcessingWorkflowTimeout
+		}
+		if err := collection.InitProcessingWorkflowWithTimeout(ctx, tracer, temporalClient, &req, timeout); err != nil {
+			return err
+		}
+
+		// Rescans would dispatch the blob again once its collection is deleted.
+		if err := colsvc.RecordDispatch(ctx, event.WatcherName, event.Key); err != nil {
+			logger.Error(err, "Error recording dispatch.", "watcher", event.WatcherName, "key", event.Key)
+		}
+
+		return nil
+	}
+
 	// Watchers, where each watcher is a group actor.
 	{
 		for _, w := range wsvc.Watchers() {
@@ -274,45 +350,54 @@ func main() {
 								}
 								continue
 							}
-							ctx, span := tracer.Start(ctx, "Watcher")
-							span.SetAttributes(
-								attribute.String("watcher", event.WatcherName),
-								attribute.String("bucket", event.Bucket),
-								attribute.String("key", event.Key),
-								attribute.Bool("dir", event.IsDir),
-							)
-							pipelineName := workflow.RandomPipeline(event.PipelineName, pipelineRegistry)
-							logger.V(1).Info(
-								"Starting new workflow",
-								"watcher", event.WatcherName,
-								"bucket", event.Bucket,
-								"key", event.Key,
-								"dir", event.IsDir,
-								"pipeline", pipelineName,
-							)
-							req := collection.ProcessingWorkflowRequest{
-								WatcherName:        event.WatcherName,
-								PipelineName:       pipelineName,
-								RetentionPeriod:    event.RetentionPeriod,
-								CompletedDir:       event.CompletedDir,
-								StripTopLevelDir:   event.StripTopLevelDir,
-								RejectDuplicates:   event.RejectDuplicates,
-								ExcludeHiddenFiles: event.ExcludeHiddenFiles,
-								TransferType:       event.TransferType,
-								Key:                event.Key,
-								IsDir:              event.IsDir,
-								ValidationConfig:   config.Validation,
-								MetadataConfig:     config.Metadata,
+							if err := dispatch(ctx, event); err != nil {
+								logger.Error(err, "Error initializing processing workflow.")
+								// Return the event to the source so it's delivered again.
+								if err := event.Nack(); err != nil {
+									logger.Error(err, "Error returning event to the event source.", "watcher", event.WatcherName, "key", event.Key)
+								}
+							} else if err := event.Ack(); err != nil {
+								logger.Error(err, "Error acknowledging event.", "watcher", event.WatcherName, "key", event.Key)
 							}
+						}
+					}
+				},
+				func(err error) {
+					close(done)
+				},
+			)
+		}
+	}
 
-							timeout := config.Workflow.InitProcessingTimeout
-							if timeout == 0 {
-								timeout = collection.DefaultInitProcessingWorkflowTimeout
+	// Watcher rescans, where each watcher with a rescan interval is a group
+	// actor that dispatches the blobs missed by the event source.
+	{
+		for _, w := range wsvc.Watchers() {
+			interval := w.RescanInterval()
+			if interval <= 0 {
+				continue
+			}
+			done := make(chan struct{})
+			g.Add(
+				func() error {
+					ticker := time.NewTicker(interval)
+					defer ticker.Stop()
+					for {
+						select {
+						case <-done:
+							return nil
+						case <-ticker.C:
+							events, err := colsvc.Rescan(ctx, w.String())
+							if err != nil {
+								logger.Error(err, "Error rescanning watcher.", "watcher", w)
+								continue
 							}
-							if err := collection.InitProcessingWorkflowWithTimeout(ctx, tracer, temporalClient, &req, timeout); err != nil {
-								logger.Error(err, "Error initializing processing workflow.")
+							for _, event := range events {
+								if err := dispatch(ctx, event); err != nil {
+									logger.Error(err, "Error initializing processing workflow.", "watcher", event.WatcherName, "key", event.Key)
+								}
 							}
-							span.End()
+							logger.V(1).Info("Watcher rescanned.", "watcher", w, "dispatched", len(events))
 						}
 					}
 				},
@@ -338,6 +423,8 @@ func main() {
 			colsvc,
 			wsvc,
 			batchsvc,
+			notificationsvc,
+			retentionsvc,
 			logger,
 			&g,
 		)
@@ -350,6 +437,8 @@ func main() {
 		colsvc,
 		wsvc,
 		batchsvc,
+		notificationsvc,
+		retentionsvc,
 		logger,
 		&g,
 	)
@@ -443,12 +532,15 @@ type configuration struct {
 	Batch              batch.Config
 	Watcher            watcher.Config
 	Pipeline           []pipeline.Config
+	Routing            pipeline.RoutingConfig
 	Validation         validation.Config
 	Telemetry          TelemetryConfig
 	Metadata           metadata.Config
 	Worker             WorkerConfig
 	Workflow           workflow.Config
 	ObjectEventWebhook objectevent.Config
+	Notifications      notification.Config
+	Retention          retention.Config
 
 	// This is a workaround for client-specific functionality.
 	// Simple mechanism to support an arbitrary number of hooks and parameters.
@@ -459,6 +551,23 @@ type WorkerConfig struct {
 	HeartbeatThrottleInterval            time.Duration
 	MaxConcurrentSessionExecutionSize    int
 	MaxConcurrentWorkflowsExecutionsSize int
+
+	// Share the pipeline capacity and maintenance state with the other
+	// instances through the database instead of keeping them in memory.
+	SharedPipelines bool
+
+	// Time a pipeline lease is kept without being renewed.
+	PipelineLeaseTTL time.Duration
+}
+
+// workerIdentity identifies this instance in the pipeline leases.
+func workerIdentity() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	return fmt.Sprintf("%d@%s", os.Getpid(), hostname)
 }
 
 func (c *configuration) Validate(baseDir string) error {
@@ -471,6 +580,24 @@ func (c *configuration) Validate(baseDir string) error {
 	if err := c.ObjectEventWebhook.Validate(); err != nil {
 		return err
 	}
+	if err := c.Validation.Validate(); err != nil {
+		return err
+	}
+	if err := c.Notifications.Validate(); err != nil {
+		return err
+	}
+	if err := c.Workflow.Receipts.Validate(); err != nil {
+		return err
+	}
+	if err := c.Workflow.Decision.Validate(); err != nil {
+		return fmt.Errorf("invalid workflow decision configuration: %v", err)
+	}
+	if err := c.Retention.Validate(); err != nil {
+		return err
+	}
+	if err := c.Routing.Validate(c.Pipeline); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -484,6 +611,8 @@ func configureViper(v *viper.Viper) {
 	v.SetDefault("api.listen", "127.0.0.1:9000")
 	v.SetDefault("api.allowedOrigins", []string{"*"})
 	v.SetDefault("api.contentSecurityPolicy", "")
+	v.SetDefault("api.auth.rolesClaim", "roles")
+	v.SetDefault("api.auth.usernameClaim", "sub")
 	v.Set("api.appVersion", version)
 	v.SetDefault("database.autoMigrate", true)
 	v.SetDefault("objectEventWebhook.listen", "127.0.0.1:7480")
@@ -590,6 +719,8 @@ func registerWorker(
 	colsvc collection.Service,
 	wsvc watcher.Service,
 	batchsvc batch.Service,
+	notificationsvc notification.Service,
+	retentionsvc retention.Service,
 	logger logr.Logger,
 	g *run.Group,
 ) {
@@ -605,7 +736,7 @@ func registerWorker(
 		DefaultHeartbeatThrottleInterval:       config.Worker.HeartbeatThrottleInterval,
 	})
 
-	w.RegisterWorkflowWithOptions(workflow.NewProcessingWorkflow(h, colsvc, pipelineRegistry, logger, config.Workflow).Execute, temporalsdk_workflow.RegisterOptions{Name: collection.ProcessingWorkflowName})
+	w.RegisterWorkflowWithOptions(workflow.NewProcessingWorkflow(h, colsvc, retentionsvc, wsvc, pipelineRegistry, logger, config.Workflow).Execute, temporalsdk_workflow.RegisterOptions{Name: collection.ProcessingWorkflowName})
 	w.RegisterActivityWithOptions(activities.NewAcquirePipelineActivity(pipelineRegistry).Execute, temporalsdk_activity.RegisterOptions{Name: activities.AcquirePipelineActivityName})
 	w.RegisterActivityWithOptions(activities.NewDownloadActivity(h, pipelineRegistry, wsvc).Execute, temporalsdk_activity.RegisterOptions{Name: activities.DownloadActivityName})
 	w.RegisterActivityWithOptions(archiveextract.New(config.ExtractActivity).Execute, temporalsdk_activity.RegisterOptions{Name: archiveextract.Name})
@@ -614,17 +745,18 @@ func registerWorker(
 	w.RegisterActivityWithOptions(activities.NewPublishTransferActivity(pipelineRegistry).Execute, temporalsdk_activity.RegisterOptions{Name: activities.PublishTransferActivityName})
 	w.RegisterActivityWithOptions(activities.NewCleanUpPublishedTransferActivity(pipelineRegistry).Execute, temporalsdk_activity.RegisterOptions{Name: activities.CleanUpPublishedActivityName})
 	w.RegisterActivityWithOptions(activities.NewTransferActivity(pipelineRegistry).Execute, temporalsdk_activity.RegisterOptions{Name: activities.TransferActivityName})
-	w.RegisterActivityWithOptions(activities.NewPollTransferActivity(pipelineRegistry).Execute, temporalsdk_activity.RegisterOptions{Name: activities.PollTransferActivityName})
-	w.RegisterActivityWithOptions(activities.NewPollIngestActivity(pipelineRegistry).Execute, temporalsdk_activity.RegisterOptions{Name: activities.PollIngestActivityName})
+	w.RegisterActivityWithOptions(activities.NewPollTransferActivity(pipelineRegistry, colsvc, colsvc).Execute, temporalsdk_activity.RegisterOptions{Name: activities.PollTransferActivityName})
+	w.RegisterActivityWithOptions(activities.NewPollIngestActivity(pipelineRegistry, colsvc, colsvc).Execute, temporalsdk_activity.RegisterOptions{Name: activities.PollIngestActivityName})
 	w.RegisterActivityWithOptions(activities.NewReconcileStorageActivity(pipelineRegistry).Execute, temporalsdk_activity.RegisterOptions{Name: activities.ReconcileStorageActivityName})
 	w.RegisterActivityWithOptions(activities.NewCleanUpActivity().Execute, temporalsdk_activity.RegisterOptions{Name: activities.CleanUpActivityName})
 	w.RegisterActivityWithOptions(activities.NewHidePackageActivity(pipelineRegistry).Execute, temporalsdk_activity.RegisterOptions{Name: activities.HidePackageActivityName})
-	w.RegisterActivityWithOptions(activities.NewDeleteOriginalActivity(wsvc).Execute, temporalsdk_activity.RegisterOptions{Name: activities.DeleteOriginalActivityName})
-	w.RegisterActivityWithOptions(activities.NewDisposeOriginalActivity(wsvc).Execute, temporalsdk_activity.RegisterOptions{Name: activities.DisposeOriginalActivityName})
+	w.RegisterActivityWithOptions(activities.NewDeleteOriginalActivity(wsvc, colsvc).Execute, temporalsdk_activity.RegisterOptions{Name: activities.DeleteOriginalActivityName})
+	w.RegisterActivityWithOptions(activities.NewDisposeOriginalActivity(wsvc, colsvc).Execute, temporalsdk_activity.RegisterOptions{Name: activities.DisposeOriginalActivityName})
 	w.RegisterActivityWithOptions(activities.NewPopulateMetadataActivity(pipelineRegistry).Execute, temporalsdk_activity.RegisterOptions{Name: activities.PopulateMetadataActivityName})
 
 	w.RegisterActivityWithOptions(nha_activities.NewUpdateHARIActivity(h).Execute, temporalsdk_activity.RegisterOptions{Name: nha_activities.UpdateHARIActivityName})
 	w.RegisterActivityWithOptions(nha_activities.NewUpdateProductionSystemActivity(h).Execute, temporalsdk_activity.RegisterOptions{Name: nha_activities.UpdateProductionSystemActivityName})
+	w.RegisterActivityWithOptions(receipt.NewSendActivity(config.Workflow.Receipts, colsvc, cleanhttp.DefaultPooledClient()).Execute, temporalsdk_activity.RegisterOptions{Name: receipt.SendActivityName})
 
 	w.RegisterWorkflowWithOptions(collection.BulkWorkflow, temporalsdk_workflow.RegisterOptions{Name: collection.BulkWorkflowName})
 	w.RegisterActivityWithOptions(collection.NewBulkActivity(colsvc).Execute, temporalsdk_activity.RegisterOptions{Name: collection.BulkActivityName})
@@ -632,6 +764,13 @@ func registerWorker(
 	w.RegisterWorkflowWithOptions(batch.BatchWorkflow, temporalsdk_workflow.RegisterOptions{Name: batch.BatchWorkflowName})
 	w.RegisterActivityWithOptions(batch.NewBatchActivity(batchsvc).Execute, temporalsdk_activity.RegisterOptions{Name: batch.BatchActivityName})
 
+	w.RegisterWorkflowWithOptions(notification.NewDeliveryWorkflow(notificationsvc).Execute, temporalsdk_workflow.RegisterOptions{Name: notification.DeliveryWorkflowName})
+	w.RegisterActivityWithOptions(notification.NewDeliveryActivity(notificationsvc, config.Notifications, cleanhttp.DefaultPooledClient()).Execute, temporalsdk_activity.RegisterOptions{Name: notification.DeliveryActivityName})
+
+	w.RegisterWorkflowWithOptions(retention.Workflow, temporalsdk_workflow.RegisterOptions{Name: retention.WorkflowName})
+	w.RegisterActivityWithOptions(retention.NewDueActivity(retentionsvc).Execute, temporalsdk_activity.RegisterOptions{Name: retention.DueActivityName})
+	w.RegisterActivityWithOptions(retention.NewCompleteActivity(retentionsvc).Execute, temporalsdk_activity.RegisterOptions{Name: retention.CompleteActivityName})
+
 	g.Add(
 		func() error {
 			if err := w.Start(); err != nil {